@@ -36,6 +36,18 @@ const (
 	// ClusterResourceSetResourcesNotAppliedReason is the reason used when applying at least one of the resources to one of the matching clusters failed.
 	ClusterResourceSetResourcesNotAppliedReason = "NotApplied"
 
+	// ClusterResourceSetResourcesNotRenderedReason is the reason used when rendering at least one of the resources
+	// for one of the matching clusters failed.
+	ClusterResourceSetResourcesNotRenderedReason = "NotRendered"
+
+	// ClusterResourceSetResourcesWaitingForWaveReason is the reason used when the rollout of the resources to one of the
+	// matching clusters is waiting for the health checks of a wave to pass.
+	ClusterResourceSetResourcesWaitingForWaveReason = "WaitingForWave"
+
+	// ClusterResourceSetResourcesNotPrunedReason is the reason used when deleting at least one of the objects removed from
+	// the resources from one of the matching clusters failed.
+	ClusterResourceSetResourcesNotPrunedReason = "NotPruned"
+
 	// ClusterResourceSetResourcesAppliedWrongSecretTypeReason is the reason used when the Secret's type in the resource list is not supported.
 	ClusterResourceSetResourcesAppliedWrongSecretTypeReason = "WrongSecretType"
 
@@ -61,6 +73,7 @@ type ClusterResourceSetSpec struct {
 	ClusterSelector metav1.LabelSelector `json:"clusterSelector,omitempty,omitzero"`
 
 	// resources is a list of Secrets/ConfigMaps where each contains 1 or more resources to be applied to remote clusters.
+	// Secrets can also contain compressed archives of manifests or packaged Helm charts, see ResourceRef.kind.
 	// +required
 	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
@@ -68,9 +81,88 @@ type ClusterResourceSetSpec struct {
 	Resources []ResourceRef `json:"resources,omitempty"`
 
 	// strategy is the strategy to be used during applying resources. Defaults to ApplyOnce. This field is immutable.
-	// +kubebuilder:validation:Enum=ApplyOnce;Reconcile
+	// +kubebuilder:validation:Enum=ApplyOnce;Reconcile;ApplyAndPrune
 	// +optional
 	Strategy string `json:"strategy,omitempty"`
+
+	// rendering defines how resources are rendered before being applied to a Cluster. Defaults to None.
+	// With GoTemplate, every manifest is rendered as a Go template using the target Cluster and its
+	// topology variables as data, e.g. {{ .Cluster.Name }} or {{ .Variables.myVariable }}.
+	// The hash of a resource is computed on the rendered manifests, so with the Reconcile strategy a
+	// change to a Cluster field used in a template triggers a re-apply.
+	// +kubebuilder:validation:Enum=None;GoTemplate
+	// +optional
+	Rendering string `json:"rendering,omitempty"`
+
+	// waves define an ordered rollout of resources to a Cluster. Resources of a wave are applied only after all
+	// the previous waves have been applied and their health checks passed in the Cluster, e.g. a wave with
+	// CustomResourceDefinitions, followed by a wave with an operator which must be Available, followed by the
+	// custom resources.
+	// Resources which are not part of any wave are applied after all the waves.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	Waves []ClusterResourceSetWave `json:"waves,omitempty"`
+}
+
+// ClusterResourceSetWave is a group of resources applied together to a Cluster.
+type ClusterResourceSetWave struct {
+	// name of the wave.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+
+	// resources is the list of resources applied in this wave; each resource must be included in
+	// ClusterResourceSet.spec.resources and can be part of only one wave.
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	Resources []ResourceRef `json:"resources,omitempty"`
+
+	// healthChecks is the list of checks which must pass in the Cluster after the resources of this wave have been applied,
+	// before applying the next wave.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	HealthChecks []ClusterResourceSetHealthCheck `json:"healthChecks,omitempty"`
+}
+
+// ClusterResourceSetHealthCheck checks the health of an object in a Cluster.
+type ClusterResourceSetHealthCheck struct {
+	// apiVersion of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// kind of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Kind string `json:"kind,omitempty"`
+
+	// namespace of the object, empty for cluster-scoped objects.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// name of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// conditionType is the type of a condition in status.conditions of the object which must have status True,
+	// e.g. Available for Deployments or Established for CustomResourceDefinitions.
+	// If not set, the object only has to exist.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	ConditionType string `json:"conditionType,omitempty"`
 }
 
 // ClusterResourceSetResourceKind is a string representation of a ClusterResourceSet resource kind.
@@ -80,6 +172,29 @@ type ClusterResourceSetResourceKind string
 const (
 	SecretClusterResourceSetResourceKind    ClusterResourceSetResourceKind = "Secret"
 	ConfigMapClusterResourceSetResourceKind ClusterResourceSetResourceKind = "ConfigMap"
+
+	// ManifestArchiveClusterResourceSetResourceKind is a Secret where each value is a gzip-compressed tar archive
+	// of yaml or json manifests.
+	ManifestArchiveClusterResourceSetResourceKind ClusterResourceSetResourceKind = "ManifestArchive"
+
+	// HelmChartClusterResourceSetResourceKind is a Secret containing a packaged Helm chart, which is rendered
+	// by the ClusterResourceSet controller.
+	HelmChartClusterResourceSetResourceKind ClusterResourceSetResourceKind = "HelmChart"
+)
+
+// Keys of the HelmChart resource Secret.
+const (
+	// HelmChartResourceChartKey is the key of the HelmChart resource Secret containing the packaged chart,
+	// e.g. the output of helm package.
+	HelmChartResourceChartKey = "chart"
+
+	// HelmChartResourceValuesKey is the key of the HelmChart resource Secret containing values in yaml format;
+	// values are merged into the default values of the chart.
+	HelmChartResourceValuesKey = "values.yaml"
+
+	// HelmChartResourceNamespaceKey is the key of the HelmChart resource Secret containing the namespace used to
+	// render the chart; if not set, the default namespace is used.
+	HelmChartResourceNamespaceKey = "namespace"
 )
 
 // ResourceRef specifies a resource.
@@ -91,11 +206,25 @@ type ResourceRef struct {
 	Name string `json:"name,omitempty"`
 
 	// kind of the resource. Supported kinds are: Secrets and ConfigMaps.
-	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// ManifestArchive and HelmChart are Secrets containing respectively gzip-compressed tar archives of manifests
+	// and a packaged Helm chart.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap;ManifestArchive;HelmChart
 	// +required
 	Kind string `json:"kind,omitempty"`
 }
 
+// ClusterResourceSetRendering is a string representation of a ClusterResourceSet Rendering.
+type ClusterResourceSetRendering string
+
+const (
+	// ClusterResourceSetRenderingNone applies manifests verbatim.
+	ClusterResourceSetRenderingNone ClusterResourceSetRendering = "None"
+
+	// ClusterResourceSetRenderingGoTemplate renders manifests as Go templates against the target Cluster
+	// before applying them.
+	ClusterResourceSetRenderingGoTemplate ClusterResourceSetRendering = "GoTemplate"
+)
+
 // ClusterResourceSetStrategy is a string representation of a ClusterResourceSet Strategy.
 type ClusterResourceSetStrategy string
 
@@ -106,6 +235,10 @@ const (
 	// ClusterResourceSetStrategyReconcile reapplies the resources managed by a ClusterResourceSet
 	// if their normalized hash changes.
 	ClusterResourceSetStrategyReconcile ClusterResourceSetStrategy = "Reconcile"
+	// ClusterResourceSetStrategyApplyAndPrune applies the resources managed by a ClusterResourceSet
+	// using server-side apply on every reconcile, thus reverting out-of-band changes to the fields it owns,
+	// and deletes objects that have been removed from the resources since the last apply.
+	ClusterResourceSetStrategyApplyAndPrune ClusterResourceSetStrategy = "ApplyAndPrune"
 )
 
 // SetTypedStrategy sets the Strategy field to the string representation of ClusterResourceSetStrategy.
@@ -41,6 +41,45 @@ type ResourceBinding struct {
 	// applied is to track if a resource is applied to the cluster or not.
 	// +required
 	Applied *bool `json:"applied,omitempty"`
+
+	// objects is the inventory of objects applied to the cluster from this resource.
+	// It is only tracked for the "ApplyAndPrune" ClusterResourceSet.spec.strategy, and it is used to
+	// delete objects from the cluster once they are removed from the resource.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=1000
+	Objects []ResourceBindingObject `json:"objects,omitempty"`
+}
+
+// ResourceBindingObject identifies an object applied to the cluster by a ClusterResourceSet.
+type ResourceBindingObject struct {
+	// group of the object.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Group string `json:"group,omitempty"`
+
+	// version of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Version string `json:"version,omitempty"`
+
+	// kind of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Kind string `json:"kind,omitempty"`
+
+	// namespace of the object, empty for cluster-scoped objects.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// name of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
 }
 
 // ResourceSetBinding keeps info on all of the resources in a ClusterResourceSet.
@@ -56,6 +95,43 @@ type ResourceSetBinding struct {
 	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=100
 	Resources []ResourceBinding `json:"resources,omitempty"`
+
+	// waves shows the rollout progress of the ClusterResourceSet waves to the cluster, in order.
+	// Waves which have not been reached yet are not listed.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	Waves []WaveBinding `json:"waves,omitempty"`
+}
+
+// WaveBinding shows the status of a ClusterResourceSet wave in the owner cluster of the ClusterResourceSetBinding object.
+type WaveBinding struct {
+	// name of the wave.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+
+	// ready is true when all the resources of the wave have been applied and its health checks passed.
+	// +required
+	Ready *bool `json:"ready,omitempty"`
+
+	// message explains why the wave is not ready.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+}
+
+// IsWaveReady returns true if the wave is ready in the cluster by checking the cluster's binding.
+func (r *ResourceSetBinding) IsWaveReady(name string) bool {
+	for _, wave := range r.Waves {
+		if wave.Name == name {
+			return deref(wave.Ready, false)
+		}
+	}
+	return false
 }
 
 // IsApplied returns true if the resource is applied to the cluster by checking the cluster's binding.
@@ -33,6 +33,18 @@ const (
 	// ApplyFailedV1Beta1Reason (Severity=Warning) documents applying at least one of the resources to one of the matching clusters is failed.
 	ApplyFailedV1Beta1Reason = "ApplyFailed"
 
+	// PruneFailedV1Beta1Reason (Severity=Warning) documents deleting at least one of the objects removed from the resources
+	// from one of the matching clusters is failed.
+	PruneFailedV1Beta1Reason = "PruneFailed"
+
+	// RenderingFailedV1Beta1Reason (Severity=Warning) documents rendering at least one of the resources for one of the
+	// matching clusters is failed.
+	RenderingFailedV1Beta1Reason = "RenderingFailed"
+
+	// WaitingForWaveV1Beta1Reason (Severity=Info) documents the rollout of the resources to one of the matching clusters
+	// is waiting for the health checks of a wave to pass.
+	WaitingForWaveV1Beta1Reason = "WaitingForWave"
+
 	// RetrievingResourceFailedV1Beta1Reason (Severity=Warning) documents at least one of the resources are not successfully retrieved.
 	RetrievingResourceFailedV1Beta1Reason = "RetrievingResourceFailed"
 
@@ -153,6 +153,21 @@ func (in *ClusterResourceSetDeprecatedStatus) DeepCopy() *ClusterResourceSetDepr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceSetHealthCheck) DeepCopyInto(out *ClusterResourceSetHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetHealthCheck.
+func (in *ClusterResourceSetHealthCheck) DeepCopy() *ClusterResourceSetHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceSetHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceSetList) DeepCopyInto(out *ClusterResourceSetList) {
 	*out = *in
@@ -194,6 +209,13 @@ func (in *ClusterResourceSetSpec) DeepCopyInto(out *ClusterResourceSetSpec) {
 		*out = make([]ResourceRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]ClusterResourceSetWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetSpec.
@@ -255,6 +277,31 @@ func (in *ClusterResourceSetV1Beta1DeprecatedStatus) DeepCopy() *ClusterResource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceSetWave) DeepCopyInto(out *ClusterResourceSetWave) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]ClusterResourceSetHealthCheck, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetWave.
+func (in *ClusterResourceSetWave) DeepCopy() *ClusterResourceSetWave {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceSetWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceBinding) DeepCopyInto(out *ResourceBinding) {
 	*out = *in
@@ -265,6 +312,11 @@ func (in *ResourceBinding) DeepCopyInto(out *ResourceBinding) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]ResourceBindingObject, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBinding.
@@ -277,6 +329,21 @@ func (in *ResourceBinding) DeepCopy() *ResourceBinding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingObject) DeepCopyInto(out *ResourceBindingObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingObject.
+func (in *ResourceBindingObject) DeepCopy() *ResourceBindingObject {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
 	*out = *in
@@ -302,6 +369,13 @@ func (in *ResourceSetBinding) DeepCopyInto(out *ResourceSetBinding) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]WaveBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSetBinding.
@@ -313,3 +387,23 @@ func (in *ResourceSetBinding) DeepCopy() *ResourceSetBinding {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WaveBinding) DeepCopyInto(out *WaveBinding) {
+	*out = *in
+	if in.Ready != nil {
+		in, out := &in.Ready, &out.Ready
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaveBinding.
+func (in *WaveBinding) DeepCopy() *WaveBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(WaveBinding)
+	in.DeepCopyInto(out)
+	return out
+}
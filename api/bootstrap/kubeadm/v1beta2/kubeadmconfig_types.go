@@ -36,6 +36,20 @@ const (
 	Ignition Format = "ignition"
 )
 
+const (
+	// EtcdRestoreScriptSecretAnnotation can be set on the KubeadmConfig of a control plane Machine with local etcd
+	// to restore etcd, e.g. from a snapshot, before running kubeadm init; the annotation value is the name of a Secret,
+	// in the namespace of the KubeadmConfig, with a bash script in the EtcdRestoreScriptSecretKey key.
+	// When this annotation is set, the bootstrap data runs kubeadm init even if the control plane of the Cluster
+	// is already initialized; the script runs after the pre kubeadm commands, with the path of the kubeadm
+	// configuration file as argument, and it must populate the etcd data directory of the Machine.
+	// If the script fails, kubeadm init is not run.
+	EtcdRestoreScriptSecretAnnotation = "bootstrap.cluster.x-k8s.io/etcd-restore-script-secret"
+
+	// EtcdRestoreScriptSecretKey is the key of the script in the Secret defined by EtcdRestoreScriptSecretAnnotation.
+	EtcdRestoreScriptSecretKey = "script"
+)
+
 // KubeadmConfigSpec defines the desired state of KubeadmConfig.
 // Either ClusterConfiguration and InitConfiguration should be defined or the JoinConfiguration should be defined.
 // +kubebuilder:validation:MinProperties=1
@@ -26,13 +26,23 @@ import (
 )
 
 // KubeadmControlPlaneRolloutStrategyType defines the rollout strategies for a KubeadmControlPlane.
-// +kubebuilder:validation:Enum=RollingUpdate
+// +kubebuilder:validation:Enum=RollingUpdate;ScaleDownFirst;OnDelete
 type KubeadmControlPlaneRolloutStrategyType string
 
 const (
 	// RollingUpdateStrategyType replaces the old control planes by new one using rolling update
 	// i.e. gradually scale up or down the old control planes and scale up or down the new one.
 	RollingUpdateStrategyType KubeadmControlPlaneRolloutStrategyType = "RollingUpdate"
+
+	// ScaleDownFirstStrategyType replaces the old control planes by new one deleting an old control plane
+	// before creating its replacement, i.e. without ever exceeding the desired number of control planes.
+	// An old control plane is deleted only if the remaining etcd members are enough to preserve quorum
+	// when the replacement joins; as a consequence this strategy requires at least 3 replicas.
+	ScaleDownFirstStrategyType KubeadmControlPlaneRolloutStrategyType = "ScaleDownFirst"
+
+	// OnDeleteStrategyType replaces the old control planes only when they are deleted by the user,
+	// i.e. a new control plane is created only after an old one has been deleted.
+	OnDeleteStrategyType KubeadmControlPlaneRolloutStrategyType = "OnDelete"
 )
 
 const (
@@ -46,6 +56,12 @@ const (
 	// SkipKubeProxyAnnotation annotation explicitly skips reconciling kube-proxy if set.
 	SkipKubeProxyAnnotation = "controlplane.cluster.x-k8s.io/skip-kube-proxy"
 
+	// AddonsKubernetesVersionAnnotation is set by KCP on the KubeadmControlPlane with the Kubernetes version
+	// cluster-critical addons, e.g. kube-proxy and CoreDNS, have been last upgraded to, either by KCP or
+	// by an UpgradeControlPlaneAddons extension.
+	// NOTE: KCP does not start the upgrade to a new Kubernetes version until addons have been upgraded to the current one.
+	AddonsKubernetesVersionAnnotation = "controlplane.cluster.x-k8s.io/addons-kubernetes-version"
+
 	// RemediationInProgressAnnotation is used to keep track that a KCP remediation is in progress, and more
 	// specifically it tracks that the system is in between having deleted an unhealthy machine and recreating its replacement.
 	// NOTE: if something external to CAPI removes this annotation the system cannot detect the above situation; this can lead to
@@ -65,9 +81,42 @@ const (
 	// ensure it runs last (thus ensuring that kubelet is still working while other pre-terminate hooks run).
 	PreTerminateHookCleanupAnnotation = clusterv1.PreTerminateDeleteHookAnnotationPrefix + "/kcp-cleanup"
 
+	// RestoreEtcdFromSnapshotAnnotation can be set on a KubeadmControlPlane to restore etcd from the snapshot
+	// with the name defined in the annotation value, e.g. after the etcd quorum has been lost.
+	// When this annotation is set, KCP scales down the control plane to a single surviving Machine, the oldest one,
+	// and then replaces it with a new control plane Machine; the bootstrap data of the new Machine restores etcd
+	// from the snapshot, as a new single member etcd cluster, before running kubeadm init, so the restore does not
+	// require the API server of the workload cluster to be reachable.
+	// Once the etcd cluster has a single member hosted on the new Machine, KCP removes the annotation and the
+	// remaining replicas are joined as usual.
+	// The snapshot is read from the S3 sink defined in spec.etcdSnapshot, and the image of the new Machine must
+	// provide etcdutl and curl.
+	// NOTE: All the changes to the workload cluster after the snapshot has been taken are lost.
+	RestoreEtcdFromSnapshotAnnotation = "controlplane.cluster.x-k8s.io/restore-etcd-from-snapshot"
+
+	// EtcdRestoredFromSnapshotAnnotation is set on the control plane Machine created to restore etcd from a snapshot,
+	// with the name of the snapshot.
+	EtcdRestoredFromSnapshotAnnotation = "controlplane.cluster.x-k8s.io/etcd-restored-from-snapshot"
+
+	// CertificatesRenewalInProgressAnnotation is set by KCP on a control plane Machine while its certificates are
+	// renewed in-place by a RenewMachineCertificates extension, with the expiry date of the certificates before the renewal.
+	// NOTE: if this annotation is removed the renewal is aborted, and the Machine is rolled out if its certificates
+	// are still about to expire.
+	CertificatesRenewalInProgressAnnotation = "controlplane.cluster.x-k8s.io/certificates-renewal-in-progress"
+
 	// DefaultMinHealthyPeriodSeconds defines the default minimum period before we consider a remediation on a
 	// machine unrelated from the previous remediation.
 	DefaultMinHealthyPeriodSeconds = int32(60 * 60)
+
+	// DefaultEtcdSnapshotRetention defines the default number of etcd snapshots to keep in the sink.
+	DefaultEtcdSnapshotRetention = int32(5)
+
+	// DefaultEtcdSnapshotChunkSizeBytes defines the default maximum size of the etcd snapshot chunk stored in each Secret
+	// when using the secret sink.
+	DefaultEtcdSnapshotChunkSizeBytes = int32(768 * 1024)
+
+	// DefaultEtcdSnapshotS3Region defines the default region used to sign requests to an S3-compatible object store.
+	DefaultEtcdSnapshotS3Region = "us-east-1"
 )
 
 // KubeadmControlPlane's Available condition and corresponding reasons.
@@ -348,6 +397,29 @@ const (
 	KubeadmControlPlaneDeletingInternalErrorReason = clusterv1.InternalErrorReason
 )
 
+// KubeadmControlPlane's WorkerMachinesRolloutRequired condition and corresponding reasons.
+const (
+	// KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition is true if worker Machines, or Nodes of MachinePools,
+	// created before the current phase of the rotation of the certificate authorities started must be rolled out
+	// before the rotation can move to the next phase.
+	// Note: KCP does not roll out worker Machines; they must be rolled out by the user, e.g. by setting
+	// spec.rollout.after on MachineDeployments.
+	// Note: this condition is set only while a rotation of the certificate authorities is in progress.
+	KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition = "WorkerMachinesRolloutRequired"
+
+	// KubeadmControlPlaneWorkerMachinesRolloutRequiredReason surfaces when worker Machines, or Nodes of MachinePools,
+	// must be rolled out.
+	KubeadmControlPlaneWorkerMachinesRolloutRequiredReason = "RolloutRequired"
+
+	// KubeadmControlPlaneWorkerMachinesRolloutNotRequiredReason surfaces when all the worker Machines, and Nodes of MachinePools,
+	// have been created after the current phase of the rotation of the certificate authorities started.
+	KubeadmControlPlaneWorkerMachinesRolloutNotRequiredReason = "RolloutNotRequired"
+
+	// KubeadmControlPlaneWorkerMachinesRolloutRequiredInternalErrorReason surfaces unexpected failures when checking
+	// worker Machines and Nodes of MachinePools.
+	KubeadmControlPlaneWorkerMachinesRolloutRequiredInternalErrorReason = clusterv1.InternalErrorReason
+)
+
 // APIServerPodHealthy, ControllerManagerPodHealthy, SchedulerPodHealthy and EtcdPodHealthy condition and corresponding
 // reasons that will be used for KubeadmControlPlane controlled machines in v1Beta2 API version.
 const (
@@ -378,6 +450,10 @@ const (
 	// failed during provisioning, e.g. CrashLoopBackOff, ImagePullBackOff or if all the containers in a pod have terminated.
 	KubeadmControlPlaneMachinePodFailedReason = "Failed"
 
+	// KubeadmControlPlaneMachinePodHealthCheckFailedReason surfaces when a pod hosted on a KubeadmControlPlane controlled machine
+	// is running, but the component it hosts is failing health checks, e.g. the /livez or /readyz endpoints of the kube-apiserver.
+	KubeadmControlPlaneMachinePodHealthCheckFailedReason = "HealthCheckFailed"
+
 	// KubeadmControlPlaneMachinePodInspectionFailedReason documents a failure when inspecting the status of a
 	// pod hosted on a KubeadmControlPlane controlled machine.
 	KubeadmControlPlaneMachinePodInspectionFailedReason = clusterv1.InspectionFailedReason
@@ -477,6 +553,23 @@ type KubeadmControlPlaneSpec struct {
 	// InfraMachines & KubeadmConfigs will use the same name as the corresponding Machines.
 	// +optional
 	MachineNaming MachineNamingSpec `json:"machineNaming,omitempty,omitzero"`
+
+	// etcdSnapshot configures periodic snapshots of the etcd cluster managed by the KubeadmControlPlane.
+	// Snapshots are taken only when using local etcd; if not set, no snapshots are taken.
+	// +optional
+	EtcdSnapshot KubeadmControlPlaneEtcdSnapshotSpec `json:"etcdSnapshot,omitempty,omitzero"`
+
+	// etcdDefragmentation configures automated defragmentation of the members of the etcd cluster managed by the KubeadmControlPlane.
+	// Members are defragmented one at a time, the leader last, and NOSPACE alarms are disarmed once defragmentation freed space.
+	// Defragmentation happens only when using local etcd; if not set, members are never defragmented.
+	// +optional
+	EtcdDefragmentation KubeadmControlPlaneEtcdDefragmentationSpec `json:"etcdDefragmentation,omitempty,omitzero"`
+
+	// certificateAuthorityRotation allows to rotate the certificate authorities of the cluster and the service account keys.
+	// The rotation happens in multiple phases, each one followed by the rollout of all the control plane and worker Machines;
+	// control plane Machines are rolled out by KCP, while worker Machines must be rolled out by the user.
+	// +optional
+	CertificateAuthorityRotation KubeadmControlPlaneCertificateAuthorityRotationSpec `json:"certificateAuthorityRotation,omitempty,omitzero"`
 }
 
 // KubeadmControlPlaneMachineTemplate defines the template for Machines
@@ -601,8 +694,7 @@ type KubeadmControlPlaneRolloutBeforeSpec struct {
 // with new ones.
 // +kubebuilder:validation:MinProperties=1
 type KubeadmControlPlaneRolloutStrategy struct {
-	// type of rollout. Currently the only supported strategy is
-	// "RollingUpdate".
+	// type of rollout. Allowed values are RollingUpdate, ScaleDownFirst and OnDelete.
 	// Default is RollingUpdate.
 	// +required
 	Type KubeadmControlPlaneRolloutStrategyType `json:"type,omitempty"`
@@ -693,12 +785,153 @@ type MachineNamingSpec struct {
 	Template string `json:"template,omitempty"`
 }
 
+// KubeadmControlPlaneEtcdSnapshotSpec configures periodic snapshots of the etcd cluster managed by the KubeadmControlPlane.
+type KubeadmControlPlaneEtcdSnapshotSpec struct {
+	// intervalSeconds is the interval between two etcd snapshots.
+	// +required
+	// +kubebuilder:validation:Minimum=300
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// retention is the number of snapshots to keep in the sink; older snapshots are deleted
+	// after a new snapshot has been successfully stored.
+	// If not set, this value is defaulted to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Retention *int32 `json:"retention,omitempty"`
+
+	// sink defines where etcd snapshots are stored.
+	// +required
+	Sink EtcdSnapshotSink `json:"sink,omitempty,omitzero"`
+}
+
+// EtcdSnapshotSink defines where etcd snapshots are stored.
+// Exactly one of secret or s3 must be set.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type EtcdSnapshotSink struct {
+	// secret stores etcd snapshots in Secrets in the namespace of the KubeadmControlPlane.
+	// Each snapshot is split in chunks stored in separate Secrets, so it can exceed the size limit of a single Secret.
+	// NOTE: This sink is intended for small clusters or for testing; use an s3 sink for production.
+	// +optional
+	Secret *EtcdSnapshotSecretSink `json:"secret,omitempty"`
+
+	// s3 stores etcd snapshots in a bucket of an S3-compatible object store.
+	// +optional
+	S3 *EtcdSnapshotS3Sink `json:"s3,omitempty"`
+}
+
+// EtcdSnapshotSecretSink stores etcd snapshots in Secrets in the namespace of the KubeadmControlPlane.
+type EtcdSnapshotSecretSink struct {
+	// chunkSizeBytes is the maximum size of the snapshot chunk stored in each Secret.
+	// If not set, this value is defaulted to 786432 (768KiB).
+	// +optional
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=1000000
+	ChunkSizeBytes *int32 `json:"chunkSizeBytes,omitempty"`
+}
+
+// EtcdSnapshotS3Sink stores etcd snapshots in a bucket of an S3-compatible object store.
+// Objects are stored using path-style requests, i.e. {endpoint}/{bucket}/{prefix}{namespace}/{cluster}/{snapshot}.
+type EtcdSnapshotS3Sink struct {
+	// endpoint is the URL of the S3-compatible object store, e.g. https://s3.us-east-1.amazonaws.com.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// region is the region of the bucket used to sign requests.
+	// If not set, this value is defaulted to us-east-1.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Region string `json:"region,omitempty"`
+
+	// bucket is the name of the bucket.
+	// +required
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	Bucket string `json:"bucket,omitempty"`
+
+	// prefix is prepended to the key of each snapshot.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Prefix string `json:"prefix,omitempty"`
+
+	// caBundle is a PEM encoded CA bundle used to validate the certificate of the S3-compatible object store.
+	// If not set, the system trust store is used.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=51200
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// credentialsSecret is the reference to a Secret in the namespace of the KubeadmControlPlane
+	// with the accessKeyID and secretAccessKey keys used to sign requests.
+	// +required
+	CredentialsSecret EtcdSnapshotS3CredentialsSecretReference `json:"credentialsSecret,omitempty,omitzero"`
+}
+
+// EtcdSnapshotS3CredentialsSecretReference is a reference to a Secret with credentials for an S3-compatible object store.
+type EtcdSnapshotS3CredentialsSecretReference struct {
+	// name of the Secret.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+}
+
+// KubeadmControlPlaneEtcdDefragmentationSpec configures automated defragmentation of etcd members.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneEtcdDefragmentationSpec struct {
+	// maintenanceWindow defines a daily window during which each etcd member is defragmented once.
+	// +optional
+	MaintenanceWindow EtcdMaintenanceWindow `json:"maintenanceWindow,omitempty,omitzero"`
+
+	// fragmentationThresholdPercent triggers the defragmentation of an etcd member, also outside of the maintenance window,
+	// when the percentage of the size of its database not in use, i.e. (dbSize - dbSizeInUse) / dbSize, is greater than
+	// or equal to this value.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	FragmentationThresholdPercent *int32 `json:"fragmentationThresholdPercent,omitempty"`
+}
+
+// EtcdMaintenanceWindow defines a daily maintenance window.
+type EtcdMaintenanceWindow struct {
+	// startTime is the time of the day when the maintenance window starts, in the HH:MM format and in UTC.
+	// +required
+	// +kubebuilder:validation:MinLength=5
+	// +kubebuilder:validation:MaxLength=5
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime,omitempty"`
+
+	// durationMinutes is the duration of the maintenance window.
+	// +required
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=1440
+	DurationMinutes int32 `json:"durationMinutes,omitempty"`
+}
+
+// KubeadmControlPlaneCertificateAuthorityRotationSpec configures the rotation of the certificate authorities of the cluster.
+type KubeadmControlPlaneCertificateAuthorityRotationSpec struct {
+	// after is a field to indicate a rotation of the cluster CA, front proxy CA, etcd CA and service account keys should be
+	// performed after the specified time; a new rotation is performed every time after is set to a time later than the
+	// start time of the last rotation.
+	// The etcd CA is rotated only when using local etcd.
+	// Example: In the YAML the time can be specified in the RFC3339 format.
+	// To specify the after target as March 9, 2023, at 9 am UTC
+	// use "2023-03-09T09:00:00Z".
+	// +required
+	After metav1.Time `json:"after,omitempty,omitzero"`
+}
+
 // KubeadmControlPlaneStatus defines the observed state of KubeadmControlPlane.
 // +kubebuilder:validation:MinProperties=1
 type KubeadmControlPlaneStatus struct {
 	// conditions represents the observations of a KubeadmControlPlane's current state.
 	// Known condition types are Available, CertificatesAvailable, EtcdClusterAvailable, MachinesReady, MachinesUpToDate,
-	// ScalingUp, ScalingDown, Remediating, Deleting, Paused.
+	// ScalingUp, ScalingDown, Remediating, Deleting, Paused, WorkerMachinesRolloutRequired.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -764,6 +997,18 @@ type KubeadmControlPlaneStatus struct {
 	// +optional
 	LastRemediation LastRemediationStatus `json:"lastRemediation,omitempty,omitzero"`
 
+	// etcdSnapshot reports the status of etcd snapshots.
+	// +optional
+	EtcdSnapshot KubeadmControlPlaneEtcdSnapshotStatus `json:"etcdSnapshot,omitempty,omitzero"`
+
+	// etcdDefragmentation reports the status of the defragmentation of etcd members.
+	// +optional
+	EtcdDefragmentation KubeadmControlPlaneEtcdDefragmentationStatus `json:"etcdDefragmentation,omitempty,omitzero"`
+
+	// certificateAuthorityRotation reports the status of the rotation of the certificate authorities of the cluster.
+	// +optional
+	CertificateAuthorityRotation KubeadmControlPlaneCertificateAuthorityRotationStatus `json:"certificateAuthorityRotation,omitempty,omitzero"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *KubeadmControlPlaneDeprecatedStatus `json:"deprecated,omitempty"`
@@ -865,6 +1110,145 @@ type LastRemediationStatus struct {
 	RetryCount *int32 `json:"retryCount,omitempty"`
 }
 
+// KubeadmControlPlaneEtcdSnapshotStatus reports the status of etcd snapshots.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneEtcdSnapshotStatus struct {
+	// lastSuccessful stores info about the last etcd snapshot successfully stored in the sink.
+	// +optional
+	LastSuccessful EtcdSnapshotStatus `json:"lastSuccessful,omitempty,omitzero"`
+
+	// inProgress stores info about the etcd snapshot that is currently being taken and stored in the sink.
+	// +optional
+	InProgress EtcdSnapshotStatus `json:"inProgress,omitempty,omitzero"`
+}
+
+// EtcdSnapshotStatus stores info about an etcd snapshot.
+type EtcdSnapshotStatus struct {
+	// name is the name of the snapshot in the sink.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// time is when the snapshot has been taken. It is represented in RFC3339 form and is in UTC.
+	// +required
+	Time metav1.Time `json:"time,omitempty,omitzero"`
+
+	// sizeBytes is the size of the snapshot.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+}
+
+// KubeadmControlPlaneEtcdDefragmentationStatus reports the status of the defragmentation of etcd members.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneEtcdDefragmentationStatus struct {
+	// lastCheckTime is when the size of the database of the etcd members has been checked for the last time.
+	// It is represented in RFC3339 form and is in UTC.
+	// +optional
+	LastCheckTime metav1.Time `json:"lastCheckTime,omitempty,omitzero"`
+
+	// members reports the defragmentation status of each etcd member.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=100
+	Members []EtcdMemberDefragmentationStatus `json:"members,omitempty"`
+}
+
+// EtcdMemberDefragmentationPhase is the phase of the defragmentation of an etcd member.
+// +kubebuilder:validation:Enum=Idle;Pending;InProgress;Failed
+type EtcdMemberDefragmentationPhase string
+
+const (
+	// EtcdMemberDefragmentationIdlePhase surfaces that the etcd member does not need to be defragmented.
+	EtcdMemberDefragmentationIdlePhase EtcdMemberDefragmentationPhase = "Idle"
+
+	// EtcdMemberDefragmentationPendingPhase surfaces that the etcd member is waiting for its turn to be defragmented.
+	EtcdMemberDefragmentationPendingPhase EtcdMemberDefragmentationPhase = "Pending"
+
+	// EtcdMemberDefragmentationInProgressPhase surfaces that the etcd member is being defragmented.
+	EtcdMemberDefragmentationInProgressPhase EtcdMemberDefragmentationPhase = "InProgress"
+
+	// EtcdMemberDefragmentationFailedPhase surfaces that the last defragmentation of the etcd member failed;
+	// defragmentation is going to be retried.
+	EtcdMemberDefragmentationFailedPhase EtcdMemberDefragmentationPhase = "Failed"
+)
+
+// EtcdMemberDefragmentationStatus reports the defragmentation status of an etcd member.
+type EtcdMemberDefragmentationStatus struct {
+	// name is the name of the etcd member, which is equal to the name of the Node hosting it.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// phase is the phase of the defragmentation of the etcd member.
+	// +optional
+	Phase EtcdMemberDefragmentationPhase `json:"phase,omitempty"`
+
+	// dbSizeBytes is the size of the database of the etcd member, as observed at the last check.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DBSizeBytes *int64 `json:"dbSizeBytes,omitempty"`
+
+	// dbSizeInUseBytes is the size of the database of the etcd member which is in use, as observed at the last check.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DBSizeInUseBytes *int64 `json:"dbSizeInUseBytes,omitempty"`
+
+	// lastDefragmentationTime is when the etcd member has been successfully defragmented for the last time.
+	// It is represented in RFC3339 form and is in UTC.
+	// +optional
+	LastDefragmentationTime metav1.Time `json:"lastDefragmentationTime,omitempty,omitzero"`
+}
+
+// KubeadmControlPlaneCertificateAuthorityRotationStatus reports the status of the rotation of the certificate authorities of the cluster.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneCertificateAuthorityRotationStatus struct {
+	// phase is the phase of the current, or of the last, rotation.
+	// +optional
+	Phase CertificateAuthorityRotationPhase `json:"phase,omitempty"`
+
+	// startTime is when the current, or the last, rotation started. It is represented in RFC3339 form and is in UTC.
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty,omitzero"`
+
+	// phaseStartTime is when the current phase of the rotation started; control plane and worker Machines created
+	// before this time must be rolled out before the rotation moves to the next phase. Control plane Machines are
+	// rolled out by KCP, while worker Machines must be rolled out by the user, see the WorkerMachinesRolloutRequired condition.
+	// It is represented in RFC3339 form and is in UTC.
+	// +optional
+	PhaseStartTime metav1.Time `json:"phaseStartTime,omitempty,omitzero"`
+
+	// completionTime is when the last rotation completed. It is represented in RFC3339 form and is in UTC.
+	// +optional
+	CompletionTime metav1.Time `json:"completionTime,omitempty,omitzero"`
+}
+
+// CertificateAuthorityRotationPhase is the phase of the rotation of the certificate authorities of the cluster.
+// +kubebuilder:validation:Enum=AddingTrust;SwitchingSigning;RemovingOldTrust;Completed
+type CertificateAuthorityRotationPhase string
+
+const (
+	// CertificateAuthorityRotationAddingTrustPhase surfaces that new certificate authorities and service account keys
+	// have been added to the trust bundles, and Machines are being rolled out to trust them;
+	// the old certificate authorities and service account keys are still used for signing.
+	CertificateAuthorityRotationAddingTrustPhase CertificateAuthorityRotationPhase = "AddingTrust"
+
+	// CertificateAuthorityRotationSwitchingSigningPhase surfaces that the new certificate authorities and service account keys
+	// are used for signing, and Machines are being rolled out to get certificates signed by them;
+	// the old certificate authorities and service account keys are still trusted.
+	CertificateAuthorityRotationSwitchingSigningPhase CertificateAuthorityRotationPhase = "SwitchingSigning"
+
+	// CertificateAuthorityRotationRemovingOldTrustPhase surfaces that the old certificate authorities and service account keys
+	// have been removed from the trust bundles, and Machines are being rolled out to stop trusting them.
+	CertificateAuthorityRotationRemovingOldTrustPhase CertificateAuthorityRotationPhase = "RemovingOldTrust"
+
+	// CertificateAuthorityRotationCompletedPhase surfaces that the rotation has been completed.
+	CertificateAuthorityRotationCompletedPhase CertificateAuthorityRotationPhase = "Completed"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=kubeadmcontrolplanes,shortName=kcp,scope=Namespaced,categories=cluster-api
 // +kubebuilder:storageversion
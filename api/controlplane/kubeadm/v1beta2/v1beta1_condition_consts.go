@@ -105,6 +105,10 @@ const (
 	// or if all the containers in a pod have terminated.
 	PodFailedV1Beta1Reason = "PodFailed"
 
+	// PodHealthCheckFailedV1Beta1Reason (Severity=Error) documents a running pod failing the health checks of the
+	// component it hosts, e.g. the /livez or /readyz endpoints of the kube-apiserver.
+	PodHealthCheckFailedV1Beta1Reason = "PodHealthCheckFailed"
+
 	// PodInspectionFailedV1Beta1Reason documents a failure in inspecting the pod status.
 	PodInspectionFailedV1Beta1Reason = "PodInspectionFailed"
 )
@@ -27,6 +27,149 @@ import (
 	corev1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdMaintenanceWindow) DeepCopyInto(out *EtcdMaintenanceWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMaintenanceWindow.
+func (in *EtcdMaintenanceWindow) DeepCopy() *EtcdMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdMemberDefragmentationStatus) DeepCopyInto(out *EtcdMemberDefragmentationStatus) {
+	*out = *in
+	if in.DBSizeBytes != nil {
+		in, out := &in.DBSizeBytes, &out.DBSizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DBSizeInUseBytes != nil {
+		in, out := &in.DBSizeInUseBytes, &out.DBSizeInUseBytes
+		*out = new(int64)
+		**out = **in
+	}
+	in.LastDefragmentationTime.DeepCopyInto(&out.LastDefragmentationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMemberDefragmentationStatus.
+func (in *EtcdMemberDefragmentationStatus) DeepCopy() *EtcdMemberDefragmentationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdMemberDefragmentationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotS3CredentialsSecretReference) DeepCopyInto(out *EtcdSnapshotS3CredentialsSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotS3CredentialsSecretReference.
+func (in *EtcdSnapshotS3CredentialsSecretReference) DeepCopy() *EtcdSnapshotS3CredentialsSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotS3CredentialsSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotS3Sink) DeepCopyInto(out *EtcdSnapshotS3Sink) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	out.CredentialsSecret = in.CredentialsSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotS3Sink.
+func (in *EtcdSnapshotS3Sink) DeepCopy() *EtcdSnapshotS3Sink {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotS3Sink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotSecretSink) DeepCopyInto(out *EtcdSnapshotSecretSink) {
+	*out = *in
+	if in.ChunkSizeBytes != nil {
+		in, out := &in.ChunkSizeBytes, &out.ChunkSizeBytes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotSecretSink.
+func (in *EtcdSnapshotSecretSink) DeepCopy() *EtcdSnapshotSecretSink {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotSecretSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotSink) DeepCopyInto(out *EtcdSnapshotSink) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(EtcdSnapshotSecretSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(EtcdSnapshotS3Sink)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotSink.
+func (in *EtcdSnapshotSink) DeepCopy() *EtcdSnapshotSink {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotStatus) DeepCopyInto(out *EtcdSnapshotStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.SizeBytes != nil {
+		in, out := &in.SizeBytes, &out.SizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotStatus.
+func (in *EtcdSnapshotStatus) DeepCopy() *EtcdSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlane) DeepCopyInto(out *KubeadmControlPlane) {
 	*out = *in
@@ -54,6 +197,40 @@ func (in *KubeadmControlPlane) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneCertificateAuthorityRotationSpec) DeepCopyInto(out *KubeadmControlPlaneCertificateAuthorityRotationSpec) {
+	*out = *in
+	in.After.DeepCopyInto(&out.After)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneCertificateAuthorityRotationSpec.
+func (in *KubeadmControlPlaneCertificateAuthorityRotationSpec) DeepCopy() *KubeadmControlPlaneCertificateAuthorityRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneCertificateAuthorityRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneCertificateAuthorityRotationStatus) DeepCopyInto(out *KubeadmControlPlaneCertificateAuthorityRotationStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.PhaseStartTime.DeepCopyInto(&out.PhaseStartTime)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneCertificateAuthorityRotationStatus.
+func (in *KubeadmControlPlaneCertificateAuthorityRotationStatus) DeepCopy() *KubeadmControlPlaneCertificateAuthorityRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneCertificateAuthorityRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlaneDeprecatedStatus) DeepCopyInto(out *KubeadmControlPlaneDeprecatedStatus) {
 	*out = *in
@@ -74,6 +251,88 @@ func (in *KubeadmControlPlaneDeprecatedStatus) DeepCopy() *KubeadmControlPlaneDe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEtcdDefragmentationSpec) DeepCopyInto(out *KubeadmControlPlaneEtcdDefragmentationSpec) {
+	*out = *in
+	out.MaintenanceWindow = in.MaintenanceWindow
+	if in.FragmentationThresholdPercent != nil {
+		in, out := &in.FragmentationThresholdPercent, &out.FragmentationThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEtcdDefragmentationSpec.
+func (in *KubeadmControlPlaneEtcdDefragmentationSpec) DeepCopy() *KubeadmControlPlaneEtcdDefragmentationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEtcdDefragmentationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEtcdDefragmentationStatus) DeepCopyInto(out *KubeadmControlPlaneEtcdDefragmentationStatus) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]EtcdMemberDefragmentationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEtcdDefragmentationStatus.
+func (in *KubeadmControlPlaneEtcdDefragmentationStatus) DeepCopy() *KubeadmControlPlaneEtcdDefragmentationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEtcdDefragmentationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEtcdSnapshotSpec) DeepCopyInto(out *KubeadmControlPlaneEtcdSnapshotSpec) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(int32)
+		**out = **in
+	}
+	in.Sink.DeepCopyInto(&out.Sink)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEtcdSnapshotSpec.
+func (in *KubeadmControlPlaneEtcdSnapshotSpec) DeepCopy() *KubeadmControlPlaneEtcdSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEtcdSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEtcdSnapshotStatus) DeepCopyInto(out *KubeadmControlPlaneEtcdSnapshotStatus) {
+	*out = *in
+	in.LastSuccessful.DeepCopyInto(&out.LastSuccessful)
+	in.InProgress.DeepCopyInto(&out.InProgress)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEtcdSnapshotStatus.
+func (in *KubeadmControlPlaneEtcdSnapshotStatus) DeepCopy() *KubeadmControlPlaneEtcdSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEtcdSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlaneInitializationStatus) DeepCopyInto(out *KubeadmControlPlaneInitializationStatus) {
 	*out = *in
@@ -312,6 +571,9 @@ func (in *KubeadmControlPlaneSpec) DeepCopyInto(out *KubeadmControlPlaneSpec) {
 	in.Rollout.DeepCopyInto(&out.Rollout)
 	in.Remediation.DeepCopyInto(&out.Remediation)
 	out.MachineNaming = in.MachineNaming
+	in.EtcdSnapshot.DeepCopyInto(&out.EtcdSnapshot)
+	in.EtcdDefragmentation.DeepCopyInto(&out.EtcdDefragmentation)
+	in.CertificateAuthorityRotation.DeepCopyInto(&out.CertificateAuthorityRotation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneSpec.
@@ -361,6 +623,9 @@ func (in *KubeadmControlPlaneStatus) DeepCopyInto(out *KubeadmControlPlaneStatus
 		copy(*out, *in)
 	}
 	in.LastRemediation.DeepCopyInto(&out.LastRemediation)
+	in.EtcdSnapshot.DeepCopyInto(&out.EtcdSnapshot)
+	in.EtcdDefragmentation.DeepCopyInto(&out.EtcdDefragmentation)
+	in.CertificateAuthorityRotation.DeepCopyInto(&out.CertificateAuthorityRotation)
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(KubeadmControlPlaneDeprecatedStatus)
@@ -997,6 +997,10 @@ func Convert_v1beta1_MachineHealthCheckSpec_To_v1beta2_MachineHealthCheckSpec(in
 	return nil
 }
 
+func Convert_v1beta2_MachineDrainRuleDrainConfig_To_v1beta1_MachineDrainRuleDrainConfig(in *clusterv1.MachineDrainRuleDrainConfig, out *MachineDrainRuleDrainConfig, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_MachineDrainRuleDrainConfig_To_v1beta1_MachineDrainRuleDrainConfig(in, out, s)
+}
+
 func Convert_v1beta2_MachineHealthCheckSpec_To_v1beta1_MachineHealthCheckSpec(in *clusterv1.MachineHealthCheckSpec, out *MachineHealthCheckSpec, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1beta2_MachineHealthCheckSpec_To_v1beta1_MachineHealthCheckSpec(in, out, s); err != nil {
 		return err
@@ -1305,6 +1309,30 @@ func Convert_v1beta1_ClusterVariable_To_v1beta2_ClusterVariable(in *ClusterVaria
 	return autoConvert_v1beta1_ClusterVariable_To_v1beta2_ClusterVariable(in, out, s)
 }
 
+func Convert_v1beta2_ClusterVariable_To_v1beta1_ClusterVariable(in *clusterv1.ClusterVariable, out *ClusterVariable, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_ClusterVariable_To_v1beta1_ClusterVariable(in, out, s)
+}
+
+func Convert_v1beta2_VariableSchema_To_v1beta1_VariableSchema(in *clusterv1.VariableSchema, out *VariableSchema, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_VariableSchema_To_v1beta1_VariableSchema(in, out, s)
+}
+
+func Convert_v1beta2_ClusterClassPatch_To_v1beta1_ClusterClassPatch(in *clusterv1.ClusterClassPatch, out *ClusterClassPatch, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_ClusterClassPatch_To_v1beta1_ClusterClassPatch(in, out, s)
+}
+
+func Convert_v1beta2_ClusterClassUpgrade_To_v1beta1_ClusterClassUpgrade(in *clusterv1.ClusterClassUpgrade, out *ClusterClassUpgrade, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_ClusterClassUpgrade_To_v1beta1_ClusterClassUpgrade(in, out, s)
+}
+
+func Convert_v1beta2_PatchSelectorMatch_To_v1beta1_PatchSelectorMatch(in *clusterv1.PatchSelectorMatch, out *PatchSelectorMatch, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_PatchSelectorMatch_To_v1beta1_PatchSelectorMatch(in, out, s)
+}
+
+func Convert_v1beta2_JSONPatchValue_To_v1beta1_JSONPatchValue(in *clusterv1.JSONPatchValue, out *JSONPatchValue, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_JSONPatchValue_To_v1beta1_JSONPatchValue(in, out, s)
+}
+
 func Convert_v1beta2_MachineSpec_To_v1beta1_MachineSpec(in *clusterv1.MachineSpec, out *MachineSpec, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1beta2_MachineSpec_To_v1beta1_MachineSpec(in, out, s); err != nil {
 		return err
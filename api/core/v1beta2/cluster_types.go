@@ -80,6 +80,10 @@ const (
 	// failing due to an error.
 	ClusterTopologyReconciledFailedReason = "ReconcileFailed"
 
+	// ClusterTopologyReconciledAuxiliaryResourcesFailedReason documents the reconciliation of a Cluster topology
+	// failing while reconciling the auxiliary resources defined in the ClusterClass.
+	ClusterTopologyReconciledAuxiliaryResourcesFailedReason = "AuxiliaryResourcesReconcileFailed"
+
 	// ClusterTopologyReconciledClusterCreatingReason documents reconciliation of a Cluster topology
 	// not yet created because the BeforeClusterCreate hook is blocking.
 	ClusterTopologyReconciledClusterCreatingReason = "ClusterCreating"
@@ -124,6 +128,10 @@ const (
 	// not yet completed because the upgrade for at least one of the MachinePools has been deferred.
 	ClusterTopologyReconciledMachinePoolsUpgradeDeferredReason = "MachinePoolsUpgradeDeferred"
 
+	// ClusterTopologyReconciledMaintenanceWindowClosedReason documents reconciliation of a Cluster topology
+	// not yet completed because the next upgrade step cannot start until a maintenance window opens.
+	ClusterTopologyReconciledMaintenanceWindowClosedReason = "MaintenanceWindowClosed"
+
 	// ClusterTopologyReconciledHookBlockingReason documents reconciliation of a Cluster topology
 	// not yet completed because at least one of the lifecycle hooks is blocking.
 	//
@@ -138,6 +146,11 @@ const (
 	// with the ClusterClass surfaced in the ClusterClass status or controller logs.
 	ClusterTopologyReconciledClusterClassNotReconciledReason = "ClusterClassNotReconciled"
 
+	// ClusterTopologyReconciledClusterClassRolloutPendingReason documents reconciliation of a Cluster topology not
+	// yet completed because the Cluster is pinned to a previous generation of the ClusterClass, e.g. because
+	// a ClusterClassRollout did not yet reach the batch of the Cluster.
+	ClusterTopologyReconciledClusterClassRolloutPendingReason = "ClusterClassRolloutPending"
+
 	// ClusterTopologyReconciledDeletingReason surfaces when the Cluster is deleting because the
 	// DeletionTimestamp is set.
 	ClusterTopologyReconciledDeletingReason = DeletingReason
@@ -584,6 +597,10 @@ type Topology struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=1000
 	Variables []ClusterVariable `json:"variables,omitempty"`
+
+	// upgrade allows to configure how version upgrades are rolled out to the Cluster.
+	// +optional
+	Upgrade TopologyUpgrade `json:"upgrade,omitempty,omitzero"`
 }
 
 // IsDefined returns true if the Topology is defined.
@@ -591,6 +608,44 @@ func (r *Topology) IsDefined() bool {
 	return !reflect.DeepEqual(r, &Topology{})
 }
 
+// TopologyUpgrade defines the upgrade configuration for a Cluster with a managed topology.
+// +kubebuilder:validation:MinProperties=1
+type TopologyUpgrade struct {
+	// maintenanceWindows defines the time windows during which the topology controller is allowed
+	// to start new upgrade steps for the control plane, MachineDeployments and MachinePools.
+	// Upgrade steps already in progress when a maintenance window closes are not interrupted.
+	// If set, maintenanceWindows take precedence over the maintenanceWindows defined in the ClusterClass.
+	// If neither the Cluster nor the ClusterClass define maintenanceWindows, upgrades can start at any time.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring time window during which upgrade steps can be started.
+type MaintenanceWindow struct {
+	// schedule defines when the maintenance window opens, using the standard five fields cron format
+	// (minute, hour, day of month, month, day of week), e.g. "0 22 * * 6" for every Saturday at 22:00.
+	// Descriptors like "@daily" or "@weekly" are also supported.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Schedule string `json:"schedule,omitempty"`
+
+	// durationSeconds is how long the maintenance window stays open after each opening defined by schedule.
+	// +required
+	// +kubebuilder:validation:Minimum=60
+	DurationSeconds *int32 `json:"durationSeconds,omitempty"`
+
+	// timeZone is the IANA name of the time zone used to interpret schedule, e.g. "Europe/Rome".
+	// If not set, UTC is used.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
 // ClusterClassRef is the ref to the ClusterClass that should be used for the topology.
 type ClusterClassRef struct {
 	// name is the name of the ClusterClass that should be used for the topology.
@@ -1257,6 +1312,11 @@ type MachinePoolTopology struct {
 	// +kubebuilder:validation:items:MaxLength=256
 	FailureDomains []string `json:"failureDomains,omitempty"`
 
+	// healthCheck allows to enable, disable and override MachinePool health check
+	// configuration from the ClusterClass for this MachinePool.
+	// +optional
+	HealthCheck MachinePoolTopologyHealthCheck `json:"healthCheck,omitempty,omitzero"`
+
 	// deletion contains configuration options for Machine deletion.
 	// +optional
 	Deletion MachinePoolTopologyMachineDeletionSpec `json:"deletion,omitempty,omitzero"`
@@ -1298,6 +1358,142 @@ type MachinePoolTopology struct {
 	Variables MachinePoolVariables `json:"variables,omitempty,omitzero"`
 }
 
+// MachinePoolTopologyHealthCheck defines a MachineHealthCheck for MachinePool machines.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolTopologyHealthCheck struct {
+	// enabled controls if a MachineHealthCheck should be created for the target machines.
+	//
+	// If false: No MachineHealthCheck will be created.
+	//
+	// If not set(default): A MachineHealthCheck will be created if it is defined here or
+	//  in the associated ClusterClass. If no MachineHealthCheck is defined then none will be created.
+	//
+	// If true: A MachineHealthCheck is guaranteed to be created. Cluster validation will
+	// block if `enable` is true and no MachineHealthCheck definition is available.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// checks are the checks that are used to evaluate if a Machine is healthy.
+	//
+	// If one of checks and remediation fields are set, the system assumes that an healthCheck override is defined,
+	// and as a consequence the checks and remediation fields from Cluster will be used instead of the
+	// corresponding fields in ClusterClass.
+	//
+	// Independent of this configuration the MachineHealthCheck controller will always
+	// flag Machines with `cluster.x-k8s.io/remediate-machine` annotation and
+	// Machines with deleted Nodes as unhealthy.
+	//
+	// Furthermore, if checks.nodeStartupTimeoutSeconds is not set it
+	// is defaulted to 10 minutes and evaluated accordingly.
+	//
+	// +optional
+	Checks MachinePoolTopologyHealthCheckChecks `json:"checks,omitempty,omitzero"`
+
+	// remediation configures if and how remediations are triggered if a Machine is unhealthy.
+	//
+	// If one of checks and remediation fields are set, the system assumes that an healthCheck override is defined,
+	// and as a consequence the checks and remediation fields from cluster will be used instead of the
+	// corresponding fields in ClusterClass.
+	//
+	// If an health check override is defined and remediation or remediation.triggerIf is not set,
+	// remediation will always be triggered for unhealthy Machines.
+	//
+	// If an health check override is defined and remediation or remediation.templateRef is not set,
+	// the OwnerRemediated condition will be set on unhealthy Machines to trigger remediation via
+	// the MachinePool, which deletes the unhealthy Machines and thus the corresponding instances
+	// via the InfrastructureMachinePool provider.
+	//
+	// +optional
+	Remediation MachinePoolTopologyHealthCheckRemediation `json:"remediation,omitempty,omitzero"`
+}
+
+// IsDefined returns true if one of checks and remediation are not zero.
+func (m *MachinePoolTopologyHealthCheck) IsDefined() bool {
+	return !reflect.ValueOf(m.Checks).IsZero() || !reflect.ValueOf(m.Remediation).IsZero()
+}
+
+// MachinePoolTopologyHealthCheckChecks are the checks that are used to evaluate if a MachinePool Machine is healthy.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolTopologyHealthCheckChecks struct {
+	// nodeStartupTimeoutSeconds allows to set the maximum time for MachineHealthCheck
+	// to consider a Machine unhealthy if a corresponding Node isn't associated
+	// through a `Spec.ProviderID` field.
+	//
+	// The duration set in this field is compared to the greatest of:
+	// - Cluster's infrastructure ready condition timestamp (if and when available)
+	// - Control Plane's initialized condition timestamp (if and when available)
+	// - Machine's infrastructure ready condition timestamp (if and when available)
+	// - Machine's metadata creation timestamp
+	//
+	// Defaults to 10 minutes.
+	// If you wish to disable this feature, set the value explicitly to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	NodeStartupTimeoutSeconds *int32 `json:"nodeStartupTimeoutSeconds,omitempty"`
+
+	// unhealthyNodeConditions contains a list of conditions that determine
+	// whether a node is considered unhealthy. The conditions are combined in a
+	// logical OR, i.e. if any of the conditions is met, the node is unhealthy.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyNodeConditions []UnhealthyNodeCondition `json:"unhealthyNodeConditions,omitempty"`
+
+	// unhealthyMachineConditions contains a list of the machine conditions that determine
+	// whether a machine is considered unhealthy.  The conditions are combined in a
+	// logical OR, i.e. if any of the conditions is met, the machine is unhealthy.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyMachineConditions []UnhealthyMachineCondition `json:"unhealthyMachineConditions,omitempty"`
+}
+
+// MachinePoolTopologyHealthCheckRemediation configures if and how remediations are triggered if a MachinePool Machine is unhealthy.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolTopologyHealthCheckRemediation struct {
+	// triggerIf configures if remediations are triggered.
+	// If this field is not set, remediations are always triggered.
+	// +optional
+	TriggerIf MachinePoolTopologyHealthCheckRemediationTriggerIf `json:"triggerIf,omitempty,omitzero"`
+
+	// templateRef is a reference to a remediation template
+	// provided by an infrastructure provider.
+	//
+	// This field is completely optional, when filled, the MachineHealthCheck controller
+	// creates a new object from the template referenced and hands off remediation of the machine to
+	// a controller that lives outside of Cluster API.
+	// +optional
+	TemplateRef MachineHealthCheckRemediationTemplateReference `json:"templateRef,omitempty,omitzero"`
+}
+
+// MachinePoolTopologyHealthCheckRemediationTriggerIf configures if remediations are triggered.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolTopologyHealthCheckRemediationTriggerIf struct {
+	// unhealthyLessThanOrEqualTo specifies that remediations are only triggered if the number of
+	// unhealthy Machines is less than or equal to the configured value.
+	// unhealthyInRange takes precedence if set.
+	//
+	// +optional
+	UnhealthyLessThanOrEqualTo *intstr.IntOrString `json:"unhealthyLessThanOrEqualTo,omitempty"`
+
+	// unhealthyInRange specifies that remediations are only triggered if the number of
+	// unhealthy Machines is in the configured range.
+	// Takes precedence over unhealthyLessThanOrEqualTo.
+	// Eg. "[3-5]" - This means that remediation will be allowed only when:
+	// (a) there are at least 3 unhealthy Machines (and)
+	// (b) there are at most 5 unhealthy Machines
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=^\[[0-9]+-[0-9]+\]$
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	UnhealthyInRange string `json:"unhealthyInRange,omitempty"`
+}
+
 // MachinePoolTopologyMachineDeletionSpec contains configuration options for Machine deletion.
 // +kubebuilder:validation:MinProperties=1
 type MachinePoolTopologyMachineDeletionSpec struct {
@@ -1338,8 +1534,48 @@ type ClusterVariable struct {
 	// hard-coded schema for apiextensionsv1.JSON which cannot be produced by another type via controller-tools,
 	// i.e. it is not possible to have no type field.
 	// Ref: https://github.com/kubernetes-sigs/controller-tools/blob/d0e03a142d0ecdd5491593e941ee1d6b5d91dba6/pkg/crd/known_types.go#L106-L111
-	// +required
+	// Note: value and valueFrom are mutually exclusive; value must be set if valueFrom is not set.
+	// +optional
 	Value apiextensionsv1.JSON `json:"value,omitempty,omitzero"`
+
+	// valueFrom is the source for the value of the variable.
+	// Note: the value is resolved by the topology controller only when computing patches, it is validated
+	// against the schema of the corresponding ClusterClassVariable at that time and it is never persisted
+	// on the Cluster.
+	// Note: value and valueFrom are mutually exclusive.
+	// +optional
+	ValueFrom ClusterVariableValueSource `json:"valueFrom,omitempty,omitzero"`
+}
+
+// ClusterVariableValueSource is the source for the value of a ClusterVariable.
+// +kubebuilder:validation:MinProperties=1
+type ClusterVariableValueSource struct {
+	// secretKeyRef selects a key of a Secret in the namespace of the Cluster.
+	// If the schema of the variable is of type string, the data of the key is used as value of the
+	// variable, otherwise the data of the key must be the JSON representation of the value.
+	// +optional
+	SecretKeyRef ClusterVariableSecretKeyReference `json:"secretKeyRef,omitempty,omitzero"`
+}
+
+// IsDefined returns true if the ClusterVariableValueSource is defined.
+func (r *ClusterVariableValueSource) IsDefined() bool {
+	return !reflect.DeepEqual(r, &ClusterVariableValueSource{})
+}
+
+// ClusterVariableSecretKeyReference selects a key of a Secret.
+type ClusterVariableSecretKeyReference struct {
+	// name of the Secret.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// key of the Secret to select.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key,omitempty"`
 }
 
 // ControlPlaneVariables can be used to provide variables for the ControlPlane.
@@ -119,6 +119,17 @@ type ClusterClassSpec struct {
 	// +optional
 	Workers WorkersClass `json:"workers,omitempty,omitzero"`
 
+	// auxiliaryResources defines additional resources, e.g. MachineDrainRules or IPAM pools, that
+	// are created for each Cluster using this ClusterClass.
+	// Auxiliary resources are created and updated by the topology controller using server side apply,
+	// they can be customized using patches, and they are deleted together with the Cluster.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	AuxiliaryResources []AuxiliaryResourceClass `json:"auxiliaryResources,omitempty"`
+
 	// variables defines the variables which can be configured
 	// in the Cluster topology and are then used in patches.
 	// +optional
@@ -791,6 +802,12 @@ type MachinePoolClass struct {
 	// +required
 	Infrastructure MachinePoolClassInfrastructureTemplate `json:"infrastructure,omitempty,omitzero"`
 
+	// healthCheck defines a MachineHealthCheck for this MachinePoolClass.
+	// The MachineHealthCheck targets the Machines of the MachinePool, which are only
+	// available if the InfrastructureMachinePool supports MachinePool Machines.
+	// +optional
+	HealthCheck MachinePoolClassHealthCheck `json:"healthCheck,omitempty,omitzero"`
+
 	// failureDomains is the list of failure domains the MachinePool should be attached to.
 	// Must match a key in the FailureDomains map stored on the cluster object.
 	// NOTE: This value can be overridden while defining a Cluster.Topology using this MachinePoolClass.
@@ -836,6 +853,122 @@ type MachinePoolClass struct {
 	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
 }
 
+// MachinePoolClassHealthCheck defines a MachineHealthCheck for MachinePool machines.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolClassHealthCheck struct {
+	// checks are the checks that are used to evaluate if a Machine is healthy.
+	//
+	// Independent of this configuration the MachineHealthCheck controller will always
+	// flag Machines with `cluster.x-k8s.io/remediate-machine` annotation and
+	// Machines with deleted Nodes as unhealthy.
+	//
+	// Furthermore, if checks.nodeStartupTimeoutSeconds is not set it
+	// is defaulted to 10 minutes and evaluated accordingly.
+	//
+	// +optional
+	Checks MachinePoolClassHealthCheckChecks `json:"checks,omitempty,omitzero"`
+
+	// remediation configures if and how remediations are triggered if a Machine is unhealthy.
+	//
+	// If remediation or remediation.triggerIf is not set,
+	// remediation will always be triggered for unhealthy Machines.
+	//
+	// If remediation or remediation.templateRef is not set,
+	// the OwnerRemediated condition will be set on unhealthy Machines to trigger remediation via
+	// the MachinePool, which deletes the unhealthy Machines and thus the corresponding instances
+	// via the InfrastructureMachinePool provider.
+	//
+	// +optional
+	Remediation MachinePoolClassHealthCheckRemediation `json:"remediation,omitempty,omitzero"`
+}
+
+// IsDefined returns true if one of checks and remediation are not zero.
+func (m *MachinePoolClassHealthCheck) IsDefined() bool {
+	return !reflect.ValueOf(m.Checks).IsZero() || !reflect.ValueOf(m.Remediation).IsZero()
+}
+
+// MachinePoolClassHealthCheckChecks are the checks that are used to evaluate if a MachinePool Machine is healthy.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolClassHealthCheckChecks struct {
+	// nodeStartupTimeoutSeconds allows to set the maximum time for MachineHealthCheck
+	// to consider a Machine unhealthy if a corresponding Node isn't associated
+	// through a `Spec.ProviderID` field.
+	//
+	// The duration set in this field is compared to the greatest of:
+	// - Cluster's infrastructure ready condition timestamp (if and when available)
+	// - Control Plane's initialized condition timestamp (if and when available)
+	// - Machine's infrastructure ready condition timestamp (if and when available)
+	// - Machine's metadata creation timestamp
+	//
+	// Defaults to 10 minutes.
+	// If you wish to disable this feature, set the value explicitly to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	NodeStartupTimeoutSeconds *int32 `json:"nodeStartupTimeoutSeconds,omitempty"`
+
+	// unhealthyNodeConditions contains a list of conditions that determine
+	// whether a node is considered unhealthy. The conditions are combined in a
+	// logical OR, i.e. if any of the conditions is met, the node is unhealthy.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyNodeConditions []UnhealthyNodeCondition `json:"unhealthyNodeConditions,omitempty"`
+
+	// unhealthyMachineConditions contains a list of the machine conditions that determine
+	// whether a machine is considered unhealthy.  The conditions are combined in a
+	// logical OR, i.e. if any of the conditions is met, the machine is unhealthy.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyMachineConditions []UnhealthyMachineCondition `json:"unhealthyMachineConditions,omitempty"`
+}
+
+// MachinePoolClassHealthCheckRemediation configures if and how remediations are triggered if a MachinePool Machine is unhealthy.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolClassHealthCheckRemediation struct {
+	// triggerIf configures if remediations are triggered.
+	// If this field is not set, remediations are always triggered.
+	// +optional
+	TriggerIf MachinePoolClassHealthCheckRemediationTriggerIf `json:"triggerIf,omitempty,omitzero"`
+
+	// templateRef is a reference to a remediation template
+	// provided by an infrastructure provider.
+	//
+	// This field is completely optional, when filled, the MachineHealthCheck controller
+	// creates a new object from the template referenced and hands off remediation of the machine to
+	// a controller that lives outside of Cluster API.
+	// +optional
+	TemplateRef MachineHealthCheckRemediationTemplateReference `json:"templateRef,omitempty,omitzero"`
+}
+
+// MachinePoolClassHealthCheckRemediationTriggerIf configures if remediations are triggered.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolClassHealthCheckRemediationTriggerIf struct {
+	// unhealthyLessThanOrEqualTo specifies that remediations are only triggered if the number of
+	// unhealthy Machines is less than or equal to the configured value.
+	// unhealthyInRange takes precedence if set.
+	//
+	// +optional
+	UnhealthyLessThanOrEqualTo *intstr.IntOrString `json:"unhealthyLessThanOrEqualTo,omitempty"`
+
+	// unhealthyInRange specifies that remediations are only triggered if the number of
+	// unhealthy Machines is in the configured range.
+	// Takes precedence over unhealthyLessThanOrEqualTo.
+	// Eg. "[3-5]" - This means that remediation will be allowed only when:
+	// (a) there are at least 3 unhealthy Machines (and)
+	// (b) there are at most 5 unhealthy Machines
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=^\[[0-9]+-[0-9]+\]$
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	UnhealthyInRange string `json:"unhealthyInRange,omitempty"`
+}
+
 // MachinePoolClassMachineDeletionSpec contains configuration options for Machine deletion.
 // +kubebuilder:validation:MinProperties=1
 type MachinePoolClassMachineDeletionSpec struct {
@@ -880,6 +1013,61 @@ type MachinePoolClassNamingSpec struct {
 	Template string `json:"template,omitempty"`
 }
 
+// AuxiliaryResourceClass defines an additional resource that the topology controller creates
+// for each Cluster using the ClusterClass.
+type AuxiliaryResourceClass struct {
+	// name of the auxiliary resource.
+	// name must be unique within a ClusterClass; it is used to select the auxiliary resource in patches
+	// and to compute the name of the object created for each Cluster, i.e. <cluster name>-<name>.
+	// name must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name,omitempty"`
+
+	// template is the template used to create the auxiliary resource for each Cluster.
+	// +required
+	Template AuxiliaryResourceClassTemplate `json:"template,omitempty,omitzero"`
+}
+
+// AuxiliaryResourceClassTemplate defines the template for an auxiliary resource.
+type AuxiliaryResourceClassTemplate struct {
+	// apiVersion of the auxiliary resource.
+	// apiVersion must be a version, optionally prefixed by a fully qualified domain name followed by /.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\/)?[a-z]([-a-z0-9]*[a-z0-9])?$`
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// kind of the auxiliary resource.
+	// kind must consist of alphanumeric characters or '-', start with an alphabetic character, and end with an alphanumeric character.
+	// Note: only namespaced resources are supported; auxiliary resources are created in the namespace of the Cluster.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`
+	Kind string `json:"kind,omitempty"`
+
+	// metadata is the metadata applied to the auxiliary resource.
+	// +optional
+	Metadata ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec is the spec of the auxiliary resource.
+	// Note: We have to use apiextensionsv1.JSON instead of our JSON type,
+	// because controller-tools has a hard-coded schema for apiextensionsv1.JSON
+	// which cannot be produced by another type (unset type field).
+	// Ref: https://github.com/kubernetes-sigs/controller-tools/blob/d0e03a142d0ecdd5491593e941ee1d6b5d91dba6/pkg/crd/known_types.go#L106-L111
+	// +optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// GroupVersionKind gets the GroupVersionKind for an AuxiliaryResourceClassTemplate.
+func (t *AuxiliaryResourceClassTemplate) GroupVersionKind() schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(t.APIVersion, t.Kind)
+}
+
 // ClusterClassVariable defines a variable which can
 // be configured in the Cluster topology and used in patches.
 type ClusterClassVariable struct {
@@ -936,6 +1124,12 @@ type VariableSchema struct {
 	// Kubernetes CRDs.
 	// +required
 	OpenAPIV3Schema JSONSchemaProps `json:"openAPIV3Schema,omitempty,omitzero"`
+
+	// sensitive marks the variable as sensitive, e.g. because it holds credentials or tokens.
+	// Values of sensitive variables must be set in Clusters using valueFrom, they are masked in logs
+	// and conditions, and the schema of sensitive variables cannot define a default value.
+	// +optional
+	Sensitive *bool `json:"sensitive,omitempty"`
 }
 
 // Adapted from https://github.com/kubernetes/apiextensions-apiserver/blob/v0.28.5/pkg/apis/apiextensions/v1/types_jsonschema.go#L40
@@ -1308,11 +1502,24 @@ type ClusterClassPatch struct {
 	// The patch will be enabled if the template evaluates to `true`, otherwise it will
 	// be disabled.
 	// If EnabledIf is not set, the patch will be enabled per default.
+	// Note: EnabledIf and EnabledIfExpression are mutually exclusive.
 	// +optional
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=256
 	EnabledIf string `json:"enabledIf,omitempty"`
 
+	// enabledIfExpression is a CEL expression to be used to calculate if a patch should be enabled.
+	// It can reference variables defined in .spec.variables and builtin variables; variables are
+	// in scope by name, e.g. `builtin.controlPlane.replicas > 1 && httpProxy.enabled`.
+	// The expression is type-checked against the variable schemas when the ClusterClass is
+	// validated, and it must evaluate to a boolean. The patch will be enabled if the expression
+	// evaluates to `true`, otherwise it will be disabled.
+	// Note: EnabledIf and EnabledIfExpression are mutually exclusive.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	EnabledIfExpression string `json:"enabledIfExpression,omitempty"`
+
 	// definitions define inline patches.
 	// Note: Patches will be applied in the order of the array.
 	// Note: Exactly one of Definitions or External must be set.
@@ -1333,6 +1540,16 @@ type ClusterClassUpgrade struct {
 	// external defines external runtime extensions for upgrade operations.
 	// +optional
 	External ClusterClassUpgradeExternal `json:"external,omitempty,omitzero"`
+
+	// maintenanceWindows defines the time windows during which the topology controller is allowed
+	// to start new upgrade steps for the control plane, MachineDeployments and MachinePools of
+	// Clusters using this ClusterClass.
+	// Clusters can override maintenanceWindows by setting spec.topology.upgrade.maintenanceWindows.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
 }
 
 // ClusterClassUpgradeExternal defines external runtime extensions for upgrade operations.
@@ -1412,6 +1629,10 @@ type PatchSelectorMatch struct {
 	// .spec.workers.machinePools.
 	// +optional
 	MachinePoolClass *PatchSelectorMatchMachinePoolClass `json:"machinePoolClass,omitempty"`
+
+	// auxiliaryResource selects specific auxiliary resources in .spec.auxiliaryResources.
+	// +optional
+	AuxiliaryResource *PatchSelectorMatchAuxiliaryResource `json:"auxiliaryResource,omitempty"`
 }
 
 // PatchSelectorMatchMachineDeploymentClass selects templates referenced
@@ -1438,6 +1659,18 @@ type PatchSelectorMatchMachinePoolClass struct {
 	Names []string `json:"names,omitempty"`
 }
 
+// PatchSelectorMatchAuxiliaryResource selects specific
+// auxiliary resources in .spec.auxiliaryResources.
+type PatchSelectorMatchAuxiliaryResource struct {
+	// names selects auxiliary resources by name.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=63
+	Names []string `json:"names,omitempty"`
+}
+
 // JSONPatch defines a JSON patch.
 type JSONPatch struct {
 	// op defines the operation of the patch.
@@ -1490,6 +1723,15 @@ type JSONPatchValue struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=10240
 	Template string `json:"template,omitempty"`
+
+	// expression is the CEL expression to be used to calculate the value.
+	// An expression can reference variables defined in .spec.variables and builtin variables;
+	// variables are in scope by name, e.g. `"%s-lb".format([builtin.cluster.name])`.
+	// The expression is type-checked against the variable schemas when the ClusterClass is validated.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	Expression string `json:"expression,omitempty"`
 }
 
 // ExternalPatchDefinition defines an external patch.
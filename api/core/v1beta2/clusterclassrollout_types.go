@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"cmp"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ClusterClassRolloutKind represents the Kind of ClusterClassRollout.
+const ClusterClassRolloutKind = "ClusterClassRollout"
+
+const (
+	// ClusterClassRolloutFinalizer is the finalizer used by the ClusterClassRollout controller to unpin the
+	// Clusters selected by a ClusterClassRollout before removing it from the API Server.
+	ClusterClassRolloutFinalizer = "clusterclassrollout.cluster.x-k8s.io"
+
+	// ClusterClassRolloutNameLabel is the label set on the Clusters pinned by a ClusterClassRollout.
+	ClusterClassRolloutNameLabel = "cluster.x-k8s.io/clusterclassrollout-name"
+)
+
+// ClusterClassRollout's RollingOut condition and corresponding reasons.
+const (
+	// ClusterClassRolloutRollingOutCondition is true if there are Clusters selected by the ClusterClassRollout
+	// which are not yet using the target ClusterClass or which are not yet up-to-date.
+	ClusterClassRolloutRollingOutCondition = RollingOutCondition
+
+	// ClusterClassRolloutRollingOutReason surfaces when the ClusterClassRollout is rolling out.
+	ClusterClassRolloutRollingOutReason = RollingOutReason
+
+	// ClusterClassRolloutWaitingForPauseReason surfaces when the ClusterClassRollout is waiting for
+	// pauseBetweenBatchesSeconds to elapse before starting the next batch.
+	ClusterClassRolloutWaitingForPauseReason = "WaitingForPause"
+
+	// ClusterClassRolloutNotRollingOutReason surfaces when all the Clusters selected by the ClusterClassRollout
+	// are using the target ClusterClass and are up-to-date.
+	ClusterClassRolloutNotRollingOutReason = NotRollingOutReason
+
+	// ClusterClassRolloutRollingOutInternalErrorReason surfaces unexpected failures when rolling out.
+	ClusterClassRolloutRollingOutInternalErrorReason = InternalErrorReason
+)
+
+// ClusterClassRollout's Halted condition and corresponding reasons.
+const (
+	// ClusterClassRolloutHaltedCondition is true if the ClusterClassRollout stopped moving Clusters to the
+	// target ClusterClass because at least one of the Clusters already moved is failing, i.e. its Available condition
+	// is false or its TopologyReconciled condition is false with reason ReconcileFailed.
+	ClusterClassRolloutHaltedCondition = "Halted"
+
+	// ClusterClassRolloutHaltedReason surfaces when the ClusterClassRollout is halted.
+	ClusterClassRolloutHaltedReason = "Halted"
+
+	// ClusterClassRolloutNotHaltedReason surfaces when the ClusterClassRollout is not halted.
+	ClusterClassRolloutNotHaltedReason = "NotHalted"
+)
+
+// ClusterClassRolloutSpec defines the desired state of ClusterClassRollout.
+type ClusterClassRolloutSpec struct {
+	// from is the ClusterClass the Clusters are rolled out from.
+	// Clusters using this ClusterClass keep using it until their batch is reached.
+	// +required
+	From ClusterClassRolloutClassRef `json:"from,omitempty,omitzero"`
+
+	// to is the ClusterClass the Clusters are rolled out to.
+	// It can be a ClusterClass different from the from ClusterClass, e.g. a copy of it including the changes to roll out,
+	// or the from ClusterClass itself, to roll out in stages the changes applied to it.
+	// +required
+	To ClusterClassRolloutClassRef `json:"to,omitempty,omitzero"`
+
+	// clusterSelector selects the Clusters to roll out among the Clusters in the namespace of the
+	// ClusterClassRollout using the from or the to ClusterClass.
+	// This field follows standard label selector semantics; if not present or
+	// empty, it selects all the Clusters using the from or the to ClusterClass.
+	// +optional
+	ClusterSelector metav1.LabelSelector `json:"clusterSelector,omitempty,omitzero"`
+
+	// strategy defines how the Clusters are rolled out.
+	// +optional
+	Strategy ClusterClassRolloutStrategy `json:"strategy,omitempty,omitzero"`
+}
+
+// ClusterClassRolloutClassRef is the reference to a ClusterClass.
+type ClusterClassRolloutClassRef struct {
+	// name is the name of the ClusterClass.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Name string `json:"name,omitempty"`
+
+	// namespace is the namespace of the ClusterClass.
+	// If namespace is empty or not set, it is defaulted to the namespace of the ClusterClassRollout.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ClusterClassRolloutStrategy defines how the Clusters are rolled out.
+// +kubebuilder:validation:MinProperties=1
+type ClusterClassRolloutStrategy struct {
+	// canary defines a group of Clusters rolled out first, as a batch on its own.
+	// +optional
+	Canary ClusterClassRolloutCanary `json:"canary,omitempty,omitzero"`
+
+	// batchSize is the number of Clusters in each batch; Clusters are assigned to batches
+	// in alphabetical order.
+	// The next batch is started only when all the Clusters of the previous batches are up-to-date.
+	// If not set, it defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	BatchSize *int32 `json:"batchSize,omitempty"`
+
+	// maxUnavailableClusters is the maximum number of Clusters moved to the target ClusterClass that can
+	// be not yet up-to-date at the same time; this limits how many Clusters of a batch are rolled out concurrently.
+	// If not set, it defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxUnavailableClusters *int32 `json:"maxUnavailableClusters,omitempty"`
+
+	// pauseBetweenBatchesSeconds is the amount of time to wait after a batch is completed before starting the next one.
+	// If not set, the next batch is started immediately.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PauseBetweenBatchesSeconds *int32 `json:"pauseBetweenBatchesSeconds,omitempty"`
+}
+
+// ClusterClassRolloutCanary defines a group of Clusters rolled out first.
+type ClusterClassRolloutCanary struct {
+	// clusterSelector selects the Clusters in the canary group among the Clusters selected by the ClusterClassRollout.
+	// +required
+	ClusterSelector metav1.LabelSelector `json:"clusterSelector,omitempty,omitzero"`
+}
+
+// IsDefined returns true if the canary group is defined.
+func (c *ClusterClassRolloutCanary) IsDefined() bool {
+	return !reflect.DeepEqual(c, &ClusterClassRolloutCanary{})
+}
+
+// ClusterClassRolloutStatus defines the observed state of ClusterClassRollout.
+// +kubebuilder:validation:MinProperties=1
+type ClusterClassRolloutStatus struct {
+	// conditions represents the observations of a ClusterClassRollout's current state.
+	// Known condition types are RollingOut, Halted, Paused.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// clusters is the number of Clusters selected by the ClusterClassRollout.
+	// +optional
+	Clusters *int32 `json:"clusters,omitempty"`
+
+	// updatedClusters is the number of Clusters selected by the ClusterClassRollout using the target ClusterClass
+	// and pinned to its current generation.
+	// +optional
+	UpdatedClusters *int32 `json:"updatedClusters,omitempty"`
+
+	// upToDateClusters is the number of updated Clusters which are up-to-date, i.e. their topology is reconciled
+	// with the current generation of the target ClusterClass, they are not rolling out and they are available.
+	// +optional
+	UpToDateClusters *int32 `json:"upToDateClusters,omitempty"`
+
+	// batches is the number of batches of the ClusterClassRollout, including the canary group.
+	// +optional
+	Batches *int32 `json:"batches,omitempty"`
+
+	// completedBatches is the number of batches whose Clusters are all up-to-date.
+	// +optional
+	CompletedBatches *int32 `json:"completedBatches,omitempty"`
+
+	// lastBatchCompletionTime is the time when the last batch was completed.
+	// +optional
+	LastBatchCompletionTime metav1.Time `json:"lastBatchCompletionTime,omitempty,omitzero"`
+
+	// observedGeneration is the latest generation observed by the controller.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clusterclassrollouts,shortName=ccr,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="From",type="string",JSONPath=".spec.from.name",description="ClusterClass the Clusters are rolled out from"
+// +kubebuilder:printcolumn:name="To",type="string",JSONPath=".spec.to.name",description="ClusterClass the Clusters are rolled out to"
+// +kubebuilder:printcolumn:name="Clusters",type="integer",JSONPath=".status.clusters",description="Number of Clusters selected by the ClusterClassRollout"
+// +kubebuilder:printcolumn:name="Up-to-date",type="integer",JSONPath=".status.upToDateClusters",description="Number of up-to-date Clusters using the target ClusterClass"
+// +kubebuilder:printcolumn:name="Halted",type="string",JSONPath=`.status.conditions[?(@.type=="Halted")].status`,description="ClusterClassRollout halted"
+// +kubebuilder:printcolumn:name="Paused",type="string",JSONPath=`.status.conditions[?(@.type=="Paused")].status`,description="Reconciliation paused",priority=10
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of ClusterClassRollout"
+
+// ClusterClassRollout is the Schema for the clusterclassrollouts API.
+// A ClusterClassRollout moves the Clusters using a ClusterClass to another ClusterClass in stages,
+// e.g. a canary group first and then batches of Clusters, halting if any of the moved Clusters is failing.
+// The selected Clusters are pinned to the generation of the ClusterClass they are using, so also changes applied
+// to the from or to ClusterClass are applied to the Clusters in stages, when their batch is reached.
+// NOTE: Changes applied to the templates referenced by a ClusterClass do not change the generation of the ClusterClass
+// and they are still propagated to all the Clusters using it at once.
+type ClusterClassRollout struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of ClusterClassRollout.
+	// +required
+	Spec ClusterClassRolloutSpec `json:"spec,omitempty,omitzero"`
+
+	// status is the observed state of ClusterClassRollout.
+	// +optional
+	Status ClusterClassRolloutStatus `json:"status,omitempty,omitzero"`
+}
+
+// GetFromClassKey returns the namespaced name of the ClusterClass the Clusters are rolled out from.
+func (r *ClusterClassRollout) GetFromClassKey() types.NamespacedName {
+	return types.NamespacedName{Namespace: cmp.Or(r.Spec.From.Namespace, r.Namespace), Name: r.Spec.From.Name}
+}
+
+// GetToClassKey returns the namespaced name of the ClusterClass the Clusters are rolled out to.
+func (r *ClusterClassRollout) GetToClassKey() types.NamespacedName {
+	return types.NamespacedName{Namespace: cmp.Or(r.Spec.To.Namespace, r.Namespace), Name: r.Spec.To.Name}
+}
+
+// GetConditions returns the set of conditions for this object.
+func (r *ClusterClassRollout) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets conditions for an API object.
+func (r *ClusterClassRollout) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterClassRolloutList contains a list of ClusterClassRollout.
+type ClusterClassRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is the list of ClusterClassRollouts.
+	Items []ClusterClassRollout `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &ClusterClassRollout{}, &ClusterClassRolloutList{})
+}
@@ -68,6 +68,25 @@ const (
 	// to track the name of the MachinePool topology it represents.
 	ClusterTopologyMachinePoolNameLabel = "topology.cluster.x-k8s.io/pool-name"
 
+	// ClusterTopologyAuxiliaryResourceNameLabel is the label set on the generated auxiliary resources
+	// to track the name of the auxiliary resource in the ClusterClass it represents.
+	ClusterTopologyAuxiliaryResourceNameLabel = "topology.cluster.x-k8s.io/auxiliary-resource-name"
+
+	// ClusterTopologyAuxiliaryResourceKindsAnnotation tracks the kinds of the auxiliary resources created for a Cluster,
+	// so the topology controller can delete auxiliary resources that are not anymore defined in the ClusterClass.
+	// The annotation contains a comma-separated list of <apiVersion>/<kind>.
+	ClusterTopologyAuxiliaryResourceKindsAnnotation = "topology.internal.cluster.x-k8s.io/auxiliary-resource-kinds"
+
+	// ClusterTopologyPinnedClusterClassGenerationAnnotation can be set on a Cluster to pin it to a generation of the
+	// ClusterClass it is using; changes applied to the ClusterClass after this generation are not applied to the Cluster
+	// topology until the annotation is updated or removed.
+	// The annotation is set by the ClusterClassRollout controller on the Clusters selected by a ClusterClassRollout.
+	ClusterTopologyPinnedClusterClassGenerationAnnotation = "topology.cluster.x-k8s.io/pinned-clusterclass-generation"
+
+	// ClusterTopologyReconciledClusterClassGenerationAnnotation tracks the generation of the ClusterClass the topology of
+	// a pinned Cluster has been last successfully reconciled with.
+	ClusterTopologyReconciledClusterClassGenerationAnnotation = "topology.internal.cluster.x-k8s.io/reconciled-class-generation"
+
 	// ClusterTopologyUnsafeUpdateClassNameAnnotation can be used to disable the webhook check on
 	// update that disallows a pre-existing Cluster to be populated with Topology information and Class.
 	ClusterTopologyUnsafeUpdateClassNameAnnotation = "unsafe.topology.cluster.x-k8s.io/disable-update-class-name-check"
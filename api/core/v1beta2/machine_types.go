@@ -381,9 +381,17 @@ const (
 	// with the `pre-drain.delete.hook.machine.cluster.x-k8s.io` prefix on the Machine anymore.
 	MachineDeletingWaitingForPreDrainHookReason = "WaitingForPreDrainHook"
 
+	// MachineDeletingWaitingForBeforeMachineDrainHookReason surfaces when the Machine deletion
+	// waits for the BeforeMachineDrain Runtime SDK hook to succeed before draining the Node.
+	MachineDeletingWaitingForBeforeMachineDrainHookReason = "WaitingForBeforeMachineDrainHook"
+
 	// MachineDeletingDrainingNodeReason surfaces when the Machine deletion is draining the Node.
 	MachineDeletingDrainingNodeReason = "DrainingNode"
 
+	// MachineDeletingWaitingForAfterMachineDrainHookReason surfaces when the Machine deletion
+	// waits for the AfterMachineDrain Runtime SDK hook to succeed after the Node has been drained.
+	MachineDeletingWaitingForAfterMachineDrainHookReason = "WaitingForAfterMachineDrainHook"
+
 	// MachineDeletingWaitingForVolumeDetachReason surfaces when the Machine deletion is
 	// waiting for volumes to detach from the Node.
 	MachineDeletingWaitingForVolumeDetachReason = "WaitingForVolumeDetach"
@@ -43,6 +43,25 @@ const (
 	MachineDrainRuleDrainBehaviorWaitCompleted MachineDrainRuleDrainBehavior = "WaitCompleted"
 )
 
+// MachineDrainRuleDrainAfterTimeout defines what happens to Pods which are still on the Node when the timeout of a
+// MachineDrainRule is reached. Can be either "ForceDelete", "Skip", or "Block".
+// +kubebuilder:validation:Enum=ForceDelete;Skip;Block
+type MachineDrainRuleDrainAfterTimeout string
+
+const (
+	// MachineDrainRuleDrainAfterTimeoutForceDelete means Pods are deleted without using the eviction API,
+	// i.e. without respecting PodDisruptionBudgets.
+	MachineDrainRuleDrainAfterTimeoutForceDelete MachineDrainRuleDrainAfterTimeout = "ForceDelete"
+
+	// MachineDrainRuleDrainAfterTimeoutSkip means Pods are skipped, i.e. the drain is completed without waiting
+	// for those Pods to be removed from the Node.
+	MachineDrainRuleDrainAfterTimeoutSkip MachineDrainRuleDrainAfterTimeout = "Skip"
+
+	// MachineDrainRuleDrainAfterTimeoutBlock means the drain keeps evicting Pods and waiting for them to be removed
+	// from the Node, so the drain is blocked until Pods are gone or the Machine's nodeDrainTimeoutSeconds is reached.
+	MachineDrainRuleDrainAfterTimeoutBlock MachineDrainRuleDrainAfterTimeout = "Block"
+)
+
 // MachineDrainRuleSpec defines the spec of a MachineDrainRule.
 type MachineDrainRuleSpec struct {
 	// drain configures if and how Pods are drained.
@@ -138,6 +157,26 @@ type MachineDrainRuleDrainConfig struct {
 	// Valid values for order are from -2147483648 to 2147483647 (inclusive).
 	// +optional
 	Order *int32 `json:"order,omitempty"`
+
+	// timeoutSeconds is the amount of time Pods to which this MachineDrainRule applies are drained, measured
+	// from the start of the Node drain, before the afterTimeout action is applied to the Pods still on the Node.
+	// This allows e.g. stateful workloads to use a different escalation than stateless ones, instead of relying
+	// only on the Machine's nodeDrainTimeoutSeconds.
+	// timeoutSeconds can only be set if behavior is set to "Drain".
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// afterTimeout defines what happens to Pods which are still on the Node when timeoutSeconds is reached.
+	// Can be either "ForceDelete", "Skip", or "Block".
+	// "ForceDelete" means that Pods are deleted without using the eviction API, i.e. without respecting
+	// PodDisruptionBudgets; Pods are still terminated gracefully.
+	// "Skip" means that Pods are skipped, i.e. the drain does not wait for them to be removed from the Node anymore.
+	// "Block" means that Pods are still evicted respecting PodDisruptionBudgets and the drain waits for them to be removed
+	// from the Node, until the Machine's nodeDrainTimeoutSeconds is reached (if set).
+	// afterTimeout can only be set if timeoutSeconds is set; if timeoutSeconds is set and afterTimeout is not, "Block" is used.
+	// +optional
+	AfterTimeout MachineDrainRuleDrainAfterTimeout `json:"afterTimeout,omitempty"`
 }
 
 // MachineDrainRuleMachineSelector defines to which Machines this MachineDrainRule should be applied.
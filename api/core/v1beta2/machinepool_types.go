@@ -64,6 +64,16 @@ const (
 ).
 */
 
+// Reasons that will be used for the OwnerRemediated condition set by MachineHealthCheck on MachinePool controlled machines
+// being remediated in v1Beta2 API version.
+const (
+	// MachinePoolMachineRemediationMachineDeletingReason surfaces when remediation of a MachinePool machine
+	// has been completed by deleting the unhealthy machine.
+	// Note: After an unhealthy machine is deleted, the InfrastructureMachinePool provider deletes the
+	// corresponding instance and a new one is created to ensure the correct number of replicas exist.
+	MachinePoolMachineRemediationMachineDeletingReason = "MachineDeleting"
+)
+
 // MachinePoolSpec defines the desired state of MachinePool.
 type MachinePoolSpec struct {
 	// clusterName is the name of the Cluster this object belongs to.
@@ -300,6 +300,10 @@ const (
 	// failing due to an error.
 	TopologyReconcileFailedV1Beta1Reason = "TopologyReconcileFailed"
 
+	// TopologyReconciledAuxiliaryResourcesFailedV1Beta1Reason (Severity=Error) documents the reconciliation of a Cluster topology
+	// failing while reconciling the auxiliary resources defined in the ClusterClass.
+	TopologyReconciledAuxiliaryResourcesFailedV1Beta1Reason = "AuxiliaryResourcesReconcileFailed"
+
 	// TopologyReconciledClusterCreatingV1Beta1Reason documents reconciliation of a Cluster topology
 	// not yet created because the BeforeClusterCreate hook is blocking.
 	TopologyReconciledClusterCreatingV1Beta1Reason = "ClusterCreating"
@@ -344,6 +348,10 @@ const (
 	// not yet completed because the upgrade for at least one of the MachinePools has been deferred.
 	TopologyReconciledMachinePoolsUpgradeDeferredV1Beta1Reason = "MachinePoolsUpgradeDeferred"
 
+	// TopologyReconciledMaintenanceWindowClosedV1Beta1Reason (Severity=Info) documents reconciliation of a Cluster topology
+	// not yet completed because the next upgrade step cannot start until a maintenance window opens.
+	TopologyReconciledMaintenanceWindowClosedV1Beta1Reason = "MaintenanceWindowClosed"
+
 	// TopologyReconciledHookBlockingV1Beta1Reason (Severity=Info) documents reconciliation of a Cluster topology
 	// not yet completed because at least one of the lifecycle hooks is blocking.
 	//
@@ -359,6 +367,11 @@ const (
 	// with the ClusterClass surfaced in the ClusterClass status or controller logs.
 	TopologyReconciledClusterClassNotReconciledV1Beta1Reason = "ClusterClassNotReconciled"
 
+	// TopologyReconciledClusterClassRolloutPendingV1Beta1Reason (Severity=Info) documents reconciliation of a Cluster topology not
+	// yet completed because the Cluster is pinned to a previous generation of the ClusterClass, e.g. because
+	// a ClusterClassRollout did not yet reach the batch of the Cluster.
+	TopologyReconciledClusterClassRolloutPendingV1Beta1Reason = "ClusterClassRolloutPending"
+
 	// TopologyReconciledPausedV1Beta1Reason (Severity=Info) surfaces when the Cluster is paused.
 	TopologyReconciledPausedV1Beta1Reason = "Paused"
 )
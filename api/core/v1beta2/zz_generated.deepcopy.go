@@ -44,6 +44,43 @@ func (in *APIEndpoint) DeepCopy() *APIEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuxiliaryResourceClass) DeepCopyInto(out *AuxiliaryResourceClass) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuxiliaryResourceClass.
+func (in *AuxiliaryResourceClass) DeepCopy() *AuxiliaryResourceClass {
+	if in == nil {
+		return nil
+	}
+	out := new(AuxiliaryResourceClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuxiliaryResourceClassTemplate) DeepCopyInto(out *AuxiliaryResourceClassTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuxiliaryResourceClassTemplate.
+func (in *AuxiliaryResourceClassTemplate) DeepCopy() *AuxiliaryResourceClassTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AuxiliaryResourceClassTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bootstrap) DeepCopyInto(out *Bootstrap) {
 	*out = *in
@@ -228,6 +265,194 @@ func (in *ClusterClassRef) DeepCopy() *ClusterClassRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassRollout) DeepCopyInto(out *ClusterClassRollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRollout.
+func (in *ClusterClassRollout) DeepCopy() *ClusterClassRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterClassRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassRolloutCanary) DeepCopyInto(out *ClusterClassRolloutCanary) {
+	*out = *in
+	in.ClusterSelector.DeepCopyInto(&out.ClusterSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRolloutCanary.
+func (in *ClusterClassRolloutCanary) DeepCopy() *ClusterClassRolloutCanary {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassRolloutCanary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassRolloutClassRef) DeepCopyInto(out *ClusterClassRolloutClassRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRolloutClassRef.
+func (in *ClusterClassRolloutClassRef) DeepCopy() *ClusterClassRolloutClassRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassRolloutClassRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassRolloutList) DeepCopyInto(out *ClusterClassRolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterClassRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRolloutList.
+func (in *ClusterClassRolloutList) DeepCopy() *ClusterClassRolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassRolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterClassRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassRolloutSpec) DeepCopyInto(out *ClusterClassRolloutSpec) {
+	*out = *in
+	out.From = in.From
+	out.To = in.To
+	in.ClusterSelector.DeepCopyInto(&out.ClusterSelector)
+	in.Strategy.DeepCopyInto(&out.Strategy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRolloutSpec.
+func (in *ClusterClassRolloutSpec) DeepCopy() *ClusterClassRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassRolloutStatus) DeepCopyInto(out *ClusterClassRolloutStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UpdatedClusters != nil {
+		in, out := &in.UpdatedClusters, &out.UpdatedClusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UpToDateClusters != nil {
+		in, out := &in.UpToDateClusters, &out.UpToDateClusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Batches != nil {
+		in, out := &in.Batches, &out.Batches
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CompletedBatches != nil {
+		in, out := &in.CompletedBatches, &out.CompletedBatches
+		*out = new(int32)
+		**out = **in
+	}
+	in.LastBatchCompletionTime.DeepCopyInto(&out.LastBatchCompletionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRolloutStatus.
+func (in *ClusterClassRolloutStatus) DeepCopy() *ClusterClassRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassRolloutStrategy) DeepCopyInto(out *ClusterClassRolloutStrategy) {
+	*out = *in
+	in.Canary.DeepCopyInto(&out.Canary)
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxUnavailableClusters != nil {
+		in, out := &in.MaxUnavailableClusters, &out.MaxUnavailableClusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PauseBetweenBatchesSeconds != nil {
+		in, out := &in.PauseBetweenBatchesSeconds, &out.PauseBetweenBatchesSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRolloutStrategy.
+func (in *ClusterClassRolloutStrategy) DeepCopy() *ClusterClassRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterClassSpec) DeepCopyInto(out *ClusterClassSpec) {
 	*out = *in
@@ -239,6 +464,13 @@ func (in *ClusterClassSpec) DeepCopyInto(out *ClusterClassSpec) {
 	out.Infrastructure = in.Infrastructure
 	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
 	in.Workers.DeepCopyInto(&out.Workers)
+	if in.AuxiliaryResources != nil {
+		in, out := &in.AuxiliaryResources, &out.AuxiliaryResources
+		*out = make([]AuxiliaryResourceClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Variables != nil {
 		in, out := &in.Variables, &out.Variables
 		*out = make([]ClusterClassVariable, len(*in))
@@ -253,7 +485,7 @@ func (in *ClusterClassSpec) DeepCopyInto(out *ClusterClassSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Upgrade = in.Upgrade
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
 	if in.KubernetesVersions != nil {
 		in, out := &in.KubernetesVersions, &out.KubernetesVersions
 		*out = make([]string, len(*in))
@@ -373,6 +605,13 @@ func (in *ClusterClassTemplateReference) DeepCopy() *ClusterClassTemplateReferen
 func (in *ClusterClassUpgrade) DeepCopyInto(out *ClusterClassUpgrade) {
 	*out = *in
 	out.External = in.External
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassUpgrade.
@@ -728,6 +967,7 @@ func (in *ClusterV1Beta1DeprecatedStatus) DeepCopy() *ClusterV1Beta1DeprecatedSt
 func (in *ClusterVariable) DeepCopyInto(out *ClusterVariable) {
 	*out = *in
 	in.Value.DeepCopyInto(&out.Value)
+	out.ValueFrom = in.ValueFrom
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVariable.
@@ -740,6 +980,37 @@ func (in *ClusterVariable) DeepCopy() *ClusterVariable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVariableSecretKeyReference) DeepCopyInto(out *ClusterVariableSecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVariableSecretKeyReference.
+func (in *ClusterVariableSecretKeyReference) DeepCopy() *ClusterVariableSecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVariableSecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVariableValueSource) DeepCopyInto(out *ClusterVariableValueSource) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVariableValueSource.
+func (in *ClusterVariableValueSource) DeepCopy() *ClusterVariableValueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVariableValueSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -2393,6 +2664,11 @@ func (in *MachineDrainRuleDrainConfig) DeepCopyInto(out *MachineDrainRuleDrainCo
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDrainRuleDrainConfig.
@@ -2889,6 +3165,7 @@ func (in *MachinePoolClass) DeepCopyInto(out *MachinePoolClass) {
 	in.Metadata.DeepCopyInto(&out.Metadata)
 	out.Bootstrap = in.Bootstrap
 	out.Infrastructure = in.Infrastructure
+	in.HealthCheck.DeepCopyInto(&out.HealthCheck)
 	if in.FailureDomains != nil {
 		in, out := &in.FailureDomains, &out.FailureDomains
 		*out = make([]string, len(*in))
@@ -2934,6 +3211,94 @@ func (in *MachinePoolClassBootstrapTemplate) DeepCopy() *MachinePoolClassBootstr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolClassHealthCheck) DeepCopyInto(out *MachinePoolClassHealthCheck) {
+	*out = *in
+	in.Checks.DeepCopyInto(&out.Checks)
+	in.Remediation.DeepCopyInto(&out.Remediation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolClassHealthCheck.
+func (in *MachinePoolClassHealthCheck) DeepCopy() *MachinePoolClassHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolClassHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolClassHealthCheckChecks) DeepCopyInto(out *MachinePoolClassHealthCheckChecks) {
+	*out = *in
+	if in.NodeStartupTimeoutSeconds != nil {
+		in, out := &in.NodeStartupTimeoutSeconds, &out.NodeStartupTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyNodeConditions != nil {
+		in, out := &in.UnhealthyNodeConditions, &out.UnhealthyNodeConditions
+		*out = make([]UnhealthyNodeCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnhealthyMachineConditions != nil {
+		in, out := &in.UnhealthyMachineConditions, &out.UnhealthyMachineConditions
+		*out = make([]UnhealthyMachineCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolClassHealthCheckChecks.
+func (in *MachinePoolClassHealthCheckChecks) DeepCopy() *MachinePoolClassHealthCheckChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolClassHealthCheckChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolClassHealthCheckRemediation) DeepCopyInto(out *MachinePoolClassHealthCheckRemediation) {
+	*out = *in
+	in.TriggerIf.DeepCopyInto(&out.TriggerIf)
+	out.TemplateRef = in.TemplateRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolClassHealthCheckRemediation.
+func (in *MachinePoolClassHealthCheckRemediation) DeepCopy() *MachinePoolClassHealthCheckRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolClassHealthCheckRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolClassHealthCheckRemediationTriggerIf) DeepCopyInto(out *MachinePoolClassHealthCheckRemediationTriggerIf) {
+	*out = *in
+	if in.UnhealthyLessThanOrEqualTo != nil {
+		in, out := &in.UnhealthyLessThanOrEqualTo, &out.UnhealthyLessThanOrEqualTo
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolClassHealthCheckRemediationTriggerIf.
+func (in *MachinePoolClassHealthCheckRemediationTriggerIf) DeepCopy() *MachinePoolClassHealthCheckRemediationTriggerIf {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolClassHealthCheckRemediationTriggerIf)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachinePoolClassInfrastructureTemplate) DeepCopyInto(out *MachinePoolClassInfrastructureTemplate) {
 	*out = *in
@@ -3170,6 +3535,7 @@ func (in *MachinePoolTopology) DeepCopyInto(out *MachinePoolTopology) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.HealthCheck.DeepCopyInto(&out.HealthCheck)
 	in.Deletion.DeepCopyInto(&out.Deletion)
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
@@ -3199,6 +3565,99 @@ func (in *MachinePoolTopology) DeepCopy() *MachinePoolTopology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolTopologyHealthCheck) DeepCopyInto(out *MachinePoolTopologyHealthCheck) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Checks.DeepCopyInto(&out.Checks)
+	in.Remediation.DeepCopyInto(&out.Remediation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolTopologyHealthCheck.
+func (in *MachinePoolTopologyHealthCheck) DeepCopy() *MachinePoolTopologyHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolTopologyHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolTopologyHealthCheckChecks) DeepCopyInto(out *MachinePoolTopologyHealthCheckChecks) {
+	*out = *in
+	if in.NodeStartupTimeoutSeconds != nil {
+		in, out := &in.NodeStartupTimeoutSeconds, &out.NodeStartupTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyNodeConditions != nil {
+		in, out := &in.UnhealthyNodeConditions, &out.UnhealthyNodeConditions
+		*out = make([]UnhealthyNodeCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnhealthyMachineConditions != nil {
+		in, out := &in.UnhealthyMachineConditions, &out.UnhealthyMachineConditions
+		*out = make([]UnhealthyMachineCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolTopologyHealthCheckChecks.
+func (in *MachinePoolTopologyHealthCheckChecks) DeepCopy() *MachinePoolTopologyHealthCheckChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolTopologyHealthCheckChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolTopologyHealthCheckRemediation) DeepCopyInto(out *MachinePoolTopologyHealthCheckRemediation) {
+	*out = *in
+	in.TriggerIf.DeepCopyInto(&out.TriggerIf)
+	out.TemplateRef = in.TemplateRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolTopologyHealthCheckRemediation.
+func (in *MachinePoolTopologyHealthCheckRemediation) DeepCopy() *MachinePoolTopologyHealthCheckRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolTopologyHealthCheckRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolTopologyHealthCheckRemediationTriggerIf) DeepCopyInto(out *MachinePoolTopologyHealthCheckRemediationTriggerIf) {
+	*out = *in
+	if in.UnhealthyLessThanOrEqualTo != nil {
+		in, out := &in.UnhealthyLessThanOrEqualTo, &out.UnhealthyLessThanOrEqualTo
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolTopologyHealthCheckRemediationTriggerIf.
+func (in *MachinePoolTopologyHealthCheckRemediationTriggerIf) DeepCopy() *MachinePoolTopologyHealthCheckRemediationTriggerIf {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolTopologyHealthCheckRemediationTriggerIf)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachinePoolTopologyMachineDeletionSpec) DeepCopyInto(out *MachinePoolTopologyMachineDeletionSpec) {
 	*out = *in
@@ -3643,6 +4102,26 @@ func (in *MachineV1Beta1DeprecatedStatus) DeepCopy() *MachineV1Beta1DeprecatedSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.DurationSeconds != nil {
+		in, out := &in.DurationSeconds, &out.DurationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkRanges) DeepCopyInto(out *NetworkRanges) {
 	*out = *in
@@ -3754,6 +4233,11 @@ func (in *PatchSelectorMatch) DeepCopyInto(out *PatchSelectorMatch) {
 		*out = new(PatchSelectorMatchMachinePoolClass)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AuxiliaryResource != nil {
+		in, out := &in.AuxiliaryResource, &out.AuxiliaryResource
+		*out = new(PatchSelectorMatchAuxiliaryResource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchSelectorMatch.
@@ -3766,6 +4250,26 @@ func (in *PatchSelectorMatch) DeepCopy() *PatchSelectorMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchSelectorMatchAuxiliaryResource) DeepCopyInto(out *PatchSelectorMatchAuxiliaryResource) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchSelectorMatchAuxiliaryResource.
+func (in *PatchSelectorMatchAuxiliaryResource) DeepCopy() *PatchSelectorMatchAuxiliaryResource {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchSelectorMatchAuxiliaryResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PatchSelectorMatchMachineDeploymentClass) DeepCopyInto(out *PatchSelectorMatchMachineDeploymentClass) {
 	*out = *in
@@ -3849,6 +4353,7 @@ func (in *Topology) DeepCopyInto(out *Topology) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topology.
@@ -3861,6 +4366,28 @@ func (in *Topology) DeepCopy() *Topology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyUpgrade) DeepCopyInto(out *TopologyUpgrade) {
+	*out = *in
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyUpgrade.
+func (in *TopologyUpgrade) DeepCopy() *TopologyUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnhealthyMachineCondition) DeepCopyInto(out *UnhealthyMachineCondition) {
 	*out = *in
@@ -3920,6 +4447,11 @@ func (in *ValidationRule) DeepCopy() *ValidationRule {
 func (in *VariableSchema) DeepCopyInto(out *VariableSchema) {
 	*out = *in
 	in.OpenAPIV3Schema.DeepCopyInto(&out.OpenAPIV3Schema)
+	if in.Sensitive != nil {
+		in, out := &in.Sensitive, &out.Sensitive
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSchema.
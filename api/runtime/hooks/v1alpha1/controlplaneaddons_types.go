@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+)
+
+// ControlPlaneAddon is a cluster-critical addon upgraded together with the control plane.
+type ControlPlaneAddon string
+
+const (
+	// KubeProxyControlPlaneAddon is the kube-proxy addon.
+	KubeProxyControlPlaneAddon ControlPlaneAddon = "kube-proxy"
+
+	// CoreDNSControlPlaneAddon is the CoreDNS addon.
+	CoreDNSControlPlaneAddon ControlPlaneAddon = "coredns"
+)
+
+// UpgradeControlPlaneAddonsRequest is the request of the UpgradeControlPlaneAddons hook.
+// +kubebuilder:object:root=true
+type UpgradeControlPlaneAddonsRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the control plane belongs to.
+	// +required
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// controlPlane is the control plane object.
+	// +required
+	ControlPlane runtime.RawExtension `json:"controlPlane"`
+
+	// fromKubernetesVersion is the Kubernetes version the addons have been last upgraded to.
+	// It is empty if the addons have never been upgraded by the control plane provider.
+	// +optional
+	FromKubernetesVersion string `json:"fromKubernetesVersion,omitempty"`
+
+	// toKubernetesVersion is the Kubernetes version of the control plane the addons must be upgraded to.
+	// +required
+	ToKubernetesVersion string `json:"toKubernetesVersion"`
+}
+
+var _ RetryResponseObject = &UpgradeControlPlaneAddonsResponse{}
+
+// UpgradeControlPlaneAddonsResponse is the response of the UpgradeControlPlaneAddons hook.
+// +kubebuilder:object:root=true
+type UpgradeControlPlaneAddonsResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	// A non-zero RetryAfterSeconds signifies that the addons are not yet ready for toKubernetesVersion.
+	CommonRetryResponse `json:",inline"`
+
+	// managedAddons is the list of addons managed by the extension; the control plane provider
+	// does not upgrade those addons.
+	// +optional
+	// +listType=set
+	ManagedAddons []ControlPlaneAddon `json:"managedAddons,omitempty"`
+}
+
+// UpgradeControlPlaneAddons is the hook that will be called by the control plane provider to delegate
+// the upgrade of cluster-critical addons to an extension.
+func UpgradeControlPlaneAddons(*UpgradeControlPlaneAddonsRequest, *UpgradeControlPlaneAddonsResponse) {
+}
+
+func init() {
+	catalogBuilder.RegisterHook(UpgradeControlPlaneAddons, &runtimecatalog.HookMeta{
+		Tags:    []string{"Control Plane Addon Hooks"},
+		Summary: "Cluster API Runtime will call this hook to upgrade cluster-critical addons together with the control plane",
+		Description: "The KubeadmControlPlane controller will call this hook when all the control plane Machines are up-to-date, " +
+			"to allow an extension to take over the upgrade of cluster-critical addons like kube-proxy and CoreDNS; " +
+			"the hook is also called before starting the upgrade to a new Kubernetes version if addons have not been " +
+			"upgraded to the current one yet.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called also when the Kubernetes version of the control plane did not change, " +
+			"so it must be idempotent and return quickly\n" +
+			"- The call's request contains the Cluster object, the control plane object, the Kubernetes version the addons " +
+			"have been last upgraded to and the current Kubernetes version of the control plane\n" +
+			"- Extensions must return the addons they manage; the control plane provider does not upgrade them\n" +
+			"- This is a blocking hook; the control plane is not upgraded to a new Kubernetes version until " +
+			"the hook reports the addons are ready for the current one\n",
+	})
+}
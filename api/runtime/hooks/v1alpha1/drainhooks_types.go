@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+)
+
+// BeforeMachineDrainRequest is the request of the BeforeMachineDrain hook.
+// +kubebuilder:object:root=true
+type BeforeMachineDrainRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the Machine belongs to.
+	// +required
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// machine is the Machine object which is going to be drained.
+	// +required
+	Machine clusterv1.Machine `json:"machine"`
+
+	// nodeName is the name of the Node which is going to be drained.
+	// +required
+	NodeName string `json:"nodeName"`
+}
+
+var _ RetryResponseObject = &BeforeMachineDrainResponse{}
+
+// BeforeMachineDrainResponse is the response of the BeforeMachineDrain hook.
+// +kubebuilder:object:root=true
+type BeforeMachineDrainResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// BeforeMachineDrain is the hook that is called during Machine deletion before the Node
+// of the Machine is cordoned and drained.
+func BeforeMachineDrain(*BeforeMachineDrainRequest, *BeforeMachineDrainResponse) {}
+
+// AfterMachineDrainRequest is the request of the AfterMachineDrain hook.
+// +kubebuilder:object:root=true
+type AfterMachineDrainRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the Machine belongs to.
+	// +required
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// machine is the Machine object which has been drained.
+	// +required
+	Machine clusterv1.Machine `json:"machine"`
+
+	// nodeName is the name of the Node which has been drained.
+	// +required
+	NodeName string `json:"nodeName"`
+}
+
+var _ RetryResponseObject = &AfterMachineDrainResponse{}
+
+// AfterMachineDrainResponse is the response of the AfterMachineDrain hook.
+// +kubebuilder:object:root=true
+type AfterMachineDrainResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// AfterMachineDrain is the hook that is called during Machine deletion after the Node
+// of the Machine has been drained.
+func AfterMachineDrain(*AfterMachineDrainRequest, *AfterMachineDrainResponse) {}
+
+func init() {
+	catalogBuilder.RegisterHook(BeforeMachineDrain, &runtimecatalog.HookMeta{
+		Tags:    []string{"Machine Drain Hooks"},
+		Summary: "Cluster API Runtime will call this hook before the Node of a Machine is drained",
+		Description: "Cluster API Runtime will call this hook during Machine deletion, after pre-drain hooks succeeded " +
+			"and immediately before the Node of the Machine is cordoned and drained.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called for all Machines, also for Machines of Clusters without a managed topology\n" +
+			"- This hook will be called only if the Node of the Machine is going to be drained\n" +
+			"- The call's request contains the Cluster object, the Machine object and the name of the Node\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to execute " +
+			"tasks before the Node is drained, e.g. to check storage replication or to hand off application workloads\n",
+	})
+
+	catalogBuilder.RegisterHook(AfterMachineDrain, &runtimecatalog.HookMeta{
+		Tags:    []string{"Machine Drain Hooks"},
+		Summary: "Cluster API Runtime will call this hook after the Node of a Machine is drained",
+		Description: "Cluster API Runtime will call this hook during Machine deletion, after the Node of the Machine " +
+			"has been drained and before waiting for volumes to be detached from the Node.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called for all Machines, also for Machines of Clusters without a managed topology\n" +
+			"- This hook will be called only if BeforeMachineDrain has been called for the Machine and the drain completed\n" +
+			"- The call's request contains the Cluster object, the Machine object and the name of the Node\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to execute " +
+			"tasks before the Machine deletion continues\n",
+	})
+}
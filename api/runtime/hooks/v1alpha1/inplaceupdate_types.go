@@ -212,6 +212,46 @@ type UpdateMachineResponse struct {
 // until it reports Done or Failed status.
 func UpdateMachine(*UpdateMachineRequest, *UpdateMachineResponse) {}
 
+// RenewMachineCertificatesRequest is the request of the RenewMachineCertificates hook.
+// +kubebuilder:object:root=true
+type RenewMachineCertificatesRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the Machine belongs to.
+	// +required
+	Cluster clusterv1.Cluster `json:"cluster,omitempty,omitzero"`
+
+	// machine is the full Machine object.
+	// +required
+	Machine clusterv1.Machine `json:"machine,omitempty,omitzero"`
+
+	// bootstrapConfig is the bootstrap config object.
+	// +optional
+	BootstrapConfig runtime.RawExtension `json:"bootstrapConfig,omitempty,omitzero"`
+}
+
+var _ RetryResponseObject = &RenewMachineCertificatesResponse{}
+
+// RenewMachineCertificatesResponse is the response of the RenewMachineCertificates hook.
+// The status of the renewal is determined by the CommonRetryResponse fields:
+// - Status=Success + RetryAfterSeconds > 0: renewal is in progress
+// - Status=Success + RetryAfterSeconds = 0: renewal completed successfully
+// - Status=Failure: renewal failed
+// +kubebuilder:object:root=true
+type RenewMachineCertificatesResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// RenewMachineCertificates is the hook that will be called to renew the certificates of a control plane
+// Machine in-place, without replacing the Machine.
+func RenewMachineCertificates(*RenewMachineCertificatesRequest, *RenewMachineCertificatesResponse) {}
+
 func init() {
 	catalogBuilder.RegisterHook(CanUpdateMachine, &runtimecatalog.HookMeta{
 		Tags:    []string{"In-Place Update Hooks"},
@@ -251,4 +291,18 @@ func init() {
 			"Notes:\n" +
 			"- This hook must be idempotent - it can be called multiple times for the same Machine\n",
 	})
+
+	catalogBuilder.RegisterHook(RenewMachineCertificates, &runtimecatalog.HookMeta{
+		Tags:    []string{"In-Place Update Hooks"},
+		Summary: "Cluster API Runtime will call this hook to renew the certificates of a control plane Machine in-place",
+		Description: "The KubeadmControlPlane controller will call this hook instead of rolling out a control plane Machine " +
+			"whose certificates are about to expire, e.g. by running `kubeadm certs renew all` and restarting the control plane static Pods. " +
+			"The request contains the Cluster, the Machine and optionally the BootstrapConfig. " +
+			"The hook will be called repeatedly until it reports Done or Failed status.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook must be idempotent - it can be called multiple times for the same Machine\n" +
+			"- The hook must report Done only after the control plane static Pods have been restarted with the renewed certificates\n" +
+			"- Certificates are renewed one Machine at a time\n",
+	})
 }
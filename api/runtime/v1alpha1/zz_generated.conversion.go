@@ -63,11 +63,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ExtensionConfigSpec)(nil), (*ExtensionConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ExtensionConfigSpec_To_v1alpha1_ExtensionConfigSpec(a.(*v1beta2.ExtensionConfigSpec), b.(*ExtensionConfigSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*GroupVersionHook)(nil), (*v1beta2.GroupVersionHook)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GroupVersionHook_To_v1beta2_GroupVersionHook(a.(*GroupVersionHook), b.(*v1beta2.GroupVersionHook), scope)
 	}); err != nil {
@@ -118,6 +113,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ExtensionConfigSpec)(nil), (*ExtensionConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ExtensionConfigSpec_To_v1alpha1_ExtensionConfigSpec(a.(*v1beta2.ExtensionConfigSpec), b.(*ExtensionConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.ExtensionConfigStatus)(nil), (*ExtensionConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ExtensionConfigStatus_To_v1alpha1_ExtensionConfigStatus(a.(*v1beta2.ExtensionConfigStatus), b.(*ExtensionConfigStatus), scope)
 	}); err != nil {
@@ -243,14 +243,10 @@ func autoConvert_v1beta2_ExtensionConfigSpec_To_v1alpha1_ExtensionConfigSpec(in
 	}
 	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	out.Settings = *(*map[string]string)(unsafe.Pointer(&in.Settings))
+	// WARNING: in.CallPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ExtensionConfigSpec_To_v1alpha1_ExtensionConfigSpec is an autogenerated conversion function.
-func Convert_v1beta2_ExtensionConfigSpec_To_v1alpha1_ExtensionConfigSpec(in *v1beta2.ExtensionConfigSpec, out *ExtensionConfigSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_ExtensionConfigSpec_To_v1alpha1_ExtensionConfigSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_ExtensionConfigStatus_To_v1beta2_ExtensionConfigStatus(in *ExtensionConfigStatus, out *v1beta2.ExtensionConfigStatus, s conversion.Scope) error {
 	if in.Handlers != nil {
 		in, out := &in.Handlers, &out.Handlers
@@ -41,6 +41,77 @@ type ExtensionConfigSpec struct {
 	// Note: Settings can be overridden on the ClusterClass.
 	// +optional
 	Settings map[string]string `json:"settings,omitempty"`
+
+	// callPolicy defines how the client protects itself and the Extension server when calling
+	// the ExtensionHandlers of this ExtensionConfig.
+	// If not set, calls are neither limited, nor guarded by a circuit breaker, nor cached.
+	// +optional
+	CallPolicy ExtensionCallPolicy `json:"callPolicy,omitempty,omitzero"`
+}
+
+// ExtensionCallPolicy defines how calls to the ExtensionHandlers of an ExtensionConfig are performed.
+// Note: All the limits are applied separately to each ExtensionHandler of the ExtensionConfig
+// and to each controller calling the ExtensionHandler.
+// +kubebuilder:validation:MinProperties=1
+type ExtensionCallPolicy struct {
+	// maxConcurrentCalls is the maximum number of in-flight calls to an ExtensionHandler.
+	// Calls exceeding the limit wait for an in-flight call to complete, until the timeout of the
+	// ExtensionHandler expires; calls that cannot be performed in time are handled according to
+	// the failurePolicy of the ExtensionHandler.
+	// If not set, the number of in-flight calls is not limited.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	MaxConcurrentCalls int32 `json:"maxConcurrentCalls,omitempty"`
+
+	// circuitBreaker stops calling an ExtensionHandler after consecutive failed calls, thus preventing
+	// a slow or unavailable Extension server from slowing down all the reconciles depending on it.
+	// +optional
+	CircuitBreaker ExtensionCircuitBreaker `json:"circuitBreaker,omitempty,omitzero"`
+
+	// responseCache enables caching of the responses of idempotent hooks.
+	// +optional
+	ResponseCache ExtensionResponseCache `json:"responseCache,omitempty,omitzero"`
+}
+
+// ExtensionCircuitBreaker defines the circuit breaker for calls to an ExtensionHandler.
+//
+// The circuit breaker opens after failureThreshold consecutive calls fail with an error
+// (e.g. connection errors, timeouts, status codes other than 200); responses with status
+// Failure are not considered failed calls. While the circuit breaker is open, calls are not sent
+// to the ExtensionHandler and are handled according to the failurePolicy of the ExtensionHandler.
+// After openDurationSeconds a single call is sent to the ExtensionHandler; if the call succeeds
+// the circuit breaker closes, otherwise it opens again.
+// +kubebuilder:validation:MinProperties=1
+type ExtensionCircuitBreaker struct {
+	// failureThreshold is the number of consecutive failed calls after which the circuit breaker opens.
+	// If not set, the circuit breaker is disabled.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+
+	// openDurationSeconds is the duration the circuit breaker stays open before calling the ExtensionHandler again.
+	// Defaults to 30 if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	OpenDurationSeconds int32 `json:"openDurationSeconds,omitempty"`
+}
+
+// ExtensionResponseCache defines caching of ExtensionHandler responses.
+//
+// Only responses of the idempotent hooks GeneratePatches, ValidateTopology and DiscoverVariables are cached.
+// Responses are cached by ExtensionHandler, ExtensionConfig resourceVersion and a hash of the request; only
+// successful responses are cached.
+// +kubebuilder:validation:MinProperties=1
+type ExtensionResponseCache struct {
+	// ttlSeconds is the duration a response is cached.
+	// If not set, responses are not cached.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	TTLSeconds int32 `json:"ttlSeconds,omitempty"`
 }
 
 // ClientConfig contains the information to make a client
@@ -120,7 +191,7 @@ func (r *ServiceReference) IsDefined() bool {
 // +kubebuilder:validation:MinProperties=1
 type ExtensionConfigStatus struct {
 	// conditions represents the observations of a ExtensionConfig's current state.
-	// Known condition types are Discovered, Paused.
+	// Known condition types are Discovered, HandlersAvailable, Paused.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -299,6 +370,21 @@ const (
 	ExtensionConfigNotDiscoveredReason = "NotDiscovered"
 )
 
+// ExtensionConfig's HandlersAvailable conditions and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// ExtensionConfigHandlersAvailableCondition is true if the circuit breakers of all the ExtensionHandlers
+	// of the ExtensionConfig are closed.
+	// Note: This condition is only set if spec.callPolicy.circuitBreaker is configured.
+	ExtensionConfigHandlersAvailableCondition = "HandlersAvailable"
+
+	// ExtensionConfigHandlersAvailableReason surfaces that the circuit breakers of all the ExtensionHandlers are closed.
+	ExtensionConfigHandlersAvailableReason = "HandlersAvailable"
+
+	// ExtensionConfigHandlersCircuitBreakerOpenReason surfaces that the circuit breaker of at least one
+	// ExtensionHandler is open.
+	ExtensionConfigHandlersCircuitBreakerOpenReason = "CircuitBreakerOpen"
+)
+
 const (
 	// RuntimeExtensionDiscoveredV1Beta1Condition is a condition set on an ExtensionConfig object once it has been discovered by the Runtime SDK client.
 	RuntimeExtensionDiscoveredV1Beta1Condition clusterv1.ConditionType = "Discovered"
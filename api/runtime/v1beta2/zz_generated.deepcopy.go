@@ -47,6 +47,38 @@ func (in *ClientConfig) DeepCopy() *ClientConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionCallPolicy) DeepCopyInto(out *ExtensionCallPolicy) {
+	*out = *in
+	out.CircuitBreaker = in.CircuitBreaker
+	out.ResponseCache = in.ResponseCache
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionCallPolicy.
+func (in *ExtensionCallPolicy) DeepCopy() *ExtensionCallPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionCallPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionCircuitBreaker) DeepCopyInto(out *ExtensionCircuitBreaker) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionCircuitBreaker.
+func (in *ExtensionCircuitBreaker) DeepCopy() *ExtensionCircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionCircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtensionConfig) DeepCopyInto(out *ExtensionConfig) {
 	*out = *in
@@ -142,6 +174,7 @@ func (in *ExtensionConfigSpec) DeepCopyInto(out *ExtensionConfigSpec) {
 			(*out)[key] = val
 		}
 	}
+	out.CallPolicy = in.CallPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionConfigSpec.
@@ -224,6 +257,21 @@ func (in *ExtensionHandler) DeepCopy() *ExtensionHandler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionResponseCache) DeepCopyInto(out *ExtensionResponseCache) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionResponseCache.
+func (in *ExtensionResponseCache) DeepCopy() *ExtensionResponseCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionResponseCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupVersionHook) DeepCopyInto(out *GroupVersionHook) {
 	*out = *in
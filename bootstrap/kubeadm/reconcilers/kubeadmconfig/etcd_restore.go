@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+)
+
+const (
+	// etcdRestoreScriptPath is the path of the script restoring etcd before kubeadm init.
+	etcdRestoreScriptPath = "/etc/kubernetes/etcd-restore.sh"
+
+	// cloudInitKubeadmConfigPath and ignitionKubeadmConfigPath are the paths of the kubeadm configuration file
+	// written by the cloud-init and the Ignition bootstrap data.
+	cloudInitKubeadmConfigPath = "/run/kubeadm/kubeadm.yaml"
+	ignitionKubeadmConfigPath  = "/etc/kubeadm.yml"
+
+	// defaultEtcdDataDir is the default etcd data directory of kubeadm.
+	defaultEtcdDataDir = "/var/lib/etcd"
+)
+
+// resolveEtcdRestoreScript returns the file with the script restoring etcd, read from the Secret defined in the
+// EtcdRestoreScriptSecretAnnotation, and the command running it before kubeadm init.
+// If the script fails, the command stops the bootstrap data before kubeadm init, so kubeadm never initializes
+// a new, empty, etcd cluster in place of the restored one.
+func (r *Reconciler) resolveEtcdRestoreScript(ctx context.Context, cfg *bootstrapv1.KubeadmConfig, secretName string) (bootstrapv1.File, string, error) {
+	script, err := r.resolveSecretFileContent(ctx, cfg.Namespace, bootstrapv1.File{
+		ContentFrom: bootstrapv1.FileSource{
+			Secret: bootstrapv1.SecretFileSource{
+				Name: secretName,
+				Key:  bootstrapv1.EtcdRestoreScriptSecretKey,
+			},
+		},
+	})
+	if err != nil {
+		return bootstrapv1.File{}, "", pkgerrors.Wrapf(err, "failed to resolve the etcd restore script defined in the %s annotation", bootstrapv1.EtcdRestoreScriptSecretAnnotation)
+	}
+
+	kubeadmConfigPath := cloudInitKubeadmConfigPath
+	if cfg.Spec.Format == bootstrapv1.Ignition {
+		kubeadmConfigPath = ignitionKubeadmConfigPath
+	}
+	file := bootstrapv1.File{
+		Path:        etcdRestoreScriptPath,
+		Owner:       "root:root",
+		Permissions: "0700",
+		Content:     string(script),
+	}
+	return file, fmt.Sprintf("/bin/bash %s %s || exit 1", etcdRestoreScriptPath, kubeadmConfigPath), nil
+}
+
+// etcdRestoreInitConfiguration returns a copy of initConfiguration ignoring the kubeadm preflight check failing
+// if the etcd data directory is not empty, because the data directory is populated by the etcd restore script.
+func etcdRestoreInitConfiguration(initConfiguration *bootstrapv1.InitConfiguration, clusterConfiguration *bootstrapv1.ClusterConfiguration) *bootstrapv1.InitConfiguration {
+	dataDir := clusterConfiguration.Etcd.Local.DataDir
+	if dataDir == "" {
+		dataDir = defaultEtcdDataDir
+	}
+
+	initConfiguration = initConfiguration.DeepCopy()
+	initConfiguration.NodeRegistration.IgnorePreflightErrors = append(initConfiguration.NodeRegistration.IgnorePreflightErrors,
+		"DirAvailable-"+strings.ReplaceAll(dataDir, "/", "-"))
+	return initConfiguration
+}
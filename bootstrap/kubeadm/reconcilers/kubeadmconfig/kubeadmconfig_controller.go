@@ -19,6 +19,7 @@ package kubeadmconfig
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
@@ -353,6 +354,12 @@ func (r *Reconciler) reconcile(ctx context.Context, scope *Scope, cluster *clust
 		return r.handleClusterNotInitialized(ctx, scope)
 	}
 
+	// If etcd must be restored, the control plane Machine initializes a new control plane
+	// even if the control plane of the Cluster is already initialized.
+	if _, ok := config.Annotations[bootstrapv1.EtcdRestoreScriptSecretAnnotation]; ok && configOwner.IsControlPlaneMachine() {
+		return r.handleClusterNotInitialized(ctx, scope)
+	}
+
 	// Every other case it's a join scenario
 	// Nb. in this case ClusterConfiguration and InitConfiguration should not be defined by users, but in case of misconfigurations, CABPK simply ignore them
 
@@ -524,7 +531,13 @@ func (r *Reconciler) handleClusterNotInitialized(ctx context.Context, scope *Sco
 		return ctrl.Result{}, err
 	}
 
-	initdata, err := kubeadmtypes.MarshalInitConfigurationForVersion(&scope.Config.Spec.InitConfiguration, parsedVersion)
+	initConfiguration := &scope.Config.Spec.InitConfiguration
+	etcdRestoreScriptSecretName, etcdRestore := scope.Config.Annotations[bootstrapv1.EtcdRestoreScriptSecretAnnotation]
+	if etcdRestore {
+		initConfiguration = etcdRestoreInitConfiguration(initConfiguration, &scope.Config.Spec.ClusterConfiguration)
+	}
+
+	initdata, err := kubeadmtypes.MarshalInitConfigurationForVersion(initConfiguration, parsedVersion)
 	if err != nil {
 		scope.Error(err, "Failed to marshal init configuration")
 		return ctrl.Result{}, err
@@ -583,6 +596,25 @@ func (r *Reconciler) handleClusterNotInitialized(ctx context.Context, scope *Sco
 		return ctrl.Result{}, err
 	}
 
+	preKubeadmCommands := scope.Config.Spec.PreKubeadmCommands
+	if etcdRestore {
+		scope.Info("Restoring etcd before kubeadm init", "Secret", etcdRestoreScriptSecretName)
+		etcdRestoreFile, etcdRestoreCommand, err := r.resolveEtcdRestoreScript(ctx, scope.Config, etcdRestoreScriptSecretName)
+		if err != nil {
+			v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+			conditions.Set(scope.Config, metav1.Condition{
+				Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+				Message: fmt.Sprintf("Failed to prepare etcd restore: %v", err),
+			})
+			return ctrl.Result{}, err
+		}
+		// Note: etcd is restored after user-defined commands, which might e.g. install etcdutl.
+		files = append(files, etcdRestoreFile)
+		preKubeadmCommands = append(slices.Clone(preKubeadmCommands), etcdRestoreCommand)
+	}
+
 	users, err := r.resolveUsers(ctx, scope.Config)
 	if err != nil {
 		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
@@ -605,7 +637,7 @@ func (r *Reconciler) handleClusterNotInitialized(ctx context.Context, scope *Sco
 				return nil
 			}(),
 			BootCommands:        scope.Config.Spec.BootCommands,
-			PreKubeadmCommands:  scope.Config.Spec.PreKubeadmCommands,
+			PreKubeadmCommands:  preKubeadmCommands,
 			PostKubeadmCommands: scope.Config.Spec.PostKubeadmCommands,
 			Users:               users,
 			Mounts:              scope.Config.Spec.Mounts,
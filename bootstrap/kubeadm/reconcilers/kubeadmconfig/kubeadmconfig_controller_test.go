@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -550,6 +551,91 @@ func TestKubeadmConfigReconciler_Reconcile_GenerateCloudConfigData(t *testing.T)
 	g.Expect(err).ToNot(HaveOccurred())
 }
 
+// If etcd must be restored on a control plane Machine, then we will generate init data even if the control plane is initialized.
+func TestKubeadmConfigReconciler_Reconcile_GenerateCloudConfigDataForEtcdRestore(t *testing.T) {
+	configName := "control-plane-restore-cfg"
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster").Build()
+	cluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: "validhost", Port: 6443}
+	cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+	cluster.Status.Conditions = []metav1.Condition{{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue}}
+
+	restoreScriptSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "etcd-restore"},
+		Data: map[string][]byte{
+			bootstrapv1.EtcdRestoreScriptSecretKey: []byte("#!/bin/bash\netcdutl snapshot restore snapshot.db\n"),
+		},
+	}
+
+	setup := func(t *testing.T, objs ...client.Object) (*Reconciler, client.Client, ctrl.Request) {
+		t.Helper()
+
+		controlPlaneRestoreMachine := newControlPlaneMachine(cluster, "control-plane-restore-machine")
+		controlPlaneRestoreConfig := newControlPlaneInitKubeadmConfig(controlPlaneRestoreMachine.Namespace, configName)
+		controlPlaneRestoreConfig.Annotations = map[string]string{bootstrapv1.EtcdRestoreScriptSecretAnnotation: restoreScriptSecret.Name}
+		controlPlaneRestoreConfig.Spec.PreKubeadmCommands = []string{"echo pre"}
+		addKubeadmConfigToMachine(controlPlaneRestoreConfig, controlPlaneRestoreMachine)
+
+		objects := []client.Object{
+			cluster.DeepCopy(),
+			controlPlaneRestoreMachine,
+			controlPlaneRestoreConfig,
+		}
+		objects = append(objects, createSecrets(t, cluster, controlPlaneRestoreConfig)...)
+		objects = append(objects, objs...)
+
+		myclient := fake.NewClientBuilder().WithObjects(objects...).WithStatusSubresource(&bootstrapv1.KubeadmConfig{}).Build()
+		k := &Reconciler{
+			Client:              myclient,
+			SecretCachingClient: myclient,
+			ClusterCache:        clustercache.NewFakeClusterCache(myclient, client.ObjectKey{Name: cluster.Name, Namespace: cluster.Namespace}),
+			KubeadmInitLock:     &myInitLocker{},
+		}
+		return k, myclient, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: configName}}
+	}
+
+	t.Run("runs the etcd restore script before kubeadm init", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k, myclient, request := setup(t, restoreScriptSecret.DeepCopy())
+
+		result, err := k.Reconcile(ctx, request)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+
+		cfg, err := getKubeadmConfig(myclient, configName, metav1.NamespaceDefault)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ptr.Deref(cfg.Status.Initialization.DataSecretCreated, false)).To(BeTrue())
+		// The preflight error is only ignored in the bootstrap data.
+		g.Expect(cfg.Spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors).To(BeEmpty())
+
+		s := &corev1.Secret{}
+		g.Expect(myclient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: configName}, s)).To(Succeed())
+		value := string(s.Data["value"])
+		g.Expect(value).To(ContainSubstring("path: " + etcdRestoreScriptPath))
+		g.Expect(value).To(ContainSubstring("etcdutl snapshot restore snapshot.db"))
+		g.Expect(value).To(ContainSubstring("DirAvailable--var-lib-etcd"))
+
+		restoreCommand := fmt.Sprintf("/bin/bash %s %s || exit 1", etcdRestoreScriptPath, cloudInitKubeadmConfigPath)
+		g.Expect(value).To(ContainSubstring(restoreCommand))
+		g.Expect(strings.Index(value, "echo pre")).To(BeNumerically("<", strings.Index(value, restoreCommand)))
+		g.Expect(strings.Index(value, restoreCommand)).To(BeNumerically("<", strings.Index(value, "kubeadm init")))
+	})
+
+	t.Run("fails if the etcd restore script Secret does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k, myclient, request := setup(t)
+
+		_, err := k.Reconcile(ctx, request)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring(bootstrapv1.EtcdRestoreScriptSecretAnnotation))
+
+		cfg, err := getKubeadmConfig(myclient, configName, metav1.NamespaceDefault)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ptr.Deref(cfg.Status.Initialization.DataSecretCreated, false)).To(BeFalse())
+	})
+}
+
 // If a control plane has no JoinConfiguration, then we will create a default and no error will occur.
 func TestKubeadmConfigReconciler_Reconcile_ErrorIfJoiningControlPlaneHasInvalidConfiguration(t *testing.T) {
 	g := NewWithT(t)
@@ -91,6 +91,12 @@ type AlphaClient interface {
 	RolloutPause(ctx context.Context, options RolloutPauseOptions) error
 	// RolloutResume provides rollout resume of paused cluster-api resources
 	RolloutResume(ctx context.Context, options RolloutResumeOptions) error
+	// TopologyPlan returns the changes the topology controller would make to the Clusters affected by
+	// new or modified Clusters, ClusterClasses and templates, without applying them.
+	TopologyPlan(ctx context.Context, options TopologyPlanOptions) (*TopologyPlanOutput, error)
+	// TopologyCheckRebase checks whether Clusters using managed topologies can be rebased onto another ClusterClass,
+	// and returns the reasons why they cannot.
+	TopologyCheckRebase(ctx context.Context, options TopologyCheckRebaseOptions) (*TopologyCheckRebaseOutput, error)
 }
 
 // YamlPrinter exposes methods that prints the processed template and
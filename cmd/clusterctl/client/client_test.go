@@ -145,6 +145,14 @@ func (f fakeClient) RolloutResume(ctx context.Context, options RolloutResumeOpti
 	return f.internalClient.RolloutResume(ctx, options)
 }
 
+func (f fakeClient) TopologyPlan(ctx context.Context, options TopologyPlanOptions) (*TopologyPlanOutput, error) {
+	return f.internalClient.TopologyPlan(ctx, options)
+}
+
+func (f fakeClient) TopologyCheckRebase(ctx context.Context, options TopologyCheckRebaseOptions) (*TopologyCheckRebaseOutput, error) {
+	return f.internalClient.TopologyCheckRebase(ctx, options)
+}
+
 func (f fakeClient) Convert(ctx context.Context, options ConvertOptions) (ConvertResult, error) {
 	return f.internalClient.Convert(ctx, options)
 }
@@ -322,6 +330,10 @@ func (f *fakeClusterClient) WorkloadCluster() cluster.WorkloadCluster {
 	return f.internalclient.WorkloadCluster()
 }
 
+func (f *fakeClusterClient) Topology() cluster.TopologyClient {
+	return f.internalclient.Topology()
+}
+
 func (f *fakeClusterClient) WithObjs(objs ...client.Object) *fakeClusterClient {
 	f.fakeProxy.WithObjs(objs...)
 	return f
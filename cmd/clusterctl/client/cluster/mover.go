@@ -29,6 +29,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -50,11 +51,43 @@ import (
 // ResourceMutatorFunc holds the type for mutators to be applied on resources during a move operation.
 type ResourceMutatorFunc func(u *unstructured.Unstructured) error
 
+// ClusterSelection restricts a move operation to a set of Clusters, together with their dependent objects.
+// If empty, all the Clusters in the namespace are moved.
+type ClusterSelection struct {
+	// Names are the names of the Clusters to be moved.
+	Names []string
+
+	// LabelSelector selects the Clusters to be moved by label.
+	LabelSelector labels.Selector
+}
+
+// IsEmpty returns true if no Clusters are selected.
+func (s ClusterSelection) IsEmpty() bool {
+	return len(s.Names) == 0 && (s.LabelSelector == nil || s.LabelSelector.Empty())
+}
+
 // ObjectMover defines methods for moving Cluster API objects to another management cluster.
 type ObjectMover interface {
 	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
 	Move(ctx context.Context, namespace string, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error
 
+	// MoveSelected moves the selected Clusters existing in a namespace (or from all the namespaces if empty), together with
+	// their dependent objects, to a target management cluster. If selection is empty, all the Cluster API objects are moved.
+	MoveSelected(ctx context.Context, namespace string, selection ClusterSelection, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error
+
+	// MoveWithCheckpoint moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster,
+	// recording the progress of the move operation in a checkpoint file, so an interrupted move can be resumed or rolled back.
+	// If selection is not empty, only the selected Clusters and their dependent objects are moved.
+	MoveWithCheckpoint(ctx context.Context, namespace string, selection ClusterSelection, toCluster Client, checkpointFile string, mutators ...ResourceMutatorFunc) error
+
+	// ResumeMove resumes an interrupted move operation from a checkpoint file.
+	ResumeMove(ctx context.Context, toCluster Client, checkpointFile string, mutators ...ResourceMutatorFunc) error
+
+	// RollbackMove reverts an interrupted move operation using a checkpoint file, by deleting the objects already created
+	// in the target management cluster and by resuming Clusters and ClusterClasses in the source management cluster.
+	// Nb. A move operation can be rolled back only if it has not yet started deleting objects from the source management cluster.
+	RollbackMove(ctx context.Context, toCluster Client, checkpointFile string) error
+
 	// ToDirectory writes all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target directory.
 	ToDirectory(ctx context.Context, namespace string, directory string) error
 
@@ -67,12 +100,19 @@ type objectMover struct {
 	fromProxy             Proxy
 	fromProviderInventory InventoryClient
 	dryRun                bool
+
+	// checkpoint records the progress of the move operation; it is nil if no checkpoint file is used.
+	checkpoint *moveCheckpoint
 }
 
 // ensure objectMover implements the ObjectMover interface.
 var _ ObjectMover = &objectMover{}
 
 func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error {
+	return o.MoveSelected(ctx, namespace, ClusterSelection{}, toCluster, dryRun, mutators...)
+}
+
+func (o *objectMover) MoveSelected(ctx context.Context, namespace string, selection ClusterSelection, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error {
 	log := logf.Log
 	log.Info("Performing move...")
 	o.dryRun = dryRun
@@ -89,7 +129,7 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 		}
 	}
 
-	objectGraph, err := o.getObjectGraph(ctx, namespace)
+	objectGraph, err := o.getObjectGraph(ctx, namespace, selection, nil)
 	if err != nil {
 		return pkgerrors.Wrap(err, "failed to get object graph")
 	}
@@ -103,11 +143,71 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 	return o.move(ctx, objectGraph, proxy, mutators...)
 }
 
+func (o *objectMover) MoveWithCheckpoint(ctx context.Context, namespace string, selection ClusterSelection, toCluster Client, checkpointFile string, mutators ...ResourceMutatorFunc) error {
+	if _, err := os.Stat(checkpointFile); err == nil {
+		return pkgerrors.Errorf("checkpoint file %q already exists, the previous move operation must be resumed or rolled back", checkpointFile)
+	} else if !os.IsNotExist(err) {
+		return pkgerrors.Wrapf(err, "failed to check checkpoint file %q", checkpointFile)
+	}
+
+	o.checkpoint = newMoveCheckpoint(checkpointFile, namespace)
+	o.checkpoint.Selective = !selection.IsEmpty()
+	if err := o.MoveSelected(ctx, namespace, selection, toCluster, false, mutators...); err != nil {
+		return o.checkpoint.wrapError(err)
+	}
+	return o.checkpoint.remove()
+}
+
+func (o *objectMover) ResumeMove(ctx context.Context, toCluster Client, checkpointFile string, mutators ...ResourceMutatorFunc) error {
+	log := logf.Log
+	log.Info("Resuming move...")
+
+	checkpoint, err := loadMoveCheckpoint(checkpointFile)
+	if err != nil {
+		return err
+	}
+
+	if err := o.checkTargetProviders(ctx, toCluster.ProviderInventory()); err != nil {
+		return pkgerrors.Wrap(err, "failed to check providers in target cluster")
+	}
+
+	objectGraph, err := o.getObjectGraph(ctx, checkpoint.Namespace, checkpoint.clusterSelection(), checkpoint.movedUIDs())
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to get object graph")
+	}
+
+	o.checkpoint = checkpoint
+	if err := o.move(ctx, objectGraph, toCluster.Proxy(), mutators...); err != nil {
+		return o.checkpoint.wrapError(err)
+	}
+	return o.checkpoint.remove()
+}
+
+func (o *objectMover) RollbackMove(ctx context.Context, toCluster Client, checkpointFile string) error {
+	log := logf.Log
+	log.Info("Rolling back move...")
+
+	checkpoint, err := loadMoveCheckpoint(checkpointFile)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint.Phase != moveCheckpointCreatingObjectsPhase {
+		return pkgerrors.Errorf("cannot roll back a move operation in phase %s because objects have already been deleted from the source cluster, the move operation must be resumed instead", checkpoint.Phase)
+	}
+
+	o.checkpoint = checkpoint
+	if err := o.rollback(ctx, toCluster.Proxy()); err != nil {
+		return pkgerrors.Wrapf(err, "rollback interrupted, it can be retried using checkpoint file %q", checkpointFile)
+	}
+	return o.checkpoint.remove()
+}
+
 func (o *objectMover) ToDirectory(ctx context.Context, namespace string, directory string) error {
 	log := logf.Log
 	log.Info("Moving to directory...")
 
-	objectGraph, err := o.getObjectGraph(ctx, namespace)
+	objectGraph, err := o.getObjectGraph(ctx, namespace, ClusterSelection{}, nil)
 	if err != nil {
 		return pkgerrors.Wrap(err, "failed to get object graph")
 	}
@@ -186,7 +286,9 @@ func (o *objectMover) filesToObjs(dir string) ([]unstructured.Unstructured, erro
 	return objs, nil
 }
 
-func (o *objectMover) getObjectGraph(ctx context.Context, namespace string) (*objectGraph, error) {
+// getObjectGraph discovers the object graph for a namespace; if selection is not empty, the graph is restricted to the selected
+// Clusters and their dependent objects, plus the objects already moved by an interrupted move operation, if any.
+func (o *objectMover) getObjectGraph(ctx context.Context, namespace string, selection ClusterSelection, alreadyMoved sets.Set[types.UID]) (*objectGraph, error) {
 	objectGraph := newObjectGraph(o.fromProxy, o.fromProviderInventory)
 
 	// Gets all the types defined by the CRDs installed by clusterctl plus the ConfigMap/Secret core types.
@@ -202,6 +304,13 @@ func (o *objectMover) getObjectGraph(ctx context.Context, namespace string) (*ob
 		return nil, pkgerrors.Wrap(err, "failed to discover the object graph")
 	}
 
+	// Restricts the object graph to the selected Clusters, if any.
+	if !selection.IsEmpty() {
+		if err := objectGraph.filterClusters(ctx, namespace, selection, alreadyMoved); err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to select Clusters")
+		}
+	}
+
 	// Checks if Cluster API has already completed the provisioning of the infrastructure for the objects involved in the move/toDirectory operation.
 	// This is required because if the infrastructure is provisioned, then we can reasonably assume that the objects we are moving/backing up are
 	// not currently waiting for long-running reconciliation loops, and so we can safely rely on the pause field on the Cluster object
@@ -335,51 +444,38 @@ func getMachineObj(ctx context.Context, proxy Proxy, machine *node, machineObj *
 }
 
 // Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
+// If a checkpoint is used, the progress of the move operation is recorded in the checkpoint file, and the phases already completed
+// according to the checkpoint are skipped.
 func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Proxy, mutators ...ResourceMutatorFunc) error {
 	log := logf.Log
 
 	clusters := graph.getClusters()
-	if err := checkClustersNotPaused(ctx, o.fromProxy, clusters); err != nil {
-		return err
-	}
+	clusterClasses := graph.getClusterClasses()
 
-	log.Info("Moving Cluster API objects", "Clusters", len(clusters))
+	if o.checkpoint.resuming() {
+		// Nb. When resuming, the Clusters and ClusterClasses are read from the checkpoint, because they are paused already
+		// and they might have been deleted from the source management cluster.
+		clusters, clusterClasses = o.checkpoint.pausedNodes()
 
-	clusterClasses := graph.getClusterClasses()
-	if err := checkClusterClassesNotPaused(ctx, o.fromProxy, clusterClasses); err != nil {
-		return err
-	}
+		log.Info("Resuming move of Cluster API objects", "Phase", o.checkpoint.Phase, "Clusters", len(clusters), "ClusterClasses", len(clusterClasses))
+	} else {
+		if err := checkClustersNotPaused(ctx, o.fromProxy, clusters); err != nil {
+			return err
+		}
 
-	log.Info("Moving Cluster API objects", "ClusterClasses", len(clusterClasses))
+		log.Info("Moving Cluster API objects", "Clusters", len(clusters))
 
-	// Sets the pause field on the Cluster object in the source management cluster, so the controllers stop reconciling it.
-	log.V(1).Info("Pausing the source cluster")
-	if err := setClusterPause(ctx, o.fromProxy, clusters, true, o.dryRun); err != nil {
-		return err
-	}
+		if err := checkClusterClassesNotPaused(ctx, o.fromProxy, clusterClasses); err != nil {
+			return err
+		}
 
-	log.V(1).Info("Pausing the source ClusterClasses")
-	if err := setClusterClassPause(ctx, o.fromProxy, clusterClasses, true, o.dryRun); err != nil {
-		return pkgerrors.Wrap(err, "error pausing ClusterClasses")
-	}
+		log.Info("Moving Cluster API objects", "ClusterClasses", len(clusterClasses))
 
-	log.Info("Waiting for all resources to be ready to move")
-	// exponential backoff configuration which returns durations for a total time of ~2m.
-	// Example: 0, 5s, 8s, 11s, 17s, 26s, 38s, 57s, 86s, 128s
-	waitForMoveUnblockedBackoff := wait.Backoff{
-		Duration: 5 * time.Second,
-		Factor:   1.5,
-		Steps:    10,
-		Jitter:   0.1,
-	}
-	if err := waitReadyForMove(ctx, o.fromProxy, graph.getMoveNodes(), o.dryRun, waitForMoveUnblockedBackoff); err != nil {
-		return pkgerrors.Wrap(err, "error waiting for resources to be ready to move")
+		if err := o.checkpoint.start(clusters, clusterClasses); err != nil {
+			return err
+		}
 	}
 
-	// Nb. DO NOT call ensureNamespaces at this point because:
-	// - namespace will be ensured to exist before creating the resource.
-	// - If it's done here, we might create a namespace that can end up unused on target cluster (due to mutators).
-
 	// Define the move sequence by processing the ownerReference chain, so we ensure that a Kubernetes object is moved only after its owners.
 	// The sequence is bases on object graph nodes, each one representing a Kubernetes object; nodes are grouped, so bulk of nodes can be moved in parallel. e.g.
 	// - All the Clusters should be moved first (group 1, processed in parallel)
@@ -387,10 +483,47 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	// - then all the MachineSets, then all the Machines, etc.
 	moveSequence := getMoveSequence(graph)
 
-	// Create all objects group by group, ensuring all the ownerReferences are re-created.
-	log.Info("Creating objects in the target cluster")
-	for groupIndex := range len(moveSequence.groups) {
-		if err := o.createGroup(ctx, moveSequence.getGroup(groupIndex), toProxy, mutators...); err != nil {
+	if o.checkpoint.pending(moveCheckpointCreatingObjectsPhase) {
+		// Sets the pause field on the Cluster object in the source management cluster, so the controllers stop reconciling it.
+		log.V(1).Info("Pausing the source cluster")
+		if err := setClusterPause(ctx, o.fromProxy, clusters, true, o.dryRun); err != nil {
+			return err
+		}
+
+		log.V(1).Info("Pausing the source ClusterClasses")
+		if err := setClusterClassPause(ctx, o.fromProxy, clusterClasses, true, o.dryRun); err != nil {
+			return pkgerrors.Wrap(err, "error pausing ClusterClasses")
+		}
+
+		log.Info("Waiting for all resources to be ready to move")
+		// exponential backoff configuration which returns durations for a total time of ~2m.
+		// Example: 0, 5s, 8s, 11s, 17s, 26s, 38s, 57s, 86s, 128s
+		waitForMoveUnblockedBackoff := wait.Backoff{
+			Duration: 5 * time.Second,
+			Factor:   1.5,
+			Steps:    10,
+			Jitter:   0.1,
+		}
+		if err := waitReadyForMove(ctx, o.fromProxy, graph.getMoveNodes(), o.dryRun, waitForMoveUnblockedBackoff); err != nil {
+			return pkgerrors.Wrap(err, "error waiting for resources to be ready to move")
+		}
+
+		// Nb. DO NOT call ensureNamespaces at this point because:
+		// - namespace will be ensured to exist before creating the resource.
+		// - If it's done here, we might create a namespace that can end up unused on target cluster (due to mutators).
+
+		// Create all objects group by group, ensuring all the ownerReferences are re-created.
+		log.Info("Creating objects in the target cluster")
+		for groupIndex := range len(moveSequence.groups) {
+			if err := o.createGroup(ctx, moveSequence.getGroup(groupIndex), toProxy, mutators...); err != nil {
+				return err
+			}
+			if err := o.checkpoint.completeGroup(groupIndex); err != nil {
+				return err
+			}
+		}
+
+		if err := o.checkpoint.setPhase(moveCheckpointDeletingObjectsPhase); err != nil {
 			return err
 		}
 	}
@@ -399,14 +532,32 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	// using the right namespace to fetch the resource from the target cluster.
 	// mutators affecting non metadata fields are no-op after this point.
 
-	// Delete all objects group by group in reverse order.
-	log.Info("Deleting objects from the source cluster")
-	for groupIndex := len(moveSequence.groups) - 1; groupIndex >= 0; groupIndex-- {
-		if err := o.deleteGroup(ctx, moveSequence.getGroup(groupIndex)); err != nil {
+	if o.checkpoint.pending(moveCheckpointDeletingObjectsPhase) {
+		// Delete all objects group by group in reverse order.
+		log.Info("Deleting objects from the source cluster")
+		for groupIndex := len(moveSequence.groups) - 1; groupIndex >= 0; groupIndex-- {
+			if err := o.deleteGroup(ctx, moveSequence.getGroup(groupIndex)); err != nil {
+				return err
+			}
+		}
+
+		if err := o.checkpoint.setPhase(moveCheckpointResumingObjectsPhase); err != nil {
 			return err
 		}
 	}
 
+	// Resume the ClusterClasses kept in the source management cluster because they are still used by Clusters not being moved.
+	keptClusterClasses := []*node{}
+	for _, clusterClass := range graph.getClusterClasses() {
+		if clusterClass.shouldNotDelete {
+			keptClusterClasses = append(keptClusterClasses, clusterClass)
+		}
+	}
+	log.V(1).Info("Resuming the source ClusterClasses still in use")
+	if err := setClusterClassPause(ctx, o.fromProxy, keptClusterClasses, false, o.dryRun); err != nil {
+		return pkgerrors.Wrap(err, "error resuming ClusterClasses")
+	}
+
 	// Resume the ClusterClasses in the target management cluster, so the controllers start reconciling it.
 	log.V(1).Info("Resuming the target ClusterClasses")
 	if err := setClusterClassPause(ctx, toProxy, clusterClasses, false, o.dryRun, mutators...); err != nil {
@@ -418,6 +569,41 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	return setClusterPause(ctx, toProxy, clusters, false, o.dryRun, mutators...)
 }
 
+// rollback reverts an interrupted move operation recorded in the checkpoint, by deleting the objects created in the target
+// management cluster in reverse creation order and by resuming Clusters and ClusterClasses in the source management cluster.
+func (o *objectMover) rollback(ctx context.Context, toProxy Proxy) error {
+	log := logf.Log
+
+	log.Info("Deleting objects from the target cluster", "Objects", len(o.checkpoint.Objects))
+	deleteTargetObjectBackoff := newWriteBackoff()
+	for i := len(o.checkpoint.Objects) - 1; i >= 0; i-- {
+		objectToDelete := o.checkpoint.Objects[i]
+		if objectToDelete.Created {
+			// Nb. The operation is wrapped in a retry loop to make rollback more resilient to unexpected conditions.
+			if err := retryWithExponentialBackoff(ctx, deleteTargetObjectBackoff, func(ctx context.Context) error {
+				return deleteTargetObject(ctx, toProxy, objectToDelete)
+			}); err != nil {
+				return err
+			}
+		}
+
+		// Drop the object from the checkpoint, so an interrupted rollback can be retried.
+		if err := o.checkpoint.truncateObjects(i); err != nil {
+			return err
+		}
+	}
+
+	clusters, clusterClasses := o.checkpoint.pausedNodes()
+
+	log.V(1).Info("Resuming the source ClusterClasses")
+	if err := setClusterClassPause(ctx, o.fromProxy, clusterClasses, false, false); err != nil {
+		return pkgerrors.Wrap(err, "error resuming ClusterClasses")
+	}
+
+	log.V(1).Info("Resuming the source cluster")
+	return setClusterPause(ctx, o.fromProxy, clusters, false, false)
+}
+
 func (o *objectMover) toDirectory(ctx context.Context, graph *objectGraph, directory string) error {
 	log := logf.Log
 
@@ -937,6 +1123,12 @@ func (o *objectMover) createGroup(ctx context.Context, group moveGroup, toProxy
 	// Nb. This prevents us from making repetitive (and expensive) calls in listing all namespaces to ensure a namespace exists before creating a resource.
 	existingNamespaces := sets.New[string]()
 	for _, nodeToCreate := range group {
+		// Skip objects already created by an interrupted move operation, restoring the newUID assigned in the target management cluster.
+		if o.checkpoint.restoreNewUID(nodeToCreate) {
+			logf.Log.V(5).Info("Object already created, skipping", nodeToCreate.identity.Kind, nodeToCreate.identity.Name, "Namespace", nodeToCreate.identity.Namespace)
+			continue
+		}
+
 		// Creates the Kubernetes object corresponding to the nodeToCreate.
 		// Nb. The operation is wrapped in a retry loop to make move more resilient to unexpected conditions.
 		err := retryWithExponentialBackoff(ctx, createTargetObjectBackoff, func(ctx context.Context) error {
@@ -1057,6 +1249,7 @@ func (o *objectMover) createTargetObject(ctx context.Context, nodeToCreate *node
 		existingNamespaces.Insert(obj.GetNamespace())
 	}
 	oldManagedFields := obj.GetManagedFields()
+	created := true
 	if err := cTo.Create(ctx, obj); err != nil {
 		if !apierrors.IsAlreadyExists(err) {
 			return pkgerrors.Wrapf(err, "error creating %q %s/%s",
@@ -1066,6 +1259,7 @@ func (o *objectMover) createTargetObject(ctx context.Context, nodeToCreate *node
 		// If the object already exists, try to update it if it is node a global object / something belonging to a global object hierarchy (e.g. a secrets owned by a global identity object).
 		if nodeToCreate.isGlobal || nodeToCreate.isGlobalHierarchy {
 			log.V(5).Info("Object already exists, skipping upgrade because it is global/it is owned by a global object", nodeToCreate.identity.Kind, nodeToCreate.identity.Name, "Namespace", nodeToCreate.identity.Namespace)
+			created = false
 		} else {
 			// Nb. This should not happen, but it is supported to make move more resilient to unexpected interrupt/restarts of the move process.
 			log.V(5).Info("Object already exists, updating", nodeToCreate.identity.Kind, nodeToCreate.identity.Name, "Namespace", nodeToCreate.identity.Namespace)
@@ -1095,7 +1289,8 @@ func (o *objectMover) createTargetObject(ctx context.Context, nodeToCreate *node
 		return pkgerrors.Wrap(err, "error patching the managed fields")
 	}
 
-	return nil
+	// Records the object in the checkpoint, if any, so an interrupted move can be resumed or rolled back.
+	return o.checkpoint.recordObject(nodeToCreate, obj, created)
 }
 
 func (o *objectMover) backupTargetObject(ctx context.Context, nodeToCreate *node, directory string) error {
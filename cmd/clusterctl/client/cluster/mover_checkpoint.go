@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"os"
+	"slices"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+)
+
+// moveCheckpointPhase is the phase of a move operation recorded in a moveCheckpoint.
+type moveCheckpointPhase string
+
+const (
+	// moveCheckpointCreatingObjectsPhase is the phase where Clusters and ClusterClasses are paused in the source management cluster
+	// and objects are created in the target management cluster. A move operation in this phase can be resumed or rolled back.
+	moveCheckpointCreatingObjectsPhase moveCheckpointPhase = "CreatingObjects"
+
+	// moveCheckpointDeletingObjectsPhase is the phase where objects are deleted from the source management cluster.
+	// A move operation in this phase can only be resumed.
+	moveCheckpointDeletingObjectsPhase moveCheckpointPhase = "DeletingObjects"
+
+	// moveCheckpointResumingObjectsPhase is the phase where Clusters and ClusterClasses are resumed in the target management cluster.
+	// A move operation in this phase can only be resumed.
+	moveCheckpointResumingObjectsPhase moveCheckpointPhase = "ResumingObjects"
+)
+
+// moveCheckpointPhases is the ordered list of the phases of a move operation.
+var moveCheckpointPhases = []moveCheckpointPhase{
+	moveCheckpointCreatingObjectsPhase,
+	moveCheckpointDeletingObjectsPhase,
+	moveCheckpointResumingObjectsPhase,
+}
+
+// moveCheckpoint records the progress of a move operation in a file, so an interrupted move can be resumed or rolled back.
+// Nb. All the methods of moveCheckpoint can be called on a nil moveCheckpoint, which is used when no checkpoint file is configured.
+type moveCheckpoint struct {
+	// file is the path of the checkpoint file.
+	file string
+
+	// objectIndex maps the UID of objects in the source management cluster to their index in Objects.
+	objectIndex map[types.UID]int
+
+	// Namespace is the namespace the move operation has been started for.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Selective is true if the move operation is restricted to a selection of Clusters; in this case
+	// the selected Clusters are the ones in PausedClusters.
+	Selective bool `json:"selective,omitempty"`
+
+	// Phase is the current phase of the move operation.
+	Phase moveCheckpointPhase `json:"phase"`
+
+	// PausedClusters are the Clusters paused in the source management cluster by the move operation.
+	PausedClusters []moveCheckpointObject `json:"pausedClusters,omitempty"`
+
+	// PausedClusterClasses are the ClusterClasses paused in the source management cluster by the move operation.
+	PausedClusterClasses []moveCheckpointObject `json:"pausedClusterClasses,omitempty"`
+
+	// CreatedGroups is the number of move groups completely created in the target management cluster.
+	CreatedGroups int `json:"createdGroups"`
+
+	// Objects are the objects created in the target management cluster, in creation order.
+	Objects []moveCheckpointObject `json:"objects,omitempty"`
+}
+
+// moveCheckpointObject is an object recorded in a moveCheckpoint.
+type moveCheckpointObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+
+	// SourceUID is the UID of the object in the source management cluster.
+	SourceUID types.UID `json:"sourceUID,omitempty"`
+
+	// TargetNamespace is the namespace of the object in the target management cluster; it might differ from Namespace
+	// if resource mutators are used.
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
+	// TargetName is the name of the object in the target management cluster.
+	TargetName string `json:"targetName,omitempty"`
+
+	// TargetUID is the UID of the object in the target management cluster.
+	TargetUID types.UID `json:"targetUID,omitempty"`
+
+	// Created is true if the object has been written to the target management cluster by the move operation,
+	// and thus it must be deleted when rolling back the move operation.
+	// It is false for global objects that already existed in the target management cluster.
+	Created bool `json:"created,omitempty"`
+}
+
+func newMoveCheckpoint(file, namespace string) *moveCheckpoint {
+	return &moveCheckpoint{
+		file:        file,
+		objectIndex: map[types.UID]int{},
+		Namespace:   namespace,
+	}
+}
+
+// loadMoveCheckpoint reads a moveCheckpoint from a checkpoint file.
+func loadMoveCheckpoint(file string) (*moveCheckpoint, error) {
+	data, err := os.ReadFile(file) //nolint:gosec // The checkpoint file path is provided by the user.
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to read checkpoint file %q", file)
+	}
+
+	c := newMoveCheckpoint(file, "")
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse checkpoint file %q", file)
+	}
+	if !slices.Contains(moveCheckpointPhases, c.Phase) {
+		return nil, pkgerrors.Errorf("invalid checkpoint file %q: unknown phase %q", file, c.Phase)
+	}
+	for i, o := range c.Objects {
+		c.objectIndex[o.SourceUID] = i
+	}
+	return c, nil
+}
+
+// save writes the moveCheckpoint to the checkpoint file.
+// Nb. The file is written to a temporary file and then renamed, so the checkpoint file is never left partially written.
+func (c *moveCheckpoint) save() error {
+	if c == nil {
+		return nil
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to marshal checkpoint")
+	}
+
+	tmpFile := c.file + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0o600); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write checkpoint file %q", tmpFile)
+	}
+	if err := os.Rename(tmpFile, c.file); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write checkpoint file %q", c.file)
+	}
+	return nil
+}
+
+// remove deletes the checkpoint file once the move operation is completed or rolled back.
+func (c *moveCheckpoint) remove() error {
+	if c == nil {
+		return nil
+	}
+
+	if err := os.Remove(c.file); err != nil && !os.IsNotExist(err) {
+		return pkgerrors.Wrapf(err, "failed to delete checkpoint file %q", c.file)
+	}
+	return nil
+}
+
+// resuming returns true if the move operation is resuming from a checkpoint.
+func (c *moveCheckpoint) resuming() bool {
+	return c != nil && c.Phase != ""
+}
+
+// pending returns true if the given phase has not been completed yet; it always returns true if no checkpoint is used.
+func (c *moveCheckpoint) pending(phase moveCheckpointPhase) bool {
+	if c == nil || c.Phase == "" {
+		return true
+	}
+	return slices.Index(moveCheckpointPhases, c.Phase) <= slices.Index(moveCheckpointPhases, phase)
+}
+
+// start records the Clusters and ClusterClasses about to be paused in the source management cluster,
+// and sets the phase of the move operation to CreatingObjects.
+func (c *moveCheckpoint) start(clusters, clusterClasses []*node) error {
+	if c == nil {
+		return nil
+	}
+
+	for _, n := range clusters {
+		c.PausedClusters = append(c.PausedClusters, newMoveCheckpointObject(n))
+	}
+	for _, n := range clusterClasses {
+		c.PausedClusterClasses = append(c.PausedClusterClasses, newMoveCheckpointObject(n))
+	}
+	return c.setPhase(moveCheckpointCreatingObjectsPhase)
+}
+
+// setPhase records the phase of the move operation.
+func (c *moveCheckpoint) setPhase(phase moveCheckpointPhase) error {
+	if c == nil {
+		return nil
+	}
+
+	c.Phase = phase
+	return c.save()
+}
+
+// pausedNodes returns nodes for the Clusters and the ClusterClasses paused in the source management cluster.
+func (c *moveCheckpoint) pausedNodes() (clusters, clusterClasses []*node) {
+	if c == nil {
+		return nil, nil
+	}
+
+	for _, o := range c.PausedClusters {
+		clusters = append(clusters, o.toNode())
+	}
+	for _, o := range c.PausedClusterClasses {
+		clusterClasses = append(clusterClasses, o.toNode())
+	}
+	return clusters, clusterClasses
+}
+
+// clusterSelection returns the Clusters selected for the move operation.
+func (c *moveCheckpoint) clusterSelection() ClusterSelection {
+	if c == nil || !c.Selective {
+		return ClusterSelection{}
+	}
+
+	selection := ClusterSelection{}
+	for _, o := range c.PausedClusters {
+		selection.Names = append(selection.Names, o.Name)
+	}
+	return selection
+}
+
+// movedUIDs returns the UIDs of the objects in the source management cluster already moved to the target management cluster.
+func (c *moveCheckpoint) movedUIDs() sets.Set[types.UID] {
+	if c == nil {
+		return nil
+	}
+
+	return sets.KeySet(c.objectIndex)
+}
+
+// recordObject records an object written to the target management cluster.
+func (c *moveCheckpoint) recordObject(n *node, obj *unstructured.Unstructured, created bool) error {
+	if c == nil {
+		return nil
+	}
+
+	o := newMoveCheckpointObject(n)
+	o.TargetNamespace = obj.GetNamespace()
+	o.TargetName = obj.GetName()
+	o.TargetUID = obj.GetUID()
+	o.Created = created
+
+	if i, ok := c.objectIndex[o.SourceUID]; ok {
+		c.Objects[i] = o
+	} else {
+		c.objectIndex[o.SourceUID] = len(c.Objects)
+		c.Objects = append(c.Objects, o)
+	}
+	return c.save()
+}
+
+// restoreNewUID sets the newUID of a node if the corresponding object has already been created in the target management cluster.
+// It returns true if the object has already been created.
+func (c *moveCheckpoint) restoreNewUID(n *node) bool {
+	if c == nil {
+		return false
+	}
+
+	i, ok := c.objectIndex[n.identity.UID]
+	if !ok {
+		return false
+	}
+	n.newUID = c.Objects[i].TargetUID
+	return true
+}
+
+// completeGroup records that all the objects in a move group have been created in the target management cluster.
+func (c *moveCheckpoint) completeGroup(groupIndex int) error {
+	if c == nil || groupIndex < c.CreatedGroups {
+		return nil
+	}
+
+	c.CreatedGroups = groupIndex + 1
+	return c.save()
+}
+
+// truncateObjects drops all the objects recorded after the first n ones.
+func (c *moveCheckpoint) truncateObjects(n int) error {
+	if c == nil {
+		return nil
+	}
+
+	for _, o := range c.Objects[n:] {
+		delete(c.objectIndex, o.SourceUID)
+	}
+	c.Objects = c.Objects[:n]
+	return c.save()
+}
+
+// wrapError adds to an error a hint about how to recover from an interrupted move operation, if a checkpoint file has been written.
+func (c *moveCheckpoint) wrapError(err error) error {
+	if c == nil || c.Phase == "" {
+		return err
+	}
+
+	if c.Phase == moveCheckpointCreatingObjectsPhase {
+		return pkgerrors.Wrapf(err, "move interrupted in phase %s, it can be resumed or rolled back using checkpoint file %q", c.Phase, c.file)
+	}
+	return pkgerrors.Wrapf(err, "move interrupted in phase %s, it can be resumed using checkpoint file %q", c.Phase, c.file)
+}
+
+func newMoveCheckpointObject(n *node) moveCheckpointObject {
+	return moveCheckpointObject{
+		APIVersion: n.identity.APIVersion,
+		Kind:       n.identity.Kind,
+		Namespace:  n.identity.Namespace,
+		Name:       n.identity.Name,
+		SourceUID:  n.identity.UID,
+	}
+}
+
+// toNode returns a node for an object in the source management cluster.
+func (o moveCheckpointObject) toNode() *node {
+	return &node{
+		identity: corev1.ObjectReference{
+			APIVersion: o.APIVersion,
+			Kind:       o.Kind,
+			Namespace:  o.Namespace,
+			Name:       o.Name,
+			UID:        o.SourceUID,
+		},
+	}
+}
+
+// deleteTargetObject deletes an object created by an interrupted move operation from the target management cluster, taking care of removing all the finalizers so
+// the objects gets immediately deleted (force delete).
+// Nb. Objects are deleted only if their UID matches the UID recorded in the checkpoint, so objects re-created in the meantime are preserved.
+func deleteTargetObject(ctx context.Context, toProxy Proxy, objectToDelete moveCheckpointObject) error {
+	log := logf.Log
+	log.V(1).Info("Deleting", objectToDelete.Kind, objectToDelete.TargetName, "Namespace", objectToDelete.TargetNamespace)
+
+	cTo, err := toProxy.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	targetObj := &unstructured.Unstructured{}
+	targetObj.SetAPIVersion(objectToDelete.APIVersion)
+	targetObj.SetKind(objectToDelete.Kind)
+	targetObjKey := client.ObjectKey{
+		Namespace: objectToDelete.TargetNamespace,
+		Name:      objectToDelete.TargetName,
+	}
+
+	if err := cTo.Get(ctx, targetObjKey, targetObj); err != nil {
+		if apierrors.IsNotFound(err) {
+			// If the object is already deleted, move on.
+			log.V(5).Info("Object already deleted, skipping delete for", objectToDelete.Kind, objectToDelete.TargetName, "Namespace", objectToDelete.TargetNamespace)
+			return nil
+		}
+		return pkgerrors.Wrapf(err, "error reading %q %s/%s",
+			targetObj.GroupVersionKind(), targetObjKey.Namespace, targetObjKey.Name)
+	}
+
+	if targetObj.GetUID() != objectToDelete.TargetUID {
+		log.V(5).Info("Object has been re-created after move, skipping delete for", objectToDelete.Kind, objectToDelete.TargetName, "Namespace", objectToDelete.TargetNamespace)
+		return nil
+	}
+
+	if err := cTo.Patch(ctx, targetObj, addDeleteForMoveAnnotationPatch); err != nil {
+		return pkgerrors.Wrapf(err, "error adding delete-for-move annotation from %q %s/%s",
+			targetObj.GroupVersionKind(), targetObj.GetNamespace(), targetObj.GetName())
+	}
+
+	if err := cTo.Delete(ctx, targetObj); err != nil {
+		return pkgerrors.Wrapf(err, "error deleting %q %s/%s",
+			targetObj.GroupVersionKind(), targetObj.GetNamespace(), targetObj.GetName())
+	}
+
+	if len(targetObj.GetFinalizers()) > 0 {
+		if err := cTo.Patch(ctx, targetObj, removeFinalizersPatch); err != nil {
+			return pkgerrors.Wrapf(err, "error removing finalizers from %q %s/%s",
+				targetObj.GroupVersionKind(), targetObj.GetNamespace(), targetObj.GetName())
+		}
+	}
+	return nil
+}
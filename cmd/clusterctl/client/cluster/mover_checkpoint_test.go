@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_moveCheckpoint(t *testing.T) {
+	t.Run("saves and loads a checkpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		file := filepath.Join(t.TempDir(), "checkpoint.yaml")
+		cluster := &node{identity: corev1.ObjectReference{APIVersion: clusterv1.GroupVersion.String(), Kind: clusterv1.ClusterKind, Namespace: "ns1", Name: "foo", UID: "source-uid"}}
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace("ns2")
+		obj.SetName("foo")
+		obj.SetUID("target-uid")
+
+		c := newMoveCheckpoint(file, "ns1")
+		g.Expect(c.start([]*node{cluster}, nil)).To(Succeed())
+		g.Expect(c.recordObject(cluster, obj, true)).To(Succeed())
+		g.Expect(c.completeGroup(0)).To(Succeed())
+
+		loaded, err := loadMoveCheckpoint(file)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(loaded.Namespace).To(Equal("ns1"))
+		g.Expect(loaded.Phase).To(Equal(moveCheckpointCreatingObjectsPhase))
+		g.Expect(loaded.CreatedGroups).To(Equal(1))
+		g.Expect(loaded.PausedClusters).To(HaveLen(1))
+		g.Expect(loaded.Objects).To(ConsistOf(moveCheckpointObject{
+			APIVersion:      clusterv1.GroupVersion.String(),
+			Kind:            clusterv1.ClusterKind,
+			Namespace:       "ns1",
+			Name:            "foo",
+			SourceUID:       "source-uid",
+			TargetNamespace: "ns2",
+			TargetName:      "foo",
+			TargetUID:       "target-uid",
+			Created:         true,
+		}))
+
+		restored := &node{identity: cluster.identity}
+		g.Expect(loaded.restoreNewUID(restored)).To(BeTrue())
+		g.Expect(restored.newUID).To(BeEquivalentTo("target-uid"))
+
+		g.Expect(loaded.remove()).To(Succeed())
+		_, err = os.Stat(file)
+		g.Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+	t.Run("fails to load a checkpoint with an unknown phase", func(t *testing.T) {
+		g := NewWithT(t)
+
+		file := filepath.Join(t.TempDir(), "checkpoint.yaml")
+		g.Expect(os.WriteFile(file, []byte("phase: Unknown\n"), 0o600)).To(Succeed())
+
+		_, err := loadMoveCheckpoint(file)
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("restores the Cluster selection", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := &moveCheckpoint{PausedClusters: []moveCheckpointObject{{Name: "foo"}, {Name: "bar"}}}
+		g.Expect(c.clusterSelection().IsEmpty()).To(BeTrue())
+
+		c.Selective = true
+		g.Expect(c.clusterSelection().Names).To(ConsistOf("foo", "bar"))
+	})
+	t.Run("pending reports phases not completed yet", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var noCheckpoint *moveCheckpoint
+		g.Expect(noCheckpoint.pending(moveCheckpointCreatingObjectsPhase)).To(BeTrue())
+		g.Expect(noCheckpoint.resuming()).To(BeFalse())
+
+		c := &moveCheckpoint{Phase: moveCheckpointDeletingObjectsPhase}
+		g.Expect(c.resuming()).To(BeTrue())
+		g.Expect(c.pending(moveCheckpointCreatingObjectsPhase)).To(BeFalse())
+		g.Expect(c.pending(moveCheckpointDeletingObjectsPhase)).To(BeTrue())
+		g.Expect(c.pending(moveCheckpointResumingObjectsPhase)).To(BeTrue())
+	})
+}
+
+// startInterruptedMove simulates a move operation interrupted after creating the first move group in the target cluster,
+// and returns the object graph re-discovered from the source cluster as a resumed move would do.
+func startInterruptedMove(ctx context.Context, g *WithT, objs []client.Object, toProxy Proxy, checkpointFile string) *objectGraph {
+	graph := getObjectGraphWithObjs(objs)
+	g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+	g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+	mover := objectMover{
+		fromProxy:  graph.proxy,
+		checkpoint: newMoveCheckpoint(checkpointFile, ""),
+	}
+	g.Expect(mover.checkpoint.start(graph.getClusters(), graph.getClusterClasses())).To(Succeed())
+	g.Expect(setClusterPause(ctx, graph.proxy, graph.getClusters(), true, false)).To(Succeed())
+	g.Expect(setClusterClassPause(ctx, graph.proxy, graph.getClusterClasses(), true, false)).To(Succeed())
+	g.Expect(mover.createGroup(ctx, getMoveSequence(graph).getGroup(0), toProxy)).To(Succeed())
+	g.Expect(mover.checkpoint.completeGroup(0)).To(Succeed())
+
+	resumedGraph := newObjectGraph(graph.proxy, graph.providerInventory)
+	g.Expect(resumedGraph.getDiscoveryTypes(ctx)).To(Succeed())
+	g.Expect(resumedGraph.Discovery(ctx, "")).To(Succeed())
+	return resumedGraph
+}
+
+func Test_objectMover_move_resumeFromCheckpoint(t *testing.T) {
+	for _, tt := range moveTests {
+		if tt.wantErr {
+			continue
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			checkpointFile := filepath.Join(t.TempDir(), "checkpoint.yaml")
+			toProxy := getFakeProxyWithCRDs()
+			graph := startInterruptedMove(ctx, g, tt.fields.objs, toProxy, checkpointFile)
+
+			checkpoint, err := loadMoveCheckpoint(checkpointFile)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(checkpoint.Phase).To(Equal(moveCheckpointCreatingObjectsPhase))
+			g.Expect(checkpoint.CreatedGroups).To(Equal(1))
+			g.Expect(checkpoint.Objects).To(HaveLen(len(getMoveSequence(graph).getGroup(0))))
+
+			// Resume the move, which must complete in spite of Clusters already paused and objects already existing in the target cluster.
+			mover := objectMover{
+				fromProxy:  graph.proxy,
+				checkpoint: checkpoint,
+			}
+			g.Expect(mover.move(ctx, graph, toProxy)).To(Succeed())
+
+			checkpoint, err = loadMoveCheckpoint(checkpointFile)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(checkpoint.Phase).To(Equal(moveCheckpointResumingObjectsPhase))
+
+			csFrom, err := graph.proxy.NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			csTo, err := toProxy.NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			for _, node := range graph.uidToNode {
+				key := client.ObjectKey{
+					Namespace: node.identity.Namespace,
+					Name:      node.identity.Name,
+				}
+
+				// objects are deleted from the source cluster
+				oFrom := &unstructured.Unstructured{}
+				oFrom.SetAPIVersion(node.identity.APIVersion)
+				oFrom.SetKind(node.identity.Kind)
+
+				err := csFrom.Get(ctx, key, oFrom)
+				if err == nil {
+					if !node.isGlobal && !node.isGlobalHierarchy && !node.shouldNotDelete {
+						t.Errorf("%s %v not deleted in source cluster", oFrom.GetKind(), key)
+					}
+				} else if !apierrors.IsNotFound(err) {
+					t.Errorf("error = %v when checking for %s %v deleted in source cluster", err, oFrom.GetKind(), key)
+				}
+
+				// objects are created in the target cluster, with owner references pointing to the target owners
+				oTo := &unstructured.Unstructured{}
+				oTo.SetAPIVersion(node.identity.APIVersion)
+				oTo.SetKind(node.identity.Kind)
+
+				if err := csTo.Get(ctx, key, oTo); err != nil {
+					t.Errorf("error = %v when checking for %s %v created in target cluster", err, oFrom.GetKind(), key)
+					continue
+				}
+				for _, ownerRef := range oTo.GetOwnerReferences() {
+					owner := &unstructured.Unstructured{}
+					owner.SetAPIVersion(ownerRef.APIVersion)
+					owner.SetKind(ownerRef.Kind)
+					ownerKey := client.ObjectKey{Namespace: oTo.GetNamespace(), Name: ownerRef.Name}
+					if err := csTo.Get(ctx, ownerKey, owner); apierrors.IsNotFound(err) {
+						// The owner is a global object.
+						ownerKey.Namespace = ""
+					}
+					g.Expect(csTo.Get(ctx, ownerKey, owner)).To(Succeed())
+					g.Expect(owner.GetUID()).To(Equal(ownerRef.UID))
+				}
+			}
+
+			// Clusters are resumed in the target cluster.
+			for _, cluster := range checkpoint.PausedClusters {
+				clusterObj := &clusterv1.Cluster{}
+				g.Expect(csTo.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Name}, clusterObj)).To(Succeed())
+				g.Expect(ptr.Deref(clusterObj.Spec.Paused, false)).To(BeFalse())
+			}
+		})
+	}
+}
+
+func Test_objectMover_rollback(t *testing.T) {
+	for _, tt := range moveTests {
+		if tt.wantErr {
+			continue
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			checkpointFile := filepath.Join(t.TempDir(), "checkpoint.yaml")
+			toProxy := getFakeProxyWithCRDs()
+			graph := startInterruptedMove(ctx, g, tt.fields.objs, toProxy, checkpointFile)
+
+			checkpoint, err := loadMoveCheckpoint(checkpointFile)
+			g.Expect(err).ToNot(HaveOccurred())
+			createdObjects := checkpoint.Objects
+
+			mover := objectMover{
+				fromProxy:  graph.proxy,
+				checkpoint: checkpoint,
+			}
+			g.Expect(mover.rollback(ctx, toProxy)).To(Succeed())
+			g.Expect(mover.checkpoint.Objects).To(BeEmpty())
+
+			csFrom, err := graph.proxy.NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			csTo, err := toProxy.NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			// objects created by the move are deleted from the target cluster
+			for _, o := range createdObjects {
+				if !o.Created {
+					continue
+				}
+				oTo := &unstructured.Unstructured{}
+				oTo.SetAPIVersion(o.APIVersion)
+				oTo.SetKind(o.Kind)
+				err := csTo.Get(ctx, client.ObjectKey{Namespace: o.TargetNamespace, Name: o.TargetName}, oTo)
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "%s %s/%s not deleted in target cluster", o.Kind, o.TargetNamespace, o.TargetName)
+			}
+
+			// Clusters and ClusterClasses are resumed in the source cluster.
+			for _, cluster := range graph.getClusters() {
+				clusterObj := &clusterv1.Cluster{}
+				g.Expect(getClusterObj(ctx, graph.proxy, cluster, clusterObj)).To(Succeed())
+				g.Expect(ptr.Deref(clusterObj.Spec.Paused, false)).To(BeFalse())
+			}
+			for _, clusterClass := range graph.getClusterClasses() {
+				clusterClassObj := &clusterv1.ClusterClass{}
+				g.Expect(csFrom.Get(ctx, client.ObjectKey{Namespace: clusterClass.identity.Namespace, Name: clusterClass.identity.Name}, clusterClassObj)).To(Succeed())
+				g.Expect(clusterClassObj.GetAnnotations()).ToNot(HaveKey(clusterv1.PausedAnnotation))
+			}
+		})
+	}
+}
+
+func Test_objectMover_RollbackMove_afterDeletingObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.yaml")
+	checkpoint := newMoveCheckpoint(checkpointFile, "ns1")
+	g.Expect(checkpoint.setPhase(moveCheckpointDeletingObjectsPhase)).To(Succeed())
+
+	mover := objectMover{
+		fromProxy: test.NewFakeProxy(),
+	}
+	g.Expect(mover.RollbackMove(context.Background(), nil, checkpointFile)).ToNot(Succeed())
+
+	// The checkpoint file is preserved, so the move can be resumed.
+	_, err := os.Stat(checkpointFile)
+	g.Expect(err).ToNot(HaveOccurred())
+}
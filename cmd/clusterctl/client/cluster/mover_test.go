@@ -30,6 +30,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -2556,3 +2557,131 @@ func Test_applyMutators(t *testing.T) {
 		})
 	}
 }
+
+func Test_objectMover_move_selectedClusters(t *testing.T) {
+	sharedClassObjs := func() []client.Object {
+		objs := test.NewFakeClusterClass("ns1", "class1").Objs()
+		objs = append(objs, test.NewFakeCluster("ns1", "foo").WithTopologyClass("class1").Objs()...)
+		objs = append(objs, test.NewFakeCluster("ns1", "bar").WithTopologyClass("class1").Objs()...)
+		for _, o := range objs {
+			if o.GetObjectKind().GroupVersionKind().Kind == clusterv1.ClusterKind && o.GetName() == "foo" {
+				o.SetLabels(map[string]string{"env": "prod"})
+			}
+		}
+		return deduplicateObjects(objs)
+	}
+
+	tests := []struct {
+		name                string
+		objs                []client.Object
+		selection           ClusterSelection
+		wantMovedClusters   []string
+		wantKeptClusters    []string
+		wantClassKeptSource bool
+		wantErr             bool
+	}{
+		{
+			name:                "Move a Cluster by name, keeping a ClusterClass shared with another Cluster",
+			objs:                sharedClassObjs(),
+			selection:           ClusterSelection{Names: []string{"foo"}},
+			wantMovedClusters:   []string{"foo"},
+			wantKeptClusters:    []string{"bar"},
+			wantClassKeptSource: true,
+		},
+		{
+			name:                "Move a Cluster by label selector, keeping a ClusterClass shared with another Cluster",
+			objs:                sharedClassObjs(),
+			selection:           ClusterSelection{LabelSelector: labels.SelectorFromSet(labels.Set{"env": "prod"})},
+			wantMovedClusters:   []string{"foo"},
+			wantKeptClusters:    []string{"bar"},
+			wantClassKeptSource: true,
+		},
+		{
+			name:                "Move all the Clusters using a ClusterClass, moving the ClusterClass too",
+			objs:                sharedClassObjs(),
+			selection:           ClusterSelection{Names: []string{"foo", "bar"}},
+			wantMovedClusters:   []string{"foo", "bar"},
+			wantClassKeptSource: false,
+		},
+		{
+			name:      "Fails if a selected Cluster does not exist",
+			objs:      sharedClassObjs(),
+			selection: ClusterSelection{Names: []string{"does-not-exist"}},
+			wantErr:   true,
+		},
+		{
+			name:      "Fails if the label selector does not match any Cluster",
+			objs:      sharedClassObjs(),
+			selection: ClusterSelection{LabelSelector: labels.SelectorFromSet(labels.Set{"env": "dev"})},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			// Create an objectGraph bound a source cluster with all the CRDs for the types involved in the test.
+			graph := getObjectGraphWithObjs(tt.objs)
+
+			// Get all the types to be considered for discovery
+			g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+
+			// trigger discovery the content of the source cluster
+			g.Expect(graph.Discovery(ctx, "ns1")).To(Succeed())
+
+			err := graph.filterClusters(ctx, "ns1", tt.selection, nil)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			clusterNames := []string{}
+			for _, cluster := range graph.getClusters() {
+				clusterNames = append(clusterNames, cluster.identity.Name)
+			}
+			g.Expect(clusterNames).To(ConsistOf(tt.wantMovedClusters))
+
+			// gets a fakeProxy to an empty cluster with all the required CRDs
+			toProxy := getFakeProxyWithCRDs()
+
+			// Run move
+			mover := objectMover{
+				fromProxy: graph.proxy,
+			}
+			g.Expect(mover.move(ctx, graph, toProxy)).To(Succeed())
+
+			csFrom, err := graph.proxy.NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			csTo, err := toProxy.NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			// Selected Clusters are moved, the other Clusters are not touched.
+			for _, name := range tt.wantMovedClusters {
+				g.Expect(apierrors.IsNotFound(csFrom.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: name}, &clusterv1.Cluster{}))).To(BeTrue())
+				g.Expect(csTo.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: name}, &clusterv1.Cluster{})).To(Succeed())
+			}
+			for _, name := range tt.wantKeptClusters {
+				cluster := &clusterv1.Cluster{}
+				g.Expect(csFrom.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: name}, cluster)).To(Succeed())
+				g.Expect(ptr.Deref(cluster.Spec.Paused, false)).To(BeFalse())
+				g.Expect(apierrors.IsNotFound(csTo.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: name}, &clusterv1.Cluster{}))).To(BeTrue())
+				g.Expect(apierrors.IsNotFound(csTo.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: name + "-kubeconfig"}, &corev1.Secret{}))).To(BeTrue())
+			}
+
+			// The ClusterClass is always created in the target cluster, and it is kept in the source cluster only if still in use.
+			g.Expect(csTo.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "class1"}, &clusterv1.ClusterClass{})).To(Succeed())
+			clusterClass := &clusterv1.ClusterClass{}
+			err = csFrom.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "class1"}, clusterClass)
+			if tt.wantClassKeptSource {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(clusterClass.GetAnnotations()).ToNot(HaveKey(clusterv1.PausedAnnotation))
+			} else {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			}
+		})
+	}
+}
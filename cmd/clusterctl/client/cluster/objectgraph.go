@@ -860,3 +860,149 @@ func (o *objectGraph) setShouldNotDeleteHierarchy(node *node) {
 		}
 	}
 }
+
+// filterClusters restricts the object graph to the selected Clusters and their dependent objects.
+// Objects not belonging to any Cluster, like ClusterClasses or ClusterResourceSets, are kept if they are used by a selected Cluster;
+// if they are used by Clusters not selected too, they are marked so they are not deleted from the source management cluster.
+// Objects already moved by an interrupted move operation are kept as well, so the move can be resumed even if the
+// selected Clusters have been already deleted from the source management cluster.
+func (o *objectGraph) filterClusters(ctx context.Context, namespace string, selection ClusterSelection, alreadyMoved sets.Set[types.UID]) error {
+	selectedClusters, err := o.getSelectedClusters(ctx, namespace, selection, alreadyMoved.Len() > 0)
+	if err != nil {
+		return err
+	}
+
+	isCluster := func(n *node) bool {
+		return n.identity.GroupVersionKind().GroupKind() == clusterv1.GroupVersion.WithKind("Cluster").GroupKind()
+	}
+	clusterTenants := func(n *node) []*node {
+		tenants := []*node{}
+		for tenant := range n.tenant {
+			if isCluster(tenant) {
+				tenants = append(tenants, tenant)
+			}
+		}
+		return tenants
+	}
+
+	// Keep all the objects belonging to a selected Cluster and collect the other tenants of those objects, e.g. ClusterClasses or ClusterResourceSets.
+	keep := map[*node]empty{}
+	otherTenants := map[*node]empty{}
+	for _, n := range o.getNodes() {
+		if alreadyMoved.Has(n.identity.UID) {
+			keep[n] = empty{}
+		}
+		for _, tenant := range clusterTenants(n) {
+			if selectedClusters.Has(tenant) {
+				keep[n] = empty{}
+				break
+			}
+		}
+		if _, ok := keep[n]; !ok {
+			continue
+		}
+		for tenant := range n.tenant {
+			if !isCluster(tenant) {
+				otherTenants[tenant] = empty{}
+			}
+		}
+	}
+
+	// Keep all the objects not belonging to a Cluster but belonging to one of the other tenants, e.g. ClusterClass templates.
+	// If the tenant is used by a Cluster not selected, those objects should not be deleted.
+	sharedTenants := map[*node]empty{}
+	for _, n := range o.getNodes() {
+		for tenant := range n.tenant {
+			if _, ok := otherTenants[tenant]; !ok {
+				continue
+			}
+			for _, clusterTenant := range clusterTenants(n) {
+				if !selectedClusters.Has(clusterTenant) {
+					sharedTenants[tenant] = empty{}
+				}
+			}
+		}
+	}
+	for _, n := range o.getNodes() {
+		if len(clusterTenants(n)) > 0 {
+			// Objects belonging to a Cluster shared with Clusters not selected should not be deleted.
+			if _, ok := keep[n]; ok {
+				for _, clusterTenant := range clusterTenants(n) {
+					if !selectedClusters.Has(clusterTenant) {
+						n.shouldNotDelete = true
+					}
+				}
+			}
+			continue
+		}
+		for tenant := range n.tenant {
+			if _, ok := otherTenants[tenant]; ok {
+				keep[n] = empty{}
+			}
+			if _, ok := sharedTenants[tenant]; ok {
+				n.shouldNotDelete = true
+			}
+		}
+	}
+
+	// Objects to be moved must be moved together with all their owners, otherwise it won't be possible to rebuild the owner references.
+	for n := range keep {
+		for owner := range n.owners {
+			if _, ok := keep[owner]; !ok && (len(owner.tenant) > 0 || owner.forceMove) {
+				return pkgerrors.Errorf("%s is owned by %s which does not belong to the selected Clusters; select all the Clusters it belongs to", n.identityStr(), owner.identityStr())
+			}
+		}
+	}
+
+	for uid, n := range o.uidToNode {
+		if _, ok := keep[n]; !ok {
+			delete(o.uidToNode, uid)
+		}
+	}
+	return nil
+}
+
+// getSelectedClusters returns the Clusters selected by name or label selector.
+func (o *objectGraph) getSelectedClusters(ctx context.Context, namespace string, selection ClusterSelection, resuming bool) (sets.Set[*node], error) {
+	selectedByLabels := sets.New[types.NamespacedName]()
+	if selection.LabelSelector != nil && !selection.LabelSelector.Empty() {
+		selectors := []client.ListOption{client.MatchingLabelsSelector{Selector: selection.LabelSelector}}
+		if namespace != "" {
+			selectors = append(selectors, client.InNamespace(namespace))
+		}
+		clusterList := &clusterv1.ClusterList{}
+		if err := retryWithExponentialBackoff(ctx, newReadBackoff(), func(ctx context.Context) error {
+			return getObjList(ctx, o.proxy, nil, selectors, clusterList)
+		}); err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusterList.Items {
+			selectedByLabels.Insert(types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name})
+		}
+	}
+
+	selectedNames := sets.New(selection.Names...)
+	selectedClusters := sets.New[*node]()
+	foundNames := sets.New[string]()
+	for _, cluster := range o.getClusters() {
+		if selectedNames.Has(cluster.identity.Name) {
+			selectedClusters.Insert(cluster)
+			foundNames.Insert(cluster.identity.Name)
+		}
+		if selectedByLabels.Has(types.NamespacedName{Namespace: cluster.identity.Namespace, Name: cluster.identity.Name}) {
+			selectedClusters.Insert(cluster)
+		}
+	}
+
+	// Nb. When resuming, selected Clusters might have been already deleted from the source management cluster.
+	if resuming {
+		return selectedClusters, nil
+	}
+	if missing := selectedNames.Difference(foundNames); missing.Len() > 0 {
+		return nil, pkgerrors.Errorf("failed to find Clusters %s", strings.Join(sets.List(missing), ", "))
+	}
+	if selectedClusters.Len() == 0 {
+		return nil, pkgerrors.Errorf("no Clusters matching label selector %q", selection.LabelSelector.String())
+	}
+	return selectedClusters, nil
+}
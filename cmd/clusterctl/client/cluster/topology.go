@@ -0,0 +1,807 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/core/reconcilers/clusterclass"
+	"sigs.k8s.io/cluster-api/core/reconcilers/machinedeployment/mdutil"
+	topologycluster "sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/contract"
+	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
+	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	utilcontract "sigs.k8s.io/cluster-api/util/contract"
+)
+
+// TopologyClient has methods to work with ClusterClasses and managed topologies.
+type TopologyClient interface {
+	// Plan returns the changes the topology controller would make to the Clusters affected by the input objects,
+	// without applying them.
+	Plan(ctx context.Context, in *TopologyPlanInput) (*TopologyPlanOutput, error)
+
+	// CheckRebase returns, for each of the selected Clusters, the reasons why the Cluster cannot be rebased
+	// onto another ClusterClass.
+	CheckRebase(ctx context.Context, in *TopologyCheckRebaseInput) (*TopologyCheckRebaseOutput, error)
+}
+
+// TopologyPlanInput defines the input for the Plan function.
+type TopologyPlanInput struct {
+	// Objects are the new or modified Clusters, ClusterClasses and templates to plan changes for.
+	Objects []*unstructured.Unstructured
+
+	// Offline, when true, computes the plan without accessing the management cluster; in this case the
+	// objects existing in the management cluster are read from CurrentObjects.
+	// NOTE: When not offline and the RuntimeSDK feature gate is enabled, external patches are computed by calling the
+	// Runtime Extensions registered in the management cluster; offline, external patches are not supported.
+	Offline bool
+
+	// CurrentObjects are the objects existing in the management cluster, used when Offline is true.
+	CurrentObjects []*unstructured.Unstructured
+
+	// TargetClusterName restricts the plan to the Cluster with this name.
+	TargetClusterName string
+
+	// TargetNamespace is the namespace used for the input objects without a namespace.
+	TargetNamespace string
+}
+
+// TopologyPlanOutput defines the output of the Plan function.
+type TopologyPlanOutput struct {
+	// Clusters are the plans for the Clusters affected by the input objects, sorted by namespace and name.
+	Clusters []*ClusterTopologyPlan
+}
+
+// ClusterTopologyPlan defines the changes the topology controller would make to a Cluster.
+type ClusterTopologyPlan struct {
+	// Cluster is the Cluster the plan is for.
+	Cluster client.ObjectKey
+
+	// Created are the objects that would be created.
+	Created []*unstructured.Unstructured
+
+	// Modified are the objects that would be modified.
+	Modified []*ModifiedObject
+
+	// Deleted are the objects that would be deleted.
+	Deleted []*unstructured.Unstructured
+
+	// MachineDeploymentRollouts are the MachineDeployments that would roll out their Machines.
+	MachineDeploymentRollouts []MachineDeploymentRollout
+}
+
+// ModifiedObject defines an object before and after a change; only the metadata and spec fields
+// the topology controller has an opinion on are included.
+type ModifiedObject struct {
+	Before *unstructured.Unstructured
+	After  *unstructured.Unstructured
+}
+
+// MachineDeploymentRollout defines a MachineDeployment that would roll out, with the reasons why.
+type MachineDeploymentRollout struct {
+	Name    string
+	Reasons []string
+}
+
+// topologyPlanAllowedPaths are the paths the topology controller has an opinion on, and thus the paths
+// considered when comparing the current and the desired state of an object.
+// NOTE: ownerReferences are not considered, because the UID of objects not existing yet is not known.
+var topologyPlanAllowedPaths = []contract.Path{
+	{"apiVersion"},
+	{"kind"},
+	{"metadata", "name"},
+	{"metadata", "namespace"},
+	{"metadata", "labels"},
+	{"metadata", "annotations"},
+	{"spec"},
+}
+
+// topologyClient implements TopologyClient.
+type topologyClient struct {
+	proxy Proxy
+}
+
+// ensure topologyClient implements TopologyClient.
+var _ TopologyClient = &topologyClient{}
+
+// newTopologyClient returns a topologyClient.
+func newTopologyClient(proxy Proxy) *topologyClient {
+	return &topologyClient{
+		proxy: proxy,
+	}
+}
+
+func (t *topologyClient) Plan(ctx context.Context, in *TopologyPlanInput) (*TopologyPlanOutput, error) {
+	objs, err := t.prepareInputObjects(in)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := t.currentReader(ctx, in.Offline, in.CurrentObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeClient, err := t.runtimeClient(ctx, in.Offline)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterClassReconciler := &clusterclass.Reconciler{
+		RuntimeClient: runtimeClient,
+	}
+	if err := clusterClassReconciler.SetupForDryRun(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := completeInputObjects(ctx, current, clusterClassReconciler, objs); err != nil {
+		return nil, err
+	}
+
+	crds, err := generateMissingCRDs(ctx, current, append(objs, in.CurrentObjects...))
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newTopologyPlanClient(current, append(objs, crds...))
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := getTopologyPlanTargetClusters(ctx, c, objs, in.TargetClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &topologycluster.Reconciler{
+		Client:        c,
+		APIReader:     c,
+		ClusterCache:  clustercache.NewFakeEmptyClusterCache(),
+		RuntimeClient: runtimeClient,
+	}
+	if err := r.SetupForDryRun(ctx); err != nil {
+		return nil, err
+	}
+
+	out := &TopologyPlanOutput{}
+	for _, cluster := range clusters {
+		s, err := r.ComputeDesiredState(ctx, cluster)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute the desired state of Cluster %s", klog.KObj(cluster))
+		}
+
+		p := &topologyPlanner{client: c, plan: &ClusterTopologyPlan{Cluster: client.ObjectKeyFromObject(cluster)}}
+		if err := p.planCluster(ctx, s); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to plan changes to Cluster %s", klog.KObj(cluster))
+		}
+		out.Clusters = append(out.Clusters, p.plan)
+	}
+	return out, nil
+}
+
+// currentReader returns a client.Reader for the objects existing in the management cluster, or for the
+// given objects when offline.
+func (t *topologyClient) currentReader(ctx context.Context, offline bool, currentObjs []*unstructured.Unstructured) (client.Reader, error) {
+	if offline {
+		return newTopologyPlanCurrentReader(currentObjs)
+	}
+	return t.proxy.NewClient(ctx)
+}
+
+// runtimeClient returns a Runtime SDK client for the Runtime Extensions registered in the management cluster, used
+// to compute external patches; nil is returned if the RuntimeSDK feature gate is disabled.
+func (t *topologyClient) runtimeClient(ctx context.Context, offline bool) (runtimeclient.Client, error) {
+	if !feature.Gates.Enabled(feature.RuntimeSDK) {
+		return nil, nil
+	}
+	if offline {
+		return nil, pkgerrors.New("planning changes offline is not supported when the RuntimeSDK feature gate is enabled, because Runtime Extensions are registered in the management cluster")
+	}
+	return newTopologyPlanRuntimeClient(ctx, t.proxy)
+}
+
+// prepareInputObjects validates the input objects and sets the target namespace on objects without a namespace.
+func (t *topologyClient) prepareInputObjects(in *TopologyPlanInput) ([]*unstructured.Unstructured, error) {
+	if len(in.Objects) == 0 {
+		return nil, pkgerrors.New("at least one input object is required")
+	}
+
+	namespace, err := t.targetNamespace(in.TargetNamespace, in.Offline)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make([]*unstructured.Unstructured, 0, len(in.Objects))
+	for _, o := range in.Objects {
+		obj := o.DeepCopy()
+		gvk := obj.GroupVersionKind()
+		if gvk.Group == clusterv1.GroupVersion.Group && gvk.Version != clusterv1.GroupVersion.Version {
+			return nil, pkgerrors.Errorf("%s %s must be converted to %s, e.g. with clusterctl convert", gvk.Kind, obj.GetName(), clusterv1.GroupVersion)
+		}
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// targetNamespace returns the given namespace or, if empty, the current namespace, or the default namespace
+// when offline.
+func (t *topologyClient) targetNamespace(namespace string, offline bool) (string, error) {
+	if namespace != "" {
+		return namespace, nil
+	}
+	if offline {
+		return metav1.NamespaceDefault, nil
+	}
+	return t.proxy.CurrentNamespace()
+}
+
+// completeInputObjects sets on the input objects the fields that are set by controllers in the management cluster,
+// e.g. the references to the InfrastructureCluster and to the ControlPlane of an existing Cluster.
+func completeInputObjects(ctx context.Context, current client.Reader, clusterClassReconciler *clusterclass.Reconciler, objs []*unstructured.Unstructured) error {
+	for _, obj := range objs {
+		switch obj.GroupVersionKind() {
+		case clusterv1.GroupVersion.WithKind("Cluster"):
+			cluster := &clusterv1.Cluster{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, cluster); err != nil {
+				return pkgerrors.Wrapf(err, "failed to convert Cluster %s", obj.GetName())
+			}
+
+			currentCluster := &clusterv1.Cluster{}
+			if err := current.Get(ctx, client.ObjectKeyFromObject(cluster), currentCluster); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return pkgerrors.Wrapf(err, "failed to get Cluster %s", klog.KObj(cluster))
+				}
+				continue
+			}
+			cluster.UID = currentCluster.UID
+			if !cluster.Spec.InfrastructureRef.IsDefined() {
+				cluster.Spec.InfrastructureRef = currentCluster.Spec.InfrastructureRef
+			}
+			if !cluster.Spec.ControlPlaneRef.IsDefined() {
+				cluster.Spec.ControlPlaneRef = currentCluster.Spec.ControlPlaneRef
+			}
+			if !cluster.Spec.ControlPlaneEndpoint.IsValid() {
+				cluster.Spec.ControlPlaneEndpoint = currentCluster.Spec.ControlPlaneEndpoint
+			}
+			cluster.Status = currentCluster.Status
+
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cluster)
+			if err != nil {
+				return pkgerrors.Wrapf(err, "failed to convert Cluster %s", obj.GetName())
+			}
+			obj.Object = u
+		case clusterv1.GroupVersion.WithKind("ClusterClass"):
+			clusterClass := &clusterv1.ClusterClass{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, clusterClass); err != nil {
+				return pkgerrors.Wrapf(err, "failed to convert ClusterClass %s", obj.GetName())
+			}
+			if err := setClusterClassStatusVariables(ctx, clusterClassReconciler, clusterClass); err != nil {
+				return err
+			}
+
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(clusterClass)
+			if err != nil {
+				return pkgerrors.Wrapf(err, "failed to convert ClusterClass %s", obj.GetName())
+			}
+			obj.Object = u
+		}
+	}
+	return nil
+}
+
+// setClusterClassStatusVariables sets the variables of a ClusterClass in its status, like the ClusterClass controller
+// does; the variables of external patches are discovered by calling Runtime Extensions, which requires a Runtime SDK client.
+func setClusterClassStatusVariables(ctx context.Context, clusterClassReconciler *clusterclass.Reconciler, clusterClass *clusterv1.ClusterClass) error {
+	if clusterClassReconciler.RuntimeClient == nil {
+		for _, patch := range clusterClass.Spec.Patches {
+			if patch.External != nil {
+				return pkgerrors.Errorf("ClusterClass %s has external patches, which require calling the Runtime Extensions registered in the management cluster with the RuntimeSDK feature gate enabled", klog.KObj(clusterClass))
+			}
+		}
+	}
+
+	if err := clusterClassReconciler.ComputeVariables(ctx, clusterClass); err != nil {
+		return pkgerrors.Wrapf(err, "failed to compute variables of ClusterClass %s", klog.KObj(clusterClass))
+	}
+	return nil
+}
+
+// generateMissingCRDs generates the CRDs for the kinds of the given objects, and for the kinds of the objects created
+// from templates, not existing in the management cluster, assuming that those kinds comply with the current contract; CRDs are required e.g. to determine the contract
+// version of a template.
+func generateMissingCRDs(ctx context.Context, current client.Reader, objs []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	gvks := map[schema.GroupKind]sets.Set[string]{}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		// Only provider kinds are referenced via contract by Cluster API.
+		if !strings.HasSuffix(gvk.Group, "."+clusterv1.GroupVersion.Group) {
+			continue
+		}
+		gks := []schema.GroupKind{gvk.GroupKind()}
+		// The objects created from a template, e.g. the ControlPlane, are of the kind without the Template suffix.
+		if kind, ok := strings.CutSuffix(gvk.Kind, "Template"); ok {
+			gks = append(gks, schema.GroupKind{Group: gvk.Group, Kind: kind})
+		}
+		for _, gk := range gks {
+			if _, ok := gvks[gk]; !ok {
+				gvks[gk] = sets.New[string]()
+			}
+			gvks[gk].Insert(gvk.Version)
+		}
+	}
+
+	crds := []*unstructured.Unstructured{}
+	for gk, versions := range gvks {
+		if _, err := contract.GetGKMetadata(ctx, current, gk); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(pkgerrors.Cause(err)) {
+			return nil, err
+		}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+				Kind:       "CustomResourceDefinition",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: utilcontract.CalculateCRDName(gk.Group, gk.Kind),
+				Labels: map[string]string{
+					fmt.Sprintf("%s/%s", clusterv1.GroupVersion.Group, contract.Version): strings.Join(sets.List(versions), "_"),
+				},
+			},
+		}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(crd)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to convert CustomResourceDefinition %s", crd.Name)
+		}
+		crds = append(crds, &unstructured.Unstructured{Object: u})
+	}
+	return crds, nil
+}
+
+// getTopologyPlanTargetClusters returns the Clusters with a managed topology affected by the input objects, i.e.
+// the input Clusters and the Clusters using an input ClusterClass or a ClusterClass referencing an input template.
+func getTopologyPlanTargetClusters(ctx context.Context, c client.Client, objs []*unstructured.Unstructured, targetClusterName string) ([]*clusterv1.Cluster, error) {
+	inputClusters := sets.New[client.ObjectKey]()
+	inputClusterClasses := sets.New[client.ObjectKey]()
+	inputTemplates := sets.New[string]()
+	for _, obj := range objs {
+		switch obj.GroupVersionKind() {
+		case clusterv1.GroupVersion.WithKind("Cluster"):
+			inputClusters.Insert(client.ObjectKeyFromObject(obj))
+		case clusterv1.GroupVersion.WithKind("ClusterClass"):
+			inputClusterClasses.Insert(client.ObjectKeyFromObject(obj))
+		default:
+			inputTemplates.Insert(templateKey(obj.GroupVersionKind().Group, obj.GetKind(), obj.GetNamespace(), obj.GetName()))
+		}
+	}
+
+	clusterClasses := &clusterv1.ClusterClassList{}
+	if err := c.List(ctx, clusterClasses); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list ClusterClasses")
+	}
+	for _, clusterClass := range clusterClasses.Items {
+		for _, ref := range clusterClassTemplateRefs(&clusterClass) {
+			if inputTemplates.Has(templateKey(ref.GroupVersionKind().Group, ref.Kind, clusterClass.Namespace, ref.Name)) {
+				inputClusterClasses.Insert(client.ObjectKeyFromObject(&clusterClass))
+			}
+		}
+	}
+
+	clusterList := &clusterv1.ClusterList{}
+	if err := c.List(ctx, clusterList); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Clusters")
+	}
+	clusters := []*clusterv1.Cluster{}
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if !cluster.Spec.Topology.IsDefined() || !cluster.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if targetClusterName != "" && cluster.Name != targetClusterName {
+			continue
+		}
+		if inputClusters.Has(client.ObjectKeyFromObject(cluster)) || inputClusterClasses.Has(client.ObjectKey(cluster.GetClassKey())) {
+			clusters = append(clusters, cluster)
+		}
+	}
+	if targetClusterName != "" && len(clusters) == 0 {
+		return nil, pkgerrors.Errorf("Cluster %s is not affected by the input objects", targetClusterName)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Namespace != clusters[j].Namespace {
+			return clusters[i].Namespace < clusters[j].Namespace
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters, nil
+}
+
+// topologyPlanner computes the changes the topology controller would make to a Cluster by comparing its current
+// and desired state, following the same rules used in the topology controller, e.g. for template rotation.
+type topologyPlanner struct {
+	client client.Client
+	plan   *ClusterTopologyPlan
+}
+
+func (p *topologyPlanner) planCluster(ctx context.Context, s *scope.Scope) error {
+	clusterName := s.Current.Cluster.Name
+
+	if err := p.planObject(s.Current.InfrastructureCluster, s.Desired.InfrastructureCluster); err != nil {
+		return err
+	}
+
+	if err := p.planObject(s.Current.ControlPlane.MachineHealthCheck, s.Desired.ControlPlane.MachineHealthCheck); err != nil {
+		return err
+	}
+	// Changes to the control plane are deferred while it is pending an upgrade.
+	if !s.UpgradeTracker.ControlPlane.IsPendingUpgrade {
+		if s.Blueprint.HasControlPlaneInfrastructureMachine() {
+			rotated, err := p.planTemplate(s.Current.ControlPlane.InfrastructureMachineTemplate, s.Desired.ControlPlane.InfrastructureMachineTemplate,
+				topologynames.ControlPlaneInfrastructureMachineTemplateNamePrefix(clusterName))
+			if err != nil {
+				return err
+			}
+			if rotated {
+				if err := p.setControlPlaneInfrastructureRefName(ctx, s.Desired.ControlPlane.Object, s.Desired.ControlPlane.InfrastructureMachineTemplate.GetName()); err != nil {
+					return err
+				}
+			}
+		}
+		if err := p.planObject(s.Current.ControlPlane.Object, s.Desired.ControlPlane.Object); err != nil {
+			return err
+		}
+	}
+
+	if err := p.planObject(s.Current.Cluster, s.Desired.Cluster); err != nil {
+		return err
+	}
+
+	if err := p.planAuxiliaryResources(s); err != nil {
+		return err
+	}
+
+	if err := p.planMachineDeployments(s); err != nil {
+		return err
+	}
+	return p.planMachinePools(s)
+}
+
+// planAuxiliaryResources records the changes to the auxiliary resources defined in the ClusterClass.
+// NOTE: Like in the topology controller, if the kind of an auxiliary resource changes the current object
+// is deleted and a new one is created.
+func (p *topologyPlanner) planAuxiliaryResources(s *scope.Scope) error {
+	for _, name := range sortedKeys(s.Desired.AuxiliaryResources) {
+		desired := s.Desired.AuxiliaryResources[name]
+		current := s.Current.AuxiliaryResources[name]
+		if current != nil && current.GroupVersionKind().GroupKind() != desired.GroupVersionKind().GroupKind() {
+			if err := p.planObject(current, nil); err != nil {
+				return err
+			}
+			current = nil
+		}
+		if err := p.planObject(current, desired); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedKeys(s.Current.AuxiliaryResources) {
+		if _, ok := s.Desired.AuxiliaryResources[name]; ok {
+			continue
+		}
+		if err := p.planObject(s.Current.AuxiliaryResources[name], nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *topologyPlanner) planMachineDeployments(s *scope.Scope) error {
+	for _, mdTopologyName := range sortedKeys(s.Desired.MachineDeployments) {
+		desired := s.Desired.MachineDeployments[mdTopologyName]
+		current, ok := s.Current.MachineDeployments[mdTopologyName]
+		if !ok {
+			// MachineDeployments are created only when they are not pending create, e.g. because of an upgrade.
+			if s.UpgradeTracker.MachineDeployments.IsPendingCreate(mdTopologyName) {
+				continue
+			}
+			for _, obj := range []client.Object{desired.InfrastructureMachineTemplate, desired.BootstrapTemplate, desired.Object, desired.MachineHealthCheck} {
+				if err := p.planObject(nil, obj); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := p.planObject(current.MachineHealthCheck, desired.MachineHealthCheck); err != nil {
+			return err
+		}
+		// Changes to a MachineDeployment are deferred while it is pending an upgrade.
+		if !current.Object.DeletionTimestamp.IsZero() || s.UpgradeTracker.MachineDeployments.IsPendingUpgrade(current.Object.Name) {
+			continue
+		}
+
+		rotated, err := p.planTemplate(current.InfrastructureMachineTemplate, desired.InfrastructureMachineTemplate,
+			topologynames.InfrastructureMachineTemplateNamePrefix(s.Current.Cluster.Name, mdTopologyName))
+		if err != nil {
+			return err
+		}
+		if rotated {
+			desired.Object.Spec.Template.Spec.InfrastructureRef.Name = desired.InfrastructureMachineTemplate.GetName()
+		}
+		rotated, err = p.planTemplate(current.BootstrapTemplate, desired.BootstrapTemplate,
+			topologynames.BootstrapTemplateNamePrefix(s.Current.Cluster.Name, mdTopologyName))
+		if err != nil {
+			return err
+		}
+		if rotated {
+			desired.Object.Spec.Template.Spec.Bootstrap.ConfigRef.Name = desired.BootstrapTemplate.GetName()
+		}
+
+		if err := p.planObject(current.Object, desired.Object); err != nil {
+			return err
+		}
+		if upToDate, res := mdutil.MachineTemplateUpToDate(&current.Object.Spec.Template, &desired.Object.Spec.Template); !upToDate {
+			p.plan.MachineDeploymentRollouts = append(p.plan.MachineDeploymentRollouts, MachineDeploymentRollout{
+				Name:    current.Object.Name,
+				Reasons: res.LogMessages,
+			})
+		}
+	}
+
+	for _, mdTopologyName := range sortedKeys(s.Current.MachineDeployments) {
+		if _, ok := s.Desired.MachineDeployments[mdTopologyName]; ok {
+			continue
+		}
+		current := s.Current.MachineDeployments[mdTopologyName]
+		for _, obj := range []client.Object{current.MachineHealthCheck, current.Object} {
+			if err := p.planObject(obj, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *topologyPlanner) planMachinePools(s *scope.Scope) error {
+	for _, mpTopologyName := range sortedKeys(s.Desired.MachinePools) {
+		desired := s.Desired.MachinePools[mpTopologyName]
+		current, ok := s.Current.MachinePools[mpTopologyName]
+		if !ok {
+			// MachinePools are created only when they are not pending create, e.g. because of an upgrade.
+			if s.UpgradeTracker.MachinePools.IsPendingCreate(mpTopologyName) {
+				continue
+			}
+			for _, obj := range []client.Object{desired.InfrastructureMachinePoolObject, desired.BootstrapObject, desired.Object, desired.MachineHealthCheck} {
+				if err := p.planObject(nil, obj); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := p.planObject(current.MachineHealthCheck, desired.MachineHealthCheck); err != nil {
+			return err
+		}
+		// Changes to a MachinePool are deferred while it is pending an upgrade.
+		if !current.Object.DeletionTimestamp.IsZero() || s.UpgradeTracker.MachinePools.IsPendingUpgrade(current.Object.Name) {
+			continue
+		}
+		for _, objs := range [][2]client.Object{
+			{current.InfrastructureMachinePoolObject, desired.InfrastructureMachinePoolObject},
+			{current.BootstrapObject, desired.BootstrapObject},
+			{current.Object, desired.Object},
+		} {
+			if err := p.planObject(objs[0], objs[1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, mpTopologyName := range sortedKeys(s.Current.MachinePools) {
+		if _, ok := s.Desired.MachinePools[mpTopologyName]; ok {
+			continue
+		}
+		current := s.Current.MachinePools[mpTopologyName]
+		for _, obj := range []client.Object{current.MachineHealthCheck, current.Object} {
+			if err := p.planObject(obj, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// planObject records the creation, the modification or the deletion of an object.
+func (p *topologyPlanner) planObject(current, desired client.Object) error {
+	currentUnstructured, err := p.toUnstructured(current)
+	if err != nil {
+		return err
+	}
+	desiredUnstructured, err := p.toUnstructured(desired)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case currentUnstructured == nil && desiredUnstructured == nil:
+	case currentUnstructured == nil:
+		p.plan.Created = append(p.plan.Created, desiredUnstructured)
+	case desiredUnstructured == nil:
+		p.plan.Deleted = append(p.plan.Deleted, currentUnstructured)
+	default:
+		if modified := computeModifiedObject(currentUnstructured, desiredUnstructured); modified != nil {
+			p.plan.Modified = append(p.plan.Modified, modified)
+		}
+	}
+	return nil
+}
+
+// planTemplate records the changes to a template; if the spec of the template changes the template is rotated, i.e.
+// a new template is created with a new name and the current template is deleted.
+// NOTE: Like in the topology controller, in case of template rotation the name of the desired template is changed.
+func (p *topologyPlanner) planTemplate(current, desired *unstructured.Unstructured, templateNamePrefix string) (bool, error) {
+	if current == nil {
+		return false, p.planObject(nil, desired)
+	}
+
+	modified := computeModifiedObject(filterTopologyPlanObject(current), filterTopologyPlanObject(desired))
+	if modified == nil {
+		return false, nil
+	}
+	if reflect.DeepEqual(modified.Before.Object["spec"], modified.After.Object["spec"]) {
+		p.plan.Modified = append(p.plan.Modified, modified)
+		return false, nil
+	}
+
+	desired.SetName(names.SimpleNameGenerator.GenerateName(templateNamePrefix))
+	if err := p.planObject(nil, desired); err != nil {
+		return false, err
+	}
+	return true, p.planObject(current, nil)
+}
+
+// setControlPlaneInfrastructureRefName sets the name of the InfrastructureMachineTemplate referenced by a ControlPlane.
+func (p *topologyPlanner) setControlPlaneInfrastructureRefName(ctx context.Context, controlPlane *unstructured.Unstructured, name string) error {
+	contractVersion, err := contract.GetContractVersionForVersion(ctx, p.client, controlPlane.GroupVersionKind().GroupKind(), controlPlane.GroupVersionKind().Version)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to get contract version for the ControlPlane object")
+	}
+	if contractVersion == "v1beta1" {
+		ref, err := contract.ControlPlane().MachineTemplate().InfrastructureV1Beta1Ref().Get(controlPlane)
+		if err != nil {
+			return err
+		}
+		ref.Name = name
+		return contract.ControlPlane().MachineTemplate().InfrastructureV1Beta1Ref().Set(controlPlane, ref)
+	}
+	ref, err := contract.ControlPlane().MachineTemplate().InfrastructureRef().Get(controlPlane)
+	if err != nil {
+		return err
+	}
+	ref.Name = name
+	return contract.ControlPlane().MachineTemplate().InfrastructureRef().Set(controlPlane, ref)
+}
+
+// toUnstructured converts an object to a filtered unstructured object; nil objects are returned as nil.
+func (p *topologyPlanner) toUnstructured(obj client.Object) (*unstructured.Unstructured, error) {
+	if obj == nil || reflect.ValueOf(obj).IsNil() {
+		return nil, nil
+	}
+
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return filterTopologyPlanObject(u), nil
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, p.client.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to convert %s %s", gvk.Kind, klog.KObj(obj))
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	return filterTopologyPlanObject(u), nil
+}
+
+// computeModifiedObject returns the object before and after applying the desired state to the current state,
+// or nil if there are no changes.
+// NOTE: Fields not set in the desired state are preserved, like when the topology controller uses server side apply.
+func computeModifiedObject(current, desired *unstructured.Unstructured) *ModifiedObject {
+	after := current.DeepCopy()
+	mergeTopologyPlanFields(after.Object, desired.Object)
+	if reflect.DeepEqual(current.Object, after.Object) {
+		return nil
+	}
+	return &ModifiedObject{Before: current, After: after}
+}
+
+// mergeTopologyPlanFields merges src into dst; maps are merged recursively, while all other values are replaced.
+func mergeTopologyPlanFields(dst, src map[string]interface{}) {
+	for k, srcValue := range src {
+		srcMap, srcIsMap := srcValue.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeTopologyPlanFields(dstMap, srcMap)
+			continue
+		}
+		dst[k] = runtime.DeepCopyJSONValue(srcValue)
+	}
+}
+
+func filterTopologyPlanObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	filtered := obj.DeepCopy()
+	ssa.FilterObject(filtered, &ssa.FilterObjectInput{AllowedPaths: topologyPlanAllowedPaths})
+	return filtered
+}
+
+// clusterClassTemplateRefs returns the references to the templates used by a ClusterClass.
+func clusterClassTemplateRefs(clusterClass *clusterv1.ClusterClass) []clusterv1.ClusterClassTemplateReference {
+	refs := []clusterv1.ClusterClassTemplateReference{
+		clusterClass.Spec.Infrastructure.TemplateRef,
+		clusterClass.Spec.ControlPlane.TemplateRef,
+		clusterClass.Spec.ControlPlane.MachineInfrastructure.TemplateRef,
+	}
+	for _, md := range clusterClass.Spec.Workers.MachineDeployments {
+		refs = append(refs, md.Infrastructure.TemplateRef, md.Bootstrap.TemplateRef)
+	}
+	for _, mp := range clusterClass.Spec.Workers.MachinePools {
+		refs = append(refs, mp.Infrastructure.TemplateRef, mp.Bootstrap.TemplateRef)
+	}
+	return refs
+}
+
+func templateKey(group, kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", group, kind, namespace, name)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// topologyPlanClient is a client.Client used to compute the desired state of Cluster topologies without applying
+// changes: objects are read from the input objects first, and then from the current state; writes are applied only
+// to the input objects.
+type topologyPlanClient struct {
+	client.Client
+	current client.Reader
+}
+
+// newTopologyPlanClient returns a topologyPlanClient reading the given objects, and then objects from current.
+func newTopologyPlanClient(current client.Reader, objs []*unstructured.Unstructured) (*topologyPlanClient, error) {
+	c, err := newFakeClient(objs)
+	if err != nil {
+		return nil, err
+	}
+	return &topologyPlanClient{
+		Client:  c,
+		current: current,
+	}, nil
+}
+
+// newTopologyPlanCurrentReader returns a client.Reader for the objects existing in the management cluster
+// when planning changes offline.
+func newTopologyPlanCurrentReader(objs []*unstructured.Unstructured) (client.Reader, error) {
+	return newFakeClient(objs)
+}
+
+func newFakeClient(objs []*unstructured.Unstructured) (client.Client, error) {
+	builder := fake.NewClientBuilder().WithScheme(localScheme)
+	for _, obj := range objs {
+		if obj.GetName() == "" {
+			return nil, pkgerrors.Errorf("%s without a name is not supported", obj.GetKind())
+		}
+		builder = builder.WithObjects(obj.DeepCopy())
+	}
+	return builder.Build(), nil
+}
+
+// Get reads an object from the input objects, or from the current state if it does not exist in the input objects.
+func (c *topologyPlanClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	err := c.Client.Get(ctx, key, obj, opts...)
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+	return c.current.Get(ctx, key, obj, opts...)
+}
+
+// List lists objects from both the input objects and the current state; input objects take precedence over the
+// objects with the same namespace and name in the current state.
+func (c *topologyPlanClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	currentList, ok := list.DeepCopyObject().(client.ObjectList)
+	if !ok {
+		return pkgerrors.Errorf("failed to copy %T", list)
+	}
+	if err := c.current.List(ctx, currentList, opts...); err != nil && !meta.IsNoMatchError(err) {
+		return err
+	}
+	currentItems, err := meta.ExtractList(currentList)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Client.List(ctx, list, opts...); err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	keys := sets.New[client.ObjectKey]()
+	for _, item := range items {
+		o, ok := item.(client.Object)
+		if !ok {
+			return pkgerrors.Errorf("unexpected list item %T", item)
+		}
+		keys.Insert(client.ObjectKeyFromObject(o))
+	}
+	for _, item := range currentItems {
+		o, ok := item.(client.Object)
+		if !ok {
+			return pkgerrors.Errorf("unexpected list item %T", item)
+		}
+		if !keys.Has(client.ObjectKeyFromObject(o)) {
+			items = append(items, item)
+		}
+	}
+	return meta.SetList(list, items)
+}
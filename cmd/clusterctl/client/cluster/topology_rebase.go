@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/core/reconcilers/clusterclass"
+	coreadmission "sigs.k8s.io/cluster-api/core/webhooks/admission"
+	"sigs.k8s.io/cluster-api/internal/topology/check"
+)
+
+// TopologyCheckRebaseInput defines the input for the CheckRebase function.
+type TopologyCheckRebaseInput struct {
+	// Objects are new or modified ClusterClasses, e.g. the ClusterClass to rebase onto when it does not exist yet
+	// in the management cluster; other objects are ignored.
+	Objects []*unstructured.Unstructured
+
+	// Offline, when true, checks the Clusters without accessing the management cluster; in this case the
+	// objects existing in the management cluster are read from CurrentObjects.
+	Offline bool
+
+	// CurrentObjects are the objects existing in the management cluster, used when Offline is true.
+	CurrentObjects []*unstructured.Unstructured
+
+	// FromClusterClass restricts the check to the Clusters using this ClusterClass, in the form [namespace/]name.
+	// If the namespace is omitted, the namespace of the Cluster is used.
+	FromClusterClass string
+
+	// ToClusterClass is the ClusterClass to rebase the Clusters onto, in the form [namespace/]name.
+	// If the namespace is omitted, the namespace of the Cluster is used.
+	ToClusterClass string
+
+	// TargetClusterNames restricts the check to the Clusters with these names.
+	TargetClusterNames []string
+
+	// TargetNamespace is the namespace of the Clusters to check, and the namespace used for the input objects
+	// without a namespace.
+	TargetNamespace string
+}
+
+// TopologyCheckRebaseOutput defines the output of the CheckRebase function.
+type TopologyCheckRebaseOutput struct {
+	// Clusters are the results of the check for each of the selected Clusters, sorted by name; Clusters already
+	// using the ClusterClass to rebase onto are not included.
+	Clusters []*ClusterRebaseCheck
+}
+
+// ClusterRebaseCheck defines the reasons why a Cluster cannot be rebased onto another ClusterClass.
+type ClusterRebaseCheck struct {
+	// Cluster is the Cluster the check is for.
+	Cluster client.ObjectKey
+
+	// From is the ClusterClass currently used by the Cluster.
+	From client.ObjectKey
+
+	// To is the ClusterClass the Cluster would be rebased onto.
+	To client.ObjectKey
+
+	// TemplateErrors are the incompatible changes to the templates referenced by the ClusterClasses.
+	TemplateErrors field.ErrorList
+
+	// VariableErrors are the variables of the Cluster which are missing or invalid under the variable definitions
+	// of the ClusterClass to rebase onto.
+	VariableErrors field.ErrorList
+
+	// WorkerClassErrors are the MachineDeployment and MachinePool classes used by the Cluster which do not exist in
+	// the ClusterClass to rebase onto.
+	WorkerClassErrors field.ErrorList
+}
+
+// IsCompatible returns true if the Cluster can be rebased onto the new ClusterClass.
+func (c *ClusterRebaseCheck) IsCompatible() bool {
+	return len(c.TemplateErrors) == 0 && len(c.VariableErrors) == 0 && len(c.WorkerClassErrors) == 0
+}
+
+// CheckRebase runs for each of the selected Clusters the same compatibility checks the Cluster webhook runs when the
+// ClusterClass of a Cluster is changed, and collects all the errors instead of stopping at the first rejected change.
+func (t *topologyClient) CheckRebase(ctx context.Context, in *TopologyCheckRebaseInput) (*TopologyCheckRebaseOutput, error) {
+	if in.ToClusterClass == "" {
+		return nil, pkgerrors.New("the ClusterClass to rebase onto is required")
+	}
+
+	namespace, err := t.targetNamespace(in.TargetNamespace, in.Offline)
+	if err != nil {
+		return nil, err
+	}
+
+	inputClusterClasses, err := prepareRebaseInputClusterClasses(ctx, in.Objects, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := t.currentReader(ctx, in.Offline, in.CurrentObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := getRebaseTargetClusters(ctx, current, namespace, in.FromClusterClass, in.TargetClusterNames)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &TopologyCheckRebaseOutput{}
+	for _, cluster := range clusters {
+		from := client.ObjectKey(cluster.GetClassKey())
+		to := parseClusterClassRef(in.ToClusterClass, cluster.Namespace)
+		if from == to {
+			continue
+		}
+
+		result := &ClusterRebaseCheck{
+			Cluster: client.ObjectKeyFromObject(cluster),
+			From:    from,
+			To:      to,
+		}
+
+		toClusterClass, ok := inputClusterClasses[to]
+		if !ok {
+			toClusterClass = &clusterv1.ClusterClass{}
+			if err := current.Get(ctx, to, toClusterClass); err != nil {
+				return nil, pkgerrors.Wrapf(err, "failed to get ClusterClass %s", to)
+			}
+			// Variable definitions are set in the ClusterClass status by the ClusterClass controller.
+			if toClusterClass.Status.ObservedGeneration != toClusterClass.Generation {
+				return nil, pkgerrors.Errorf("ClusterClass %s has not been reconciled yet, the variables of the Clusters cannot be validated; "+
+					"provide the ClusterClass as an input object to validate its inline variables", to)
+			}
+		}
+
+		// Note: Like in the Cluster webhook, the ClusterClass currently used by the Cluster is not required to be reconciled,
+		// to allow to rebase away from a broken ClusterClass.
+		fromClusterClass, ok := inputClusterClasses[from]
+		if !ok {
+			fromClusterClass = &clusterv1.ClusterClass{}
+			if err := current.Get(ctx, from, fromClusterClass); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, pkgerrors.Wrapf(err, "failed to get ClusterClass %s", from)
+				}
+				fromClusterClass = nil
+			}
+		}
+
+		if fromClusterClass != nil {
+			result.TemplateErrors = check.ClusterClassesAreCompatible(fromClusterClass, toClusterClass)
+		} else {
+			result.TemplateErrors = field.ErrorList{field.Forbidden(field.NewPath("spec", "topology", "classRef"),
+				"ClusterClass "+from.String()+" does not exist, the compatibility of the templates cannot be validated")}
+		}
+
+		result.WorkerClassErrors = append(result.WorkerClassErrors, check.MachineDeploymentTopologiesAreValidAndDefinedInClusterClass(cluster, toClusterClass)...)
+		result.WorkerClassErrors = append(result.WorkerClassErrors, check.MachinePoolTopologiesAreValidAndDefinedInClusterClass(cluster, toClusterClass)...)
+
+		// Variables are defaulted and validated like in the Cluster webhook; the current variables are used
+		// as old values, e.g. for CEL transition rules.
+		result.VariableErrors = (&coreadmission.Cluster{}).DefaultAndValidateVariables(ctx, cluster.DeepCopy(), cluster, toClusterClass)
+
+		out.Clusters = append(out.Clusters, result)
+	}
+	return out, nil
+}
+
+// prepareRebaseInputClusterClasses returns the ClusterClasses in the input objects, with the variables
+// set in their status.
+// NOTE: Runtime Extensions are not called, so ClusterClasses with external patches are not supported.
+func prepareRebaseInputClusterClasses(ctx context.Context, objs []*unstructured.Unstructured, namespace string) (map[client.ObjectKey]*clusterv1.ClusterClass, error) {
+	clusterClassReconciler := &clusterclass.Reconciler{}
+	if err := clusterClassReconciler.SetupForDryRun(ctx); err != nil {
+		return nil, err
+	}
+
+	clusterClasses := map[client.ObjectKey]*clusterv1.ClusterClass{}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		if gvk.GroupKind() != clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind() {
+			continue
+		}
+		if gvk.Version != clusterv1.GroupVersion.Version {
+			return nil, pkgerrors.Errorf("%s %s must be converted to %s, e.g. with clusterctl convert", gvk.Kind, obj.GetName(), clusterv1.GroupVersion)
+		}
+
+		clusterClass := &clusterv1.ClusterClass{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, clusterClass); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to convert ClusterClass %s", obj.GetName())
+		}
+		if clusterClass.Namespace == "" {
+			clusterClass.Namespace = namespace
+		}
+		if err := setClusterClassStatusVariables(ctx, clusterClassReconciler, clusterClass); err != nil {
+			return nil, err
+		}
+		clusterClasses[client.ObjectKeyFromObject(clusterClass)] = clusterClass
+	}
+	return clusterClasses, nil
+}
+
+// getRebaseTargetClusters returns the Clusters with a managed topology in the given namespace, optionally restricted to
+// the Clusters using the given ClusterClass and to the Clusters with the given names.
+func getRebaseTargetClusters(ctx context.Context, c client.Reader, namespace, fromClusterClass string, clusterNames []string) ([]*clusterv1.Cluster, error) {
+	clusterList := &clusterv1.ClusterList{}
+	if err := c.List(ctx, clusterList, client.InNamespace(namespace)); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Clusters")
+	}
+
+	names := sets.New(clusterNames...)
+	found := sets.New[string]()
+	clusters := []*clusterv1.Cluster{}
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if names.Len() > 0 && !names.Has(cluster.Name) {
+			continue
+		}
+		found.Insert(cluster.Name)
+
+		if !cluster.Spec.Topology.IsDefined() || !cluster.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if fromClusterClass != "" && client.ObjectKey(cluster.GetClassKey()) != parseClusterClassRef(fromClusterClass, cluster.Namespace) {
+			continue
+		}
+		clusters = append(clusters, cluster)
+	}
+	if missing := names.Difference(found); missing.Len() > 0 {
+		return nil, pkgerrors.Errorf("Clusters %s not found in namespace %s", strings.Join(sets.List(missing), ", "), namespace)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters, nil
+}
+
+// parseClusterClassRef parses a ClusterClass reference in the form [namespace/]name, using the given namespace
+// when the reference has no namespace.
+func parseClusterClassRef(ref, namespace string) client.ObjectKey {
+	if ns, name, ok := strings.Cut(ref, "/"); ok {
+		return client.ObjectKey{Namespace: ns, Name: name}
+	}
+	return client.ObjectKey{Namespace: namespace, Name: ref}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func Test_topologyClient_CheckRebase(t *testing.T) {
+	infrastructureClusterTemplate := builder.TestInfrastructureClusterTemplate("ns1", "infra-cluster-template").Build()
+	controlPlaneTemplate := builder.TestControlPlaneTemplate("ns1", "control-plane-template").Build()
+	infrastructureMachineTemplate := builder.TestInfrastructureMachineTemplate("ns1", "infra-machine-template").Build()
+	bootstrapTemplate := builder.TestBootstrapTemplate("ns1", "bootstrap-template").Build()
+	workerClass := *builder.MachineDeploymentClass("worker").
+		WithInfrastructureTemplate(infrastructureMachineTemplate).
+		WithBootstrapTemplate(bootstrapTemplate).
+		Build()
+	fooVariable := clusterv1.ClusterClassVariable{
+		Name:     "foo",
+		Required: ptr.To(true),
+		Schema: clusterv1.VariableSchema{
+			OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "string"},
+		},
+	}
+
+	newClusterClass := func(name string) *builder.ClusterClassBuilder {
+		return builder.ClusterClass("ns1", name).
+			WithInfrastructureClusterTemplate(infrastructureClusterTemplate).
+			WithControlPlaneTemplate(controlPlaneTemplate).
+			WithControlPlaneInfrastructureMachineTemplate(infrastructureMachineTemplate).
+			WithWorkerMachineDeploymentClasses(workerClass).
+			WithVariables(fooVariable)
+	}
+	newCluster := func(name, class string) *clusterv1.Cluster {
+		return builder.Cluster("ns1", name).
+			WithTopology(builder.ClusterTopology().
+				WithClass(class).
+				WithVersion("v1.33.0").
+				WithVariables(clusterv1.ClusterVariable{Name: "foo", Value: apiextensionsv1.JSON{Raw: []byte(`"bar"`)}}).
+				WithMachineDeployment(builder.MachineDeploymentTopology("md1").
+					WithClass("worker").
+					Build()).
+				Build()).
+			Build()
+	}
+
+	class1 := newClusterClass("class1").Build()
+	class3 := newClusterClass("class3").Build()
+	cluster1 := newCluster("cluster1", "class1")
+	cluster2 := newCluster("cluster2", "class3")
+	cluster3 := newCluster("cluster3", "class2")
+	current := []client.Object{class1, class3, cluster1, cluster2, cluster3}
+
+	tests := []struct {
+		name    string
+		to      *clusterv1.ClusterClass
+		in      TopologyCheckRebaseInput
+		want    map[string]ClusterRebaseCheck
+		wantErr string
+	}{
+		{
+			name: "Clusters can be rebased onto a compatible ClusterClass",
+			to:   newClusterClass("class2").Build(),
+			in:   TopologyCheckRebaseInput{ToClusterClass: "class2"},
+			want: map[string]ClusterRebaseCheck{
+				"cluster1": {},
+				"cluster2": {},
+			},
+		},
+		{
+			name: "Reports incompatible templates",
+			to: newClusterClass("class2").
+				WithInfrastructureClusterTemplate(builder.InfrastructureClusterTemplate("ns1", "infra-cluster-template").Build()).
+				Build(),
+			in: TopologyCheckRebaseInput{ToClusterClass: "class2", FromClusterClass: "class1"},
+			want: map[string]ClusterRebaseCheck{
+				"cluster1": {TemplateErrors: make([]*field.Error, 1)},
+			},
+		},
+		{
+			name: "Reports worker classes which do not exist in the new ClusterClass",
+			to: builder.ClusterClass("ns1", "class2").
+				WithInfrastructureClusterTemplate(infrastructureClusterTemplate).
+				WithControlPlaneTemplate(controlPlaneTemplate).
+				WithControlPlaneInfrastructureMachineTemplate(infrastructureMachineTemplate).
+				WithVariables(fooVariable).
+				Build(),
+			in: TopologyCheckRebaseInput{ToClusterClass: "ns1/class2", TargetClusterNames: []string{"cluster1"}},
+			want: map[string]ClusterRebaseCheck{
+				"cluster1": {WorkerClassErrors: make([]*field.Error, 1)},
+			},
+		},
+		{
+			name: "Reports variables missing or invalid in the new ClusterClass",
+			to: newClusterClass("class2").
+				WithVariables(
+					clusterv1.ClusterClassVariable{
+						Name:     "foo",
+						Required: ptr.To(true),
+						Schema: clusterv1.VariableSchema{
+							OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "integer"},
+						},
+					},
+					clusterv1.ClusterClassVariable{
+						Name:     "bar",
+						Required: ptr.To(true),
+						Schema: clusterv1.VariableSchema{
+							OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "string"},
+						},
+					},
+				).
+				Build(),
+			in: TopologyCheckRebaseInput{ToClusterClass: "class2", TargetClusterNames: []string{"cluster1"}},
+			want: map[string]ClusterRebaseCheck{
+				"cluster1": {VariableErrors: make([]*field.Error, 2)},
+			},
+		},
+		{
+			name:    "Fails if a Cluster does not exist",
+			to:      newClusterClass("class2").Build(),
+			in:      TopologyCheckRebaseInput{ToClusterClass: "class2", TargetClusterNames: []string{"cluster1", "cluster4"}},
+			wantErr: "Clusters cluster4 not found in namespace ns1",
+		},
+		{
+			name:    "Fails if the new ClusterClass does not exist",
+			in:      TopologyCheckRebaseInput{ToClusterClass: "class2"},
+			wantErr: "failed to get ClusterClass ns1/class2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			in := tt.in
+			in.Offline = true
+			in.TargetNamespace = "ns1"
+			in.CurrentObjects = topologyPlanTestObjects(g, current...)
+			if tt.to != nil {
+				in.Objects = topologyPlanTestObjects(g, tt.to)
+			}
+
+			out, err := newTopologyClient(nil).CheckRebase(context.Background(), &in)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got := map[string]ClusterRebaseCheck{}
+			for _, result := range out.Clusters {
+				g.Expect(result.To.Name).To(Equal("class2"))
+				got[result.Cluster.Name] = *result
+			}
+			g.Expect(got).To(HaveLen(len(tt.want)))
+			for name, want := range tt.want {
+				g.Expect(got).To(HaveKey(name))
+				g.Expect(got[name].TemplateErrors).To(HaveLen(len(want.TemplateErrors)), "TemplateErrors: %v", got[name].TemplateErrors)
+				g.Expect(got[name].VariableErrors).To(HaveLen(len(want.VariableErrors)), "VariableErrors: %v", got[name].VariableErrors)
+				g.Expect(got[name].WorkerClassErrors).To(HaveLen(len(want.WorkerClassErrors)), "WorkerClassErrors: %v", got[name].WorkerClassErrors)
+			}
+		})
+	}
+
+	t.Run("fails if the new ClusterClass in the management cluster has not been reconciled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		class2 := newClusterClass("class2").Build()
+		class2.Generation = 2
+		_, err := newTopologyClient(nil).CheckRebase(context.Background(), &TopologyCheckRebaseInput{
+			Offline:         true,
+			CurrentObjects:  topologyPlanTestObjects(g, append(current, class2)...),
+			ToClusterClass:  "class2",
+			TargetNamespace: "ns1",
+		})
+		g.Expect(err).To(MatchError(ContainSubstring("has not been reconciled yet")))
+	})
+
+	t.Run("ignores input objects other than ClusterClasses", func(t *testing.T) {
+		g := NewWithT(t)
+
+		out, err := newTopologyClient(nil).CheckRebase(context.Background(), &TopologyCheckRebaseInput{
+			Objects:            append(topologyPlanTestObjects(g, newClusterClass("class2").Build()), &unstructured.Unstructured{Object: infrastructureClusterTemplate.Object}),
+			Offline:            true,
+			CurrentObjects:     topologyPlanTestObjects(g, current...),
+			ToClusterClass:     "class2",
+			TargetClusterNames: []string{"cluster1"},
+			TargetNamespace:    "ns1",
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(out.Clusters).To(HaveLen(1))
+		g.Expect(out.Clusters[0].IsCompatible()).To(BeTrue())
+	})
+}
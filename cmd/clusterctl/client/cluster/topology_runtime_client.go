@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
+	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
+)
+
+// topologyPlanHooks are the hooks computing the desired state of Cluster topologies, which are the only hooks
+// called when planning changes to Cluster topologies.
+var topologyPlanHooks = sets.New(
+	runtimecatalog.HookName(runtimehooksv1.DiscoverVariables),
+	runtimecatalog.HookName(runtimehooksv1.GeneratePatches),
+	runtimecatalog.HookName(runtimehooksv1.ValidateTopology),
+	runtimecatalog.HookName(runtimehooksv1.GenerateUpgradePlan),
+)
+
+// topologyPlanRuntimeClient is a Runtime SDK client calling only the hooks computing the desired state of
+// Cluster topologies, e.g. GeneratePatches and ValidateTopology.
+// Lifecycle hooks are never called, because they might trigger actions; instead, they are planned as if no
+// Runtime Extension was registered for them, i.e. as if they never block changes to Cluster topologies.
+type topologyPlanRuntimeClient struct {
+	runtimeclient.Client
+}
+
+// newTopologyPlanRuntimeClient returns a Runtime SDK client for the Runtime Extensions registered in the management
+// cluster; Runtime Extensions referencing a Service are called through the service proxy of the API server.
+func newTopologyPlanRuntimeClient(ctx context.Context, proxy Proxy) (runtimeclient.Client, error) {
+	c, err := proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config, err := proxy.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	extensionConfigs := &runtimev1.ExtensionConfigList{}
+	if err := c.List(ctx, extensionConfigs); err != nil && !meta.IsNoMatchError(err) {
+		return nil, pkgerrors.Wrap(err, "failed to list ExtensionConfigs")
+	}
+
+	catalog := runtimecatalog.New()
+	if err := runtimehooksv1.AddToCatalog(catalog); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create Runtime SDK catalog")
+	}
+	registry := runtimeregistry.New()
+	if err := registry.WarmUp(extensionConfigs); err != nil {
+		return nil, err
+	}
+
+	runtimeClient, _, err := internalruntimeclient.New(ctx, internalruntimeclient.Options{
+		Catalog:            catalog,
+		Registry:           registry,
+		Client:             c,
+		ServiceProxyConfig: config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &topologyPlanRuntimeClient{Client: runtimeClient}, nil
+}
+
+// GetAllExtensions gets all the ExtensionHandlers registered for a hook; no ExtensionHandlers are returned
+// for lifecycle hooks.
+func (c *topologyPlanRuntimeClient) GetAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject client.Object) ([]string, error) {
+	if !topologyPlanHooks.Has(runtimecatalog.HookName(hook)) {
+		return nil, nil
+	}
+	return c.Client.GetAllExtensions(ctx, hook, forObject)
+}
+
+// CallAllExtensions calls all the ExtensionHandlers registered for a hook; lifecycle hooks are not called.
+func (c *topologyPlanRuntimeClient) CallAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject client.Object, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject) error {
+	if !topologyPlanHooks.Has(runtimecatalog.HookName(hook)) {
+		response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+		return nil
+	}
+	return c.Client.CallAllExtensions(ctx, hook, forObject, request, response)
+}
+
+// CallExtension calls the ExtensionHandler with the given name; lifecycle hooks are not called.
+func (c *topologyPlanRuntimeClient) CallExtension(ctx context.Context, hook runtimecatalog.Hook, forObject client.Object, name string, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject, opts ...runtimeclient.CallExtensionOption) error {
+	if !topologyPlanHooks.Has(runtimecatalog.HookName(hook)) {
+		return pkgerrors.Errorf("calling the %s hook is not supported when planning changes to Cluster topologies", runtimecatalog.HookName(hook))
+	}
+	return c.Client.CallExtension(ctx, hook, forObject, name, request, response, opts...)
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func Test_topologyClient_Plan(t *testing.T) {
+	infrastructureClusterTemplate := builder.TestInfrastructureClusterTemplate("ns1", "infra-cluster-template").Build()
+	controlPlaneTemplate := builder.TestControlPlaneTemplate("ns1", "control-plane-template").Build()
+	infrastructureMachineTemplate := builder.TestInfrastructureMachineTemplate("ns1", "infra-machine-template").Build()
+	bootstrapTemplate := builder.TestBootstrapTemplate("ns1", "bootstrap-template").Build()
+	clusterClass := builder.ClusterClass("ns1", "class1").
+		WithInfrastructureClusterTemplate(infrastructureClusterTemplate).
+		WithControlPlaneTemplate(controlPlaneTemplate).
+		WithControlPlaneInfrastructureMachineTemplate(infrastructureMachineTemplate).
+		WithWorkerMachineDeploymentClasses(*builder.MachineDeploymentClass("worker").
+			WithInfrastructureTemplate(infrastructureMachineTemplate).
+			WithBootstrapTemplate(bootstrapTemplate).
+			Build()).
+		Build()
+	cluster := builder.Cluster("ns1", "cluster1").
+		WithTopology(builder.ClusterTopology().
+			WithClass(clusterClass.Name).
+			WithVersion("v1.33.0").
+			WithControlPlaneReplicas(1).
+			WithMachineDeployment(builder.MachineDeploymentTopology("md1").
+				WithClass("worker").
+				WithReplicas(1).
+				Build()).
+			Build()).
+		Build()
+
+	t.Run("plans the creation of a new Cluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		out, err := newTopologyClient(nil).Plan(context.Background(), &TopologyPlanInput{
+			Objects: topologyPlanTestObjects(g, infrastructureClusterTemplate, controlPlaneTemplate, infrastructureMachineTemplate, bootstrapTemplate, clusterClass, cluster),
+			Offline: true,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(out.Clusters).To(HaveLen(1))
+
+		plan := out.Clusters[0]
+		g.Expect(plan.Cluster).To(Equal(client.ObjectKeyFromObject(cluster)))
+		g.Expect(topologyPlanKinds(plan.Created)).To(ConsistOf(
+			builder.TestInfrastructureClusterKind,
+			builder.TestControlPlaneKind,
+			builder.TestInfrastructureMachineTemplateKind, // control plane
+			builder.TestInfrastructureMachineTemplateKind, // md1
+			builder.TestBootstrapConfigTemplateKind,
+			"MachineDeployment",
+		))
+		g.Expect(plan.Modified).To(HaveLen(1))
+		g.Expect(plan.Modified[0].After.GetKind()).To(Equal(clusterv1.ClusterKind))
+		g.Expect(plan.Deleted).To(BeEmpty())
+		g.Expect(plan.MachineDeploymentRollouts).To(BeEmpty())
+	})
+
+	t.Run("plans a MachineDeployment rollout when a template changes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		// Compute the objects existing after the Cluster has been created.
+		out, err := newTopologyClient(nil).Plan(context.Background(), &TopologyPlanInput{
+			Objects: topologyPlanTestObjects(g, infrastructureClusterTemplate, controlPlaneTemplate, infrastructureMachineTemplate, bootstrapTemplate, clusterClass, cluster),
+			Offline: true,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		current := topologyPlanTestObjects(g, infrastructureClusterTemplate, controlPlaneTemplate, infrastructureMachineTemplate, bootstrapTemplate, clusterClass)
+		current = append(current, out.Clusters[0].Created...)
+		current = append(current, out.Clusters[0].Modified[0].After)
+
+		changedTemplate := builder.TestInfrastructureMachineTemplate("ns1", "infra-machine-template").
+			WithSpecFields(map[string]interface{}{"spec.template.spec.fakeSetting": true}).
+			Build()
+		out, err = newTopologyClient(nil).Plan(context.Background(), &TopologyPlanInput{
+			Objects:        topologyPlanTestObjects(g, changedTemplate),
+			Offline:        true,
+			CurrentObjects: current,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(out.Clusters).To(HaveLen(1))
+
+		plan := out.Clusters[0]
+		g.Expect(topologyPlanKinds(plan.Created)).To(ConsistOf(
+			builder.TestInfrastructureMachineTemplateKind, // control plane
+			builder.TestInfrastructureMachineTemplateKind, // md1
+		))
+		g.Expect(topologyPlanKinds(plan.Deleted)).To(ConsistOf(
+			builder.TestInfrastructureMachineTemplateKind, // control plane
+			builder.TestInfrastructureMachineTemplateKind, // md1
+		))
+		modifiedKinds := []string{}
+		for _, m := range plan.Modified {
+			modifiedKinds = append(modifiedKinds, m.After.GetKind())
+		}
+		g.Expect(modifiedKinds).To(ConsistOf(builder.TestControlPlaneKind, "MachineDeployment"))
+		g.Expect(plan.MachineDeploymentRollouts).To(HaveLen(1))
+		g.Expect(plan.MachineDeploymentRollouts[0].Reasons).ToNot(BeEmpty())
+	})
+
+	t.Run("plans the creation of auxiliary resources", func(t *testing.T) {
+		g := NewWithT(t)
+
+		clusterClassWithAuxiliaryResources := clusterClass.DeepCopy()
+		clusterClassWithAuxiliaryResources.Spec.AuxiliaryResources = []clusterv1.AuxiliaryResourceClass{
+			{
+				Name: "drain-rule",
+				Template: clusterv1.AuxiliaryResourceClassTemplate{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "MachineDrainRule",
+					Spec:       &apiextensionsv1.JSON{Raw: []byte(`{"drain":{"behavior":"Skip"},"pods":[{"selector":{}}]}`)},
+				},
+			},
+		}
+
+		out, err := newTopologyClient(nil).Plan(context.Background(), &TopologyPlanInput{
+			Objects: topologyPlanTestObjects(g, infrastructureClusterTemplate, controlPlaneTemplate, infrastructureMachineTemplate, bootstrapTemplate, clusterClassWithAuxiliaryResources, cluster),
+			Offline: true,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(out.Clusters).To(HaveLen(1))
+
+		var drainRule *unstructured.Unstructured
+		for _, obj := range out.Clusters[0].Created {
+			if obj.GetKind() == "MachineDrainRule" {
+				drainRule = obj
+			}
+		}
+		g.Expect(drainRule).ToNot(BeNil())
+		g.Expect(drainRule.GetName()).To(Equal("cluster1-drain-rule"))
+		g.Expect(drainRule.GetNamespace()).To(Equal("ns1"))
+		g.Expect(drainRule.GetLabels()).To(HaveKeyWithValue(clusterv1.ClusterTopologyAuxiliaryResourceNameLabel, "drain-rule"))
+	})
+
+	t.Run("plans changes computed by external patches", func(t *testing.T) {
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+		g := NewWithT(t)
+
+		srv, calledHooks := topologyPlanTestExtensionServer()
+		defer srv.Close()
+
+		extensionConfig := &runtimev1.ExtensionConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-extension",
+			},
+			Spec: runtimev1.ExtensionConfigSpec{
+				ClientConfig: runtimev1.ClientConfig{
+					URL:      srv.URL,
+					CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}),
+				},
+				NamespaceSelector: &metav1.LabelSelector{},
+			},
+			Status: runtimev1.ExtensionConfigStatus{
+				Handlers: []runtimev1.ExtensionHandler{
+					topologyPlanTestExtensionHandler("discover-variables.test-extension", "DiscoverVariables"),
+					topologyPlanTestExtensionHandler("generate-patches.test-extension", "GeneratePatches"),
+					topologyPlanTestExtensionHandler("validate-topology.test-extension", "ValidateTopology"),
+				},
+			},
+		}
+
+		clusterClassWithExternalPatches := clusterClass.DeepCopy()
+		clusterClassWithExternalPatches.Spec.Patches = []clusterv1.ClusterClassPatch{
+			{
+				Name: "external",
+				External: &clusterv1.ExternalPatchDefinition{
+					DiscoverVariablesExtension: "discover-variables.test-extension",
+					GeneratePatchesExtension:   "generate-patches.test-extension",
+					ValidateTopologyExtension:  "validate-topology.test-extension",
+				},
+			},
+		}
+
+		proxy := test.NewFakeProxy().WithNamespace("ns1").WithObjs(extensionConfig)
+		out, err := newTopologyClient(proxy).Plan(context.Background(), &TopologyPlanInput{
+			Objects: topologyPlanTestObjects(g, infrastructureClusterTemplate, controlPlaneTemplate, infrastructureMachineTemplate, bootstrapTemplate, clusterClassWithExternalPatches, cluster),
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(out.Clusters).To(HaveLen(1))
+		g.Expect(calledHooks()).To(ConsistOf("discovervariables", "generatepatches", "validatetopology"))
+
+		var infrastructureCluster *unstructured.Unstructured
+		for _, obj := range out.Clusters[0].Created {
+			if obj.GetKind() == builder.TestInfrastructureClusterKind {
+				infrastructureCluster = obj
+			}
+		}
+		g.Expect(infrastructureCluster).ToNot(BeNil())
+		fakeSetting, _, err := unstructured.NestedBool(infrastructureCluster.Object, "spec", "fakeSetting")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(fakeSetting).To(BeTrue())
+	})
+
+	t.Run("fails if ClusterClasses have external patches and the plan is computed offline", func(t *testing.T) {
+		g := NewWithT(t)
+
+		clusterClassWithExternalPatches := clusterClass.DeepCopy()
+		clusterClassWithExternalPatches.Spec.Patches = []clusterv1.ClusterClassPatch{
+			{
+				Name:     "external",
+				External: &clusterv1.ExternalPatchDefinition{GeneratePatchesExtension: "generate-patches.test-extension"},
+			},
+		}
+
+		_, err := newTopologyClient(nil).Plan(context.Background(), &TopologyPlanInput{
+			Objects: topologyPlanTestObjects(g, infrastructureClusterTemplate, controlPlaneTemplate, infrastructureMachineTemplate, bootstrapTemplate, clusterClassWithExternalPatches, cluster),
+			Offline: true,
+		})
+		g.Expect(err).To(MatchError(ContainSubstring("has external patches")))
+	})
+
+	t.Run("fails if the target Cluster is not affected by the input objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := newTopologyClient(nil).Plan(context.Background(), &TopologyPlanInput{
+			Objects:           topologyPlanTestObjects(g, infrastructureClusterTemplate, controlPlaneTemplate, infrastructureMachineTemplate, bootstrapTemplate, clusterClass, cluster),
+			Offline:           true,
+			TargetClusterName: "cluster2",
+		})
+		g.Expect(err).To(MatchError(ContainSubstring("is not affected by the input objects")))
+	})
+
+	t.Run("fails if the input objects use an older API version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj := topologyPlanTestObjects(g, cluster)[0]
+		obj.SetAPIVersion(clusterv1.GroupVersion.Group + "/v1beta1")
+		_, err := newTopologyClient(nil).Plan(context.Background(), &TopologyPlanInput{
+			Objects: []*unstructured.Unstructured{obj},
+			Offline: true,
+		})
+		g.Expect(err).To(MatchError(ContainSubstring("must be converted to")))
+	})
+}
+
+func topologyPlanTestObjects(g *WithT, objs ...client.Object) []*unstructured.Unstructured {
+	ret := []*unstructured.Unstructured{}
+	for _, obj := range objs {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			ret = append(ret, u.DeepCopy())
+			continue
+		}
+		gvks, _, err := localScheme.ObjectKinds(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		u := &unstructured.Unstructured{Object: m}
+		u.SetGroupVersionKind(gvks[0])
+		ret = append(ret, u)
+	}
+	return ret
+}
+
+func topologyPlanKinds(objs []*unstructured.Unstructured) []string {
+	kinds := []string{}
+	for _, obj := range objs {
+		kinds = append(kinds, obj.GetKind())
+	}
+	return kinds
+}
+
+// topologyPlanTestExtensionServer returns a Runtime Extension server setting spec.template.spec.fakeSetting in
+// TestInfrastructureClusterTemplates, and a func returning the hooks called so far.
+func topologyPlanTestExtensionServer() (*httptest.Server, func() []string) {
+	lock := sync.Mutex{}
+	calledHooks := []string{}
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Note: The path of a request is /<group>/<version>/<hook>/<handler>.
+		hook := path.Base(path.Dir(r.URL.Path))
+		lock.Lock()
+		calledHooks = append(calledHooks, hook)
+		lock.Unlock()
+
+		var response runtimehooksv1.ResponseObject
+		switch hook {
+		case "generatepatches":
+			request := &runtimehooksv1.GeneratePatchesRequest{}
+			if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			generatePatchesResponse := &runtimehooksv1.GeneratePatchesResponse{}
+			for _, item := range request.Items {
+				obj := &unstructured.Unstructured{}
+				if err := obj.UnmarshalJSON(item.Object.Raw); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				if obj.GetKind() != builder.TestInfrastructureClusterTemplateKind {
+					continue
+				}
+				generatePatchesResponse.Items = append(generatePatchesResponse.Items, runtimehooksv1.GeneratePatchesResponseItem{
+					UID:       item.UID,
+					PatchType: runtimehooksv1.JSONPatchType,
+					Patch:     []byte(`[{"op":"add","path":"/spec/template/spec/fakeSetting","value":true}]`),
+				})
+			}
+			response = generatePatchesResponse
+		case "validatetopology":
+			response = &runtimehooksv1.ValidateTopologyResponse{}
+		case "discovervariables":
+			response = &runtimehooksv1.DiscoverVariablesResponse{}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}))
+
+	return srv, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string{}, calledHooks...)
+	}
+}
+
+func topologyPlanTestExtensionHandler(name, hook string) runtimev1.ExtensionHandler {
+	return runtimev1.ExtensionHandler{
+		Name: name,
+		RequestHook: runtimev1.GroupVersionHook{
+			APIVersion: runtimehooksv1.GroupVersion.String(),
+			Hook:       hook,
+		},
+		TimeoutSeconds: 10,
+		FailurePolicy:  runtimev1.FailurePolicyFail,
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 
 	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 )
@@ -39,6 +40,14 @@ type MoveOptions struct {
 	// namespace will be used.
 	Namespace string
 
+	// Clusters are the names of the Clusters to be moved, together with their dependent objects.
+	// If both Clusters and ClusterSelector are unspecified, all the Clusters in Namespace are moved.
+	Clusters []string
+
+	// ClusterSelector is a label selector for the Clusters to be moved, together with their dependent objects.
+	// If both Clusters and ClusterSelector are unspecified, all the Clusters in Namespace are moved.
+	ClusterSelector string
+
 	// ExperimentalResourceMutatorFn accepts any number of resource mutator functions that are applied on all resources being moved.
 	// This is an experimental feature and is exposed only from the library and not (yet) through the CLI.
 	ExperimentalResourceMutators []cluster.ResourceMutatorFunc
@@ -51,6 +60,17 @@ type MoveOptions struct {
 
 	// DryRun means the move action is a dry run, no real action will be performed.
 	DryRun bool
+
+	// CheckpointFile is the path of a file where the progress of the move is recorded, so an interrupted move
+	// can be resumed or rolled back. The file is deleted once the move is completed.
+	CheckpointFile string
+
+	// Resume resumes an interrupted move from CheckpointFile.
+	Resume bool
+
+	// Rollback reverts an interrupted move using CheckpointFile, by deleting the objects already created in the
+	// target management cluster and by resuming the Clusters in the source management cluster.
+	Rollback bool
 }
 
 func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error {
@@ -66,6 +86,26 @@ func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error
 		return pkgerrors.Errorf("at least one of FromDirectory, ToDirectory and ToKubeconfig must be set")
 	}
 
+	if options.Resume && options.Rollback {
+		return pkgerrors.Errorf("can't set both Resume and Rollback")
+	}
+
+	if (options.Resume || options.Rollback) && options.CheckpointFile == "" {
+		return pkgerrors.Errorf("CheckpointFile must be set when using Resume or Rollback")
+	}
+
+	// Clusters can be selected only when moving objects between management clusters; when resuming or rolling back,
+	// the selection is read from the checkpoint file.
+	if (len(options.Clusters) > 0 || options.ClusterSelector != "") &&
+		(options.FromDirectory != "" || options.ToDirectory != "" || options.Resume || options.Rollback) {
+		return pkgerrors.Errorf("Clusters and ClusterSelector can't be used with FromDirectory, ToDirectory, Resume or Rollback")
+	}
+
+	// A checkpoint can be used only when moving objects between management clusters.
+	if options.CheckpointFile != "" && (options.DryRun || options.FromDirectory != "" || options.ToDirectory != "") {
+		return pkgerrors.Errorf("CheckpointFile can't be used with DryRun, FromDirectory or ToDirectory")
+	}
+
 	if options.ToDirectory != "" {
 		return c.toDirectory(ctx, options)
 	} else if options.FromDirectory != "" {
@@ -91,6 +131,15 @@ func (c *clusterctlClient) move(ctx context.Context, options MoveOptions) error
 		options.Namespace = currentNamespace
 	}
 
+	selection := cluster.ClusterSelection{
+		Names: options.Clusters,
+	}
+	if options.ClusterSelector != "" {
+		if selection.LabelSelector, err = labels.Parse(options.ClusterSelector); err != nil {
+			return pkgerrors.Wrapf(err, "invalid ClusterSelector %q", options.ClusterSelector)
+		}
+	}
+
 	var toCluster cluster.Client
 	if !options.DryRun {
 		// Get the client for interacting with the target management cluster.
@@ -99,7 +148,15 @@ func (c *clusterctlClient) move(ctx context.Context, options MoveOptions) error
 		}
 	}
 
-	return fromCluster.ObjectMover().Move(ctx, options.Namespace, toCluster, options.DryRun, options.ExperimentalResourceMutators...)
+	switch {
+	case options.Resume:
+		return fromCluster.ObjectMover().ResumeMove(ctx, toCluster, options.CheckpointFile, options.ExperimentalResourceMutators...)
+	case options.Rollback:
+		return fromCluster.ObjectMover().RollbackMove(ctx, toCluster, options.CheckpointFile)
+	case options.CheckpointFile != "":
+		return fromCluster.ObjectMover().MoveWithCheckpoint(ctx, options.Namespace, selection, toCluster, options.CheckpointFile, options.ExperimentalResourceMutators...)
+	}
+	return fromCluster.ObjectMover().MoveSelected(ctx, options.Namespace, selection, toCluster, options.DryRun, options.ExperimentalResourceMutators...)
 }
 
 func (c *clusterctlClient) fromDirectory(ctx context.Context, options MoveOptions) error {
@@ -122,6 +122,108 @@ func Test_clusterctlClient_Move(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "does not return an error if resuming from a checkpoint file",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					CheckpointFile: "/var/cache/checkpoint.yaml",
+					Resume:         true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "does not return an error if moving selected Clusters",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig:  Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:    Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					Clusters:        []string{"foo"},
+					ClusterSelector: "env=prod",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "returns an error if ClusterSelector is invalid",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig:  Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:    Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					ClusterSelector: "env in (prod",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if Clusters are set with ToDirectory",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToDirectory:    "/var/cache/toDirectory",
+					Clusters:       []string{"foo"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if both Resume and Rollback are set",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					CheckpointFile: "/var/cache/checkpoint.yaml",
+					Resume:         true,
+					Rollback:       true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if Rollback is set without CheckpointFile",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					Rollback:       true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if CheckpointFile is set with DryRun",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					CheckpointFile: "/var/cache/checkpoint.yaml",
+					DryRun:         true,
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -301,6 +403,22 @@ func (f *fakeObjectMover) Move(_ context.Context, _ string, _ cluster.Client, _
 	return f.moveErr
 }
 
+func (f *fakeObjectMover) MoveSelected(_ context.Context, _ string, _ cluster.ClusterSelection, _ cluster.Client, _ bool, _ ...cluster.ResourceMutatorFunc) error {
+	return f.moveErr
+}
+
+func (f *fakeObjectMover) MoveWithCheckpoint(_ context.Context, _ string, _ cluster.ClusterSelection, _ cluster.Client, _ string, _ ...cluster.ResourceMutatorFunc) error {
+	return f.moveErr
+}
+
+func (f *fakeObjectMover) ResumeMove(_ context.Context, _ cluster.Client, _ string, _ ...cluster.ResourceMutatorFunc) error {
+	return f.moveErr
+}
+
+func (f *fakeObjectMover) RollbackMove(_ context.Context, _ cluster.Client, _ string) error {
+	return f.moveErr
+}
+
 func (f *fakeObjectMover) ToDirectory(_ context.Context, _ string, _ string) error {
 	return f.toDirectoryErr
 }
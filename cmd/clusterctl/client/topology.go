@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+// TopologyPlanOptions define options for TopologyPlan.
+type TopologyPlanOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Objects are the new or modified Clusters, ClusterClasses and templates to plan changes for.
+	Objects []*unstructured.Unstructured
+
+	// Offline, when true, computes the plan without accessing the management cluster; in this case the
+	// objects existing in the management cluster are read from CurrentObjects.
+	Offline bool
+
+	// CurrentObjects are the objects existing in the management cluster, used when Offline is true.
+	CurrentObjects []*unstructured.Unstructured
+
+	// Cluster is the name of the Cluster to plan changes for. If empty, changes are planned for all the
+	// Clusters affected by the input objects.
+	Cluster string
+
+	// Namespace is the namespace used for the input objects without a namespace. If unspecified, the current
+	// namespace will be used, or the default namespace when Offline is true.
+	Namespace string
+}
+
+// TopologyPlanOutput defines the output of TopologyPlan.
+type TopologyPlanOutput = cluster.TopologyPlanOutput
+
+func (c *clusterctlClient) TopologyPlan(ctx context.Context, options TopologyPlanOptions) (*TopologyPlanOutput, error) {
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure this command only runs against management clusters with the current Cluster API contract.
+	if !options.Offline {
+		if err := clusterClient.ProviderInventory().CheckCAPIContract(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return clusterClient.Topology().Plan(ctx, &cluster.TopologyPlanInput{
+		Objects:           options.Objects,
+		Offline:           options.Offline,
+		CurrentObjects:    options.CurrentObjects,
+		TargetClusterName: options.Cluster,
+		TargetNamespace:   options.Namespace,
+	})
+}
+
+// TopologyCheckRebaseOptions define options for TopologyCheckRebase.
+type TopologyCheckRebaseOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Objects are new or modified ClusterClasses, e.g. the ClusterClass to rebase onto when it does not exist yet
+	// in the management cluster.
+	Objects []*unstructured.Unstructured
+
+	// Offline, when true, checks the Clusters without accessing the management cluster; in this case the
+	// objects existing in the management cluster are read from CurrentObjects.
+	Offline bool
+
+	// CurrentObjects are the objects existing in the management cluster, used when Offline is true.
+	CurrentObjects []*unstructured.Unstructured
+
+	// From restricts the check to the Clusters using this ClusterClass, in the form [namespace/]name.
+	From string
+
+	// To is the ClusterClass to rebase the Clusters onto, in the form [namespace/]name.
+	To string
+
+	// Clusters are the names of the Clusters to check. If empty, all the Clusters in the namespace are checked.
+	Clusters []string
+
+	// Namespace is the namespace of the Clusters to check. If unspecified, the current namespace will be used,
+	// or the default namespace when Offline is true.
+	Namespace string
+}
+
+// TopologyCheckRebaseOutput defines the output of TopologyCheckRebase.
+type TopologyCheckRebaseOutput = cluster.TopologyCheckRebaseOutput
+
+func (c *clusterctlClient) TopologyCheckRebase(ctx context.Context, options TopologyCheckRebaseOptions) (*TopologyCheckRebaseOutput, error) {
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure this command only runs against management clusters with the current Cluster API contract.
+	if !options.Offline {
+		if err := clusterClient.ProviderInventory().CheckCAPIContract(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return clusterClient.Topology().CheckRebase(ctx, &cluster.TopologyCheckRebaseInput{
+		Objects:            options.Objects,
+		Offline:            options.Offline,
+		CurrentObjects:     options.CurrentObjects,
+		FromClusterClass:   options.From,
+		ToClusterClass:     options.To,
+		TargetClusterNames: options.Clusters,
+		TargetNamespace:    options.Namespace,
+	})
+}
@@ -38,10 +38,15 @@ type moveOptions struct {
 	toKubeconfig          string
 	toKubeconfigContext   string
 	namespace             string
+	clusters              []string
+	clusterSelector       string
 	fromDirectory         string
 	toDirectory           string
 	dryRun                bool
 	hideAPIWarnings       string
+	checkpointFile        string
+	resume                bool
+	rollback              bool
 }
 
 var mo = &moveOptions{}
@@ -59,11 +64,26 @@ var moveCmd = &cobra.Command{
 		Move Cluster API objects and all dependencies between management clusters.
 		clusterctl move --to-kubeconfig=target-kubeconfig.yaml
 
+		Move only the Clusters named cluster1 and cluster2, together with their dependencies, between management clusters.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --cluster cluster1,cluster2
+
+		Move only the Clusters with the label env=prod, together with their dependencies, between management clusters.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --selector env=prod
+
 		Write Cluster API objects and all dependencies from a management cluster to directory.
 		clusterctl move --to-directory /tmp/backup-directory
 
 		Read Cluster API objects and all dependencies from a directory into a management cluster.
 		clusterctl move --from-directory /tmp/backup-directory
+
+		Move Cluster API objects recording progress in a checkpoint file, so an interrupted move can be resumed or rolled back.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --checkpoint-file move-checkpoint.yaml
+
+		Resume an interrupted move.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --checkpoint-file move-checkpoint.yaml --resume
+
+		Roll back an interrupted move.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --checkpoint-file move-checkpoint.yaml --rollback
 	`),
 	Args: helpOnErrorArgs(cobra.NoArgs),
 	RunE: func(*cobra.Command, []string) error {
@@ -82,6 +102,10 @@ func init() {
 		"Context to be used within the kubeconfig file for the destination management cluster. If empty, current context will be used.")
 	moveCmd.Flags().StringVarP(&mo.namespace, "namespace", "n", "",
 		"The namespace where the workload cluster is hosted. If unspecified, the current context's namespace is used.")
+	moveCmd.Flags().StringSliceVar(&mo.clusters, "cluster", nil,
+		"The names of the Clusters to move, together with their dependencies. If unspecified, all the Clusters in the namespace are moved.")
+	moveCmd.Flags().StringVarP(&mo.clusterSelector, "selector", "l", "",
+		"Label selector for the Clusters to move, together with their dependencies. If unspecified, all the Clusters in the namespace are moved.")
 	moveCmd.Flags().BoolVar(&mo.dryRun, "dry-run", false,
 		"Enable dry run, don't really perform the move actions")
 	moveCmd.Flags().StringVar(&mo.toDirectory, "to-directory", "",
@@ -90,10 +114,24 @@ func init() {
 		"Read Cluster API objects and all dependencies from a directory into a management cluster.")
 	moveCmd.Flags().StringVar(&mo.hideAPIWarnings, "hide-api-warnings", "default",
 		"Set of API server warnings to hide. Valid sets are \"default\" (includes metadata.finalizer warnings), \"all\" , and \"none\".")
+	moveCmd.Flags().StringVar(&mo.checkpointFile, "checkpoint-file", "",
+		"Path to a file where the progress of the move is recorded, so an interrupted move can be resumed or rolled back. The file is deleted once the move completes.")
+	moveCmd.Flags().BoolVar(&mo.resume, "resume", false,
+		"Resume an interrupted move from the file specified with --checkpoint-file.")
+	moveCmd.Flags().BoolVar(&mo.rollback, "rollback", false,
+		"Roll back an interrupted move using the file specified with --checkpoint-file. Not supported once the move started deleting objects from the source management cluster.")
 
 	moveCmd.MarkFlagsMutuallyExclusive("to-directory", "to-kubeconfig")
 	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "to-directory")
 	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "kubeconfig")
+	moveCmd.MarkFlagsMutuallyExclusive("resume", "rollback")
+	for _, flag := range []string{"to-directory", "from-directory", "resume", "rollback"} {
+		moveCmd.MarkFlagsMutuallyExclusive("cluster", flag)
+		moveCmd.MarkFlagsMutuallyExclusive("selector", flag)
+	}
+	moveCmd.MarkFlagsMutuallyExclusive("checkpoint-file", "dry-run")
+	moveCmd.MarkFlagsMutuallyExclusive("checkpoint-file", "to-directory")
+	moveCmd.MarkFlagsMutuallyExclusive("checkpoint-file", "from-directory")
 
 	RootCmd.AddCommand(moveCmd)
 }
@@ -108,6 +146,10 @@ func runMove() error {
 		return pkgerrors.New("please specify a target cluster using the --to-kubeconfig flag when not using --dry-run, --to-directory or --from-directory")
 	}
 
+	if (mo.resume || mo.rollback) && mo.checkpointFile == "" {
+		return pkgerrors.New("please specify the checkpoint file of the interrupted move using the --checkpoint-file flag when using --resume or --rollback")
+	}
+
 	configClient, err := config.New(ctx, cfgFile)
 	if err != nil {
 		return err
@@ -162,11 +204,16 @@ func runMove() error {
 	}
 
 	return c.Move(ctx, client.MoveOptions{
-		FromKubeconfig: client.Kubeconfig{Path: mo.fromKubeconfig, Context: mo.fromKubeconfigContext},
-		ToKubeconfig:   client.Kubeconfig{Path: mo.toKubeconfig, Context: mo.toKubeconfigContext},
-		FromDirectory:  mo.fromDirectory,
-		ToDirectory:    mo.toDirectory,
-		Namespace:      mo.namespace,
-		DryRun:         mo.dryRun,
+		FromKubeconfig:  client.Kubeconfig{Path: mo.fromKubeconfig, Context: mo.fromKubeconfigContext},
+		ToKubeconfig:    client.Kubeconfig{Path: mo.toKubeconfig, Context: mo.toKubeconfigContext},
+		FromDirectory:   mo.fromDirectory,
+		ToDirectory:     mo.toDirectory,
+		Namespace:       mo.namespace,
+		Clusters:        mo.clusters,
+		ClusterSelector: mo.clusterSelector,
+		DryRun:          mo.dryRun,
+		CheckpointFile:  mo.checkpointFile,
+		Resume:          mo.resume,
+		Rollback:        mo.rollback,
 	})
 }
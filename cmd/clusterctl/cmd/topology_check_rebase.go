@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type topologyCheckRebaseOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	files             []string
+	currentFiles      []string
+	from              string
+	to                string
+	clusters          []string
+	namespace         string
+}
+
+var tcr = &topologyCheckRebaseOptions{}
+
+var topologyCheckRebaseCmd = &cobra.Command{
+	Use:   "check-rebase",
+	Short: "Check whether clusters that use managed topologies can be rebased onto another ClusterClass",
+	Long: templates.LongDesc(`
+		Check whether Clusters can be rebased onto another ClusterClass, i.e. whether
+		Cluster.spec.topology.classRef can be changed to the given ClusterClass.
+
+		For each Cluster, the command runs the same checks the Cluster webhook runs on a rebase and reports
+		all the problems found: incompatible changes to the referenced templates, variables missing or invalid
+		under the variable definitions of the new ClusterClass, and MachineDeployment and MachinePool classes
+		used by the Cluster that do not exist in the new ClusterClass.
+
+		The command exits with an error if at least one Cluster cannot be rebased.`),
+
+	Example: templates.Examples(`
+		# Check whether all the Clusters using ClusterClass quick-start in the current namespace can be rebased
+		# onto ClusterClass quick-start-v2.
+		clusterctl alpha topology check-rebase --from quick-start --to quick-start-v2
+
+		# Check whether two Clusters can be rebased onto a ClusterClass which does not exist yet in the management cluster.
+		clusterctl alpha topology check-rebase --to quick-start-v2 -f quick-start-v2.yaml -c cluster1 -c cluster2
+
+		# Check offline, reading the current state from a file, e.g. exported
+		# with kubectl get clusters,clusterclasses -o yaml.
+		clusterctl alpha topology check-rebase --to quick-start-v2 --current-file current.yaml`),
+
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runTopologyCheckRebase()
+	},
+}
+
+func init() {
+	topologyCheckRebaseCmd.Flags().StringVar(&tcr.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig for the management cluster. If unspecified, default discovery rules apply.")
+	topologyCheckRebaseCmd.Flags().StringVar(&tcr.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	topologyCheckRebaseCmd.Flags().StringArrayVarP(&tcr.files, "file", "f", nil,
+		"Path to a file containing new or modified ClusterClasses; other objects are ignored. The flag can be repeated.")
+	topologyCheckRebaseCmd.Flags().StringArrayVar(&tcr.currentFiles, "current-file", nil,
+		"Path to a file containing the objects existing in the management cluster. If set, the management cluster is not accessed. The flag can be repeated.")
+	topologyCheckRebaseCmd.Flags().StringVar(&tcr.from, "from", "",
+		"The ClusterClass currently used by the Clusters to check, in the form [namespace/]name. If unspecified, Clusters using any ClusterClass are checked.")
+	topologyCheckRebaseCmd.Flags().StringVar(&tcr.to, "to", "",
+		"The ClusterClass to rebase the Clusters onto, in the form [namespace/]name. If the namespace is omitted, the namespace of the Cluster is used.")
+	topologyCheckRebaseCmd.Flags().StringArrayVarP(&tcr.clusters, "cluster", "c", nil,
+		"Name of a Cluster to check. If unspecified, all the Clusters in the namespace are checked. The flag can be repeated.")
+	topologyCheckRebaseCmd.Flags().StringVarP(&tcr.namespace, "namespace", "n", "",
+		"The namespace of the Clusters to check. If unspecified, the current namespace will be used.")
+
+	if err := topologyCheckRebaseCmd.MarkFlagRequired("to"); err != nil {
+		panic(err)
+	}
+}
+
+func runTopologyCheckRebase() error {
+	ctx := context.Background()
+
+	objs, err := readTopologyPlanFiles(tcr.files)
+	if err != nil {
+		return err
+	}
+	currentObjs, err := readTopologyPlanFiles(tcr.currentFiles)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.TopologyCheckRebase(ctx, client.TopologyCheckRebaseOptions{
+		Kubeconfig:     client.Kubeconfig{Path: tcr.kubeconfig, Context: tcr.kubeconfigContext},
+		Objects:        objs,
+		Offline:        len(tcr.currentFiles) > 0,
+		CurrentObjects: currentObjs,
+		From:           tcr.from,
+		To:             tcr.to,
+		Clusters:       tcr.clusters,
+		Namespace:      tcr.namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	if incompatible := printTopologyCheckRebase(os.Stdout, out); incompatible > 0 {
+		return pkgerrors.Errorf("%d of %d Clusters cannot be rebased", incompatible, len(out.Clusters))
+	}
+	return nil
+}
+
+// printTopologyCheckRebase prints the result of the check for each Cluster, and returns the number of Clusters
+// which cannot be rebased.
+func printTopologyCheckRebase(w io.Writer, out *client.TopologyCheckRebaseOutput) int {
+	if len(out.Clusters) == 0 {
+		fmt.Fprintln(w, "No Clusters to check.")
+		return 0
+	}
+
+	incompatible := 0
+	for _, result := range out.Clusters {
+		if result.IsCompatible() {
+			fmt.Fprintf(w, "Cluster %s can be rebased from ClusterClass %s to %s.\n", result.Cluster, result.From, result.To)
+			continue
+		}
+		incompatible++
+		fmt.Fprintf(w, "Cluster %s cannot be rebased from ClusterClass %s to %s:\n", result.Cluster, result.From, result.To)
+		printTopologyCheckRebaseErrors(w, "Incompatible templates", result.TemplateErrors)
+		printTopologyCheckRebaseErrors(w, "Invalid variables", result.VariableErrors)
+		printTopologyCheckRebaseErrors(w, "Missing worker classes", result.WorkerClassErrors)
+	}
+	return incompatible
+}
+
+func printTopologyCheckRebaseErrors(w io.Writer, title string, errs field.ErrorList) {
+	if len(errs) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "  %s:\n", title)
+	for _, err := range errs {
+		fmt.Fprintf(w, "    %s\n", err.Error())
+	}
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+	"sigs.k8s.io/cluster-api/feature"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+type topologyPlanOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	files             []string
+	currentFiles      []string
+	cluster           string
+	namespace         string
+	outDir            string
+}
+
+var tp = &topologyPlanOptions{}
+
+var topologyPlanCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "List the changes to clusters that use managed topologies for a given input",
+	Long: templates.LongDesc(`
+		Provide a list of the changes the topology controller would make to the Clusters affected by
+		new or modified Clusters, ClusterClasses and templates, without applying them.
+
+		The current state of the affected Clusters is read from the management cluster or, when
+		--current-file is set, from local files, without accessing any management cluster.
+
+		For each affected Cluster, the command lists the objects that would be created, modified or
+		deleted and the MachineDeployments that would roll out their Machines, followed by a diff
+		of each modified object.
+
+		External patches are computed by calling the Runtime Extensions registered in the management
+		cluster, through the API server for Runtime Extensions referencing a Service. Only the
+		DiscoverVariables, GeneratePatches, ValidateTopology and GenerateUpgradePlan hooks are called;
+		lifecycle hooks are never called and are planned as if they do not block any change.
+		ClusterClasses with external patches are not supported when --current-file is set.`),
+
+	Example: templates.Examples(`
+		# List the changes to the Clusters using a modified ClusterClass in the management cluster.
+		clusterctl alpha topology plan -f modified-clusterclass.yaml
+
+		# List the changes to a Cluster when upgrading it, writing created, modified and deleted objects
+		# into the output directory.
+		clusterctl alpha topology plan -f modified-cluster.yaml -o output/
+
+		# List the changes offline, reading the current state from a file, e.g. exported
+		# with kubectl get clusters,machinedeployments,... -o yaml.
+		clusterctl alpha topology plan -f modified-clusterclass.yaml -f new-template.yaml --current-file current.yaml`),
+
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runTopologyPlan()
+	},
+}
+
+func init() {
+	topologyPlanCmd.Flags().StringVar(&tp.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig for the management cluster. If unspecified, default discovery rules apply.")
+	topologyPlanCmd.Flags().StringVar(&tp.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	topologyPlanCmd.Flags().StringArrayVarP(&tp.files, "file", "f", nil,
+		"Path to a file containing new or modified Clusters, ClusterClasses and templates. The flag can be repeated.")
+	topologyPlanCmd.Flags().StringArrayVar(&tp.currentFiles, "current-file", nil,
+		"Path to a file containing the objects existing in the management cluster. If set, the management cluster is not accessed. The flag can be repeated.")
+	topologyPlanCmd.Flags().StringVarP(&tp.cluster, "cluster", "c", "",
+		"Name of the Cluster to plan changes for. If unspecified, changes are planned for all the affected Clusters.")
+	topologyPlanCmd.Flags().StringVarP(&tp.namespace, "namespace", "n", "",
+		"The namespace used for input objects without a namespace. If unspecified, the current namespace will be used.")
+	topologyPlanCmd.Flags().StringVarP(&tp.outDir, "output-directory", "o", "",
+		"Directory where created, modified and deleted objects are written. If unspecified, diffs are written to stdout.")
+
+	if err := topologyPlanCmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+}
+
+func runTopologyPlan() error {
+	ctx := context.Background()
+
+	objs, err := readTopologyPlanFiles(tp.files)
+	if err != nil {
+		return err
+	}
+	currentObjs, err := readTopologyPlanFiles(tp.currentFiles)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	// External patches are computed by calling the Runtime Extensions registered in the management cluster,
+	// which requires the RuntimeSDK feature gate; offline, Runtime Extensions cannot be called.
+	offline := len(tp.currentFiles) > 0
+	if !offline {
+		if err := feature.MutableGates.Set(fmt.Sprintf("%s=true", feature.RuntimeSDK)); err != nil {
+			return err
+		}
+	}
+
+	out, err := c.TopologyPlan(ctx, client.TopologyPlanOptions{
+		Kubeconfig:     client.Kubeconfig{Path: tp.kubeconfig, Context: tp.kubeconfigContext},
+		Objects:        objs,
+		Offline:        offline,
+		CurrentObjects: currentObjs,
+		Cluster:        tp.cluster,
+		Namespace:      tp.namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := printTopologyPlan(os.Stdout, out, tp.outDir == ""); err != nil {
+		return err
+	}
+	if tp.outDir != "" {
+		return writeTopologyPlan(out, tp.outDir)
+	}
+	return nil
+}
+
+func readTopologyPlanFiles(files []string) ([]*unstructured.Unstructured, error) {
+	objs := []*unstructured.Unstructured{}
+	for _, f := range files {
+		// #nosec G304
+		// command accepts user-provided file path by design.
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to read input file %q", f)
+		}
+		fileObjs, err := utilyaml.ToUnstructured(data)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to parse input file %q", f)
+		}
+		for i := range fileObjs {
+			// Expand lists, e.g. produced by kubectl get -o yaml.
+			if fileObjs[i].IsList() {
+				if err := fileObjs[i].EachListItem(func(o runtime.Object) error {
+					u, ok := o.(*unstructured.Unstructured)
+					if !ok {
+						return pkgerrors.Errorf("unexpected list item %T", o)
+					}
+					objs = append(objs, u)
+					return nil
+				}); err != nil {
+					return nil, pkgerrors.Wrapf(err, "failed to parse input file %q", f)
+				}
+				continue
+			}
+			objs = append(objs, &fileObjs[i])
+		}
+	}
+	return objs, nil
+}
+
+// printTopologyPlan prints the summary of the plan and, if printDiffs is true, the diffs of the modified objects.
+func printTopologyPlan(w io.Writer, out *client.TopologyPlanOutput, printDiffs bool) error {
+	if len(out.Clusters) == 0 {
+		fmt.Fprintln(w, "No Clusters are affected by the input objects.")
+		return nil
+	}
+
+	for _, plan := range out.Clusters {
+		fmt.Fprintf(w, "Cluster %s:\n", plan.Cluster)
+		if len(plan.Created) == 0 && len(plan.Modified) == 0 && len(plan.Deleted) == 0 {
+			fmt.Fprintln(w, "  No changes.")
+			continue
+		}
+		for _, obj := range plan.Created {
+			fmt.Fprintf(w, "  created   %s\n", objectName(obj))
+		}
+		for _, obj := range plan.Modified {
+			fmt.Fprintf(w, "  modified  %s\n", objectName(obj.After))
+		}
+		for _, obj := range plan.Deleted {
+			fmt.Fprintf(w, "  deleted   %s\n", objectName(obj))
+		}
+		if len(plan.MachineDeploymentRollouts) > 0 {
+			fmt.Fprintln(w, "  MachineDeployments rolling out:")
+			for _, rollout := range plan.MachineDeploymentRollouts {
+				fmt.Fprintf(w, "    %s: %s\n", rollout.Name, strings.Join(rollout.Reasons, ", "))
+			}
+		}
+	}
+
+	if !printDiffs {
+		return nil
+	}
+	for _, plan := range out.Clusters {
+		for _, obj := range plan.Modified {
+			diff, err := objectDiff(obj)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "\n%s", diff)
+		}
+	}
+	return nil
+}
+
+// writeTopologyPlan writes the created and deleted objects, and the diffs of the modified objects, into
+// a created, modified and deleted directory for each Cluster.
+func writeTopologyPlan(out *client.TopologyPlanOutput, outDir string) error {
+	for _, plan := range out.Clusters {
+		dir := filepath.Join(outDir, plan.Cluster.Namespace, plan.Cluster.Name)
+		for _, obj := range plan.Created {
+			data, err := yaml.Marshal(obj.Object)
+			if err != nil {
+				return err
+			}
+			if err := writeTopologyPlanFile(filepath.Join(dir, "created"), objectFileName(obj, "yaml"), data); err != nil {
+				return err
+			}
+		}
+		for _, obj := range plan.Modified {
+			diff, err := objectDiff(obj)
+			if err != nil {
+				return err
+			}
+			if err := writeTopologyPlanFile(filepath.Join(dir, "modified"), objectFileName(obj.After, "diff"), []byte(diff)); err != nil {
+				return err
+			}
+		}
+		for _, obj := range plan.Deleted {
+			data, err := yaml.Marshal(obj.Object)
+			if err != nil {
+				return err
+			}
+			if err := writeTopologyPlanFile(filepath.Join(dir, "deleted"), objectFileName(obj, "yaml"), data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeTopologyPlanFile(dir, name string, data []byte) error {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return pkgerrors.Wrapf(err, "failed to create directory %q", dir)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write file %q", path)
+	}
+	return nil
+}
+
+// objectDiff returns the unified diff of an object before and after a change.
+func objectDiff(obj *cluster.ModifiedObject) (string, error) {
+	before, err := yaml.Marshal(obj.Before.Object)
+	if err != nil {
+		return "", err
+	}
+	after, err := yaml.Marshal(obj.After.Object)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(before)),
+		B:        difflib.SplitLines(string(after)),
+		FromFile: fmt.Sprintf("%s (current)", objectName(obj.Before)),
+		ToFile:   fmt.Sprintf("%s (planned)", objectName(obj.After)),
+		Context:  3,
+	})
+}
+
+func objectName(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
+
+func objectFileName(obj *unstructured.Unstructured, extension string) string {
+	return fmt.Sprintf("%s_%s_%s.%s", obj.GetKind(), obj.GetNamespace(), obj.GetName(), extension)
+}
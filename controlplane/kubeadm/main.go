@@ -390,7 +390,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	}
 
 	var runtimeClient runtimeclient.Client
-	if feature.Gates.Enabled(feature.InPlaceUpdates) {
+	if feature.Gates.Enabled(feature.InPlaceUpdates) || feature.Gates.Enabled(feature.RuntimeSDK) {
 		// This is the creation of the runtimeClient for the controllers, embedding a shared catalog and registry instance.
 		var certWatcher *certwatcher.CertWatcher
 		runtimeClient, certWatcher, err = internalruntimeclient.New(ctx, internalruntimeclient.Options{
@@ -182,11 +182,16 @@ func (m *Management) GetWorkloadCluster(ctx context.Context, cluster *clusterv1.
 		InsecureSkipVerify: true, //nolint:gosec // host name verification is replaced by VerifyConnection below.
 		VerifyConnection:   verifyEtcdServerCertificate(caPool),
 	}
+	etcdClientGenerator := NewEtcdClientGenerator(restConfig, tlsConfig, m.EtcdDialTimeout, m.EtcdCallTimeout, m.EtcdLogger)
 	return &Workload{
 		restConfig:          restConfig,
 		Client:              c,
 		CoreDNSMigrator:     &CoreDNSMigrator{},
-		etcdClientGenerator: NewEtcdClientGenerator(restConfig, tlsConfig, m.EtcdDialTimeout, m.EtcdCallTimeout, m.EtcdLogger),
+		etcdClientGenerator: etcdClientGenerator,
+		healthChecker: &endpointHealthChecker{
+			restConfig:          restConfig,
+			etcdClientGenerator: etcdClientGenerator,
+		},
 	}, nil
 }
 
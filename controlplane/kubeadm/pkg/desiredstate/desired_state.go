@@ -19,6 +19,7 @@ package desiredstate
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -115,6 +116,12 @@ func ComputeDesiredMachine(kcp *controlplanev1.KubeadmControlPlane, cluster *clu
 		if remediationData, ok := kcp.Annotations[controlplanev1.RemediationInProgressAnnotation]; ok {
 			annotations[controlplanev1.RemediationForAnnotation] = remediationData
 		}
+
+		// In case this machine is being created to restore etcd from a snapshot, then add an annotation
+		// tracking the snapshot.
+		if snapshotName, ok := kcp.Annotations[controlplanev1.RestoreEtcdFromSnapshotAnnotation]; ok {
+			annotations[controlplanev1.EtcdRestoredFromSnapshotAnnotation] = snapshotName
+		}
 	} else {
 		// Updating an existing machine
 		machineName = existingMachine.Name
@@ -129,6 +136,11 @@ func ComputeDesiredMachine(kcp *controlplanev1.KubeadmControlPlane, cluster *clu
 		if remediationData, ok := existingMachine.Annotations[controlplanev1.RemediationForAnnotation]; ok {
 			annotations[controlplanev1.RemediationForAnnotation] = remediationData
 		}
+
+		// If the machine has been created to restore etcd from a snapshot then preserve it.
+		if snapshotName, ok := existingMachine.Annotations[controlplanev1.EtcdRestoredFromSnapshotAnnotation]; ok {
+			annotations[controlplanev1.EtcdRestoredFromSnapshotAnnotation] = snapshotName
+		}
 	}
 	// Setting pre-terminate hook so we can later remove the etcd member right before Machine termination
 	// (i.e. before InfraMachine deletion).
@@ -248,9 +260,23 @@ func ComputeDesiredKubeadmConfig(kcp *controlplanev1.KubeadmControlPlane, cluste
 		kubeadmConfig.SetName(existingKubeadmConfig.GetName())
 		kubeadmConfig.SetUID(existingKubeadmConfig.GetUID())
 	}
+
+	// In case the first CP machine is being created to restore etcd from a snapshot, then add the annotation
+	// instructing the kubeadm bootstrap provider to run the etcd restore script before kubeadm init.
+	// Note: This annotation is only set when creating the KubeadmConfig; it is then preserved because
+	// KCP drops the ownership of labels and annotations after creation.
+	if _, ok := kcp.Annotations[controlplanev1.RestoreEtcdFromSnapshotAnnotation]; ok && !isJoin && existingKubeadmConfig == nil {
+		kubeadmConfig.Annotations[bootstrapv1.EtcdRestoreScriptSecretAnnotation] = EtcdRestoreScriptSecretName(kcp.Name)
+	}
 	return kubeadmConfig, nil
 }
 
+// EtcdRestoreScriptSecretName returns the name of the Secret with the script restoring etcd from a snapshot
+// on the control plane Machine created to restore etcd.
+func EtcdRestoreScriptSecretName(kcpName string) string {
+	return fmt.Sprintf("%s-etcd-restore", kcpName)
+}
+
 // ComputeDesiredInfraMachine computes the desired InfraMachine.
 func ComputeDesiredInfraMachine(ctx context.Context, c client.Client, kcp *controlplanev1.KubeadmControlPlane, cluster *clusterv1.Cluster, name string, existingInfraMachine *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	// Create an owner reference without a controller reference because the owning controller is the machine controller
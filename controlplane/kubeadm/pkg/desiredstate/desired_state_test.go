@@ -687,6 +687,87 @@ func Test_ComputeDesiredKubeadmConfig(t *testing.T) {
 	}
 }
 
+func Test_ComputeDesiredMachineForEtcdRestore(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kcp-foo",
+			Namespace: cluster.Namespace,
+			UID:       "abc-123-kcp-control-plane",
+			Annotations: map[string]string{
+				controlplanev1.RestoreEtcdFromSnapshotAnnotation: "foo-etcd-20260101000000",
+			},
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.31.0",
+		},
+	}
+
+	// The Machine created to restore etcd tracks the snapshot.
+	machine, err := ComputeDesiredMachine(kcp, cluster, "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(machine.Annotations).To(HaveKeyWithValue(controlplanev1.EtcdRestoredFromSnapshotAnnotation, "foo-etcd-20260101000000"))
+
+	// The annotation is preserved on the Machine created to restore etcd, even after the annotation is removed from KCP.
+	kcpWithoutAnnotation := kcp.DeepCopy()
+	kcpWithoutAnnotation.Annotations = nil
+	machine, err = ComputeDesiredMachine(kcpWithoutAnnotation, cluster, "", machine)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(machine.Annotations).To(HaveKeyWithValue(controlplanev1.EtcdRestoredFromSnapshotAnnotation, "foo-etcd-20260101000000"))
+
+	// Machines created when etcd is not being restored do not track any snapshot.
+	machine, err = ComputeDesiredMachine(kcpWithoutAnnotation, cluster, "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(machine.Annotations).ToNot(HaveKey(controlplanev1.EtcdRestoredFromSnapshotAnnotation))
+}
+
+func Test_ComputeDesiredKubeadmConfigForEtcdRestore(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kcp-foo",
+			Namespace: cluster.Namespace,
+			UID:       "abc-123-kcp-control-plane",
+			Annotations: map[string]string{
+				controlplanev1.RestoreEtcdFromSnapshotAnnotation: "foo-etcd-20260101000000",
+			},
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.31.0",
+		},
+	}
+
+	// The KubeadmConfig of the Machine created to restore etcd runs the etcd restore script before kubeadm init.
+	kubeadmConfig, err := ComputeDesiredKubeadmConfig(kcp, cluster, false, "machine-1", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(kubeadmConfig.Annotations).To(HaveKeyWithValue(bootstrapv1.EtcdRestoreScriptSecretAnnotation, "kcp-foo-etcd-restore"))
+	// The spec is not changed, so the Machine is not rolled out after the restore.
+	g.Expect(kubeadmConfig.Spec.PreKubeadmCommands).To(BeEmpty())
+	g.Expect(kubeadmConfig.Spec.Files).To(BeEmpty())
+
+	// The annotation is never set on joining Machines or existing KubeadmConfigs.
+	kubeadmConfig, err = ComputeDesiredKubeadmConfig(kcp, cluster, true, "machine-1", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(kubeadmConfig.Annotations).ToNot(HaveKey(bootstrapv1.EtcdRestoreScriptSecretAnnotation))
+	kubeadmConfig, err = ComputeDesiredKubeadmConfig(kcp, cluster, false, "machine-1", &bootstrapv1.KubeadmConfig{ObjectMeta: metav1.ObjectMeta{Name: "machine-1"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(kubeadmConfig.Annotations).ToNot(HaveKey(bootstrapv1.EtcdRestoreScriptSecretAnnotation))
+}
+
 func Test_ComputeDesiredInfraMachine(t *testing.T) {
 	g := NewWithT(t)
 
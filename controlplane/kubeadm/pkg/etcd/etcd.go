@@ -19,11 +19,14 @@ package etcd
 import (
 	"context"
 	"crypto/tls"
+	"errors"
+	"io"
 	"net"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -38,12 +41,16 @@ type GRPCDial func(ctx context.Context, addr string) (net.Conn, error)
 // etcd wraps the etcd client from etcd's clientv3 package.
 // This interface is implemented by both the clientv3 package and the backoff adapter that adds retries to the client.
 type etcd interface {
+	AlarmDisarm(ctx context.Context, m *clientv3.AlarmMember) (*clientv3.AlarmResponse, error)
 	AlarmList(ctx context.Context) (*clientv3.AlarmResponse, error)
 	Close() error
+	Defragment(ctx context.Context, endpoint string) (*clientv3.DefragmentResponse, error)
 	Endpoints() []string
+	Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error)
 	MemberList(ctx context.Context, opts ...clientv3.OpOption) (*clientv3.MemberListResponse, error)
 	MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error)
 	MoveLeader(ctx context.Context, id uint64) (*clientv3.MoveLeaderResponse, error)
+	Snapshot(ctx context.Context) (io.ReadCloser, error)
 	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
 }
 
@@ -78,6 +85,18 @@ const (
 	AlarmCorrupt
 )
 
+// DBStatus reports the size of the backend database of an etcd member.
+type DBStatus struct {
+	// DBSize is the size of the backend database, in bytes.
+	DBSize int64
+
+	// DBSizeInUse is the size of the backend database logically in use, in bytes.
+	DBSizeInUse int64
+
+	// DBSizeQuota is the size limit of the backend database, in bytes; it is 0 if not reported by the member.
+	DBSizeQuota int64
+}
+
 // DefaultCallTimeout represents the duration that the etcd client waits at most
 // for read and write operations to etcd.
 const DefaultCallTimeout = 15 * time.Second
@@ -263,3 +282,76 @@ func (c *Client) Alarms(ctx context.Context) ([]MemberAlarm, error) {
 
 	return memberAlarms, nil
 }
+
+// Snapshot streams a snapshot of the etcd backend database of the member the client is connected to into w.
+// It returns the number of bytes written.
+// NOTE: The call timeout is not applied to this call, because the duration of the call depends on the size
+// of the database; callers are responsible for setting a deadline on ctx.
+func (c *Client) Snapshot(ctx context.Context, w io.Writer) (int64, error) {
+	rc, err := c.EtcdClient.Snapshot(ctx)
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to get etcd snapshot")
+	}
+	defer rc.Close()
+
+	n, err := io.Copy(w, rc)
+	if err != nil {
+		return n, pkgerrors.Wrap(err, "failed to read etcd snapshot")
+	}
+	return n, nil
+}
+
+// DBStatus returns the size of the backend database of the member the client is connected to.
+func (c *Client) DBStatus(ctx context.Context) (*DBStatus, error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, pkgerrors.New("call timeout expired"))
+	defer cancel()
+
+	status, err := c.EtcdClient.Status(ctx, c.Endpoint)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to get etcd status")
+	}
+	return &DBStatus{
+		DBSize:      status.DbSize,
+		DBSizeInUse: status.DbSizeInUse,
+		DBSizeQuota: status.DbSizeQuota,
+	}, nil
+}
+
+// Defragment defragments the backend database of the member the client is connected to.
+// NOTE: The call timeout is not applied to this call, because the duration of the call depends on the size
+// of the database; callers are responsible for setting a deadline on ctx.
+func (c *Client) Defragment(ctx context.Context) error {
+	if _, err := c.EtcdClient.Defragment(ctx, c.Endpoint); err != nil {
+		return pkgerrors.Wrap(err, "failed to defragment etcd member")
+	}
+	return nil
+}
+
+// Health checks the health of the member the client is connected to, like etcdctl endpoint health, by issuing
+// a linearizable read that requires the member to be part of a cluster with quorum.
+// It returns the duration of the read.
+func (c *Client) Health(ctx context.Context) (time.Duration, error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, pkgerrors.New("call timeout expired"))
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.EtcdClient.Get(ctx, "health")
+	took := time.Since(start)
+	// Permission denied is returned when auth is enabled, and it still implies the member processed the request.
+	if err != nil && !errors.Is(err, rpctypes.ErrPermissionDenied) {
+		return took, pkgerrors.Wrap(err, "failed to check etcd member health")
+	}
+	return took, nil
+}
+
+// DisarmAlarm disarms an alarm raised by a cluster member.
+func (c *Client) DisarmAlarm(ctx context.Context, alarm MemberAlarm) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, pkgerrors.New("call timeout expired"))
+	defer cancel()
+
+	_, err := c.EtcdClient.AlarmDisarm(ctx, &clientv3.AlarmMember{
+		MemberID: alarm.MemberID,
+		Alarm:    etcdserverpb.AlarmType(alarm.Type),
+	})
+	return pkgerrors.Wrapf(err, "failed to disarm etcd alarm %s for member %d", AlarmTypeName[alarm.Type], alarm.MemberID)
+}
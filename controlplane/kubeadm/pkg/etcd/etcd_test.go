@@ -17,11 +17,15 @@ limitations under the License.
 package etcd
 
 import (
+	"bytes"
+	"io"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -95,3 +99,129 @@ func TestEtcdMembers_WithSuccess(t *testing.T) {
 	err = client.RemoveMember(ctx, 1234)
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestEtcdSnapshot(t *testing.T) {
+	t.Run("streams the snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeEtcdClient := &etcdfake.FakeEtcdClient{
+			EtcdEndpoints:    []string{"https://etcd-instance:2379"},
+			StatusResponse:   &clientv3.StatusResponse{},
+			SnapshotResponse: io.NopCloser(strings.NewReader("snapshot-data")),
+		}
+
+		client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		buf := &bytes.Buffer{}
+		n, err := client.Snapshot(ctx, buf)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(n).To(Equal(int64(len("snapshot-data"))))
+		g.Expect(buf.String()).To(Equal("snapshot-data"))
+	})
+	t.Run("fails if the snapshot cannot be taken", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeEtcdClient := &etcdfake.FakeEtcdClient{
+			EtcdEndpoints:  []string{"https://etcd-instance:2379"},
+			StatusResponse: &clientv3.StatusResponse{},
+			SnapshotError:  pkgerrors.New("something went wrong"),
+		}
+
+		client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.Snapshot(ctx, &bytes.Buffer{})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestEtcdDefragmentation(t *testing.T) {
+	t.Run("gets the db status, defragments and disarms alarms", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeEtcdClient := &etcdfake.FakeEtcdClient{
+			EtcdEndpoints: []string{"https://etcd-instance:2379"},
+			StatusResponse: &clientv3.StatusResponse{
+				DbSize:      100,
+				DbSizeInUse: 40,
+				DbSizeQuota: 1000,
+			},
+			DefragmentResponse:  &clientv3.DefragmentResponse{},
+			AlarmDisarmResponse: &clientv3.AlarmResponse{},
+		}
+
+		client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		status, err := client.DBStatus(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*status).To(Equal(DBStatus{DBSize: 100, DBSizeInUse: 40, DBSizeQuota: 1000}))
+
+		g.Expect(client.Defragment(ctx)).To(Succeed())
+		g.Expect(fakeEtcdClient.DefragmentedMember).To(Equal("https://etcd-instance:2379"))
+
+		g.Expect(client.DisarmAlarm(ctx, MemberAlarm{MemberID: 1234, Type: AlarmNoSpace})).To(Succeed())
+		g.Expect(fakeEtcdClient.DisarmedAlarm).To(Equal(&clientv3.AlarmMember{MemberID: 1234, Alarm: etcdserverpb.AlarmType_NOSPACE}))
+	})
+	t.Run("fails if the member cannot be defragmented", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeEtcdClient := &etcdfake.FakeEtcdClient{
+			EtcdEndpoints:    []string{"https://etcd-instance:2379"},
+			StatusResponse:   &clientv3.StatusResponse{},
+			DefragmentError:  pkgerrors.New("something went wrong"),
+			AlarmDisarmError: pkgerrors.New("something went wrong"),
+		}
+
+		client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(client.Defragment(ctx)).ToNot(Succeed())
+		g.Expect(client.DisarmAlarm(ctx, MemberAlarm{MemberID: 1234, Type: AlarmNoSpace})).ToNot(Succeed())
+	})
+}
+
+func TestEtcdHealth(t *testing.T) {
+	tests := []struct {
+		name    string
+		getErr  error
+		wantErr bool
+	}{
+		{
+			name: "healthy if the read succeeds",
+		},
+		{
+			name:   "healthy if the read is denied because auth is enabled",
+			getErr: rpctypes.ErrPermissionDenied,
+		},
+		{
+			name:    "unhealthy if the read fails",
+			getErr:  rpctypes.ErrNoLeader,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeEtcdClient := &etcdfake.FakeEtcdClient{
+				EtcdEndpoints:  []string{"https://etcd-instance:2379"},
+				StatusResponse: &clientv3.StatusResponse{},
+				GetResponse:    &clientv3.GetResponse{},
+				GetError:       tt.getErr,
+			}
+
+			client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			took, err := client.Health(ctx)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(took).To(BeNumerically(">=", 0))
+		})
+	}
+}
@@ -19,6 +19,7 @@ package fake
 
 import (
 	"context"
+	"io"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
@@ -29,6 +30,12 @@ type FakeEtcdClient struct { //nolint:revive
 	AlarmResponse *clientv3.AlarmResponse
 	AlarmError    error
 
+	AlarmDisarmResponse *clientv3.AlarmResponse
+	AlarmDisarmError    error
+
+	DefragmentResponse *clientv3.DefragmentResponse
+	DefragmentError    error
+
 	MemberListResponse *clientv3.MemberListResponse
 	MemberListError    error
 
@@ -38,11 +45,19 @@ type FakeEtcdClient struct { //nolint:revive
 	MoveLeaderResponse *clientv3.MoveLeaderResponse
 	MoveLeaderError    error
 
+	SnapshotResponse io.ReadCloser
+	SnapshotError    error
+
 	StatusResponse *clientv3.StatusResponse
 	StatusError    error
 
-	MovedLeader   uint64
-	RemovedMember uint64
+	GetResponse *clientv3.GetResponse
+	GetError    error
+
+	MovedLeader        uint64
+	RemovedMember      uint64
+	DefragmentedMember string
+	DisarmedAlarm      *clientv3.AlarmMember
 }
 
 func (c *FakeEtcdClient) Endpoints() []string {
@@ -58,10 +73,20 @@ func (c *FakeEtcdClient) Close() error {
 	return nil
 }
 
+func (c *FakeEtcdClient) AlarmDisarm(_ context.Context, m *clientv3.AlarmMember) (*clientv3.AlarmResponse, error) {
+	c.DisarmedAlarm = m
+	return c.AlarmDisarmResponse, c.AlarmDisarmError
+}
+
 func (c *FakeEtcdClient) AlarmList(_ context.Context) (*clientv3.AlarmResponse, error) {
 	return c.AlarmResponse, c.AlarmError
 }
 
+func (c *FakeEtcdClient) Defragment(_ context.Context, endpoint string) (*clientv3.DefragmentResponse, error) {
+	c.DefragmentedMember = endpoint
+	return c.DefragmentResponse, c.DefragmentError
+}
+
 func (c *FakeEtcdClient) MemberList(_ context.Context, _ ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
 	return c.MemberListResponse, c.MemberListError
 }
@@ -69,6 +94,12 @@ func (c *FakeEtcdClient) MemberRemove(_ context.Context, i uint64) (*clientv3.Me
 	c.RemovedMember = i
 	return c.MemberRemoveResponse, c.MemberRemoveError
 }
+func (c *FakeEtcdClient) Snapshot(_ context.Context) (io.ReadCloser, error) {
+	return c.SnapshotResponse, c.SnapshotError
+}
+func (c *FakeEtcdClient) Get(_ context.Context, _ string, _ ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	return c.GetResponse, c.GetError
+}
 func (c *FakeEtcdClient) Status(_ context.Context, _ string) (*clientv3.StatusResponse, error) {
 	return c.StatusResponse, c.StatusError
 }
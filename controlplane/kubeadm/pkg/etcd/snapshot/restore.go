@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// restoreScriptTemplate restores etcd, as a new single member etcd cluster, from a snapshot before kubeadm init.
+// The member name, the peer URL and the data directory are read from the etcd static Pod manifest generated by
+// kubeadm using the same configuration file kubeadm init is going to use, so the restored member matches the
+// member started by kubeadm init. The revision is bumped and compacted, so clients watching the API server of the
+// restored cluster do not miss changes.
+const restoreScriptTemplate = `#!/bin/bash
+# Restores etcd from a snapshot before kubeadm init.
+# Usage: etcd-restore.sh <kubeadm configuration file>
+set -o errexit
+set -o nounset
+set -o pipefail
+
+kubeadm_config="$1"
+manifest=/etc/kubernetes/manifests/etcd.yaml
+
+for command in curl etcdutl kubeadm; do
+  if ! command -v "${command}" >/dev/null 2>&1; then
+    echo "Cannot restore etcd: ${command} is not available on the Machine" >&2
+    exit 1
+  fi
+done
+
+workdir="$(mktemp -d)"
+trap 'rm -rf "${workdir}"' EXIT
+
+curl_args=(--fail --silent --show-error --location --retry 10 --retry-delay 5)
+%s
+echo "Downloading the etcd snapshot"
+curl "${curl_args[@]}" --output "${workdir}/snapshot.db" %s
+
+kubeadm init phase etcd local --config "${kubeadm_config}"
+manifest_flag() {
+  sed -n "s/^ *- --$1=//p" "${manifest}" | head -n 1
+}
+name="$(manifest_flag name)"
+peer_urls="$(manifest_flag initial-advertise-peer-urls)"
+data_dir="$(manifest_flag data-dir)"
+# The manifest is generated again by kubeadm init.
+rm -f "${manifest}"
+if [[ -z "${name}" || -z "${peer_urls}" || -z "${data_dir}" ]]; then
+  echo "Cannot restore etcd: failed to read the etcd configuration from ${manifest}" >&2
+  exit 1
+fi
+
+rm -rf "${data_dir}"
+etcdutl snapshot restore "${workdir}/snapshot.db" \
+  --name "${name}" \
+  --initial-cluster "${name}=${peer_urls}" \
+  --initial-advertise-peer-urls "${peer_urls}" \
+  --data-dir "${data_dir}" \
+  --bump-revision 1000000000 \
+  --mark-compacted
+echo "Restored etcd member ${name} from the snapshot"
+`
+
+// RestoreScript returns a bash script restoring etcd, as a new single member etcd cluster, from the snapshot
+// downloaded from snapshotURL; the script runs on a control plane Machine before kubeadm init, with the path of
+// the kubeadm configuration file as argument.
+// If caBundle is set, it is used to validate the certificate of the server of snapshotURL.
+// The script fails early if curl, etcdutl or kubeadm are not available on the Machine.
+func RestoreScript(snapshotURL string, caBundle []byte) []byte {
+	caBundleArgs := ""
+	if len(caBundle) > 0 {
+		caBundleArgs = fmt.Sprintf("cat > \"${workdir}/ca.crt\" <<'EOF'\n%s\nEOF\ncurl_args+=(--cacert \"${workdir}/ca.crt\")\n",
+			strings.TrimSpace(string(caBundle)))
+	}
+	return []byte(fmt.Sprintf(restoreScriptTemplate, caBundleArgs, shellQuote(snapshotURL)))
+}
+
+// shellQuote quotes s so it is interpreted as a single word by bash.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRestoreScript(t *testing.T) {
+	t.Run("downloads and restores the snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		script := string(RestoreScript("https://s3.example.com/bucket/snapshot?X-Amz-Signature=abc&X-Amz-Expires=86400", nil))
+		g.Expect(script).To(ContainSubstring(`for command in curl etcdutl kubeadm; do`))
+		g.Expect(script).To(ContainSubstring(`--output "${workdir}/snapshot.db" 'https://s3.example.com/bucket/snapshot?X-Amz-Signature=abc&X-Amz-Expires=86400'`))
+		g.Expect(script).To(ContainSubstring(`kubeadm init phase etcd local --config "${kubeadm_config}"`))
+		g.Expect(script).To(ContainSubstring(`etcdutl snapshot restore "${workdir}/snapshot.db"`))
+		g.Expect(script).To(ContainSubstring(`--initial-cluster "${name}=${peer_urls}"`))
+		g.Expect(script).ToNot(ContainSubstring("--cacert"))
+		g.Expect(script).ToNot(ContainSubstring("%!"))
+	})
+	t.Run("validates the certificate of the object store with the CA bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		script := string(RestoreScript("https://s3.example.com/bucket/snapshot", []byte("-----BEGIN CERTIFICATE-----\nabc\n-----END CERTIFICATE-----\n")))
+		g.Expect(script).To(ContainSubstring("<<'EOF'\n-----BEGIN CERTIFICATE-----\nabc\n-----END CERTIFICATE-----\nEOF\n"))
+		g.Expect(script).To(ContainSubstring(`curl_args+=(--cacert "${workdir}/ca.crt")`))
+	})
+	t.Run("quotes the snapshot URL", func(t *testing.T) {
+		g := NewWithT(t)
+
+		script := string(RestoreScript("https://s3.example.com/bucket/it's", nil))
+		g.Expect(script).To(ContainSubstring(`'https://s3.example.com/bucket/it'\''s'`))
+	})
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// unsignedPayload is used as payload hash when signing requests, so snapshots
+	// can be streamed without computing their hash upfront.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+
+	amzDateFormat = "20060102T150405Z"
+)
+
+// S3Sink stores etcd snapshots in a bucket of an S3-compatible object store.
+// Requests use path-style addressing and are signed using AWS Signature Version 4.
+type S3Sink struct {
+	HTTPClient      *http.Client
+	Endpoint        *url.URL
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// now is only used for testing.
+	now func() time.Time
+}
+
+var _ Sink = &S3Sink{}
+
+// Save uploads a snapshot to the bucket.
+func (s *S3Sink) Save(ctx context.Context, name string, r io.Reader, size int64) error {
+	req, err := s.newRequest(ctx, http.MethodPut, s.Prefix+name, nil, io.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+
+	resp, err := s.do(req)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to upload etcd snapshot %s", name)
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// Open downloads a snapshot from the bucket.
+func (s *S3Sink) Open(ctx context.Context, name string) (io.ReadCloser, int64, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, s.Prefix+name, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, 0, pkgerrors.Wrapf(err, "failed to download etcd snapshot %s", name)
+	}
+	if resp.ContentLength < 0 {
+		_ = resp.Body.Close()
+		return nil, 0, pkgerrors.Errorf("failed to download etcd snapshot %s: unknown size", name)
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// listBucketResult is the response of a ListObjectsV2 request.
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List returns the names of the snapshots in the bucket with the sink prefix.
+func (s *S3Sink) List(ctx context.Context) ([]string, error) {
+	names := []string{}
+	continuationToken := ""
+	for {
+		query := url.Values{
+			"list-type": []string{"2"},
+			"prefix":    []string{s.Prefix},
+		}
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+		req, err := s.newRequest(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.do(req)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to list etcd snapshots")
+		}
+		result := &listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to list etcd snapshots: failed to decode response")
+		}
+
+		for _, o := range result.Contents {
+			name := strings.TrimPrefix(o.Key, s.Prefix)
+			// Ignore objects in "sub-directories" of the prefix.
+			if name == "" || strings.Contains(name, "/") {
+				continue
+			}
+			names = append(names, name)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		continuationToken = result.NextContinuationToken
+	}
+	return names, nil
+}
+
+// Delete deletes a snapshot from the bucket.
+func (s *S3Sink) Delete(ctx context.Context, name string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, s.Prefix+name, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		if pkgerrors.Is(err, errNotFound) {
+			return nil
+		}
+		return pkgerrors.Wrapf(err, "failed to delete etcd snapshot %s", name)
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// PresignedURL returns a URL which allows to download the snapshot with the given name without credentials
+// until expires has elapsed, e.g. from a Machine which cannot access the credentials of the sink.
+// Note: The URL grants read access to the snapshot to anyone who knows it, it must be handled as a secret.
+func (s *S3Sink) PresignedURL(name string, expires time.Duration) string {
+	now := time.Now
+	if s.now != nil {
+		now = s.now
+	}
+	return presignV4(s.objectURL(s.Prefix+name, nil), s.AccessKeyID, s.SecretAccessKey, s.Region, now(), expires).String()
+}
+
+var errNotFound = pkgerrors.New("not found")
+
+// newRequest returns a signed request for an object in the bucket, or for the bucket itself if key is empty.
+func (s *S3Sink) newRequest(ctx context.Context, method, key string, query url.Values, body io.ReadCloser) (*http.Request, error) {
+	u := s.objectURL(key, query)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to create %s request for %s", method, u.String())
+	}
+
+	now := time.Now
+	if s.now != nil {
+		now = s.now
+	}
+	signV4(req, unsignedPayload, s.AccessKeyID, s.SecretAccessKey, s.Region, now())
+	return req, nil
+}
+
+// objectURL returns the path-style URL of an object in the bucket, or of the bucket itself if key is empty.
+func (s *S3Sink) objectURL(key string, query url.Values) *url.URL {
+	u := *s.Endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.Bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = awsURIEncode(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+	return &u
+}
+
+// do sends a request and returns an error if the response status code is not 2xx.
+// Note: The response body must be closed by the caller if the returned error is nil.
+func (s *S3Sink) do(req *http.Request) (*http.Response, error) {
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errNotFound
+	}
+	// Error responses are small XML documents with a code and a message; include them in the error.
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return nil, pkgerrors.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+}
+
+// signV4 signs a request using AWS Signature Version 4 for the s3 service.
+// All the headers of the request and the host are signed.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html.
+func signV4(req *http.Request, payloadHash, accessKeyID, secretAccessKey, region string, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format(amzDateFormat)
+	date := t.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.Join(v, ",")
+	}
+	headerNames := make([]string, 0, len(headers))
+	for k := range headers {
+		headerNames = append(headerNames, k)
+	}
+	slices.Sort(headerNames)
+
+	canonicalHeaders := &strings.Builder{}
+	for _, k := range headerNames {
+		fmt.Fprintf(canonicalHeaders, "%s:%s\n", k, strings.TrimSpace(headers[k]))
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		awsURIEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, region)
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	signature := hex.EncodeToString(hmacSHA256(signingKey(secretAccessKey, date, region), stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature))
+}
+
+// presignV4 returns a copy of u with a query string authorizing a GET request for expires, signed
+// using AWS Signature Version 4 for the s3 service; only the host is signed.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html.
+func presignV4(u *url.URL, accessKeyID, secretAccessKey, region string, t time.Time, expires time.Duration) *url.URL {
+	t = t.UTC()
+	amzDate := t.Format(amzDateFormat)
+	date := t.Format("20060102")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, region)
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", accessKeyID+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		awsURIEncode(u.Path, false),
+		canonicalQuery(query),
+		fmt.Sprintf("host:%s\n", u.Host),
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+	query.Set("X-Amz-Signature", hex.EncodeToString(hmacSHA256(signingKey(secretAccessKey, date, region), stringToSign)))
+
+	presigned := *u
+	presigned.RawQuery = canonicalQuery(query)
+	return &presigned
+}
+
+// signingKey returns the key used to sign requests for the s3 service using AWS Signature Version 4.
+func signingKey(secretAccessKey, date, region string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	return hmacSHA256(key, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery returns the query string with keys sorted and keys and values encoded as required by AWS Signature Version 4.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	parts := []string{}
+	for _, k := range keys {
+		values := slices.Clone(query[k])
+		slices.Sort(values)
+		for _, v := range values {
+			parts = append(parts, awsURIEncode(k, true)+"="+awsURIEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// awsURIEncode encodes a string as required by AWS Signature Version 4, i.e. all
+// characters except unreserved characters are percent-encoded; '/' is preserved if encodeSlash is false.
+func awsURIEncode(s string, encodeSlash bool) string {
+	b := &strings.Builder{}
+	for i := range len(s) {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	// SnapshotLabel is the label set on Secrets storing etcd snapshot chunks.
+	SnapshotLabel = "controlplane.cluster.x-k8s.io/etcd-snapshot"
+
+	// SnapshotNameAnnotation is the annotation set on Secrets storing etcd snapshot chunks
+	// with the name of the snapshot.
+	SnapshotNameAnnotation = "controlplane.cluster.x-k8s.io/etcd-snapshot-name"
+
+	// SnapshotChunkAnnotation is the annotation set on Secrets storing etcd snapshot chunks
+	// with the index of the chunk, starting from 0.
+	SnapshotChunkAnnotation = "controlplane.cluster.x-k8s.io/etcd-snapshot-chunk"
+
+	// SnapshotChunksAnnotation is the annotation set on Secrets storing etcd snapshot chunks
+	// with the number of chunks of the snapshot.
+	SnapshotChunksAnnotation = "controlplane.cluster.x-k8s.io/etcd-snapshot-chunks"
+
+	// snapshotDataKey is the key of the snapshot chunk in the Secret data.
+	snapshotDataKey = "snapshot"
+)
+
+// SecretSink stores etcd snapshots in Secrets; each snapshot is split in chunks
+// of at most ChunkSize bytes, and each chunk is stored in a separate Secret.
+type SecretSink struct {
+	Client      client.Client
+	Namespace   string
+	ClusterName string
+	OwnerRef    metav1.OwnerReference
+	ChunkSize   int
+}
+
+var _ Sink = &SecretSink{}
+
+// Save stores a snapshot.
+// If storing any of the chunks fails, the chunks already stored are deleted.
+func (s *SecretSink) Save(ctx context.Context, name string, r io.Reader, size int64) error {
+	if s.ChunkSize <= 0 {
+		return pkgerrors.New("invalid argument: chunk size must be greater than 0")
+	}
+
+	chunks := int((size + int64(s.ChunkSize) - 1) / int64(s.ChunkSize))
+	if chunks == 0 {
+		return pkgerrors.New("invalid argument: cannot store an empty etcd snapshot")
+	}
+
+	buf := make([]byte, s.ChunkSize)
+	for i := range chunks {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && (!pkgerrors.Is(err, io.ErrUnexpectedEOF) || i != chunks-1) {
+			return s.cleanup(ctx, name, pkgerrors.Wrapf(err, "failed to read chunk %d of etcd snapshot %s", i, name))
+		}
+
+		chunk := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: s.Namespace,
+				Name:      fmt.Sprintf("%s-%d", name, i),
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: s.ClusterName,
+					SnapshotLabel:              "",
+				},
+				Annotations: map[string]string{
+					SnapshotNameAnnotation:   name,
+					SnapshotChunkAnnotation:  strconv.Itoa(i),
+					SnapshotChunksAnnotation: strconv.Itoa(chunks),
+				},
+				OwnerReferences: []metav1.OwnerReference{s.OwnerRef},
+			},
+			Type: clusterv1.ClusterSecretType,
+			Data: map[string][]byte{
+				snapshotDataKey: buf[:n],
+			},
+		}
+		if err := s.Client.Create(ctx, chunk); err != nil {
+			return s.cleanup(ctx, name, pkgerrors.Wrapf(err, "failed to create Secret for chunk %d of etcd snapshot %s", i, name))
+		}
+	}
+	return nil
+}
+
+// cleanup deletes the chunks of a snapshot whose Save failed.
+func (s *SecretSink) cleanup(ctx context.Context, name string, err error) error {
+	if deleteErr := s.Delete(ctx, name); deleteErr != nil {
+		return kerrors.NewAggregate([]error{err, deleteErr})
+	}
+	return err
+}
+
+// Open returns a reader for a snapshot stored in Secrets.
+// It returns an error if any of the chunks of the snapshot is missing.
+func (s *SecretSink) Open(ctx context.Context, name string) (io.ReadCloser, int64, error) {
+	chunks, err := s.listChunks(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var data [][]byte
+	for i := range chunks {
+		chunk := &chunks[i]
+		if chunk.Annotations[SnapshotNameAnnotation] != name {
+			continue
+		}
+		index, err := strconv.Atoi(chunk.Annotations[SnapshotChunkAnnotation])
+		if err != nil {
+			return nil, 0, pkgerrors.Wrapf(err, "failed to open etcd snapshot %s: invalid chunk index on Secret %s", name, klog.KObj(chunk))
+		}
+		count, err := strconv.Atoi(chunk.Annotations[SnapshotChunksAnnotation])
+		if err != nil {
+			return nil, 0, pkgerrors.Wrapf(err, "failed to open etcd snapshot %s: invalid number of chunks on Secret %s", name, klog.KObj(chunk))
+		}
+		if data == nil {
+			data = make([][]byte, count)
+		}
+		if index < 0 || index >= len(data) || count != len(data) {
+			return nil, 0, pkgerrors.Errorf("failed to open etcd snapshot %s: inconsistent chunk annotations on Secret %s", name, klog.KObj(chunk))
+		}
+		data[index] = chunk.Data[snapshotDataKey]
+	}
+	if data == nil {
+		return nil, 0, pkgerrors.Errorf("etcd snapshot %s not found", name)
+	}
+
+	readers := make([]io.Reader, 0, len(data))
+	size := int64(0)
+	for i, d := range data {
+		if d == nil {
+			return nil, 0, pkgerrors.Errorf("failed to open etcd snapshot %s: chunk %d is missing", name, i)
+		}
+		readers = append(readers, bytes.NewReader(d))
+		size += int64(len(d))
+	}
+	return io.NopCloser(io.MultiReader(readers...)), size, nil
+}
+
+// List returns the names of the snapshots stored in Secrets.
+func (s *SecretSink) List(ctx context.Context) ([]string, error) {
+	chunks, err := s.listChunks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := sets.Set[string]{}
+	for _, chunk := range chunks {
+		if name := chunk.Annotations[SnapshotNameAnnotation]; name != "" {
+			names.Insert(name)
+		}
+	}
+	return sets.List(names), nil
+}
+
+// Delete deletes all the Secrets storing chunks of a snapshot.
+func (s *SecretSink) Delete(ctx context.Context, name string) error {
+	chunks, err := s.listChunks(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range chunks {
+		chunk := &chunks[i]
+		if chunk.Annotations[SnapshotNameAnnotation] != name {
+			continue
+		}
+		if err := s.Client.Delete(ctx, chunk); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, pkgerrors.Wrapf(err, "failed to delete Secret %s", klog.KObj(chunk)))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+func (s *SecretSink) listChunks(ctx context.Context) ([]corev1.Secret, error) {
+	secrets := &corev1.SecretList{}
+	if err := s.Client.List(ctx, secrets, client.InNamespace(s.Namespace), client.MatchingLabels{
+		clusterv1.ClusterNameLabel: s.ClusterName,
+		SnapshotLabel:              "",
+	}); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Secrets storing etcd snapshots")
+	}
+	return secrets.Items, nil
+}
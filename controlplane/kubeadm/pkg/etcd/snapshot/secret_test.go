@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestSecretSink(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	sink := &SecretSink{
+		Client:      c,
+		Namespace:   "ns",
+		ClusterName: "cluster",
+		OwnerRef: metav1.OwnerReference{
+			APIVersion: "controlplane.cluster.x-k8s.io/v1beta2",
+			Kind:       "KubeadmControlPlane",
+			Name:       "kcp",
+			UID:        "kcp-uid",
+		},
+		ChunkSize: 4,
+	}
+
+	// Store a snapshot spanning three chunks, the last one partial.
+	g.Expect(sink.Save(ctx, "cluster-etcd-20260101000000", strings.NewReader("0123456789"), 10)).To(Succeed())
+
+	secrets := &corev1.SecretList{}
+	g.Expect(c.List(ctx, secrets, client.InNamespace("ns"))).To(Succeed())
+	g.Expect(secrets.Items).To(HaveLen(3))
+	data := ""
+	for i, name := range []string{"cluster-etcd-20260101000000-0", "cluster-etcd-20260101000000-1", "cluster-etcd-20260101000000-2"} {
+		chunk := &corev1.Secret{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: "ns", Name: name}, chunk)).To(Succeed())
+		g.Expect(chunk.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "cluster"))
+		g.Expect(chunk.Labels).To(HaveKey(SnapshotLabel))
+		g.Expect(chunk.Annotations).To(HaveKeyWithValue(SnapshotNameAnnotation, "cluster-etcd-20260101000000"))
+		g.Expect(chunk.Annotations).To(HaveKeyWithValue(SnapshotChunkAnnotation, []string{"0", "1", "2"}[i]))
+		g.Expect(chunk.Annotations).To(HaveKeyWithValue(SnapshotChunksAnnotation, "3"))
+		g.Expect(chunk.OwnerReferences).To(ConsistOf(sink.OwnerRef))
+		g.Expect(chunk.Type).To(Equal(clusterv1.ClusterSecretType))
+		data += string(chunk.Data[snapshotDataKey])
+	}
+	g.Expect(data).To(Equal("0123456789"))
+
+	r, size, err := sink.Open(ctx, "cluster-etcd-20260101000000")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(size).To(Equal(int64(10)))
+	read, err := io.ReadAll(r)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.Close()).To(Succeed())
+	g.Expect(string(read)).To(Equal("0123456789"))
+
+	// Opening a snapshot with missing chunks fails.
+	g.Expect(c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster-etcd-20260101000000-1"}})).To(Succeed())
+	_, _, err = sink.Open(ctx, "cluster-etcd-20260101000000")
+	g.Expect(err).To(MatchError(ContainSubstring("chunk 1 is missing")))
+	_, _, err = sink.Open(ctx, "does-not-exist")
+	g.Expect(err).To(MatchError(ContainSubstring("not found")))
+
+	g.Expect(sink.Save(ctx, "cluster-etcd-20260102000000", strings.NewReader("0123"), 4)).To(Succeed())
+
+	names, err := sink.List(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names).To(Equal([]string{"cluster-etcd-20260101000000", "cluster-etcd-20260102000000"}))
+
+	g.Expect(sink.Delete(ctx, "cluster-etcd-20260101000000")).To(Succeed())
+	names, err = sink.List(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names).To(Equal([]string{"cluster-etcd-20260102000000"}))
+
+	// Chunks are cleaned up if the snapshot is shorter than expected.
+	g.Expect(sink.Save(ctx, "cluster-etcd-20260103000000", strings.NewReader("0123456"), 10)).ToNot(Succeed())
+	names, err = sink.List(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names).To(Equal([]string{"cluster-etcd-20260102000000"}))
+}
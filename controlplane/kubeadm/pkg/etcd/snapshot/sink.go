@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	// timeFormat is the format of the time in snapshot names; it ensures that snapshot names
+	// of the same cluster sort in chronological order.
+	timeFormat = "20060102150405"
+
+	// S3AccessKeyIDKey is the key of the access key ID in the credentials Secret of an S3 sink.
+	S3AccessKeyIDKey = "accessKeyID"
+
+	// S3SecretAccessKeyKey is the key of the secret access key in the credentials Secret of an S3 sink.
+	S3SecretAccessKeyKey = "secretAccessKey" //nolint:gosec
+
+	// s3RequestTimeout is the maximum duration of a request to an S3-compatible object store,
+	// including the time required to upload or download a snapshot.
+	s3RequestTimeout = 5 * time.Minute
+
+	// s3ResponseHeaderTimeout is the maximum duration to wait for the response headers of a request to an
+	// S3-compatible object store after the request has been fully written.
+	s3ResponseHeaderTimeout = 30 * time.Second
+)
+
+// Sink stores etcd snapshots.
+type Sink interface {
+	// Save stores a snapshot with the given name reading size bytes from r.
+	Save(ctx context.Context, name string, r io.Reader, size int64) error
+
+	// Open returns a reader for the snapshot with the given name and the size of the snapshot.
+	// Note: The returned reader must be closed by the caller.
+	Open(ctx context.Context, name string) (io.ReadCloser, int64, error)
+
+	// List returns the names of the snapshots in the sink.
+	List(ctx context.Context) ([]string, error)
+
+	// Delete deletes the snapshot with the given name; deleting a snapshot that does not exist is not an error.
+	Delete(ctx context.Context, name string) error
+}
+
+// Name returns the name of a snapshot of the etcd cluster of a Cluster taken at the given time.
+func Name(clusterName string, t time.Time) string {
+	return fmt.Sprintf("%s-etcd-%s", clusterName, t.UTC().Format(timeFormat))
+}
+
+// Prune deletes the oldest snapshots of a Cluster from the sink, keeping the retention most recent ones.
+// Snapshots in the sink that are not named using Name for the given Cluster are ignored.
+// It returns the names of the deleted snapshots.
+func Prune(ctx context.Context, sink Sink, clusterName string, retention int) ([]string, error) {
+	names, err := sink.List(ctx)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list etcd snapshots")
+	}
+
+	prefix := fmt.Sprintf("%s-etcd-", clusterName)
+	snapshots := []string{}
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if _, err := time.Parse(timeFormat, strings.TrimPrefix(name, prefix)); err != nil {
+			continue
+		}
+		snapshots = append(snapshots, name)
+	}
+	if len(snapshots) <= retention {
+		return nil, nil
+	}
+
+	// Names sort in chronological order, so the oldest snapshots are the first ones.
+	slices.Sort(snapshots)
+	deleted := []string{}
+	for _, name := range snapshots[:len(snapshots)-retention] {
+		if err := sink.Delete(ctx, name); err != nil {
+			return deleted, pkgerrors.Wrapf(err, "failed to delete etcd snapshot %s", name)
+		}
+		deleted = append(deleted, name)
+	}
+	return deleted, nil
+}
+
+// NewSink returns the Sink defined in the etcdSnapshot spec of a KubeadmControlPlane.
+func NewSink(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, kcp *controlplanev1.KubeadmControlPlane) (Sink, error) {
+	sink := kcp.Spec.EtcdSnapshot.Sink
+	switch {
+	case sink.Secret != nil:
+		return &SecretSink{
+			Client:      c,
+			Namespace:   kcp.Namespace,
+			ClusterName: cluster.Name,
+			OwnerRef:    *metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind("KubeadmControlPlane")),
+			ChunkSize:   int(ptr.Deref(sink.Secret.ChunkSizeBytes, controlplanev1.DefaultEtcdSnapshotChunkSizeBytes)),
+		}, nil
+	case sink.S3 != nil:
+		endpoint, err := url.Parse(sink.S3.Endpoint)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to parse S3 endpoint %q", sink.S3.Endpoint)
+		}
+		if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+			return nil, pkgerrors.Errorf("invalid S3 endpoint %q: scheme must be http or https", sink.S3.Endpoint)
+		}
+
+		credentials := &corev1.Secret{}
+		key := client.ObjectKey{Namespace: kcp.Namespace, Name: sink.S3.CredentialsSecret.Name}
+		if err := c.Get(ctx, key, credentials); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get S3 credentials Secret %s", key)
+		}
+		accessKeyID, secretAccessKey := string(credentials.Data[S3AccessKeyIDKey]), string(credentials.Data[S3SecretAccessKeyKey])
+		if accessKeyID == "" || secretAccessKey == "" {
+			return nil, pkgerrors.Errorf("S3 credentials Secret %s must have the %s and %s keys", key, S3AccessKeyIDKey, S3SecretAccessKeyKey)
+		}
+
+		httpClient, err := newS3HTTPClient(sink.S3.CABundle)
+		if err != nil {
+			return nil, err
+		}
+
+		region := sink.S3.Region
+		if region == "" {
+			region = controlplanev1.DefaultEtcdSnapshotS3Region
+		}
+		return &S3Sink{
+			HTTPClient:      httpClient,
+			Endpoint:        endpoint,
+			Region:          region,
+			Bucket:          sink.S3.Bucket,
+			Prefix:          fmt.Sprintf("%s%s/%s/", sink.S3.Prefix, cluster.Namespace, cluster.Name),
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+		}, nil
+	}
+	return nil, pkgerrors.New("etcd snapshot sink is not defined")
+}
+
+// newS3HTTPClient returns the HTTP client used to send requests to an S3-compatible object store.
+// The client honors the proxy configuration from the environment and, if caBundle is set, it validates
+// the certificate of the object store using caBundle instead of the system trust store.
+func newS3HTTPClient(caBundle []byte) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.ResponseHeaderTimeout = s3ResponseHeaderTimeout
+	if len(caBundle) > 0 {
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, pkgerrors.New("failed to parse S3 CA bundle: no valid PEM encoded certificates found")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    rootCAs,
+		}
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   s3RequestTimeout,
+	}, nil
+}
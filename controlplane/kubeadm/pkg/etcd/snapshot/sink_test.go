@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+var (
+	ctx = ctrl.SetupSignalHandler()
+)
+
+func TestName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(Name("cluster", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))).To(Equal("cluster-etcd-20260102030405"))
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		name        string
+		snapshots   []string
+		retention   int
+		wantDeleted []string
+	}{
+		{
+			name:        "Nothing to delete if the number of snapshots is equal to retention",
+			snapshots:   []string{"cluster-etcd-20260101000000", "cluster-etcd-20260102000000"},
+			retention:   2,
+			wantDeleted: nil,
+		},
+		{
+			name:        "Delete the oldest snapshots",
+			snapshots:   []string{"cluster-etcd-20260103000000", "cluster-etcd-20260101000000", "cluster-etcd-20260102000000"},
+			retention:   1,
+			wantDeleted: []string{"cluster-etcd-20260101000000", "cluster-etcd-20260102000000"},
+		},
+		{
+			name: "Ignore snapshots of other clusters and objects with an unexpected name",
+			snapshots: []string{
+				"cluster-etcd-20260101000000", "cluster-etcd-20260102000000",
+				"other-etcd-20250101000000", "cluster-etcd-latest",
+			},
+			retention:   1,
+			wantDeleted: []string{"cluster-etcd-20260101000000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			sink := &fakeSink{snapshots: map[string]bool{}}
+			for _, s := range tt.snapshots {
+				sink.snapshots[s] = true
+			}
+
+			deleted, err := Prune(ctx, sink, "cluster", tt.retention)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(deleted).To(Equal(tt.wantDeleted))
+			for _, s := range tt.wantDeleted {
+				g.Expect(sink.snapshots).ToNot(HaveKey(s))
+			}
+		})
+	}
+}
+
+func TestNewSink(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster"},
+	}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "kcp", UID: "kcp-uid"},
+	}
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "credentials"},
+		Data: map[string][]byte{
+			S3AccessKeyIDKey:     []byte("access-key-id"),
+			S3SecretAccessKeyKey: []byte("secret-access-key"),
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	t.Run("secret sink", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := kcp.DeepCopy()
+		kcp.Spec.EtcdSnapshot.Sink.Secret = &controlplanev1.EtcdSnapshotSecretSink{}
+
+		sink, err := NewSink(ctx, fake.NewClientBuilder().WithScheme(scheme).Build(), cluster, kcp)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(sink).To(BeAssignableToTypeOf(&SecretSink{}))
+		secretSink := sink.(*SecretSink)
+		g.Expect(secretSink.Namespace).To(Equal("ns"))
+		g.Expect(secretSink.ClusterName).To(Equal("cluster"))
+		g.Expect(secretSink.OwnerRef.UID).To(Equal(kcp.UID))
+		g.Expect(secretSink.ChunkSize).To(Equal(int(controlplanev1.DefaultEtcdSnapshotChunkSizeBytes)))
+	})
+	t.Run("s3 sink", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := kcp.DeepCopy()
+		kcp.Spec.EtcdSnapshot.Sink.S3 = &controlplanev1.EtcdSnapshotS3Sink{
+			Endpoint:          "https://s3.example.com",
+			Bucket:            "bucket",
+			Prefix:            "backups/",
+			CredentialsSecret: controlplanev1.EtcdSnapshotS3CredentialsSecretReference{Name: "credentials"},
+		}
+
+		sink, err := NewSink(ctx, fake.NewClientBuilder().WithScheme(scheme).WithObjects(credentials).Build(), cluster, kcp)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(sink).To(BeAssignableToTypeOf(&S3Sink{}))
+		s3Sink := sink.(*S3Sink)
+		g.Expect(s3Sink.Endpoint.String()).To(Equal("https://s3.example.com"))
+		g.Expect(s3Sink.Region).To(Equal(controlplanev1.DefaultEtcdSnapshotS3Region))
+		g.Expect(s3Sink.Prefix).To(Equal("backups/ns/cluster/"))
+		g.Expect(s3Sink.AccessKeyID).To(Equal("access-key-id"))
+		g.Expect(s3Sink.SecretAccessKey).To(Equal("secret-access-key"))
+		g.Expect(s3Sink.HTTPClient).ToNot(BeIdenticalTo(http.DefaultClient))
+		g.Expect(s3Sink.HTTPClient.Timeout).To(Equal(s3RequestTimeout))
+		g.Expect(s3Sink.HTTPClient.Transport.(*http.Transport).Proxy).ToNot(BeNil())
+	})
+	t.Run("s3 sink with a CA bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+		kcp := kcp.DeepCopy()
+		kcp.Spec.EtcdSnapshot.Sink.S3 = &controlplanev1.EtcdSnapshotS3Sink{
+			Endpoint:          server.URL,
+			Bucket:            "bucket",
+			CABundle:          caBundle,
+			CredentialsSecret: controlplanev1.EtcdSnapshotS3CredentialsSecretReference{Name: "credentials"},
+		}
+
+		sink, err := NewSink(ctx, fake.NewClientBuilder().WithScheme(scheme).WithObjects(credentials).Build(), cluster, kcp)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(sink.Delete(ctx, "foo")).To(Succeed())
+	})
+	t.Run("s3 sink fails with an invalid CA bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := kcp.DeepCopy()
+		kcp.Spec.EtcdSnapshot.Sink.S3 = &controlplanev1.EtcdSnapshotS3Sink{
+			Endpoint:          "https://s3.example.com",
+			Bucket:            "bucket",
+			CABundle:          []byte("not a certificate"),
+			CredentialsSecret: controlplanev1.EtcdSnapshotS3CredentialsSecretReference{Name: "credentials"},
+		}
+
+		_, err := NewSink(ctx, fake.NewClientBuilder().WithScheme(scheme).WithObjects(credentials).Build(), cluster, kcp)
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("s3 sink fails if the credentials Secret does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := kcp.DeepCopy()
+		kcp.Spec.EtcdSnapshot.Sink.S3 = &controlplanev1.EtcdSnapshotS3Sink{
+			Endpoint:          "https://s3.example.com",
+			Bucket:            "bucket",
+			CredentialsSecret: controlplanev1.EtcdSnapshotS3CredentialsSecretReference{Name: "credentials"},
+		}
+
+		_, err := NewSink(ctx, fake.NewClientBuilder().WithScheme(scheme).Build(), cluster, kcp)
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("s3 sink fails with an invalid endpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := kcp.DeepCopy()
+		kcp.Spec.EtcdSnapshot.Sink.S3 = &controlplanev1.EtcdSnapshotS3Sink{
+			Endpoint:          "s3.example.com",
+			Bucket:            "bucket",
+			CredentialsSecret: controlplanev1.EtcdSnapshotS3CredentialsSecretReference{Name: "credentials"},
+		}
+
+		_, err := NewSink(ctx, fake.NewClientBuilder().WithScheme(scheme).WithObjects(credentials).Build(), cluster, kcp)
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("fails if no sink is defined", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := kcp.DeepCopy()
+		kcp.Spec.EtcdSnapshot.Retention = ptr.To[int32](1)
+
+		_, err := NewSink(ctx, fake.NewClientBuilder().WithScheme(scheme).Build(), cluster, kcp)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+type fakeSink struct {
+	snapshots map[string]bool
+}
+
+func (f *fakeSink) Save(_ context.Context, name string, _ io.Reader, _ int64) error {
+	f.snapshots[name] = true
+	return nil
+}
+
+func (f *fakeSink) Open(_ context.Context, name string) (io.ReadCloser, int64, error) {
+	if _, ok := f.snapshots[name]; !ok {
+		return nil, 0, pkgerrors.Errorf("snapshot %s not found", name)
+	}
+	return io.NopCloser(strings.NewReader(name)), int64(len(name)), nil
+}
+
+func (f *fakeSink) List(_ context.Context) ([]string, error) {
+	names := []string{}
+	for name := range f.snapshots {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (f *fakeSink) Delete(_ context.Context, name string) error {
+	if _, ok := f.snapshots[name]; !ok {
+		return pkgerrors.Errorf("snapshot %s not found", name)
+	}
+	delete(f.snapshots, name)
+	return nil
+}
@@ -40,6 +40,8 @@ type UpToDateResult struct {
 	LogMessages              []string
 	ConditionMessages        []string
 	EligibleForInPlaceUpdate bool
+	// OnlyCertificatesExpiring is true if certificates about to expire are the only reason for the Machine to not be up-to-date.
+	OnlyCertificatesExpiring bool
 	DesiredMachine           *clusterv1.Machine
 	CurrentInfraMachine      *unstructured.Unstructured
 	DesiredInfraMachine      *unstructured.Unstructured
@@ -76,10 +78,13 @@ func UpToDate(
 	}
 
 	// Machines whose certificates are about to expire.
-	if collections.ShouldRolloutBefore(reconciliationTime, kcp.Spec.Rollout.Before)(machine) {
+	// Note: Machines whose certificates are being renewed in-place are not rolled out.
+	if _, renewing := machine.Annotations[controlplanev1.CertificatesRenewalInProgressAnnotation]; !renewing &&
+		collections.ShouldRolloutBefore(reconciliationTime, kcp.Spec.Rollout.Before)(machine) {
 		res.LogMessages = append(res.LogMessages, "certificates will expire soon, rolloutBefore expired")
 		res.ConditionMessages = append(res.ConditionMessages, "Certificates will expire soon")
 		res.EligibleForInPlaceUpdate = false
+		res.OnlyCertificatesExpiring = true
 	}
 
 	// Machines that are scheduled for rollout (KCP.Spec.RolloutAfter set,
@@ -88,6 +93,15 @@ func UpToDate(
 		res.LogMessages = append(res.LogMessages, "rolloutAfter expired")
 		res.ConditionMessages = append(res.ConditionMessages, "KubeadmControlPlane spec.rolloutAfter expired")
 		res.EligibleForInPlaceUpdate = false
+		res.OnlyCertificatesExpiring = false
+	}
+
+	// Machines created before the start of the current phase of the rotation of the certificate authorities.
+	if collections.ShouldRolloutAfter(reconciliationTime, certificateAuthorityRotationPhaseStartTime(kcp))(machine) {
+		res.LogMessages = append(res.LogMessages, "certificate authorities rotation in progress")
+		res.ConditionMessages = append(res.ConditionMessages, "Certificate authorities rotation in progress")
+		res.EligibleForInPlaceUpdate = false
+		res.OnlyCertificatesExpiring = false
 	}
 
 	// Machines that do not match with KCP config.
@@ -99,6 +113,7 @@ func UpToDate(
 	if !matches {
 		res.LogMessages = append(res.LogMessages, specLogMessages...)
 		res.ConditionMessages = append(res.ConditionMessages, specConditionMessages...)
+		res.OnlyCertificatesExpiring = false
 	}
 
 	if len(res.LogMessages) > 0 || len(res.ConditionMessages) > 0 {
@@ -110,6 +125,17 @@ func UpToDate(
 	return true, res, nil
 }
 
+// certificateAuthorityRotationPhaseStartTime returns the start time of the current phase of the rotation of
+// the certificate authorities, or a zero time if no rotation is in progress.
+func certificateAuthorityRotationPhaseStartTime(kcp *controlplanev1.KubeadmControlPlane) metav1.Time {
+	switch kcp.Status.CertificateAuthorityRotation.Phase {
+	case "", controlplanev1.CertificateAuthorityRotationCompletedPhase:
+		return metav1.Time{}
+	default:
+		return kcp.Status.CertificateAuthorityRotation.PhaseStartTime
+	}
+}
+
 // matchesMachineSpec checks if a Machine matches any of a set of KubeadmConfigs and a set of infra machine configs.
 // If it doesn't, it returns the reasons why.
 // Kubernetes version, infrastructure template, and KubeadmConfig field need to be equivalent.
@@ -1631,6 +1631,7 @@ func TestUpToDate(t *testing.T) {
 		machineConfigs                 map[string]*bootstrapv1.KubeadmConfig
 		expectUptoDate                 bool
 		expectEligibleForInPlaceUpdate bool
+		expectOnlyCertificatesExpiring bool
 		expectLogMessages              []string
 		expectConditionMessages        []string
 	}{
@@ -1657,9 +1658,48 @@ func TestUpToDate(t *testing.T) {
 			machineConfigs:                 defaultMachineConfigs,
 			expectUptoDate:                 false,
 			expectEligibleForInPlaceUpdate: false,
+			expectOnlyCertificatesExpiring: true,
 			expectLogMessages:              []string{"certificates will expire soon, rolloutBefore expired"},
 			expectConditionMessages:        []string{"Certificates will expire soon"},
 		},
+		{
+			name: "certificate are expiring soon and rollout after expired",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Rollout.Before.CertificatesExpiryDays = 150                                    // rollout if certificates will expire in less then 150 days.
+				kcp.Spec.Rollout.After = metav1.Time{Time: reconciliationTime.Add(-1 * 24 * time.Hour)} // one day ago
+				return kcp
+			}(),
+			machine:                        defaultMachine, // certificates will expire in 100 days from now, created two days ago.
+			infraConfigs:                   defaultInfraConfigs,
+			machineConfigs:                 defaultMachineConfigs,
+			expectUptoDate:                 false,
+			expectEligibleForInPlaceUpdate: false,
+			expectOnlyCertificatesExpiring: false,
+			expectLogMessages:              []string{"certificates will expire soon, rolloutBefore expired", "rolloutAfter expired"},
+			expectConditionMessages:        []string{"Certificates will expire soon", "KubeadmControlPlane spec.rolloutAfter expired"},
+		},
+		{
+			name: "certificate are expiring soon but are being renewed in-place",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Rollout.Before.CertificatesExpiryDays = 150 // rollout if certificates will expire in less then 150 days.
+				return kcp
+			}(),
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy() // certificates will expire in 100 days from now.
+				machine.Annotations = map[string]string{
+					controlplanev1.CertificatesRenewalInProgressAnnotation: machine.Status.CertificatesExpiryDate.Format(time.RFC3339),
+				}
+				return machine
+			}(),
+			infraConfigs:                   defaultInfraConfigs,
+			machineConfigs:                 defaultMachineConfigs,
+			expectUptoDate:                 true,
+			expectEligibleForInPlaceUpdate: false,
+			expectLogMessages:              nil,
+			expectConditionMessages:        nil,
+		},
 		{
 			name: "rollout after expired",
 			kcp: func() *controlplanev1.KubeadmControlPlane {
@@ -1675,6 +1715,44 @@ func TestUpToDate(t *testing.T) {
 			expectLogMessages:              []string{"rolloutAfter expired"},
 			expectConditionMessages:        []string{"KubeadmControlPlane spec.rolloutAfter expired"},
 		},
+		{
+			name: "certificate authorities rotation in progress",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Status.CertificateAuthorityRotation = controlplanev1.KubeadmControlPlaneCertificateAuthorityRotationStatus{
+					Phase:          controlplanev1.CertificateAuthorityRotationAddingTrustPhase,
+					StartTime:      metav1.Time{Time: reconciliationTime.Add(-1 * 24 * time.Hour)}, // one day ago
+					PhaseStartTime: metav1.Time{Time: reconciliationTime.Add(-1 * 24 * time.Hour)}, // one day ago
+				}
+				return kcp
+			}(),
+			machine:                        defaultMachine, // created two days ago
+			infraConfigs:                   defaultInfraConfigs,
+			machineConfigs:                 defaultMachineConfigs,
+			expectUptoDate:                 false,
+			expectEligibleForInPlaceUpdate: false,
+			expectLogMessages:              []string{"certificate authorities rotation in progress"},
+			expectConditionMessages:        []string{"Certificate authorities rotation in progress"},
+		},
+		{
+			name: "certificate authorities rotation completed",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Status.CertificateAuthorityRotation = controlplanev1.KubeadmControlPlaneCertificateAuthorityRotationStatus{
+					Phase:          controlplanev1.CertificateAuthorityRotationCompletedPhase,
+					StartTime:      metav1.Time{Time: reconciliationTime.Add(-1 * 24 * time.Hour)}, // one day ago
+					PhaseStartTime: metav1.Time{Time: reconciliationTime.Add(-1 * 24 * time.Hour)}, // one day ago
+				}
+				return kcp
+			}(),
+			machine:                        defaultMachine, // created two days ago
+			infraConfigs:                   defaultInfraConfigs,
+			machineConfigs:                 defaultMachineConfigs,
+			expectUptoDate:                 true,
+			expectEligibleForInPlaceUpdate: false,
+			expectLogMessages:              nil,
+			expectConditionMessages:        nil,
+		},
 		{
 			name: "kubernetes version does not match",
 			kcp: func() *controlplanev1.KubeadmControlPlane {
@@ -1803,6 +1881,7 @@ func TestUpToDate(t *testing.T) {
 			g.Expect(upToDate).To(Equal(tt.expectUptoDate))
 			g.Expect(res).ToNot(BeNil())
 			g.Expect(res.EligibleForInPlaceUpdate).To(Equal(tt.expectEligibleForInPlaceUpdate))
+			g.Expect(res.OnlyCertificatesExpiring).To(Equal(tt.expectOnlyCertificatesExpiring))
 			g.Expect(res.DesiredMachine).ToNot(BeNil())
 			g.Expect(res.DesiredMachine.Spec.Version).To(Equal(tt.kcp.Spec.Version))
 			g.Expect(res.CurrentInfraMachine).ToNot(BeNil())
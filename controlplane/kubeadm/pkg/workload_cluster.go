@@ -17,6 +17,7 @@ limitations under the License.
 package pkg
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/rand"
@@ -24,6 +25,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
+	"io"
 	"math/big"
 	"reflect"
 	"time"
@@ -34,8 +36,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/retry"
+	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -79,8 +84,15 @@ type WorkloadCluster interface {
 	UpdateEncryptionAlgorithm(encryptionAlgorithm bootstrapv1.EncryptionAlgorithmType) func(*bootstrapv1.ClusterConfiguration)
 	UpdateKubeProxyImageInfo(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error
 	UpdateCoreDNS(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error
+	UpdateClusterInfoCertificateAuthority(ctx context.Context, caData []byte) error
+	GetNodesCreatedBefore(ctx context.Context, nodeNames []string, t time.Time) ([]string, error)
 	RemoveEtcdMember(ctx context.Context, m *etcd.Member, nodes []*Node) error
 	ForwardEtcdLeadership(ctx context.Context, fromMember, toMember string) error
+	SnapshotEtcd(ctx context.Context, w io.Writer) (int64, error)
+	EtcdMemberDBStatus(ctx context.Context, nodeName string) (*etcd.DBStatus, error)
+	DefragmentEtcdMember(ctx context.Context, nodeName string) error
+	DisarmEtcdAlarm(ctx context.Context, nodeName string, alarm etcd.MemberAlarm) error
+	IsEtcdRestoreCompleted(ctx context.Context, nodeName string) (bool, error)
 	EnsureKubeadmPermissions(ctx context.Context, version semver.Version) error
 	UpdateClusterConfiguration(ctx context.Context, version semver.Version, mutators ...func(*bootstrapv1.ClusterConfiguration)) error
 }
@@ -90,6 +102,7 @@ type Workload struct {
 	Client              client.Client
 	CoreDNSMigrator     coreDNSMigrator
 	etcdClientGenerator etcdClientFor
+	healthChecker       controlPlaneHealthChecker
 	restConfig          *rest.Config
 }
 
@@ -351,6 +364,63 @@ func staticPodName(component, nodeName string) string {
 	return fmt.Sprintf("%s-%s", component, nodeName)
 }
 
+// UpdateClusterInfoCertificateAuthority updates the certificate authority data in the kube-public/cluster-info ConfigMap,
+// which is used by kubeadm join to discover and trust the cluster.
+// Note: The signature of the ConfigMap used for token based discovery is updated by the bootstrap signer controller.
+func (w *Workload) UpdateClusterInfoCertificateAuthority(ctx context.Context, caData []byte) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		key := client.ObjectKey{Name: bootstrapapi.ConfigMapClusterInfo, Namespace: metav1.NamespacePublic}
+		configMap, err := w.getConfigMap(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		kubeconfig, err := clientcmd.Load([]byte(configMap.Data[bootstrapapi.KubeConfigKey]))
+		if err != nil {
+			return pkgerrors.Wrapf(err, "unable to decode %q in the %s ConfigMap", bootstrapapi.KubeConfigKey, bootstrapapi.ConfigMapClusterInfo)
+		}
+
+		changed := false
+		for _, cluster := range kubeconfig.Clusters {
+			if !bytes.Equal(cluster.CertificateAuthorityData, caData) {
+				cluster.CertificateAuthorityData = caData
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+
+		data, err := clientcmd.Write(*kubeconfig)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "unable to encode %q in the %s ConfigMap", bootstrapapi.KubeConfigKey, bootstrapapi.ConfigMapClusterInfo)
+		}
+		configMap.Data[bootstrapapi.KubeConfigKey] = string(data)
+		if err := w.Client.Update(ctx, configMap); err != nil {
+			return pkgerrors.Wrapf(err, "failed to update the %s ConfigMap", bootstrapapi.ConfigMapClusterInfo)
+		}
+		return nil
+	})
+}
+
+// GetNodesCreatedBefore returns the names of the Nodes, among the ones with the given names, created before the given time.
+// Note: Nodes which do not exist are ignored.
+func (w *Workload) GetNodesCreatedBefore(ctx context.Context, nodeNames []string, t time.Time) ([]string, error) {
+	nodes, err := ListTransformedNodes(ctx, w.Client)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Nodes")
+	}
+
+	names := sets.New(nodeNames...)
+	outdatedNodes := []string{}
+	for _, node := range nodes {
+		if names.Has(node.Name) && node.CreationTimestamp.Time.Before(t) {
+			outdatedNodes = append(outdatedNodes, node.Name)
+		}
+	}
+	return outdatedNodes, nil
+}
+
 // UpdateKubeProxyImageInfo updates kube-proxy image in the kube-proxy DaemonSet.
 func (w *Workload) UpdateKubeProxyImageInfo(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error {
 	// Return early if we've been asked to skip kube-proxy upgrades entirely.
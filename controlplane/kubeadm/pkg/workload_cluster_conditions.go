@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
@@ -124,8 +125,11 @@ func (w *Workload) updateManagedEtcdConditions(ctx context.Context, controlPlane
 	// Update etcd member healthy conditions for machines not provisioning or deleting.
 	// This is implemented by reading info about members and alarms from etcd.
 	machinesNotProvisioningOrDeleting := controlPlane.Machines.Filter(collections.And(collections.HasNode(), collections.Not(collections.HasDeletionTimestamp)))
-	currentMembers, etcdLeader, alarms, err := w.getCurrentEtcdMembersAndAlarms(ctx, machinesNotProvisioningOrDeleting, controlPlane.Nodes)
+	currentMembers, etcdLeader, alarms, etcdClient, err := w.getCurrentEtcdMembersAndAlarms(ctx, machinesNotProvisioningOrDeleting, controlPlane.Nodes)
 	if err == nil {
+		if etcdClient != nil {
+			defer etcdClient.Close()
+		}
 		controlPlane.EtcdMembers = currentMembers
 		controlPlane.EtcdMembersAlarms = alarms
 		controlPlane.EtcdLeader = etcdLeader
@@ -184,13 +188,36 @@ func (w *Workload) updateManagedEtcdConditions(ctx context.Context, controlPlane
 				continue
 			}
 
+			// Check the health of the etcd member endpoint, which requires the member to be connected to a cluster with quorum.
+			var msg string
+			if w.healthChecker != nil {
+				took, err := w.healthChecker.checkEtcdMemberHealth(ctx, etcdClient, machine.Status.NodeRef.Name)
+				if err != nil {
+					v1beta1conditions.MarkFalse(machine, controlplanev1.MachineEtcdMemberHealthyV1Beta1Condition, controlplanev1.EtcdMemberUnhealthyV1Beta1Reason, clusterv1.ConditionSeverityError, "Etcd member endpoint health check failed")
+
+					conditions.Set(machine, metav1.Condition{
+						Type:    controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition,
+						Status:  metav1.ConditionFalse,
+						Reason:  controlplanev1.KubeadmControlPlaneMachineEtcdMemberNotHealthyReason,
+						Message: fmt.Sprintf("Etcd member endpoint health check failed: %s", unwrapAll(err)),
+					})
+					continue
+				}
+				log.V(4).Info(fmt.Sprintf("Etcd member endpoint health check for Node %s took %s", machine.Status.NodeRef.Name, took))
+				// Note: The duration is rounded to avoid frequent condition changes.
+				if took > etcdMemberSlowHealthCheckThreshold {
+					msg = fmt.Sprintf("Etcd member endpoint health check took %s", took.Round(100*time.Millisecond))
+				}
+			}
+
 			// Otherwise consider the member healthy
 			v1beta1conditions.MarkTrue(machine, controlplanev1.MachineEtcdMemberHealthyV1Beta1Condition)
 
 			conditions.Set(machine, metav1.Condition{
-				Type:   controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition,
-				Status: metav1.ConditionTrue,
-				Reason: controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyReason,
+				Type:    controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyReason,
+				Message: msg,
 			})
 		}
 	} else {
@@ -242,12 +269,13 @@ func unwrapAll(err error) error {
 // getCurrentEtcdMembersAndAlarms returns the current list of etcd member and alarms.
 // Considering that the underlying etcd SDK calls (MemberList and AlarmList) requires quorum across all etcd members, it is possible
 // to run those calls towards any etcd Pod hosting an etcd member.
-func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines collections.Machines, nodes []*Node) ([]*etcd.Member, *etcd.Member, []etcd.MemberAlarm, error) {
+// If no error is returned, the etcd client used for those calls is returned as well, and it must be closed by the caller.
+func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines collections.Machines, nodes []*Node) ([]*etcd.Member, *etcd.Member, []etcd.MemberAlarm, *etcd.Client, error) {
 	// Get the list of nodes hosting an etcd member sorted by the last known etcd health,
 	// so the client generator in the following line will try to connect first to nodes with higher chance to answer.
 	nodeNames := getNodeNamesSortedByLastKnownEtcdHealth(nodes, machines)
 	if len(nodeNames) == 0 {
-		return nil, nil, nil, nil
+		return nil, nil, nil, nil, nil
 	}
 
 	// Create the etcd Client for one of the etcd Pods running on the given nodes.
@@ -263,10 +291,8 @@ func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines
 				Message: fmt.Sprintf("Failed to connect to etcd: %s", unwrapAll(err)),
 			})
 		}
-		return nil, nil, nil, pkgerrors.Wrapf(err, "failed to get an etcd client for %s Nodes", strings.Join(nodeNames, ","))
+		return nil, nil, nil, nil, pkgerrors.Wrapf(err, "failed to get an etcd client for %s Nodes", strings.Join(nodeNames, ","))
 	}
-	defer etcdClient.Close()
-
 	// Gets the list of etcd members in the cluster.
 	currentMembers, err := etcdClient.Members(ctx)
 	if err != nil {
@@ -280,7 +306,8 @@ func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines
 				Message: fmt.Sprintf("Failed to get etcd members: %s", unwrapAll(err)),
 			})
 		}
-		return nil, nil, nil, pkgerrors.Wrapf(err, "failed to get etcd members")
+		_ = etcdClient.Close()
+		return nil, nil, nil, nil, pkgerrors.Wrapf(err, "failed to get etcd members")
 	}
 
 	var etcdLeader *etcd.Member
@@ -291,7 +318,8 @@ func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines
 		}
 	}
 	if etcdLeader == nil {
-		return nil, nil, nil, pkgerrors.Errorf("failed to get etcd leader")
+		_ = etcdClient.Close()
+		return nil, nil, nil, nil, pkgerrors.Errorf("failed to get etcd leader")
 	}
 
 	// Gets the list of etcd alarms.
@@ -307,10 +335,11 @@ func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines
 				Message: fmt.Sprintf("Failed to get etcd alarms: %s", unwrapAll(err)),
 			})
 		}
-		return nil, nil, nil, pkgerrors.Wrapf(err, "failed to get etcd alarms")
+		_ = etcdClient.Close()
+		return nil, nil, nil, nil, pkgerrors.Wrapf(err, "failed to get etcd alarms")
 	}
 
-	return currentMembers, etcdLeader, alarms, nil
+	return currentMembers, etcdLeader, alarms, etcdClient, nil
 }
 
 // getNodeNamesSortedByLastKnownEtcdHealth return the list of nodes hosting an etcd member sorted by the last known etcd health.
@@ -518,6 +547,7 @@ func (w *Workload) UpdateStaticPodConditions(ctx context.Context, controlPlane *
 
 	// Update conditions for control plane components hosted as static pods on the nodes.
 	var kcpErrors []string
+	var apiServerHealthChecks []*apiServerHealthCheck
 
 	hasProvisioningMachines := false
 	for i := range controlPlane.Machines {
@@ -664,6 +694,9 @@ func (w *Workload) UpdateStaticPodConditions(ctx context.Context, controlPlane *
 
 		// Otherwise updates static pod based conditions reflecting the status of the underlying object generated by kubeadm.
 		w.updateStaticPodCondition(ctx, machine, node, "kube-apiserver", controlplanev1.MachineAPIServerPodHealthyV1Beta1Condition, controlplanev1.KubeadmControlPlaneMachineAPIServerPodHealthyCondition)
+		if check := w.newAPIServerHealthCheck(ctx, machine, node); check != nil {
+			apiServerHealthChecks = append(apiServerHealthChecks, check)
+		}
 		w.updateStaticPodCondition(ctx, machine, node, "kube-controller-manager", controlplanev1.MachineControllerManagerPodHealthyV1Beta1Condition, controlplanev1.KubeadmControlPlaneMachineControllerManagerPodHealthyCondition)
 		w.updateStaticPodCondition(ctx, machine, node, "kube-scheduler", controlplanev1.MachineSchedulerPodHealthyV1Beta1Condition, controlplanev1.KubeadmControlPlaneMachineSchedulerPodHealthyCondition)
 		if controlPlane.IsEtcdManaged() {
@@ -674,6 +707,10 @@ func (w *Workload) UpdateStaticPodConditions(ctx context.Context, controlPlane *
 		w.updateNodeCondition(controlPlane, machine, node)
 	}
 
+	// Check the health endpoints of kube-apiservers concurrently, so a slow or unresponsive kube-apiserver
+	// does not delay checks for the other ones.
+	w.updateAPIServerHealthConditions(ctx, apiServerHealthChecks)
+
 	// If there are no provisioning machines, check for control plane nodes
 	// without a corresponding machine and surface issues at KCP level.
 	if !hasProvisioningMachines {
@@ -969,6 +1006,64 @@ func (w *Workload) updateStaticPodCondition(ctx context.Context, machine *cluste
 	}
 }
 
+// apiServerHealthCheck is a check of the /livez and /readyz endpoints of the kube-apiserver hosted on a Machine.
+type apiServerHealthCheck struct {
+	machine  *clusterv1.Machine
+	nodeName string
+	port     int
+	err      error
+}
+
+// newAPIServerHealthCheck returns a health check for a kube-apiserver whose static pod is reported as healthy, because
+// a running kube-apiserver can still fail to serve requests, e.g. when it cannot reach etcd.
+// This operation is best effort, in the sense that in case of problems in retrieving the pod, no check is returned.
+func (w *Workload) newAPIServerHealthCheck(ctx context.Context, machine *clusterv1.Machine, node *Node) *apiServerHealthCheck {
+	if w.healthChecker == nil || !conditions.IsTrue(machine, controlplanev1.KubeadmControlPlaneMachineAPIServerPodHealthyCondition) {
+		return nil
+	}
+
+	pod, err := GetTransformedPod(ctx, w.Client, ctrlclient.ObjectKey{
+		Namespace: metav1.NamespaceSystem,
+		Name:      staticPodName("kube-apiserver", node.Name),
+	})
+	if err != nil {
+		return nil
+	}
+
+	return &apiServerHealthCheck{
+		machine:  machine,
+		nodeName: node.Name,
+		port:     apiServerBindPort(pod),
+	}
+}
+
+// updateAPIServerHealthConditions runs the given kube-apiserver health checks concurrently and marks
+// the APIServerPodHealthy condition as false on Machines whose kube-apiserver failed the check.
+func (w *Workload) updateAPIServerHealthConditions(ctx context.Context, checks []*apiServerHealthCheck) {
+	var wg sync.WaitGroup
+	for _, check := range checks {
+		wg.Go(func() {
+			check.err = w.healthChecker.checkAPIServerHealth(ctx, check.nodeName, check.port)
+		})
+	}
+	wg.Wait()
+
+	for _, check := range checks {
+		if check.err == nil {
+			continue
+		}
+
+		v1beta1conditions.MarkFalse(check.machine, controlplanev1.MachineAPIServerPodHealthyV1Beta1Condition, controlplanev1.PodHealthCheckFailedV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", check.err)
+
+		conditions.Set(check.machine, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneMachineAPIServerPodHealthyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  controlplanev1.KubeadmControlPlaneMachinePodHealthCheckFailedReason,
+			Message: check.err.Error(),
+		})
+	}
+}
+
 func nodeReadyUnknown(node *Node) bool {
 	for _, condition := range node.Status.Conditions {
 		if condition.Type == corev1.NodeReady {
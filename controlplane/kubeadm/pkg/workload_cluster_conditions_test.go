@@ -165,6 +165,7 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 		nodes                                     []*Node
 		nodeListError                             error
 		injectEtcdClientGenerator                 etcdClientFor // This test is injecting a fake etcdClientGenerator because it is required to nodes with a controlled Status or to fail with a specific error.
+		injectHealthChecker                       controlPlaneHealthChecker
 		expectedKCPV1Beta1Condition               *clusterv1.Condition
 		expectedKCPCondition                      *metav1.Condition
 		expectedMachineV1Beta1Conditions          map[string]clusterv1.Conditions
@@ -571,6 +572,72 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 			expectedEtcdMembersAndMachinesAreMatching: true,
 			expectedEtcdLeader:                        &etcd.Member{ClusterID: 1, ID: uint64(1), Name: "n1"},
 		},
+		{
+			name: "etcd members failing the endpoint health check should report false",
+			machines: []*clusterv1.Machine{
+				fakeMachine("m1", withNodeRef("n1")),
+				fakeMachine("m2", withNodeRef("n2")),
+			},
+			nodes: []*Node{
+				fakeNode("n1"),
+				fakeNode("n2"),
+			},
+			injectEtcdClientGenerator: &fakeEtcdClientGenerator{
+				client: &etcd.Client{
+					EtcdClient: &fake2.FakeEtcdClient{
+						EtcdEndpoints: []string{},
+						MemberListResponse: &clientv3.MemberListResponse{
+							Header: &pb.ResponseHeader{
+								ClusterId: uint64(1),
+							},
+							Members: []*pb.Member{
+								{Name: "n1", ID: uint64(1)},
+								{Name: "n2", ID: uint64(2)},
+							},
+						},
+						AlarmResponse: &clientv3.AlarmResponse{
+							Alarms: []*pb.AlarmMember{},
+						},
+					},
+					LeaderID: uint64(1),
+				},
+			},
+			injectHealthChecker: &fakeHealthChecker{
+				etcdMemberHealthCheckDurations: map[string]time.Duration{
+					"n1": 1234 * time.Millisecond,
+				},
+				etcdMemberHealthCheckErrors: map[string]error{
+					"n2": pkgerrors.New("etcdserver: no leader"),
+				},
+			},
+			expectedKCPV1Beta1Condition: v1beta1conditions.FalseCondition(controlplanev1.EtcdClusterHealthyV1Beta1Condition, controlplanev1.EtcdClusterUnhealthyV1Beta1Reason, clusterv1.ConditionSeverityError, "Following Machines are reporting etcd member errors: %s", "m2"),
+			expectedMachineV1Beta1Conditions: map[string]clusterv1.Conditions{
+				"m1": {
+					*v1beta1conditions.TrueCondition(controlplanev1.MachineEtcdMemberHealthyV1Beta1Condition),
+				},
+				"m2": {
+					*v1beta1conditions.FalseCondition(controlplanev1.MachineEtcdMemberHealthyV1Beta1Condition, controlplanev1.EtcdMemberUnhealthyV1Beta1Reason, clusterv1.ConditionSeverityError, "Etcd member endpoint health check failed"),
+				},
+			},
+			expectedKCPCondition: &metav1.Condition{
+				Type:   controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition,
+				Status: metav1.ConditionFalse,
+				Reason: controlplanev1.KubeadmControlPlaneEtcdClusterNotHealthyReason,
+				Message: "* Machine m2:\n" +
+					"  * EtcdMemberHealthy: Etcd member endpoint health check failed: etcdserver: no leader",
+			},
+			expectedMachineConditions: map[string][]metav1.Condition{
+				"m1": {
+					{Type: controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition, Status: metav1.ConditionTrue, Reason: controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyReason, Message: "Etcd member endpoint health check took 1.2s"},
+				},
+				"m2": {
+					{Type: controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition, Status: metav1.ConditionFalse, Reason: controlplanev1.KubeadmControlPlaneMachineEtcdMemberNotHealthyReason, Message: "Etcd member endpoint health check failed: etcdserver: no leader"},
+				},
+			},
+			expectedEtcdMembers:                       []string{"n1", "n2"},
+			expectedEtcdMembersAndMachinesAreMatching: true,
+			expectedEtcdLeader:                        &etcd.Member{ClusterID: 1, ID: uint64(1), Name: "n1"},
+		},
 		{
 			name: "etcd members without a name when there are no provisioning machines should be reported",
 			machines: []*clusterv1.Machine{
@@ -670,6 +737,7 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 			}
 			w := &Workload{
 				etcdClientGenerator: tt.injectEtcdClientGenerator,
+				healthChecker:       tt.injectHealthChecker,
 			}
 			controlPane := &ControlPlane{
 				KCP:           tt.kcp,
@@ -781,6 +849,7 @@ func TestUpdateStaticPodConditions(t *testing.T) {
 		machines                         []*clusterv1.Machine
 		kubeadmConfigs                   map[string]*bootstrapv1.KubeadmConfig
 		injectClient                     client.Client // This test is injecting a fake client because it is required to create nodes with a controlled Status or to fail with a specific error.
+		injectHealthChecker              controlPlaneHealthChecker
 		expectedKCPV1Beta1Condition      *clusterv1.Condition
 		expectedKCPCondition             metav1.Condition
 		expectedMachineConditions        map[string][]metav1.Condition
@@ -1292,6 +1361,69 @@ func TestUpdateStaticPodConditions(t *testing.T) {
 			},
 			expectedNodes: []string{"n1"}, // node from list
 		},
+		{
+			name: "Should surface control plane components failing health checks",
+			machines: []*clusterv1.Machine{
+				fakeMachine("m1", withNodeRef("n1")),
+			},
+			kubeadmConfigs: map[string]*bootstrapv1.KubeadmConfig{
+				"m1": {}, // A default kubeadm config requires the control plane taint
+			},
+			injectClient: &fakeClient{
+				list: &corev1.NodeList{
+					Items: []corev1.Node{*fakeCoreV1Node("n1", withControlPlaneTaint())},
+				},
+				get: map[string]interface{}{
+					n1APIServerPodKey: fakePod(n1APIServerPodName,
+						withPhase(corev1.PodRunning),
+						withCondition(corev1.PodReady, corev1.ConditionTrue),
+					),
+					n1ControllerManagerPodNKey: fakePod(n1ControllerManagerPodName,
+						withPhase(corev1.PodRunning),
+						withCondition(corev1.PodReady, corev1.ConditionTrue),
+					),
+					n1SchedulerPodKey: fakePod(n1SchedulerPodName,
+						withPhase(corev1.PodRunning),
+						withCondition(corev1.PodReady, corev1.ConditionTrue),
+					),
+					n1EtcdPodKey: fakePod(n1EtcdPodName,
+						withPhase(corev1.PodRunning),
+						withCondition(corev1.PodReady, corev1.ConditionTrue),
+					),
+				},
+			},
+			injectHealthChecker: &fakeHealthChecker{
+				apiServerHealthCheckErrors: map[string]error{
+					"n1": pkgerrors.New("/readyz check failed: failed checks: etcd"),
+				},
+			},
+			expectedKCPV1Beta1Condition: v1beta1conditions.FalseCondition(controlplanev1.ControlPlaneComponentsHealthyV1Beta1Condition, controlplanev1.ControlPlaneComponentsUnhealthyV1Beta1Reason, clusterv1.ConditionSeverityError, "Following Machines are reporting control plane errors: %s", "m1"),
+			expectedMachineV1Beta1Conditions: map[string]clusterv1.Conditions{
+				"m1": {
+					*v1beta1conditions.FalseCondition(controlplanev1.MachineAPIServerPodHealthyV1Beta1Condition, controlplanev1.PodHealthCheckFailedV1Beta1Reason, clusterv1.ConditionSeverityError, "/readyz check failed: failed checks: etcd"),
+					*v1beta1conditions.TrueCondition(controlplanev1.MachineControllerManagerPodHealthyV1Beta1Condition),
+					*v1beta1conditions.TrueCondition(controlplanev1.MachineSchedulerPodHealthyV1Beta1Condition),
+					*v1beta1conditions.TrueCondition(controlplanev1.MachineEtcdPodHealthyV1Beta1Condition),
+				},
+			},
+			expectedKCPCondition: metav1.Condition{
+				Type:   controlplanev1.KubeadmControlPlaneControlPlaneComponentsHealthyCondition,
+				Status: metav1.ConditionFalse,
+				Reason: controlplanev1.KubeadmControlPlaneControlPlaneComponentsNotHealthyReason,
+				Message: "* Machine m1:\n" +
+					"  * APIServerPodHealthy: /readyz check failed: failed checks: etcd",
+			},
+			expectedMachineConditions: map[string][]metav1.Condition{
+				"m1": {
+					{Type: controlplanev1.KubeadmControlPlaneMachineNodeKubeadmLabelsAndTaintsSetCondition, Status: metav1.ConditionTrue, Reason: controlplanev1.KubeadmControlPlaneMachineNodeKubeadmLabelsAndTaintsSetReason, Message: ""},
+					{Type: controlplanev1.KubeadmControlPlaneMachineAPIServerPodHealthyCondition, Status: metav1.ConditionFalse, Reason: controlplanev1.KubeadmControlPlaneMachinePodHealthCheckFailedReason, Message: "/readyz check failed: failed checks: etcd"},
+					{Type: controlplanev1.KubeadmControlPlaneMachineControllerManagerPodHealthyCondition, Status: metav1.ConditionTrue, Reason: controlplanev1.KubeadmControlPlaneMachinePodRunningReason, Message: ""},
+					{Type: controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition, Status: metav1.ConditionTrue, Reason: controlplanev1.KubeadmControlPlaneMachinePodRunningReason, Message: ""},
+					{Type: controlplanev1.KubeadmControlPlaneMachineSchedulerPodHealthyCondition, Status: metav1.ConditionTrue, Reason: controlplanev1.KubeadmControlPlaneMachinePodRunningReason, Message: ""},
+				},
+			},
+			expectedNodes: []string{"n1"}, // node from list
+		},
 		{
 			name: "Should surface control plane components health with external etcd",
 			kcp: &controlplanev1.KubeadmControlPlane{
@@ -1368,7 +1500,8 @@ func TestUpdateStaticPodConditions(t *testing.T) {
 				tt.kcp = &controlplanev1.KubeadmControlPlane{}
 			}
 			w := &Workload{
-				Client: tt.injectClient,
+				Client:        tt.injectClient,
+				healthChecker: tt.injectHealthChecker,
 			}
 			controlPane := &ControlPlane{
 				KCP:            tt.kcp,
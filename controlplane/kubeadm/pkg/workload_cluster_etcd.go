@@ -18,6 +18,7 @@ package pkg
 
 import (
 	"context"
+	"io"
 
 	pkgerrors "github.com/pkg/errors"
 
@@ -122,6 +123,82 @@ func (w *Workload) ForwardEtcdLeadership(ctx context.Context, fromMember, toMemb
 	return nil
 }
 
+// SnapshotEtcd streams a snapshot of the etcd cluster into w, reading it from the first
+// control plane node hosting a reachable etcd member.
+// It returns the number of bytes written.
+func (w *Workload) SnapshotEtcd(ctx context.Context, wr io.Writer) (int64, error) {
+	nodes, err := w.getNodesWithControlPlaneLabel(ctx)
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to list control plane nodes")
+	}
+	nodeNames := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		nodeNames = append(nodeNames, n.Name)
+	}
+
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, nodeNames)
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	return etcdClient.Snapshot(ctx, wr)
+}
+
+// EtcdMemberDBStatus returns the size of the backend database of the etcd member hosted on the given node.
+func (w *Workload) EtcdMemberDBStatus(ctx context.Context, nodeName string) (*etcd.DBStatus, error) {
+	// Note: This works on the assumption that member name is equal to the node name (kubeadm).
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	return etcdClient.DBStatus(ctx)
+}
+
+// DefragmentEtcdMember defragments the backend database of the etcd member hosted on the given node.
+// Note: The etcd member does not serve requests while it is being defragmented; it is a responsibility of the caller
+// to defragment one member at a time.
+func (w *Workload) DefragmentEtcdMember(ctx context.Context, nodeName string) error {
+	// Note: This works on the assumption that member name is equal to the node name (kubeadm).
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	return etcdClient.Defragment(ctx)
+}
+
+// DisarmEtcdAlarm disarms an alarm raised by the etcd member hosted on the given node.
+func (w *Workload) DisarmEtcdAlarm(ctx context.Context, nodeName string, alarm etcd.MemberAlarm) error {
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	return etcdClient.DisarmAlarm(ctx, alarm)
+}
+
+// IsEtcdRestoreCompleted returns true if etcd has been restored from a snapshot on the control plane Node with
+// the given name, i.e. the etcd cluster has a single member, hosted on the Node.
+func (w *Workload) IsEtcdRestoreCompleted(ctx context.Context, nodeName string) (bool, error) {
+	// Note: This works on the assumption that member name is equal to the node name (kubeadm).
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+	if err != nil {
+		return false, pkgerrors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	members, err := etcdClient.Members(ctx)
+	if err != nil {
+		return false, pkgerrors.Wrap(err, "failed to list etcd members using etcd client")
+	}
+	return len(members) == 1 && members[0].Name == nodeName, nil
+}
+
 // EtcdMemberStatus contains status information for a single etcd member.
 type EtcdMemberStatus struct {
 	Name       string
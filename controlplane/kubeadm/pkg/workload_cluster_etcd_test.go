@@ -464,6 +464,49 @@ func TestForwardEtcdLeadership(t *testing.T) {
 	})
 }
 
+func TestDefragmentEtcdMember(t *testing.T) {
+	g := NewWithT(t)
+
+	etcdClient := &fake2.FakeEtcdClient{
+		StatusResponse: &clientv3.StatusResponse{
+			DbSize:      100,
+			DbSizeInUse: 40,
+		},
+		DefragmentResponse:  &clientv3.DefragmentResponse{},
+		AlarmDisarmResponse: &clientv3.AlarmResponse{},
+	}
+	var nodeNames []string
+	etcdClientGenerator := &fakeEtcdClientGenerator{
+		clientFunc: func(n []string) (*etcd.Client, error) {
+			nodeNames = n
+			return &etcd.Client{
+				EtcdClient:  etcdClient,
+				Endpoint:    "etcd-m1",
+				CallTimeout: etcd.DefaultCallTimeout,
+			}, nil
+		},
+	}
+
+	w := &Workload{
+		etcdClientGenerator: etcdClientGenerator,
+	}
+
+	status, err := w.EtcdMemberDBStatus(ctx, "m1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(status.DBSize).To(Equal(int64(100)))
+	g.Expect(status.DBSizeInUse).To(Equal(int64(40)))
+	g.Expect(nodeNames).To(ConsistOf("m1"))
+
+	g.Expect(w.DefragmentEtcdMember(ctx, "m1")).To(Succeed())
+	g.Expect(etcdClient.DefragmentedMember).To(Equal("etcd-m1"))
+
+	g.Expect(w.DisarmEtcdAlarm(ctx, "m1", etcd.MemberAlarm{MemberID: 1, Type: etcd.AlarmNoSpace})).To(Succeed())
+	g.Expect(etcdClient.DisarmedAlarm.MemberID).To(Equal(uint64(1)))
+
+	w.etcdClientGenerator = &fakeEtcdClientGenerator{err: pkgerrors.New("no etcdClient")}
+	g.Expect(w.DefragmentEtcdMember(ctx, "m1")).ToNot(Succeed())
+}
+
 type fakeEtcdClientGenerator struct {
 	client     *etcd.Client
 	clientFunc func([]string) (*etcd.Client, error)
@@ -476,3 +519,48 @@ func (c *fakeEtcdClientGenerator) forFirstAvailableNode(_ context.Context, n []s
 	}
 	return c.client, c.err
 }
+
+func TestIsEtcdRestoreCompleted(t *testing.T) {
+	tests := []struct {
+		name          string
+		members       []*pb.Member
+		wantCompleted bool
+	}{
+		{
+			name:    "Not completed if etcd has more than one member",
+			members: []*pb.Member{{Name: "node-1", ID: 1}, {Name: "node-2", ID: 2}},
+		},
+		{
+			name:    "Not completed if the etcd member is not hosted on the Node",
+			members: []*pb.Member{{Name: "node-2", ID: 2}},
+		},
+		{
+			name:          "Completed if etcd has a single member hosted on the Node",
+			members:       []*pb.Member{{Name: "node-1", ID: 1}},
+			wantCompleted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			w := &Workload{
+				etcdClientGenerator: &fakeEtcdClientGenerator{
+					client: &etcd.Client{
+						EtcdClient: &fake2.FakeEtcdClient{
+							MemberListResponse: &clientv3.MemberListResponse{
+								Members: tt.members,
+							},
+						},
+						CallTimeout: etcd.DefaultCallTimeout,
+					},
+				},
+			}
+
+			completed, err := w.IsEtcdRestoreCompleted(ctx, "node-1")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(completed).To(Equal(tt.wantCompleted))
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkg
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/proxy"
+)
+
+const (
+	// apiServerHealthCheckTimeout is the timeout for checking the health endpoints of a kube-apiserver.
+	// Note: kube-apiservers are checked concurrently, so this is also the upper bound for checking all of them.
+	apiServerHealthCheckTimeout = 5 * time.Second
+
+	// etcdMemberSlowHealthCheckThreshold is the duration of the etcd member endpoint health check
+	// above which the duration is surfaced in the EtcdMemberHealthy condition.
+	etcdMemberSlowHealthCheckThreshold = time.Second
+
+	// apiServerAdvertiseAddressEndpointAnnotation is set by kubeadm on the kube-apiserver static pod with
+	// the address and the port the kube-apiserver is listening on.
+	apiServerAdvertiseAddressEndpointAnnotation = "kubeadm.kubernetes.io/kube-apiserver.advertise-address.endpoint"
+
+	// defaultAPIServerBindPort is the port the kube-apiserver is listening on by default.
+	defaultAPIServerBindPort = 6443
+
+	// apiServerServerName is a host name always included in the kube-apiserver serving certificate.
+	apiServerServerName = "kubernetes"
+)
+
+// controlPlaneHealthChecker checks the health of control plane components hosted on a Node
+// by calling their health endpoints.
+type controlPlaneHealthChecker interface {
+	checkAPIServerHealth(ctx context.Context, nodeName string, port int) error
+	checkEtcdMemberHealth(ctx context.Context, etcdClient *etcd.Client, nodeName string) (time.Duration, error)
+}
+
+// endpointHealthChecker checks the health of control plane components through port-forwards via the
+// API server of the workload cluster, the same used to connect to etcd members.
+type endpointHealthChecker struct {
+	restConfig          *rest.Config
+	etcdClientGenerator etcdClientFor
+}
+
+// checkAPIServerHealth calls the /livez and /readyz endpoints of the kube-apiserver hosted on the given Node.
+func (c *endpointHealthChecker) checkAPIServerHealth(ctx context.Context, nodeName string, port int) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, apiServerHealthCheckTimeout, pkgerrors.New("health check timeout expired"))
+	defer cancel()
+
+	dialer, err := proxy.NewDialer(proxy.Proxy{
+		Kind:       "pods",
+		Namespace:  metav1.NamespaceSystem,
+		KubeConfig: rest.CopyConfig(c.restConfig),
+		Port:       port,
+	})
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to create a dialer")
+	}
+
+	// Connections are established through a port-forward to the kube-apiserver Pod, while authentication
+	// and verification of the serving certificate use the same configuration used to connect to the workload cluster.
+	podName := staticPodName("kube-apiserver", nodeName)
+	config := rest.CopyConfig(c.restConfig)
+	config.Host = "https://" + apiServerServerName
+	config.APIPath = ""
+	config.TLSClientConfig.ServerName = apiServerServerName
+	config.Dial = func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return dialer.DialContext(ctx, "tcp", podName)
+	}
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to create an HTTP client")
+	}
+	defer httpClient.CloseIdleConnections()
+
+	for _, path := range []string{"/livez", "/readyz"} {
+		if err := checkHealthEndpoint(ctx, httpClient, config.Host+path); err != nil {
+			return pkgerrors.Wrapf(err, "%s check failed", path)
+		}
+	}
+	return nil
+}
+
+// checkHealthEndpoint calls a Kubernetes health endpoint, returning an error with the names of
+// the failed checks if the endpoint does not report success.
+func checkHealthEndpoint(ctx context.Context, httpClient *http.Client, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if failedChecks := failedHealthChecks(body); len(failedChecks) > 0 {
+		return pkgerrors.Errorf("failed checks: %s", strings.Join(failedChecks, ", "))
+	}
+	return pkgerrors.Errorf("unexpected status code %d", resp.StatusCode)
+}
+
+// failedHealthChecks returns the names of the failed checks from the response of a Kubernetes health endpoint,
+// e.g. "etcd" from "[-]etcd failed: reason withheld".
+func failedHealthChecks(body []byte) []string {
+	var failedChecks []string
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "[-]") {
+			continue
+		}
+		name, _, _ := strings.Cut(strings.TrimPrefix(line, "[-]"), " ")
+		failedChecks = append(failedChecks, name)
+	}
+	return failedChecks
+}
+
+// checkEtcdMemberHealth checks the health of the etcd member hosted on the given Node, returning the duration of the check.
+// The given etcd client is reused if it is connected to the etcd member hosted on the Node, otherwise a new one is created.
+func (c *endpointHealthChecker) checkEtcdMemberHealth(ctx context.Context, etcdClient *etcd.Client, nodeName string) (time.Duration, error) {
+	if etcdClient == nil || etcdClient.Endpoint != staticPodName("etcd", nodeName) {
+		var err error
+		etcdClient, err = c.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+		if err != nil {
+			return 0, err
+		}
+		defer etcdClient.Close()
+	}
+
+	return etcdClient.Health(ctx)
+}
+
+// apiServerBindPort returns the port the kube-apiserver hosted in the given Pod is listening on.
+func apiServerBindPort(pod *Pod) int {
+	endpoint, ok := pod.Annotations[apiServerAdvertiseAddressEndpointAnnotation]
+	if !ok {
+		return defaultAPIServerBindPort
+	}
+	_, portString, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return defaultAPIServerBindPort
+	}
+	port, err := strconv.Atoi(portString)
+	if err != nil || port <= 0 {
+		return defaultAPIServerBindPort
+	}
+	return port
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd"
+	fake2 "sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd/fake"
+)
+
+type fakeHealthChecker struct {
+	apiServerHealthCheckErrors     map[string]error
+	etcdMemberHealthCheckDurations map[string]time.Duration
+	etcdMemberHealthCheckErrors    map[string]error
+}
+
+func (f *fakeHealthChecker) checkAPIServerHealth(_ context.Context, nodeName string, _ int) error {
+	return f.apiServerHealthCheckErrors[nodeName]
+}
+
+func (f *fakeHealthChecker) checkEtcdMemberHealth(_ context.Context, _ *etcd.Client, nodeName string) (time.Duration, error) {
+	return f.etcdMemberHealthCheckDurations[nodeName], f.etcdMemberHealthCheckErrors[nodeName]
+}
+
+func TestCheckHealthEndpoint(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		body        string
+		expectedErr string
+	}{
+		{
+			name:       "healthy endpoint",
+			statusCode: http.StatusOK,
+			body:       "ok",
+		},
+		{
+			name:       "unhealthy endpoint reports failed checks",
+			statusCode: http.StatusInternalServerError,
+			body: "[+]ping ok\n" +
+				"[-]etcd failed: reason withheld\n" +
+				"[+]poststarthook/start-apiextensions-controllers ok\n" +
+				"[-]poststarthook/rbac/bootstrap-roles failed: not finished\n" +
+				"readyz check failed\n",
+			expectedErr: "failed checks: etcd, poststarthook/rbac/bootstrap-roles",
+		},
+		{
+			name:        "unhealthy endpoint without failed checks",
+			statusCode:  http.StatusForbidden,
+			body:        "forbidden",
+			expectedErr: "unexpected status code 403",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			err := checkHealthEndpoint(ctx, server.Client(), server.URL+"/readyz")
+			if tt.expectedErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(tt.expectedErr))
+		})
+	}
+}
+
+func TestAPIServerBindPort(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		expectedPort int
+	}{
+		{
+			name:         "default port without annotation",
+			expectedPort: defaultAPIServerBindPort,
+		},
+		{
+			name:         "port from annotation",
+			annotations:  map[string]string{apiServerAdvertiseAddressEndpointAnnotation: "10.0.0.1:8443"},
+			expectedPort: 8443,
+		},
+		{
+			name:         "port from annotation with an IPv6 address",
+			annotations:  map[string]string{apiServerAdvertiseAddressEndpointAnnotation: "[fd00::1]:9443"},
+			expectedPort: 9443,
+		},
+		{
+			name:         "default port with an invalid annotation",
+			annotations:  map[string]string{apiServerAdvertiseAddressEndpointAnnotation: "10.0.0.1"},
+			expectedPort: defaultAPIServerBindPort,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pod := &Pod{ObjectMeta: ObjectMeta{Annotations: tt.annotations}}
+			g.Expect(apiServerBindPort(pod)).To(Equal(tt.expectedPort))
+		})
+	}
+}
+
+func TestCheckEtcdMemberHealth(t *testing.T) {
+	tests := []struct {
+		name            string
+		etcdClient      *etcd.Client
+		nodeName        string
+		expectNewClient bool
+		expectErr       bool
+	}{
+		{
+			name: "reuses the etcd client connected to the member hosted on the Node",
+			etcdClient: &etcd.Client{
+				EtcdClient:  &fake2.FakeEtcdClient{},
+				Endpoint:    staticPodName("etcd", "cp1"),
+				CallTimeout: time.Second,
+			},
+			nodeName:        "cp1",
+			expectNewClient: false,
+		},
+		{
+			name: "creates an etcd client when the given one is connected to another member",
+			etcdClient: &etcd.Client{
+				EtcdClient:  &fake2.FakeEtcdClient{GetError: pkgerrors.New("unreachable")},
+				Endpoint:    staticPodName("etcd", "cp1"),
+				CallTimeout: time.Second,
+			},
+			nodeName:        "cp2",
+			expectNewClient: true,
+		},
+		{
+			name:            "creates an etcd client when no client is given",
+			nodeName:        "cp2",
+			expectNewClient: true,
+		},
+		{
+			name: "reports errors from the etcd member",
+			etcdClient: &etcd.Client{
+				EtcdClient:  &fake2.FakeEtcdClient{GetError: pkgerrors.New("unreachable")},
+				Endpoint:    staticPodName("etcd", "cp1"),
+				CallTimeout: time.Second,
+			},
+			nodeName:  "cp1",
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			newClient := false
+			checker := &endpointHealthChecker{
+				etcdClientGenerator: &fakeEtcdClientGenerator{
+					clientFunc: func(nodeNames []string) (*etcd.Client, error) {
+						g.Expect(nodeNames).To(ConsistOf(tt.nodeName))
+						newClient = true
+						return &etcd.Client{
+							EtcdClient:  &fake2.FakeEtcdClient{},
+							Endpoint:    staticPodName("etcd", tt.nodeName),
+							CallTimeout: time.Second,
+						}, nil
+					},
+				},
+			}
+
+			_, err := checker.checkEtcdMemberHealth(ctx, tt.etcdClient, tt.nodeName)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(newClient).To(Equal(tt.expectNewClient))
+		})
+	}
+}
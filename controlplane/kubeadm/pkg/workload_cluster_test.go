@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/google/go-cmp/cmp"
@@ -28,6 +29,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -849,6 +852,75 @@ func TestHasKubeadmConfig(t *testing.T) {
 	}
 }
 
+func TestUpdateClusterInfoCertificateAuthority(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterInfoKubeconfig := `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: b2xkLWNh
+    server: https://test-cluster-api:6443
+  name: ""
+contexts: null
+current-context: ""
+kind: Config
+preferences: {}
+users: null
+`
+	clusterInfo := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootstrapapi.ConfigMapClusterInfo,
+			Namespace: metav1.NamespacePublic,
+		},
+		Data: map[string]string{
+			bootstrapapi.KubeConfigKey: clusterInfoKubeconfig,
+			"jws-kubeconfig-abcdef":    "signature",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(clusterInfo).Build()
+	w := &Workload{
+		Client: fakeClient,
+	}
+
+	caData := []byte("new-ca\nold-ca")
+	g.Expect(w.UpdateClusterInfoCertificateAuthority(ctx, caData)).To(Succeed())
+
+	actual := &corev1.ConfigMap{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(clusterInfo), actual)).To(Succeed())
+	kubeconfig, err := clientcmd.Load([]byte(actual.Data[bootstrapapi.KubeConfigKey]))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(kubeconfig.Clusters).To(HaveLen(1))
+	g.Expect(kubeconfig.Clusters[""].CertificateAuthorityData).To(Equal(caData))
+	g.Expect(kubeconfig.Clusters[""].Server).To(Equal("https://test-cluster-api:6443"))
+	g.Expect(actual.Data).To(HaveKeyWithValue("jws-kubeconfig-abcdef", "signature"))
+
+	// Updating again with the same data is a no-op.
+	resourceVersion := actual.ResourceVersion
+	g.Expect(w.UpdateClusterInfoCertificateAuthority(ctx, caData)).To(Succeed())
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(clusterInfo), actual)).To(Succeed())
+	g.Expect(actual.ResourceVersion).To(Equal(resourceVersion))
+}
+
+func TestGetNodesCreatedBefore(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now().Truncate(time.Second)
+	node := func(name string, creationTimestamp time.Time) client.Object {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(creationTimestamp)}}
+	}
+	w := &Workload{
+		Client: fake.NewClientBuilder().WithObjects(
+			node("old", now.Add(-time.Hour)),
+			node("new", now.Add(time.Minute)),
+			node("other", now.Add(-time.Hour)),
+		).Build(),
+	}
+
+	nodes, err := w.GetNodesCreatedBefore(ctx, []string{"old", "new", "missing"}, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(nodes).To(ConsistOf("old"))
+}
+
 func TestUpdateFeatureGatesInKubeadmConfigMap(t *testing.T) {
 	tests := []struct {
 		name                     string
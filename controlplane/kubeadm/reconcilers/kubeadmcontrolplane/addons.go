@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	pkgerrors "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/util/patch"
+	"sigs.k8s.io/cluster-api/util/version"
+)
+
+// controlPlaneAddon is a cluster-critical addon that KCP upgrades together with the control plane,
+// unless the addon is managed by an UpgradeControlPlaneAddons extension.
+type controlPlaneAddon struct {
+	name    runtimehooksv1.ControlPlaneAddon
+	upgrade func(ctx context.Context, workloadCluster pkg.WorkloadCluster, kcp *controlplanev1.KubeadmControlPlane) error
+}
+
+// controlPlaneAddons is the list of addons KCP upgrades by default.
+var controlPlaneAddons = []controlPlaneAddon{
+	{
+		name: runtimehooksv1.KubeProxyControlPlaneAddon,
+		upgrade: func(ctx context.Context, workloadCluster pkg.WorkloadCluster, kcp *controlplanev1.KubeadmControlPlane) error {
+			return pkgerrors.Wrap(workloadCluster.UpdateKubeProxyImageInfo(ctx, kcp), "failed to update kube-proxy daemonset")
+		},
+	},
+	{
+		name: runtimehooksv1.CoreDNSControlPlaneAddon,
+		upgrade: func(ctx context.Context, workloadCluster pkg.WorkloadCluster, kcp *controlplanev1.KubeadmControlPlane) error {
+			return pkgerrors.Wrap(workloadCluster.UpdateCoreDNS(ctx, kcp), "failed to update CoreDNS deployment")
+		},
+	},
+}
+
+// reconcileAddons upgrades cluster-critical addons to the given Kubernetes version.
+// If UpgradeControlPlaneAddons extensions are registered, the upgrade of the addons they manage is delegated
+// to them and KCP only upgrades the remaining ones. Once all the addons are ready for the given version, the
+// version is recorded in the AddonsKubernetesVersionAnnotation of the KubeadmControlPlane.
+func (r *Reconciler) reconcileAddons(ctx context.Context, controlPlane *pkg.ControlPlane, kubernetesVersion string) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	managedAddons, retryAfterSeconds, messages, err := r.callUpgradeControlPlaneAddonsExtensions(ctx, controlPlane, kubernetesVersion)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Addons must be upgraded to the given version, which might be different from the version in spec
+	// when upgrades are sequenced before a rollout.
+	kcp := controlPlane.KCP
+	if kcp.Spec.Version != kubernetesVersion {
+		kcp = kcp.DeepCopy()
+		kcp.Spec.Version = kubernetesVersion
+	}
+	for _, addon := range controlPlaneAddons {
+		if managedAddons.Has(addon.name) {
+			continue
+		}
+		if err := addon.upgrade(ctx, workloadCluster, kcp); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if retryAfterSeconds != 0 {
+		log.Info(fmt.Sprintf("Waiting for UpgradeControlPlaneAddons extensions to upgrade addons to Kubernetes version %s", kubernetesVersion), "message", strings.Join(messages, ", "))
+		return ctrl.Result{RequeueAfter: time.Duration(retryAfterSeconds) * time.Second}, nil
+	}
+
+	if controlPlane.KCP.Annotations[controlplanev1.AddonsKubernetesVersionAnnotation] != kubernetesVersion {
+		if controlPlane.KCP.Annotations == nil {
+			controlPlane.KCP.Annotations = map[string]string{}
+		}
+		controlPlane.KCP.Annotations[controlplanev1.AddonsKubernetesVersionAnnotation] = kubernetesVersion
+	}
+	return ctrl.Result{}, nil
+}
+
+// addonsUpgradeRequiredBeforeRollout returns the current Kubernetes version of the control plane, i.e. the lowest
+// version of its Machines, if addons must be upgraded to it before starting the upgrade to the version in spec.
+// Note: This is not required for control planes where addons have never been upgraded since the
+// AddonsKubernetesVersionAnnotation was introduced.
+func addonsUpgradeRequiredBeforeRollout(controlPlane *pkg.ControlPlane) (string, bool) {
+	addonsVersion, ok := controlPlane.KCP.Annotations[controlplanev1.AddonsKubernetesVersionAnnotation]
+	if !ok {
+		return "", false
+	}
+	currentVersion := controlPlane.Machines.LowestVersion()
+	if currentVersion == "" || currentVersion == controlPlane.KCP.Spec.Version {
+		return "", false
+	}
+
+	parsedAddonsVersion, err := semver.ParseTolerant(addonsVersion)
+	if err != nil {
+		return "", false
+	}
+	parsedCurrentVersion, err := semver.ParseTolerant(currentVersion)
+	if err != nil {
+		return "", false
+	}
+	if version.Compare(parsedCurrentVersion, parsedAddonsVersion, version.WithBuildTags()) <= 0 {
+		return "", false
+	}
+	return currentVersion, true
+}
+
+// callUpgradeControlPlaneAddonsExtensions calls all the registered UpgradeControlPlaneAddons extensions and
+// returns the union of the addons they manage, the lowest non-zero retryAfterSeconds and their messages.
+// Note: CallAllExtensions is not used because it does not aggregate managedAddons.
+func (r *Reconciler) callUpgradeControlPlaneAddonsExtensions(ctx context.Context, controlPlane *pkg.ControlPlane, kubernetesVersion string) (sets.Set[runtimehooksv1.ControlPlaneAddon], int32, []string, error) {
+	managedAddons := sets.Set[runtimehooksv1.ControlPlaneAddon]{}
+
+	if !feature.Gates.Enabled(feature.RuntimeSDK) || r.RuntimeClient == nil {
+		return managedAddons, 0, nil, nil
+	}
+
+	extensionHandlers, err := r.RuntimeClient.GetAllExtensions(ctx, runtimehooksv1.UpgradeControlPlaneAddons, controlPlane.Cluster)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if len(extensionHandlers) == 0 {
+		return managedAddons, 0, nil, nil
+	}
+
+	req, err := createUpgradeControlPlaneAddonsRequest(controlPlane, kubernetesVersion)
+	if err != nil {
+		return nil, 0, nil, pkgerrors.Wrap(err, "failed to generate UpgradeControlPlaneAddons request")
+	}
+
+	var retryAfterSeconds int32
+	var messages []string
+	for _, extensionHandler := range extensionHandlers {
+		resp := &runtimehooksv1.UpgradeControlPlaneAddonsResponse{}
+		if err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.UpgradeControlPlaneAddons, controlPlane.Cluster, extensionHandler, req, resp); err != nil {
+			return nil, 0, nil, err
+		}
+		managedAddons.Insert(resp.ManagedAddons...)
+		if resp.RetryAfterSeconds != 0 && (retryAfterSeconds == 0 || resp.RetryAfterSeconds < retryAfterSeconds) {
+			retryAfterSeconds = resp.RetryAfterSeconds
+		}
+		if resp.Message != "" {
+			messages = append(messages, fmt.Sprintf("%s: %s", extensionHandler, resp.Message))
+		}
+	}
+	slices.Sort(messages)
+	return managedAddons, retryAfterSeconds, messages, nil
+}
+
+func createUpgradeControlPlaneAddonsRequest(controlPlane *pkg.ControlPlane, kubernetesVersion string) (*runtimehooksv1.UpgradeControlPlaneAddonsRequest, error) {
+	kcp := controlPlane.KCP.DeepCopy()
+	// Set GVK because object is later marshalled with json.Marshal.
+	kcp.SetGroupVersionKind(controlplanev1.GroupVersion.WithKind("KubeadmControlPlane"))
+	kcp.ManagedFields = nil
+	controlPlaneRaw, err := patch.ConvertToRawExtension(kcp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimehooksv1.UpgradeControlPlaneAddonsRequest{
+		Cluster:               *cleanupCluster(controlPlane.Cluster),
+		ControlPlane:          controlPlaneRaw,
+		FromKubernetesVersion: controlPlane.KCP.Annotations[controlplanev1.AddonsKubernetesVersionAnnotation],
+		ToKubernetesVersion:   kubernetesVersion,
+	}, nil
+}
+
+func cleanupCluster(cluster *clusterv1.Cluster) *clusterv1.Cluster {
+	return &clusterv1.Cluster{
+		// Set GVK because object is later marshalled with json.Marshal.
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       "Cluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cluster.Name,
+			Namespace:   cluster.Namespace,
+			Labels:      cluster.Labels,
+			Annotations: cluster.Annotations,
+		},
+		Spec: *cluster.Spec.DeepCopy(),
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/feature"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+func TestReconcileAddons(t *testing.T) {
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+	upgradeControlPlaneAddonsGVH, err := catalog.GroupVersionHook(runtimehooksv1.UpgradeControlPlaneAddons)
+	if err != nil {
+		panic("unable to compute GVH")
+	}
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+
+	tests := []struct {
+		name                      string
+		enableRuntimeSDK          bool
+		annotations               map[string]string
+		getAllExtensionsResponses map[runtimecatalog.GroupVersionHook][]string
+		callExtensionResponses    map[string]runtimehooksv1.ResponseObject
+		wantUpgradedAddons        []string
+		wantResult                time.Duration
+		wantErr                   bool
+		wantAnnotation            string
+	}{
+		{
+			name:               "Upgrade all addons if the RuntimeSDK feature gate is disabled",
+			wantUpgradedAddons: []string{"kube-proxy", "coredns"},
+			wantAnnotation:     "v1.31.0",
+		},
+		{
+			name:                      "Upgrade all addons if there are no UpgradeControlPlaneAddons extensions",
+			enableRuntimeSDK:          true,
+			annotations:               map[string]string{controlplanev1.AddonsKubernetesVersionAnnotation: "v1.30.0"},
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{},
+			wantUpgradedAddons:        []string{"kube-proxy", "coredns"},
+			wantAnnotation:            "v1.31.0",
+		},
+		{
+			name:             "Do not upgrade addons managed by extensions",
+			enableRuntimeSDK: true,
+			annotations:      map[string]string{controlplanev1.AddonsKubernetesVersionAnnotation: "v1.30.0"},
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				upgradeControlPlaneAddonsGVH: {"cni-extension", "dns-extension"},
+			},
+			callExtensionResponses: map[string]runtimehooksv1.ResponseObject{
+				"cni-extension": &runtimehooksv1.UpgradeControlPlaneAddonsResponse{
+					CommonRetryResponse: runtimehooksv1.CommonRetryResponse{CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess}},
+					ManagedAddons:       []runtimehooksv1.ControlPlaneAddon{runtimehooksv1.KubeProxyControlPlaneAddon},
+				},
+				"dns-extension": &runtimehooksv1.UpgradeControlPlaneAddonsResponse{
+					CommonRetryResponse: runtimehooksv1.CommonRetryResponse{CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess}},
+					ManagedAddons:       []runtimehooksv1.ControlPlaneAddon{runtimehooksv1.CoreDNSControlPlaneAddon},
+				},
+			},
+			wantUpgradedAddons: nil,
+			wantAnnotation:     "v1.31.0",
+		},
+		{
+			name:             "Requeue without updating the annotation if extensions are not ready",
+			enableRuntimeSDK: true,
+			annotations:      map[string]string{controlplanev1.AddonsKubernetesVersionAnnotation: "v1.30.0"},
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				upgradeControlPlaneAddonsGVH: {"cni-extension", "other-extension"},
+			},
+			callExtensionResponses: map[string]runtimehooksv1.ResponseObject{
+				"cni-extension": &runtimehooksv1.UpgradeControlPlaneAddonsResponse{
+					CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+						CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess, Message: "cilium rolling out"},
+						RetryAfterSeconds: 30,
+					},
+					ManagedAddons: []runtimehooksv1.ControlPlaneAddon{runtimehooksv1.KubeProxyControlPlaneAddon},
+				},
+				"other-extension": &runtimehooksv1.UpgradeControlPlaneAddonsResponse{
+					CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+						CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+						RetryAfterSeconds: 10,
+					},
+				},
+			},
+			wantUpgradedAddons: []string{"coredns"},
+			wantResult:         10 * time.Second,
+			wantAnnotation:     "v1.30.0",
+		},
+		{
+			name:             "Return error if an extension fails",
+			enableRuntimeSDK: true,
+			annotations:      map[string]string{controlplanev1.AddonsKubernetesVersionAnnotation: "v1.30.0"},
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				upgradeControlPlaneAddonsGVH: {"cni-extension"},
+			},
+			callExtensionResponses: map[string]runtimehooksv1.ResponseObject{
+				"cni-extension": &runtimehooksv1.UpgradeControlPlaneAddonsResponse{
+					CommonRetryResponse: runtimehooksv1.CommonRetryResponse{CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusFailure}},
+				},
+			},
+			wantUpgradedAddons: nil,
+			wantErr:            true,
+			wantAnnotation:     "v1.30.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			if tt.enableRuntimeSDK {
+				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+			}
+
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCatalog(catalog).
+				WithGetAllExtensionResponses(tt.getAllExtensionsResponses).
+				WithCallExtensionResponses(tt.callExtensionResponses).
+				WithCallExtensionValidations(func(_ string, object runtimehooksv1.RequestObject) error {
+					req, ok := object.(*runtimehooksv1.UpgradeControlPlaneAddonsRequest)
+					if !ok {
+						return pkgerrors.Errorf("unexpected request type %T", object)
+					}
+					if req.Cluster.Name != cluster.Name || req.FromKubernetesVersion != "v1.30.0" || req.ToKubernetesVersion != "v1.31.0" {
+						return pkgerrors.Errorf("unexpected request %v", req)
+					}
+					return nil
+				}).
+				Build()
+
+			var upgradedAddons []string
+			workload := &fakeWorkloadCluster{
+				OverrideUpdateKubeProxy: func(_ context.Context, kcp *controlplanev1.KubeadmControlPlane) error {
+					upgradedAddons = append(upgradedAddons, "kube-proxy")
+					g.Expect(kcp.Spec.Version).To(Equal("v1.31.0"))
+					return nil
+				},
+				OverrideUpdateCoreDNS: func(_ context.Context, kcp *controlplanev1.KubeadmControlPlane) error {
+					upgradedAddons = append(upgradedAddons, "coredns")
+					g.Expect(kcp.Spec.Version).To(Equal("v1.31.0"))
+					return nil
+				},
+			}
+
+			controlPlane := &pkg.ControlPlane{
+				KCP: &controlplanev1.KubeadmControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "foo",
+						Namespace:   metav1.NamespaceDefault,
+						Annotations: tt.annotations,
+					},
+					Spec: controlplanev1.KubeadmControlPlaneSpec{
+						Version: "v1.31.0",
+					},
+				},
+				Cluster:  cluster,
+				Machines: collections.New(),
+			}
+			controlPlane.InjectTestManagementCluster(&fakeManagementCluster{Workload: workload})
+
+			r := &Reconciler{
+				RuntimeClient: runtimeClient,
+			}
+
+			res, err := r.reconcileAddons(ctx, controlPlane, controlPlane.KCP.Spec.Version)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(res.RequeueAfter).To(Equal(tt.wantResult))
+			g.Expect(upgradedAddons).To(Equal(tt.wantUpgradedAddons))
+			g.Expect(controlPlane.KCP.Annotations[controlplanev1.AddonsKubernetesVersionAnnotation]).To(Equal(tt.wantAnnotation))
+		})
+	}
+}
+
+func TestAddonsUpgradeRequiredBeforeRollout(t *testing.T) {
+	tests := []struct {
+		name            string
+		addonsVersion   *string
+		machineVersions []string
+		wantVersion     string
+		wantRequired    bool
+	}{
+		{
+			name:            "Not required if addons have never been upgraded",
+			machineVersions: []string{"v1.30.0"},
+		},
+		{
+			name:            "Not required if addons have been upgraded to the current version",
+			addonsVersion:   ptr.To("v1.30.0"),
+			machineVersions: []string{"v1.30.0", "v1.30.0"},
+		},
+		{
+			name:            "Not required if the current version is the version in spec",
+			addonsVersion:   ptr.To("v1.30.0"),
+			machineVersions: []string{"v1.31.0"},
+		},
+		{
+			name:            "Not required during the rollout to the version in spec",
+			addonsVersion:   ptr.To("v1.30.0"),
+			machineVersions: []string{"v1.30.0", "v1.31.0"},
+		},
+		{
+			name:            "Required if addons have not been upgraded to the current version",
+			addonsVersion:   ptr.To("v1.29.0"),
+			machineVersions: []string{"v1.30.0", "v1.30.0"},
+			wantVersion:     "v1.30.0",
+			wantRequired:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Version: "v1.31.0",
+				},
+			}
+			if tt.addonsVersion != nil {
+				kcp.Annotations = map[string]string{controlplanev1.AddonsKubernetesVersionAnnotation: *tt.addonsVersion}
+			}
+			machines := collections.New()
+			for i, v := range tt.machineVersions {
+				machines.Insert(&clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("machine-%d", i)},
+					Spec:       clusterv1.MachineSpec{Version: v},
+				})
+			}
+
+			version, required := addonsUpgradeRequiredBeforeRollout(&pkg.ControlPlane{KCP: kcp, Machines: machines})
+			g.Expect(required).To(Equal(tt.wantRequired))
+			g.Expect(version).To(Equal(tt.wantVersion))
+		})
+	}
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/kubeconfig"
+	clog "sigs.k8s.io/cluster-api/util/log"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+const (
+	// certificateAuthorityRotationCheckPeriod is the period after which KCP checks again if all the Machines
+	// have been rolled out during a rotation of the certificate authorities.
+	// Note: This is also the minimum duration of each phase of the rotation, so a phase is never completed
+	// based on a stale status.
+	certificateAuthorityRotationCheckPeriod = 1 * time.Minute
+)
+
+// nextCertificateAuthorityRotationPhase defines the sequence of the phases of a rotation of the certificate authorities.
+var nextCertificateAuthorityRotationPhase = map[controlplanev1.CertificateAuthorityRotationPhase]controlplanev1.CertificateAuthorityRotationPhase{
+	controlplanev1.CertificateAuthorityRotationAddingTrustPhase:      controlplanev1.CertificateAuthorityRotationSwitchingSigningPhase,
+	controlplanev1.CertificateAuthorityRotationSwitchingSigningPhase: controlplanev1.CertificateAuthorityRotationRemovingOldTrustPhase,
+	controlplanev1.CertificateAuthorityRotationRemovingOldTrustPhase: controlplanev1.CertificateAuthorityRotationCompletedPhase,
+}
+
+// reconcileCertificateAuthorityRotation rotates the cluster CA, the front proxy CA, the etcd CA (only when using local etcd)
+// and the service account keys when requested via spec.certificateAuthorityRotation.after. The rotation has three phases:
+//   - AddingTrust: new key pairs are added to the trust bundles, while the old ones are still used for signing.
+//   - SwitchingSigning: the new key pairs are used for signing, while the old ones are still trusted.
+//   - RemovingOldTrust: the old key pairs are removed from the trust bundles.
+//
+// At the beginning of each phase the kubeconfig Secret and the cluster-info ConfigMap in the workload cluster are updated,
+// then all the control plane and worker Machines created before the phase started must be rolled out, so they pick up the
+// new trust bundles and key pairs; control plane Machines are rolled out by the usual rollout logic (see pkg.UpToDate),
+// while worker Machines, and Nodes of MachinePools, must be rolled out by the user, e.g. by setting spec.rollout.after
+// on MachineDeployments; they are surfaced by the WorkerMachinesRolloutRequired condition.
+// Note: This is done at the end of the reconcile, so phases are completed only when all the control plane Machines
+// have been rolled out.
+func (r *Reconciler) reconcileCertificateAuthorityRotation(ctx context.Context, controlPlane *pkg.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	status := &controlPlane.KCP.Status.CertificateAuthorityRotation
+	after := controlPlane.KCP.Spec.CertificateAuthorityRotation.After
+	now := time.Now()
+
+	var phase controlplanev1.CertificateAuthorityRotationPhase
+	switch status.Phase {
+	case "", controlplanev1.CertificateAuthorityRotationCompletedPhase:
+		conditions.Delete(controlPlane.KCP, controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition)
+
+		// Start a new rotation only if requested after the start of the last one.
+		if after.IsZero() || !status.StartTime.Before(&after) {
+			return ctrl.Result{}, nil
+		}
+		if now.Before(after.Time) {
+			return ctrl.Result{RequeueAfter: after.Sub(now)}, nil
+		}
+		phase = controlplanev1.CertificateAuthorityRotationAddingTrustPhase
+	default:
+		// Move to the next phase only when all the Machines created before the current phase started have been rolled out.
+		outdatedMachines, err := r.outdatedMachinesForCertificateAuthorityRotation(ctx, controlPlane)
+		if err != nil {
+			conditions.Set(controlPlane.KCP, metav1.Condition{
+				Type:    controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredInternalErrorReason,
+				Message: "Please check controller logs for errors",
+			})
+			return ctrl.Result{}, err
+		}
+		setWorkerMachinesRolloutRequiredCondition(controlPlane.KCP, outdatedMachines.workers)
+
+		if elapsed := now.Sub(status.PhaseStartTime.Time); elapsed < certificateAuthorityRotationCheckPeriod {
+			return ctrl.Result{RequeueAfter: certificateAuthorityRotationCheckPeriod - elapsed}, nil
+		}
+		if n := outdatedMachines.controlPlane + outdatedMachines.workers.Len(); n > 0 {
+			log.Info(fmt.Sprintf("Waiting for %d Machines to be rolled out before completing phase %s of the certificate authorities rotation", n, status.Phase))
+			return ctrl.Result{RequeueAfter: certificateAuthorityRotationCheckPeriod}, nil
+		}
+		phase = nextCertificateAuthorityRotationPhase[status.Phase]
+	}
+
+	if phase != controlplanev1.CertificateAuthorityRotationCompletedPhase {
+		if err := r.rotateCertificateAuthorities(ctx, controlPlane, phase); err != nil {
+			r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "CertificateAuthorityRotationFailed", "Failed to start phase %s of the certificate authorities rotation: %v", phase, err)
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to start phase %s of the certificate authorities rotation", phase)
+		}
+	}
+
+	switch phase {
+	case controlplanev1.CertificateAuthorityRotationAddingTrustPhase:
+		status.StartTime = metav1.NewTime(now)
+		status.CompletionTime = metav1.Time{}
+	case controlplanev1.CertificateAuthorityRotationCompletedPhase:
+		status.CompletionTime = metav1.NewTime(now)
+		conditions.Delete(controlPlane.KCP, controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition)
+	}
+	status.Phase = phase
+	status.PhaseStartTime = metav1.NewTime(now)
+
+	log.Info(fmt.Sprintf("Certificate authorities rotation moved to phase %s", phase))
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, "CertificateAuthorityRotation", "Certificate authorities rotation moved to phase %s", phase)
+	if phase == controlplanev1.CertificateAuthorityRotationCompletedPhase {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: certificateAuthorityRotationCheckPeriod}, nil
+}
+
+// rotateCertificateAuthorities updates the Secrets holding the certificate authorities and the service account keys
+// according to the given phase of the rotation, and then the kubeconfig Secret and the cluster-info ConfigMap.
+// Note: All the operations are idempotent, so they can be retried in case of errors.
+func (r *Reconciler) rotateCertificateAuthorities(ctx context.Context, controlPlane *pkg.ControlPlane, phase controlplanev1.CertificateAuthorityRotationPhase) error {
+	clusterName := util.ObjectKey(controlPlane.Cluster)
+	certificates := secret.NewCertificatesForInitialControlPlane(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.DeepCopy())
+	if err := certificates.LookupCached(ctx, r.SecretCachingClient, r.Client, clusterName); err != nil {
+		return pkgerrors.Wrap(err, "failed to look up cluster certificates")
+	}
+
+	for _, c := range certificates {
+		// External etcd certificates are managed by the user.
+		if c.External {
+			continue
+		}
+
+		var changed bool
+		var err error
+		switch phase {
+		case controlplanev1.CertificateAuthorityRotationAddingTrustPhase:
+			changed, err = c.AddNextKeyPair()
+		case controlplanev1.CertificateAuthorityRotationSwitchingSigningPhase:
+			changed, err = c.SwitchToNextKeyPair()
+		case controlplanev1.CertificateAuthorityRotationRemovingOldTrustPhase:
+			changed, err = c.RemovePreviousKeyPairs()
+		}
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+		if err := r.Client.Update(ctx, c.Secret); err != nil {
+			return pkgerrors.Wrapf(err, "failed to update Secret %s", klog.KObj(c.Secret))
+		}
+	}
+
+	// Regenerate the kubeconfig, so it trusts the current CA bundle and it uses a client certificate signed by the current CA.
+	configSecret, err := secret.GetFromNamespacedName(ctx, r.SecretCachingClient, clusterName, secret.Kubeconfig)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to retrieve kubeconfig Secret")
+	}
+	if util.IsControlledBy(configSecret, controlPlane.KCP, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind).GroupKind()) {
+		if err := kubeconfig.RegenerateSecret(ctx, r.Client, configSecret, kubeconfig.KeyEncryptionAlgorithm(controlPlane.GetKeyEncryptionAlgorithm())); err != nil {
+			return pkgerrors.Wrap(err, "failed to regenerate kubeconfig")
+		}
+	}
+
+	// Update the CA bundle used by kubeadm join, so new Machines trust the current CA bundle.
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to create client to workload cluster")
+	}
+	if err := workloadCluster.UpdateClusterInfoCertificateAuthority(ctx, certificates.GetByPurpose(secret.ClusterCA).KeyPair.Cert); err != nil {
+		return err
+	}
+	return nil
+}
+
+// outdatedMachines are the Machines created before the start of the current phase of the rotation of the certificate authorities.
+type outdatedMachines struct {
+	// controlPlane is the number of outdated control plane Machines.
+	controlPlane int
+
+	// workers are the outdated worker Machines, and Nodes of MachinePools, grouped by the object they must be rolled out with,
+	// e.g. "MachineDeployment md-1".
+	workers outdatedWorkers
+}
+
+// outdatedWorkers are outdated worker Machines, and Nodes of MachinePools, grouped by the object they must be rolled out with.
+type outdatedWorkers map[string]int
+
+// Len returns the number of outdated worker Machines and Nodes of MachinePools.
+func (o outdatedWorkers) Len() int {
+	n := 0
+	for _, count := range o {
+		n += count
+	}
+	return n
+}
+
+// outdatedMachinesForCertificateAuthorityRotation returns the control plane and worker Machines, and the Nodes of MachinePools
+// not backed by Machines, created before the start of the current phase of the rotation of the certificate authorities.
+func (r *Reconciler) outdatedMachinesForCertificateAuthorityRotation(ctx context.Context, controlPlane *pkg.ControlPlane) (*outdatedMachines, error) {
+	phaseStartTime := controlPlane.KCP.Status.CertificateAuthorityRotation.PhaseStartTime
+
+	machines, err := r.managementCluster.GetMachinesForCluster(ctx, controlPlane.Cluster)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Machines")
+	}
+	outdated := &outdatedMachines{workers: outdatedWorkers{}}
+	machinePoolsWithMachines := sets.Set[string]{}
+	for _, m := range machines {
+		if name, ok := m.Labels[clusterv1.MachinePoolNameLabel]; ok {
+			machinePoolsWithMachines.Insert(name)
+		}
+		if !m.CreationTimestamp.Before(&phaseStartTime) {
+			continue
+		}
+		switch {
+		case util.IsControlPlaneMachine(m):
+			outdated.controlPlane++
+		case m.Labels[clusterv1.MachineDeploymentNameLabel] != "":
+			outdated.workers["MachineDeployment "+m.Labels[clusterv1.MachineDeploymentNameLabel]]++
+		case m.Labels[clusterv1.MachinePoolNameLabel] != "":
+			outdated.workers["MachinePool "+m.Labels[clusterv1.MachinePoolNameLabel]]++
+		case m.Labels[clusterv1.MachineSetNameLabel] != "":
+			outdated.workers["MachineSet "+m.Labels[clusterv1.MachineSetNameLabel]]++
+		default:
+			outdated.workers["Machine "+m.Name]++
+		}
+	}
+
+	if !feature.Gates.Enabled(feature.MachinePool) {
+		return outdated, nil
+	}
+
+	// Nodes of MachinePools not backed by Machines are checked in the workload cluster.
+	machinePools, err := r.managementCluster.GetMachinePoolsForCluster(ctx, controlPlane.Cluster)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list MachinePools")
+	}
+	var workloadCluster pkg.WorkloadCluster
+	for _, mp := range machinePools.Items {
+		if machinePoolsWithMachines.Has(mp.Name) || len(mp.Status.NodeRefs) == 0 {
+			continue
+		}
+		if workloadCluster == nil {
+			workloadCluster, err = controlPlane.GetWorkloadCluster(ctx)
+			if err != nil {
+				return nil, pkgerrors.Wrap(err, "failed to create client to workload cluster")
+			}
+		}
+		nodeNames := make([]string, 0, len(mp.Status.NodeRefs))
+		for _, ref := range mp.Status.NodeRefs {
+			nodeNames = append(nodeNames, ref.Name)
+		}
+		outdatedNodes, err := workloadCluster.GetNodesCreatedBefore(ctx, nodeNames, phaseStartTime.Time)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to check Nodes of MachinePool %s", klog.KObj(&mp))
+		}
+		if len(outdatedNodes) > 0 {
+			outdated.workers["MachinePool "+mp.Name] += len(outdatedNodes)
+		}
+	}
+	return outdated, nil
+}
+
+// setWorkerMachinesRolloutRequiredCondition surfaces the worker Machines, and the Nodes of MachinePools, which must be rolled
+// out by the user before the rotation of the certificate authorities can move to the next phase.
+func setWorkerMachinesRolloutRequiredCondition(kcp *controlplanev1.KubeadmControlPlane, workers outdatedWorkers) {
+	if len(workers) == 0 {
+		conditions.Set(kcp, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition,
+			Status: metav1.ConditionFalse,
+			Reason: controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutNotRequiredReason,
+		})
+		return
+	}
+
+	owners := sets.List(sets.KeySet(workers))
+	conditions.Set(kcp, metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition,
+		Status: metav1.ConditionTrue,
+		Reason: controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredReason,
+		Message: fmt.Sprintf("%d worker Machines or Nodes created before phase %s of the certificate authorities rotation started must be rolled out: %s",
+			workers.Len(), kcp.Status.CertificateAuthorityRotation.Phase, clog.ListToString(owners, func(s string) string { return s }, 5)),
+	})
+}
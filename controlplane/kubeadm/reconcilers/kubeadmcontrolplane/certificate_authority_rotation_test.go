@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/kubeconfig"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestReconcileCertificateAuthorityRotation(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "kcp-uid",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.30.0",
+		},
+	}
+	owner := *metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind))
+
+	certificates := secret.NewCertificatesForInitialControlPlane(&bootstrapv1.ClusterConfiguration{})
+	g.Expect(certificates.Generate()).To(Succeed())
+	objs := []client.Object{}
+	for _, c := range certificates {
+		objs = append(objs, c.AsSecret(util.ObjectKey(cluster), owner))
+	}
+	fakeClient := newFakeClient(objs...)
+	g.Expect(kubeconfig.CreateSecretWithOwner(ctx, fakeClient, util.ObjectKey(cluster), "localhost:6443", owner)).To(Succeed())
+
+	newMachines := func(creationTimestamp time.Time) collections.Machines {
+		return collections.FromMachines(
+			&clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+				Name:              "cp",
+				Labels:            map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+				CreationTimestamp: metav1.NewTime(creationTimestamp),
+			}},
+			&clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+				Name:              "worker",
+				Labels:            map[string]string{clusterv1.MachineDeploymentNameLabel: "md"},
+				CreationTimestamp: metav1.NewTime(creationTimestamp),
+			}},
+			&clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+				Name:              "machine-pool-worker",
+				Labels:            map[string]string{clusterv1.MachinePoolNameLabel: "mp"},
+				CreationTimestamp: metav1.NewTime(creationTimestamp),
+			}},
+		)
+	}
+
+	machinePools := &clusterv1.MachinePoolList{Items: []clusterv1.MachinePool{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "mp"},
+			Status:     clusterv1.MachinePoolStatus{NodeRefs: []corev1.ObjectReference{{Name: "mp-node"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "mp-without-machines"},
+			Status:     clusterv1.MachinePoolStatus{NodeRefs: []corev1.ObjectReference{{Name: "mp-without-machines-node"}}},
+		},
+	}}
+
+	workload := &fakeWorkloadCluster{OutdatedNodes: []string{"mp-node", "mp-without-machines-node"}}
+	managementCluster := &fakeManagementCluster{Workload: workload, Machines: newMachines(time.Now().Add(-2 * time.Hour)), MachinePools: machinePools}
+	recorder := record.NewFakeRecorder(32)
+	r := &Reconciler{
+		Client:              fakeClient,
+		SecretCachingClient: fakeClient,
+		managementCluster:   managementCluster,
+		recorder:            recorder,
+	}
+	controlPlane := &pkg.ControlPlane{
+		KCP:     kcp,
+		Cluster: cluster,
+	}
+	controlPlane.InjectTestManagementCluster(managementCluster)
+
+	getSecret := func(purpose secret.Purpose) *corev1.Secret {
+		s, err := secret.GetFromNamespacedName(ctx, fakeClient, util.ObjectKey(cluster), purpose)
+		g.Expect(err).ToNot(HaveOccurred())
+		return s
+	}
+	kubeconfigCAData := func() []byte {
+		config, err := clientcmd.Load(getSecret(secret.Kubeconfig).Data[secret.KubeconfigDataName])
+		g.Expect(err).ToNot(HaveOccurred())
+		return config.Clusters[cluster.Name].CertificateAuthorityData
+	}
+	rotatedPurposes := []secret.Purpose{secret.ClusterCA, secret.EtcdCA, secret.FrontProxyCA, secret.ServiceAccount}
+	oldKeys := map[secret.Purpose][]byte{}
+	for _, purpose := range rotatedPurposes {
+		oldKeys[purpose] = getSecret(purpose).Data[secret.TLSKeyDataName]
+	}
+
+	// Does nothing if the rotation is not requested.
+	res, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsZero()).To(BeTrue())
+	g.Expect(kcp.Status.CertificateAuthorityRotation).To(BeZero())
+
+	// Waits if the rotation is requested in the future.
+	kcp.Spec.CertificateAuthorityRotation.After = metav1.NewTime(time.Now().Add(time.Hour))
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+	g.Expect(kcp.Status.CertificateAuthorityRotation).To(BeZero())
+
+	// Adds the new key pairs to the trust bundles.
+	kcp.Spec.CertificateAuthorityRotation.After = metav1.NewTime(time.Now().Add(-time.Minute))
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(Equal(certificateAuthorityRotationCheckPeriod))
+	g.Expect(kcp.Status.CertificateAuthorityRotation.Phase).To(Equal(controlplanev1.CertificateAuthorityRotationAddingTrustPhase))
+	g.Expect(kcp.Status.CertificateAuthorityRotation.StartTime.IsZero()).To(BeFalse())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("AddingTrust")))
+	nextKeys := map[secret.Purpose][]byte{}
+	for _, purpose := range rotatedPurposes {
+		s := getSecret(purpose)
+		g.Expect(bytes.Count(s.Data[secret.TLSCrtDataName], []byte("-----BEGIN "))).To(Equal(2))
+		g.Expect(s.Data[secret.TLSKeyDataName]).To(Equal(oldKeys[purpose]))
+		g.Expect(s.Data).To(HaveKey(secret.NextTLSKeyDataName))
+		nextKeys[purpose] = s.Data[secret.NextTLSKeyDataName]
+	}
+	caBundle := getSecret(secret.ClusterCA).Data[secret.TLSCrtDataName]
+	g.Expect(workload.clusterInfoCAData).To(Equal(caBundle))
+	g.Expect(kubeconfigCAData()).To(Equal(caBundle))
+
+	// Waits for the minimum duration of the phase.
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+	g.Expect(kcp.Status.CertificateAuthorityRotation.Phase).To(Equal(controlplanev1.CertificateAuthorityRotationAddingTrustPhase))
+
+	// Reports the worker Machines, and the Nodes of MachinePools not backed by Machines, created before the phase started,
+	// and waits for all the Machines to be rolled out.
+	kcp.Status.CertificateAuthorityRotation.PhaseStartTime = metav1.NewTime(time.Now().Add(-2 * certificateAuthorityRotationCheckPeriod).Truncate(time.Second))
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(Equal(certificateAuthorityRotationCheckPeriod))
+	g.Expect(kcp.Status.CertificateAuthorityRotation.Phase).To(Equal(controlplanev1.CertificateAuthorityRotationAddingTrustPhase))
+	condition := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredReason))
+	g.Expect(condition.Message).To(Equal("3 worker Machines or Nodes created before phase AddingTrust of the certificate authorities rotation started must be rolled out: " +
+		"MachineDeployment md, MachinePool mp, MachinePool mp-without-machines"))
+
+	// Waits for the Nodes of MachinePools not backed by Machines to be rolled out.
+	managementCluster.Machines = newMachines(time.Now())
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(Equal(certificateAuthorityRotationCheckPeriod))
+	g.Expect(kcp.Status.CertificateAuthorityRotation.Phase).To(Equal(controlplanev1.CertificateAuthorityRotationAddingTrustPhase))
+	condition = conditions.Get(kcp, controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition)
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(condition.Message).To(HaveSuffix(": MachinePool mp-without-machines"))
+
+	// Switches signing to the new key pairs once all the Machines have been rolled out.
+	workload.OutdatedNodes = nil
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(Equal(certificateAuthorityRotationCheckPeriod))
+	g.Expect(kcp.Status.CertificateAuthorityRotation.Phase).To(Equal(controlplanev1.CertificateAuthorityRotationSwitchingSigningPhase))
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("SwitchingSigning")))
+	condition = conditions.Get(kcp, controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition)
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutNotRequiredReason))
+	for _, purpose := range rotatedPurposes {
+		s := getSecret(purpose)
+		g.Expect(bytes.Count(s.Data[secret.TLSCrtDataName], []byte("-----BEGIN "))).To(Equal(2))
+		g.Expect(s.Data[secret.TLSKeyDataName]).To(Equal(nextKeys[purpose]))
+		g.Expect(s.Data).ToNot(HaveKey(secret.NextTLSKeyDataName))
+	}
+	caBundle = getSecret(secret.ClusterCA).Data[secret.TLSCrtDataName]
+	g.Expect(workload.clusterInfoCAData).To(Equal(caBundle))
+	g.Expect(kubeconfigCAData()).To(Equal(caBundle))
+
+	// Removes the old key pairs from the trust bundles.
+	kcp.Status.CertificateAuthorityRotation.PhaseStartTime = metav1.NewTime(time.Now().Add(-2 * certificateAuthorityRotationCheckPeriod))
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(Equal(certificateAuthorityRotationCheckPeriod))
+	g.Expect(kcp.Status.CertificateAuthorityRotation.Phase).To(Equal(controlplanev1.CertificateAuthorityRotationRemovingOldTrustPhase))
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("RemovingOldTrust")))
+	for _, purpose := range rotatedPurposes {
+		s := getSecret(purpose)
+		g.Expect(bytes.Count(s.Data[secret.TLSCrtDataName], []byte("-----BEGIN "))).To(Equal(1))
+		g.Expect(s.Data[secret.TLSKeyDataName]).To(Equal(nextKeys[purpose]))
+	}
+	caBundle = getSecret(secret.ClusterCA).Data[secret.TLSCrtDataName]
+	g.Expect(workload.clusterInfoCAData).To(Equal(caBundle))
+	g.Expect(kubeconfigCAData()).To(Equal(caBundle))
+
+	// Completes the rotation.
+	kcp.Status.CertificateAuthorityRotation.PhaseStartTime = metav1.NewTime(time.Now().Add(-2 * certificateAuthorityRotationCheckPeriod))
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsZero()).To(BeTrue())
+	g.Expect(kcp.Status.CertificateAuthorityRotation.Phase).To(Equal(controlplanev1.CertificateAuthorityRotationCompletedPhase))
+	g.Expect(kcp.Status.CertificateAuthorityRotation.CompletionTime.IsZero()).To(BeFalse())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("Completed")))
+	g.Expect(conditions.Has(kcp, controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition)).To(BeFalse())
+
+	// Does not start a new rotation until after is set to a time later than the start time of the last rotation.
+	res, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsZero()).To(BeTrue())
+	g.Expect(kcp.Status.CertificateAuthorityRotation.Phase).To(Equal(controlplanev1.CertificateAuthorityRotationCompletedPhase))
+	g.Expect(recorder.Events).ToNot(Receive())
+}
+
+func TestReconcileCertificateAuthorityRotationFailsWithoutCAKey(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "kcp-uid",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.30.0",
+			CertificateAuthorityRotation: controlplanev1.KubeadmControlPlaneCertificateAuthorityRotationSpec{
+				After: metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+		},
+	}
+	owner := *metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind))
+
+	certificates := secret.NewCertificatesForInitialControlPlane(&bootstrapv1.ClusterConfiguration{})
+	g.Expect(certificates.Generate()).To(Succeed())
+	objs := []client.Object{}
+	for _, c := range certificates {
+		s := c.AsSecret(util.ObjectKey(cluster), owner)
+		// The cluster CA is provided by the user without its key.
+		if c.Purpose == secret.ClusterCA {
+			delete(s.Data, secret.TLSKeyDataName)
+		}
+		objs = append(objs, s)
+	}
+	fakeClient := newFakeClient(objs...)
+
+	managementCluster := &fakeManagementCluster{Workload: &fakeWorkloadCluster{}}
+	recorder := record.NewFakeRecorder(32)
+	r := &Reconciler{
+		Client:              fakeClient,
+		SecretCachingClient: fakeClient,
+		managementCluster:   managementCluster,
+		recorder:            recorder,
+	}
+	controlPlane := &pkg.ControlPlane{
+		KCP:     kcp,
+		Cluster: cluster,
+	}
+	controlPlane.InjectTestManagementCluster(managementCluster)
+
+	_, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(kcp.Status.CertificateAuthorityRotation).To(BeZero())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("CertificateAuthorityRotationFailed")))
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/util/patch"
+	"sigs.k8s.io/cluster-api/util/collections"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+)
+
+// reconcileCertificatesRenewal renews in-place the certificates of control plane Machines that are about to expire,
+// one Machine at a time, if a RenewMachineCertificates extension is registered; otherwise those Machines are rolled out.
+// It returns true if a renewal is in progress, so the caller must not start other operations on the control plane.
+func (r *Reconciler) reconcileCertificatesRenewal(ctx context.Context, controlPlane *pkg.ControlPlane) (renewing bool, _ ctrl.Result, _ error) {
+	if !feature.Gates.Enabled(feature.InPlaceUpdates) {
+		return false, ctrl.Result{}, nil
+	}
+
+	// Complete ongoing renewals first.
+	// Note: Machines being deleted are ignored because they are not going to be rolled out anyway.
+	if machines := controlPlane.Machines.Filter(
+		collections.HasAnnotationKey(controlplanev1.CertificatesRenewalInProgressAnnotation),
+		collections.Not(collections.HasDeletionTimestamp),
+	); machines.Len() > 0 {
+		var result ctrl.Result
+		for _, machine := range machines.SortedByCreationTimestamp() {
+			res, err := r.renewMachineCertificates(ctx, controlPlane, machine)
+			if err != nil {
+				return true, ctrl.Result{}, err
+			}
+			if res.RequeueAfter > 0 && (result.RequeueAfter == 0 || res.RequeueAfter < result.RequeueAfter) {
+				result = res
+			}
+		}
+		return true, result, nil
+	}
+
+	// Renew certificates only for Machines that need rollout just because their certificates are about to expire.
+	// Note: Machines with the expiry date of certificates set by the user via annotation are not renewed, because the expiry
+	// date of the renewed certificates would not be propagated to them.
+	machinesNeedingRollout, machinesUpToDateResults := controlPlane.MachinesNeedingRollout()
+	machines := machinesNeedingRollout.Filter(func(machine *clusterv1.Machine) bool {
+		return machinesUpToDateResults[machine.Name].OnlyCertificatesExpiring && machine.Status.NodeRef.IsDefined()
+	}, collections.Not(collections.HasAnnotationKey(clusterv1.MachineCertificatesExpiryDateAnnotation)))
+	if machines.Len() == 0 {
+		return false, ctrl.Result{}, nil
+	}
+
+	extensionHandlers, err := r.RuntimeClient.GetAllExtensions(ctx, runtimehooksv1.RenewMachineCertificates, controlPlane.Cluster)
+	if err != nil {
+		return false, ctrl.Result{}, err
+	}
+	if len(extensionHandlers) == 0 {
+		// Fallback to rollout.
+		return false, ctrl.Result{}, nil
+	}
+	if len(extensionHandlers) > 1 {
+		return false, ctrl.Result{}, pkgerrors.Errorf("found multiple RenewMachineCertificates hooks (%s): only one hook is supported", strings.Join(extensionHandlers, ","))
+	}
+
+	// Run preflight checks to ensure that the control plane is stable before renewing certificates, given that
+	// control plane components are restarted during the renewal.
+	if result := r.preflightChecks(ctx, controlPlane, false); !result.IsZero() {
+		return true, result, nil
+	}
+
+	// Renew first the certificates expiring first.
+	machinesToRenew := machines.UnsortedList()
+	sort.SliceStable(machinesToRenew, func(i, j int) bool {
+		return machinesToRenew[i].Status.CertificatesExpiryDate.Before(&machinesToRenew[j].Status.CertificatesExpiryDate)
+	})
+	return true, ctrl.Result{}, r.startMachineCertificatesRenewal(ctx, controlPlane, machinesToRenew[0])
+}
+
+// startMachineCertificatesRenewal marks a Machine for certificates renewal by setting the
+// CertificatesRenewalInProgressAnnotation with the current expiry date of its certificates.
+func (r *Reconciler) startMachineCertificatesRenewal(ctx context.Context, controlPlane *pkg.ControlPlane, machine *clusterv1.Machine) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("Machine", klog.KObj(machine))
+
+	kubeadmConfig, ok := controlPlane.GetKubeadmConfig(machine.Name)
+	if !ok {
+		return pkgerrors.Errorf("failed to start certificates renewal for Machine %s: KubeadmConfig not found", klog.KObj(machine))
+	}
+	expiry, ok := kubeadmConfig.Annotations[clusterv1.MachineCertificatesExpiryDateAnnotation]
+	if !ok {
+		// Note: This can only happen if the annotation was removed after the expiry date was propagated to the Machine.
+		expiry = machine.Status.CertificatesExpiryDate.Format(time.RFC3339)
+	}
+
+	log.Info(fmt.Sprintf("Starting certificates renewal for Machine %s", klog.KObj(machine)), "expiryDate", expiry)
+
+	orig := machine.DeepCopy()
+	if machine.Annotations == nil {
+		machine.Annotations = map[string]string{}
+	}
+	machine.Annotations[controlplanev1.CertificatesRenewalInProgressAnnotation] = expiry
+	if err := r.Client.Patch(ctx, machine, client.MergeFrom(orig)); err != nil {
+		return pkgerrors.Wrapf(err, "failed to start certificates renewal for Machine %s by setting the %s annotation", klog.KObj(machine), controlplanev1.CertificatesRenewalInProgressAnnotation)
+	}
+
+	// Wait until the cache observed the Machine with CertificatesRenewalInProgressAnnotation to ensure subsequent reconciles
+	// will observe it as well and accordingly don't start a rollout for it.
+	r.controller.DeferNextReconcileUntilCacheUpToDate(controlPlane.KCP, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "Machine"), machine.ResourceVersion)
+
+	r.recorder.Event(machine, corev1.EventTypeNormal, "SuccessfulStartCertificatesRenewal", "Machine starting certificates renewal")
+	return nil
+}
+
+// renewMachineCertificates calls the RenewMachineCertificates extension for a Machine until the renewal is completed,
+// then it records the new expiry date of the certificates on the KubeadmConfig and waits for it to be propagated
+// to the Machine before removing the CertificatesRenewalInProgressAnnotation.
+func (r *Reconciler) renewMachineCertificates(ctx context.Context, controlPlane *pkg.ControlPlane, machine *clusterv1.Machine) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("Machine", klog.KObj(machine))
+
+	previousExpiry := machine.Annotations[controlplanev1.CertificatesRenewalInProgressAnnotation]
+
+	kubeadmConfig, ok := controlPlane.GetKubeadmConfig(machine.Name)
+	if !ok {
+		return ctrl.Result{}, pkgerrors.Errorf("failed to renew certificates for Machine %s: KubeadmConfig not found", klog.KObj(machine))
+	}
+
+	// If the expiry date of the renewed certificates has been already recorded, wait for the Machine controller to propagate it to
+	// the Machine; this prevents KCP from rolling out the Machine after the CertificatesRenewalInProgressAnnotation has been removed.
+	if expiry, ok := kubeadmConfig.Annotations[clusterv1.MachineCertificatesExpiryDateAnnotation]; ok && expiry != previousExpiry {
+		if expiryTime, err := time.Parse(time.RFC3339, expiry); err != nil || !machine.Status.CertificatesExpiryDate.Equal(&metav1.Time{Time: expiryTime}) {
+			log.V(4).Info("Waiting for the expiry date of the renewed certificates to be propagated to the Machine")
+			return ctrl.Result{RequeueAfter: certificatesRenewalRequeueAfter}, nil
+		}
+
+		orig := machine.DeepCopy()
+		delete(machine.Annotations, controlplanev1.CertificatesRenewalInProgressAnnotation)
+		if err := r.Client.Patch(ctx, machine, client.MergeFrom(orig)); err != nil {
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to complete certificates renewal for Machine %s", klog.KObj(machine))
+		}
+
+		log.Info(fmt.Sprintf("Completed certificates renewal for Machine %s", klog.KObj(machine)), "expiryDate", expiry)
+		r.recorder.Event(machine, corev1.EventTypeNormal, "SuccessfulRenewCertificates", "Machine certificates renewed")
+		return ctrl.Result{}, nil
+	}
+
+	extensionHandlers, err := r.RuntimeClient.GetAllExtensions(ctx, runtimehooksv1.RenewMachineCertificates, controlPlane.Cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(extensionHandlers) == 0 {
+		return ctrl.Result{}, pkgerrors.Errorf("failed to renew certificates for Machine %s: no extensions registered for RenewMachineCertificates hook", klog.KObj(machine))
+	}
+	if len(extensionHandlers) > 1 {
+		return ctrl.Result{}, pkgerrors.Errorf("failed to renew certificates for Machine %s: found multiple RenewMachineCertificates hooks (%s): only one hook is supported", klog.KObj(machine), strings.Join(extensionHandlers, ","))
+	}
+
+	req, err := createRenewMachineCertificatesRequest(controlPlane, machine, kubeadmConfig)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrap(err, "failed to generate RenewMachineCertificates request")
+	}
+	resp := &runtimehooksv1.RenewMachineCertificatesResponse{}
+	if err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.RenewMachineCertificates, controlPlane.Cluster, extensionHandlers[0], req, resp); err != nil {
+		r.recorder.Eventf(machine, corev1.EventTypeWarning, "FailedRenewCertificates", "Failed to renew certificates: %v", err)
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to renew certificates for Machine %s", klog.KObj(machine))
+	}
+	if resp.RetryAfterSeconds != 0 {
+		log.Info(fmt.Sprintf("Waiting for RenewMachineCertificates extension to renew certificates for Machine %s", klog.KObj(machine)), "message", resp.Message)
+		return ctrl.Result{RequeueAfter: time.Duration(resp.RetryAfterSeconds) * time.Second}, nil
+	}
+
+	// Read the expiry date of the renewed certificates from the kube-apiserver.
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to renew certificates for Machine %s: cannot get remote client to workload cluster", klog.KObj(machine))
+	}
+	certificateExpiry, err := workloadCluster.GetAPIServerCertificateExpiry(ctx, kubeadmConfig, machine.Status.NodeRef.Name)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to renew certificates for Machine %s", klog.KObj(machine))
+	}
+	expiry := certificateExpiry.Format(time.RFC3339)
+	if expiry == previousExpiry {
+		// Note: The extension is called again until the kube-apiserver uses the renewed certificates.
+		log.Info(fmt.Sprintf("Waiting for the kube-apiserver on Machine %s to use the renewed certificates", klog.KObj(machine)))
+		return ctrl.Result{RequeueAfter: certificatesRenewalRequeueAfter}, nil
+	}
+
+	log.V(2).Info(fmt.Sprintf("Setting certificate expiry date on KubeadmConfig %s", klog.KObj(kubeadmConfig)), "expiryDate", expiry)
+	orig := kubeadmConfig.DeepCopy()
+	if kubeadmConfig.Annotations == nil {
+		kubeadmConfig.Annotations = map[string]string{}
+	}
+	kubeadmConfig.Annotations[clusterv1.MachineCertificatesExpiryDateAnnotation] = expiry
+	if err := r.Client.Patch(ctx, kubeadmConfig, client.MergeFrom(orig)); err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to renew certificates for Machine %s", klog.KObj(machine))
+	}
+	return ctrl.Result{RequeueAfter: certificatesRenewalRequeueAfter}, nil
+}
+
+func createRenewMachineCertificatesRequest(controlPlane *pkg.ControlPlane, machine *clusterv1.Machine, kubeadmConfig *bootstrapv1.KubeadmConfig) (*runtimehooksv1.RenewMachineCertificatesRequest, error) {
+	kubeadmConfig = kubeadmConfig.DeepCopy()
+	// Set GVK because object is later marshalled with json.Marshal.
+	kubeadmConfig.SetGroupVersionKind(bootstrapv1.GroupVersion.WithKind("KubeadmConfig"))
+	kubeadmConfig.ManagedFields = nil
+	kubeadmConfig.Status = bootstrapv1.KubeadmConfigStatus{}
+	bootstrapConfigRaw, err := patch.ConvertToRawExtension(kubeadmConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimehooksv1.RenewMachineCertificatesRequest{
+		Cluster: *cleanupCluster(controlPlane.Cluster),
+		Machine: clusterv1.Machine{
+			// Set GVK because object is later marshalled with json.Marshal.
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "Machine",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        machine.Name,
+				Namespace:   machine.Namespace,
+				Labels:      machine.Labels,
+				Annotations: machine.Annotations,
+			},
+			Spec: *machine.Spec.DeepCopy(),
+			Status: clusterv1.MachineStatus{
+				NodeRef:                machine.Status.NodeRef,
+				CertificatesExpiryDate: machine.Status.CertificatesExpiryDate,
+			},
+		},
+		BootstrapConfig: bootstrapConfigRaw,
+	}, nil
+}
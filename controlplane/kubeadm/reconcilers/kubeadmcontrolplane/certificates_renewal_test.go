@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/feature"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
+	"sigs.k8s.io/cluster-api/util/collections"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestReconcileCertificatesRenewal(t *testing.T) {
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+	renewMachineCertificatesGVH, err := catalog.GroupVersionHook(runtimehooksv1.RenewMachineCertificates)
+	if err != nil {
+		panic("unable to compute GVH")
+	}
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	oldExpiry := now.Add(10 * 24 * time.Hour)
+	newExpiry := now.Add(365 * 24 * time.Hour)
+
+	successResponse := &runtimehooksv1.RenewMachineCertificatesResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess}},
+	}
+	inProgressResponse := &runtimehooksv1.RenewMachineCertificatesResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess, Message: "renewing certificates"},
+			RetryAfterSeconds: 20,
+		},
+	}
+
+	tests := []struct {
+		name                       string
+		disableInPlaceUpdates      bool
+		machineAnnotations         map[string]string
+		machineExpiry              time.Time
+		kubeadmConfigExpiry        time.Time
+		apiServerCertificateExpiry time.Time
+		getAllExtensionsResponses  map[runtimecatalog.GroupVersionHook][]string
+		callExtensionResponses     map[string]runtimehooksv1.ResponseObject
+		wantRenewing               bool
+		wantResult                 time.Duration
+		wantErr                    bool
+		wantMachineAnnotation      *string
+		wantKubeadmConfigExpiry    time.Time
+	}{
+		{
+			name:                    "Do nothing if the InPlaceUpdates feature gate is disabled",
+			disableInPlaceUpdates:   true,
+			machineExpiry:           oldExpiry,
+			kubeadmConfigExpiry:     oldExpiry,
+			wantRenewing:            false,
+			wantKubeadmConfigExpiry: oldExpiry,
+		},
+		{
+			name:                    "Do nothing if certificates are not expiring",
+			machineExpiry:           newExpiry,
+			kubeadmConfigExpiry:     newExpiry,
+			wantRenewing:            false,
+			wantKubeadmConfigExpiry: newExpiry,
+		},
+		{
+			name:                      "Fallback to rollout if there are no RenewMachineCertificates extensions",
+			machineExpiry:             oldExpiry,
+			kubeadmConfigExpiry:       oldExpiry,
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{},
+			wantRenewing:              false,
+			wantKubeadmConfigExpiry:   oldExpiry,
+		},
+		{
+			name:                "Return error if there are multiple RenewMachineCertificates extensions",
+			machineExpiry:       oldExpiry,
+			kubeadmConfigExpiry: oldExpiry,
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				renewMachineCertificatesGVH: {"renew-extension", "other-extension"},
+			},
+			wantErr:                 true,
+			wantKubeadmConfigExpiry: oldExpiry,
+		},
+		{
+			name:                "Start renewal if certificates are expiring",
+			machineExpiry:       oldExpiry,
+			kubeadmConfigExpiry: oldExpiry,
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				renewMachineCertificatesGVH: {"renew-extension"},
+			},
+			wantRenewing:            true,
+			wantMachineAnnotation:   ptr.To(oldExpiry.Format(time.RFC3339)),
+			wantKubeadmConfigExpiry: oldExpiry,
+		},
+		{
+			name:                "Requeue if the extension is renewing certificates",
+			machineAnnotations:  map[string]string{controlplanev1.CertificatesRenewalInProgressAnnotation: oldExpiry.Format(time.RFC3339)},
+			machineExpiry:       oldExpiry,
+			kubeadmConfigExpiry: oldExpiry,
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				renewMachineCertificatesGVH: {"renew-extension"},
+			},
+			callExtensionResponses:  map[string]runtimehooksv1.ResponseObject{"renew-extension": inProgressResponse},
+			wantRenewing:            true,
+			wantResult:              20 * time.Second,
+			wantMachineAnnotation:   ptr.To(oldExpiry.Format(time.RFC3339)),
+			wantKubeadmConfigExpiry: oldExpiry,
+		},
+		{
+			name:                "Return error if the extension fails",
+			machineAnnotations:  map[string]string{controlplanev1.CertificatesRenewalInProgressAnnotation: oldExpiry.Format(time.RFC3339)},
+			machineExpiry:       oldExpiry,
+			kubeadmConfigExpiry: oldExpiry,
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				renewMachineCertificatesGVH: {"renew-extension"},
+			},
+			callExtensionResponses: map[string]runtimehooksv1.ResponseObject{
+				"renew-extension": &runtimehooksv1.RenewMachineCertificatesResponse{
+					CommonRetryResponse: runtimehooksv1.CommonRetryResponse{CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusFailure}},
+				},
+			},
+			wantRenewing:            true,
+			wantErr:                 true,
+			wantMachineAnnotation:   ptr.To(oldExpiry.Format(time.RFC3339)),
+			wantKubeadmConfigExpiry: oldExpiry,
+		},
+		{
+			name:                       "Wait for the kube-apiserver to use the renewed certificates",
+			machineAnnotations:         map[string]string{controlplanev1.CertificatesRenewalInProgressAnnotation: oldExpiry.Format(time.RFC3339)},
+			machineExpiry:              oldExpiry,
+			kubeadmConfigExpiry:        oldExpiry,
+			apiServerCertificateExpiry: oldExpiry,
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				renewMachineCertificatesGVH: {"renew-extension"},
+			},
+			callExtensionResponses:  map[string]runtimehooksv1.ResponseObject{"renew-extension": successResponse},
+			wantRenewing:            true,
+			wantResult:              certificatesRenewalRequeueAfter,
+			wantMachineAnnotation:   ptr.To(oldExpiry.Format(time.RFC3339)),
+			wantKubeadmConfigExpiry: oldExpiry,
+		},
+		{
+			name:                       "Record the expiry date of the renewed certificates",
+			machineAnnotations:         map[string]string{controlplanev1.CertificatesRenewalInProgressAnnotation: oldExpiry.Format(time.RFC3339)},
+			machineExpiry:              oldExpiry,
+			kubeadmConfigExpiry:        oldExpiry,
+			apiServerCertificateExpiry: newExpiry,
+			getAllExtensionsResponses: map[runtimecatalog.GroupVersionHook][]string{
+				renewMachineCertificatesGVH: {"renew-extension"},
+			},
+			callExtensionResponses:  map[string]runtimehooksv1.ResponseObject{"renew-extension": successResponse},
+			wantRenewing:            true,
+			wantResult:              certificatesRenewalRequeueAfter,
+			wantMachineAnnotation:   ptr.To(oldExpiry.Format(time.RFC3339)),
+			wantKubeadmConfigExpiry: newExpiry,
+		},
+		{
+			name:                    "Wait for the expiry date of the renewed certificates to be propagated to the Machine",
+			machineAnnotations:      map[string]string{controlplanev1.CertificatesRenewalInProgressAnnotation: oldExpiry.Format(time.RFC3339)},
+			machineExpiry:           oldExpiry,
+			kubeadmConfigExpiry:     newExpiry,
+			wantRenewing:            true,
+			wantResult:              certificatesRenewalRequeueAfter,
+			wantMachineAnnotation:   ptr.To(oldExpiry.Format(time.RFC3339)),
+			wantKubeadmConfigExpiry: newExpiry,
+		},
+		{
+			name:                    "Complete renewal when the expiry date of the renewed certificates has been propagated to the Machine",
+			machineAnnotations:      map[string]string{controlplanev1.CertificatesRenewalInProgressAnnotation: oldExpiry.Format(time.RFC3339)},
+			machineExpiry:           newExpiry,
+			kubeadmConfigExpiry:     newExpiry,
+			wantRenewing:            true,
+			wantMachineAnnotation:   nil,
+			wantKubeadmConfigExpiry: newExpiry,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.InPlaceUpdates, !tt.disableInPlaceUpdates)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Version: "v1.31.0",
+					Rollout: controlplanev1.KubeadmControlPlaneRolloutSpec{
+						Before: controlplanev1.KubeadmControlPlaneRolloutBeforeSpec{
+							CertificatesExpiryDays: 30,
+						},
+					},
+				},
+			}
+			kubeadmConfig := &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "m1",
+					Namespace:   metav1.NamespaceDefault,
+					Annotations: map[string]string{clusterv1.MachineCertificatesExpiryDateAnnotation: tt.kubeadmConfigExpiry.Format(time.RFC3339)},
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+						FeatureGates: map[string]bool{"ControlPlaneKubeletLocalMode": true},
+					},
+				},
+			}
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "m1",
+					Namespace:   metav1.NamespaceDefault,
+					Annotations: tt.machineAnnotations,
+				},
+				Spec: clusterv1.MachineSpec{
+					ClusterName: cluster.Name,
+					Version:     "v1.31.0",
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						APIGroup: builder.InfrastructureGroupVersion.Group,
+						Kind:     builder.TestInfrastructureMachineKind,
+						Name:     "m1-infra",
+					},
+					Bootstrap: clusterv1.Bootstrap{
+						ConfigRef: clusterv1.ContractVersionedObjectReference{
+							APIGroup: bootstrapv1.GroupVersion.Group,
+							Kind:     "KubeadmConfig",
+							Name:     kubeadmConfig.Name,
+						},
+					},
+				},
+				Status: clusterv1.MachineStatus{
+					NodeRef:                clusterv1.MachineNodeReference{Name: "n1"},
+					CertificatesExpiryDate: metav1.NewTime(tt.machineExpiry),
+				},
+			}
+			fakeClient := newFakeClient(
+				machine.DeepCopy(),
+				kubeadmConfig.DeepCopy(),
+				// Note: CRD is needed to look up the apiVersion from contract labels.
+				builder.TestInfrastructureMachineCRD,
+			)
+
+			apiServerCertificateExpiry := tt.apiServerCertificateExpiry
+			managementCluster := &fakeManagementCluster{
+				Workload: &fakeWorkloadCluster{
+					APIServerCertificateExpiry: &apiServerCertificateExpiry,
+				},
+			}
+			controlPlane, err := pkg.NewControlPlane(ctx, managementCluster, fakeClient, cluster, kcp, collections.FromMachines(machine))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			r := &Reconciler{
+				Client: fakeClient,
+				RuntimeClient: fakeruntimeclient.NewRuntimeClientBuilder().
+					WithCatalog(catalog).
+					WithGetAllExtensionResponses(tt.getAllExtensionsResponses).
+					WithCallExtensionResponses(tt.callExtensionResponses).
+					Build(),
+				recorder:   record.NewFakeRecorder(32),
+				controller: capicontrollerutil.NewFakeController(),
+				overridePreflightChecksFunc: func(_ context.Context, _ *pkg.ControlPlane, _ ...*clusterv1.Machine) ctrl.Result {
+					return ctrl.Result{}
+				},
+			}
+
+			renewing, res, err := r.reconcileCertificatesRenewal(ctx, controlPlane)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(renewing).To(Equal(tt.wantRenewing))
+			}
+			g.Expect(res.RequeueAfter).To(Equal(tt.wantResult))
+
+			gotMachine := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+			if tt.wantMachineAnnotation != nil {
+				g.Expect(gotMachine.Annotations).To(HaveKeyWithValue(controlplanev1.CertificatesRenewalInProgressAnnotation, *tt.wantMachineAnnotation))
+			} else {
+				g.Expect(gotMachine.Annotations).ToNot(HaveKey(controlplanev1.CertificatesRenewalInProgressAnnotation))
+			}
+
+			gotKubeadmConfig := &bootstrapv1.KubeadmConfig{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kubeadmConfig), gotKubeadmConfig)).To(Succeed())
+			g.Expect(gotKubeadmConfig.Annotations).To(HaveKeyWithValue(clusterv1.MachineCertificatesExpiryDateAnnotation, tt.wantKubeadmConfigExpiry.Format(time.RFC3339)))
+		})
+	}
+}
@@ -30,4 +30,8 @@ const (
 	// dependentCertRequeueAfter is how long to wait before checking again to see if
 	// dependent certificates have been created.
 	dependentCertRequeueAfter = 30 * time.Second
+
+	// certificatesRenewalRequeueAfter is how long to wait before checking again to see if
+	// the renewed certificates of a Machine are in use.
+	certificatesRenewalRequeueAfter = 10 * time.Second
 )
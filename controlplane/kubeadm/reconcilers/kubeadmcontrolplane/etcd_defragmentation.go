@@ -0,0 +1,438 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd"
+)
+
+const (
+	// etcdDefragmentationTimeout is the maximum duration of the defragmentation of an etcd member.
+	etcdDefragmentationTimeout = 5 * time.Minute
+
+	// etcdDefragmentationPollPeriod is the period after which KCP checks if the defragmentation of an etcd member in progress is completed.
+	etcdDefragmentationPollPeriod = 15 * time.Second
+
+	// etcdDefragmentationRetryPeriod is the period after which the defragmentation of an etcd member is retried after a failure.
+	etcdDefragmentationRetryPeriod = 1 * time.Minute
+
+	// etcdDefragmentationNextMemberPeriod is the period KCP waits after defragmenting an etcd member before defragmenting
+	// the next one, so the member can catch up with the rest of the cluster.
+	etcdDefragmentationNextMemberPeriod = 10 * time.Second
+
+	// etcdDefragmentationCheckPeriod is the period after which KCP checks again if etcd members must be defragmented.
+	// Note: This must be shorter than the minimum duration of the maintenance window.
+	etcdDefragmentationCheckPeriod = 5 * time.Minute
+
+	// etcdNoSpaceAlarmDisarmQuotaPercent is the percentage of the quota the database of an etcd member must be below of
+	// after defragmentation to disarm its NOSPACE alarm; the margin prevents the alarm from being raised again right away.
+	etcdNoSpaceAlarmDisarmQuotaPercent = 90
+
+	// etcdDefragmentationMinInterval is the minimum interval between two defragmentations of the same etcd member
+	// triggered by fragmentation exceeding the threshold or by NOSPACE alarms; it prevents defragmenting a member
+	// over and over when defragmentation does not free enough space.
+	etcdDefragmentationMinInterval = 15 * time.Minute
+)
+
+// etcdDefragmentationTracker tracks the defragmentations of etcd members that are running in the background, so
+// defragmenting an etcd member does not block the reconciliation of the KubeadmControlPlane.
+type etcdDefragmentationTracker struct {
+	lock             sync.Mutex
+	defragmentations map[types.NamespacedName]*etcdDefragmentationOperation
+}
+
+// etcdDefragmentationOperation is the defragmentation of an etcd member running in the background.
+type etcdDefragmentationOperation struct {
+	member    string
+	startTime time.Time
+	// cancel stops the operation, if it is still running.
+	cancel context.CancelFunc
+
+	// The following fields are set when the operation is completed.
+	done bool
+	// defragmented is true if the etcd member has been defragmented, even if disarming its NOSPACE alarm failed.
+	defragmented bool
+	dbStatus     *etcd.DBStatus
+	err          error
+}
+
+// get returns a copy of the etcd defragmentation operation for a KubeadmControlPlane, if any.
+func (t *etcdDefragmentationTracker) get(key types.NamespacedName) (etcdDefragmentationOperation, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	op, ok := t.defragmentations[key]
+	if !ok {
+		return etcdDefragmentationOperation{}, false
+	}
+	return *op, true
+}
+
+// start tracks a new etcd defragmentation operation for a KubeadmControlPlane.
+func (t *etcdDefragmentationTracker) start(key types.NamespacedName, op *etcdDefragmentationOperation) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.defragmentations == nil {
+		t.defragmentations = map[types.NamespacedName]*etcdDefragmentationOperation{}
+	}
+	t.defragmentations[key] = op
+}
+
+// complete records the result of an etcd defragmentation operation.
+func (t *etcdDefragmentationTracker) complete(op *etcdDefragmentationOperation, setResult func(op *etcdDefragmentationOperation)) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	setResult(op)
+	op.done = true
+}
+
+// forget stops tracking the etcd defragmentation operation for a KubeadmControlPlane; if the operation
+// is still running it is cancelled.
+func (t *etcdDefragmentationTracker) forget(key types.NamespacedName) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if op, ok := t.defragmentations[key]; ok && op.cancel != nil {
+		op.cancel()
+	}
+	delete(t.defragmentations, key)
+}
+
+// reconcileEtcdDefragmentation defragments etcd members according to spec.etcdDefragmentation, and disarms NOSPACE alarms
+// once defragmentation freed space. A member is defragmented when:
+//   - it raised a NOSPACE alarm;
+//   - the percentage of its database not in use is greater than or equal to fragmentationThresholdPercent;
+//   - the maintenance window is open and it has not been defragmented yet during this window.
+//
+// Members are defragmented one at a time, the leader last, because a member does not serve requests while it is being defragmented.
+// Defragmentation runs in the background and it is tracked in r.etcdDefragmentations, so it does not block other KCP operations.
+// Failures are surfaced as events and retried after etcdDefragmentationRetryPeriod, so they do not block other KCP operations.
+//
+// The size of the database of each member is checked at most once every etcdDefragmentationCheckPeriod, unless a member
+// raised a NOSPACE alarm, is waiting to be defragmented, or the maintenance window opened since the last check.
+//
+// Note: KCP does not compact etcd; the API server already compacts it periodically (see --etcd-compaction-interval),
+// and compacting more aggressively would break watches and list requests relying on older revisions.
+func (r *Reconciler) reconcileEtcdDefragmentation(ctx context.Context, controlPlane *pkg.ControlPlane) ctrl.Result {
+	log := ctrl.LoggerFrom(ctx)
+
+	key := client.ObjectKeyFromObject(controlPlane.KCP)
+	spec := controlPlane.KCP.Spec.EtcdDefragmentation
+	if spec == (controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{}) || !controlPlane.IsEtcdManaged() {
+		// Note: a defragmentation in progress, if any, is cancelled.
+		r.etcdDefragmentations.forget(key)
+		controlPlane.KCP.Status.EtcdDefragmentation = controlplanev1.KubeadmControlPlaneEtcdDefragmentationStatus{}
+		return ctrl.Result{}
+	}
+
+	if op, ok := r.etcdDefragmentations.get(key); ok {
+		if !op.done {
+			if status := etcdMemberDefragmentationStatus(controlPlane.KCP, op.member); status != nil {
+				status.Phase = controlplanev1.EtcdMemberDefragmentationInProgressPhase
+			}
+			return ctrl.Result{RequeueAfter: etcdDefragmentationPollPeriod}
+		}
+
+		r.etcdDefragmentations.forget(key)
+		return r.completeEtcdDefragmentation(ctx, controlPlane, op)
+	}
+
+	// Members, leader and alarms are read while computing conditions; if etcd could not be inspected, wait for the next reconcile.
+	if controlPlane.EtcdLeader == nil || len(controlPlane.EtcdMembers) == 0 {
+		return ctrl.Result{RequeueAfter: etcdDefragmentationCheckPeriod}
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		log.Error(err, "Failed to create client to workload cluster")
+		return ctrl.Result{RequeueAfter: etcdDefragmentationRetryPeriod}
+	}
+
+	now := time.Now()
+	windowStart, inWindow := maintenanceWindow(spec.MaintenanceWindow, now)
+
+	noSpaceAlarms := map[uint64]etcd.MemberAlarm{}
+	for _, alarm := range controlPlane.EtcdMembersAlarms {
+		if alarm.Type == etcd.AlarmNoSpace {
+			noSpaceAlarms[alarm.MemberID] = alarm
+		}
+	}
+
+	// Do not check the size of the database of the members on every reconcile, because it requires a request to each member.
+	lastCheck := controlPlane.KCP.Status.EtcdDefragmentation.LastCheckTime.Time
+	if sinceLastCheck := now.Sub(lastCheck); sinceLastCheck >= 0 && sinceLastCheck < etcdDefragmentationCheckPeriod &&
+		len(noSpaceAlarms) == 0 && !(inWindow && lastCheck.Before(windowStart)) && !isEtcdDefragmentationPending(controlPlane.KCP) {
+		return ctrl.Result{RequeueAfter: etcdDefragmentationCheckPeriod - sinceLastCheck}
+	}
+
+	previousStatuses := map[string]controlplanev1.EtcdMemberDefragmentationStatus{}
+	for _, s := range controlPlane.KCP.Status.EtcdDefragmentation.Members {
+		previousStatuses[s.Name] = s
+	}
+
+	members := etcd.Members(controlPlane.EtcdMembers)
+	sort.Sort(members)
+
+	// Check which members must be defragmented.
+	statuses := make([]controlplanev1.EtcdMemberDefragmentationStatus, 0, len(members))
+	var candidates []*etcd.Member
+	for _, member := range members {
+		if member.Name == "" {
+			// The member has not been started yet.
+			continue
+		}
+
+		status, ok := previousStatuses[member.Name]
+		if !ok {
+			status = controlplanev1.EtcdMemberDefragmentationStatus{Name: member.Name}
+		}
+
+		dbStatus, err := workloadCluster.EtcdMemberDBStatus(ctx, member.Name)
+		if err != nil {
+			log.Error(err, "Failed to get etcd member status", "member", member.Name)
+			statuses = append(statuses, status)
+			continue
+		}
+		status.DBSizeBytes = ptr.To(dbStatus.DBSize)
+		status.DBSizeInUseBytes = ptr.To(dbStatus.DBSizeInUse)
+
+		if mustDefragmentEtcdMember(spec, status, dbStatus, noSpaceAlarms[member.ID].Type == etcd.AlarmNoSpace, inWindow, windowStart, now) {
+			candidates = append(candidates, member)
+			if status.Phase != controlplanev1.EtcdMemberDefragmentationFailedPhase {
+				status.Phase = controlplanev1.EtcdMemberDefragmentationPendingPhase
+			}
+		} else {
+			status.Phase = controlplanev1.EtcdMemberDefragmentationIdlePhase
+		}
+		statuses = append(statuses, status)
+	}
+	controlPlane.KCP.Status.EtcdDefragmentation.Members = statuses
+	controlPlane.KCP.Status.EtcdDefragmentation.LastCheckTime = metav1.NewTime(now)
+
+	if len(candidates) == 0 {
+		return ctrl.Result{RequeueAfter: etcdDefragmentationCheckPeriod}
+	}
+
+	// Pick the member to be defragmented, leaving the leader last.
+	member := candidates[0]
+	for _, c := range candidates {
+		if c.ID != controlPlane.EtcdLeader.ID {
+			member = c
+			break
+		}
+	}
+
+	if err := r.startEtcdDefragmentation(ctx, controlPlane, workloadCluster, member, noSpaceAlarms[member.ID], now); err != nil {
+		log.Error(err, "Failed to defragment etcd member", "member", member.Name)
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdDefragmentationFailed", "Failed to defragment etcd member %s: %v", member.Name, err)
+		etcdMemberDefragmentationStatus(controlPlane.KCP, member.Name).Phase = controlplanev1.EtcdMemberDefragmentationFailedPhase
+		return ctrl.Result{RequeueAfter: etcdDefragmentationRetryPeriod}
+	}
+	log.Info("Defragmenting etcd member", "member", member.Name)
+	etcdMemberDefragmentationStatus(controlPlane.KCP, member.Name).Phase = controlplanev1.EtcdMemberDefragmentationInProgressPhase
+	return ctrl.Result{RequeueAfter: etcdDefragmentationPollPeriod}
+}
+
+// startEtcdDefragmentation starts defragmenting an etcd member in the background; once defragmentation freed space,
+// the NOSPACE alarm of the member, if any, is disarmed.
+// The operation is tracked in r.etcdDefragmentations until its result is processed by reconcileEtcdDefragmentation.
+func (r *Reconciler) startEtcdDefragmentation(ctx context.Context, controlPlane *pkg.ControlPlane, workloadCluster pkg.WorkloadCluster, member *etcd.Member, noSpaceAlarm etcd.MemberAlarm, now time.Time) error {
+	status := etcdMemberDefragmentationStatus(controlPlane.KCP, member.Name)
+	if status == nil {
+		// Note: This should never happen, the status of all the members is set before starting defragmentation.
+		return pkgerrors.Errorf("failed to get defragmentation status of etcd member %s", member.Name)
+	}
+	sizeBefore := ptr.Deref(status.DBSizeBytes, 0)
+
+	op := &etcdDefragmentationOperation{
+		member:    member.Name,
+		startTime: now,
+	}
+
+	// Note: Defragmentation outlives the reconcile, so the context of the reconcile, which is cancelled when the
+	// reconcile returns, can't be used; defragmentation is instead cancelled when it is forgotten, e.g. when
+	// defragmentation is disabled or the KubeadmControlPlane is deleted.
+	defragCtx, cancel := context.WithTimeoutCause(context.WithoutCancel(ctx), etcdDefragmentationTimeout, pkgerrors.New("etcd defragmentation timeout expired"))
+	op.cancel = cancel
+	r.etcdDefragmentations.start(client.ObjectKeyFromObject(controlPlane.KCP), op)
+
+	go func() {
+		defer cancel()
+
+		defragmented, dbStatus, err := defragmentEtcdMember(defragCtx, workloadCluster, member, noSpaceAlarm, sizeBefore)
+		r.etcdDefragmentations.complete(op, func(op *etcdDefragmentationOperation) {
+			op.defragmented = defragmented
+			op.dbStatus = dbStatus
+			op.err = err
+		})
+	}()
+	return nil
+}
+
+// completeEtcdDefragmentation records the result of the defragmentation of an etcd member in KCP status.
+func (r *Reconciler) completeEtcdDefragmentation(ctx context.Context, controlPlane *pkg.ControlPlane, op etcdDefragmentationOperation) ctrl.Result {
+	log := ctrl.LoggerFrom(ctx)
+
+	status := etcdMemberDefragmentationStatus(controlPlane.KCP, op.member)
+	if status == nil {
+		controlPlane.KCP.Status.EtcdDefragmentation.Members = append(controlPlane.KCP.Status.EtcdDefragmentation.Members, controlplanev1.EtcdMemberDefragmentationStatus{Name: op.member})
+		status = etcdMemberDefragmentationStatus(controlPlane.KCP, op.member)
+	}
+	if op.defragmented {
+		status.LastDefragmentationTime = metav1.NewTime(op.startTime)
+		status.Phase = controlplanev1.EtcdMemberDefragmentationIdlePhase
+	}
+	if op.dbStatus != nil {
+		status.DBSizeBytes = ptr.To(op.dbStatus.DBSize)
+		status.DBSizeInUseBytes = ptr.To(op.dbStatus.DBSizeInUse)
+	}
+
+	if op.err != nil {
+		log.Error(op.err, "Failed to defragment etcd member", "member", op.member)
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdDefragmentationFailed", "Failed to defragment etcd member %s: %v", op.member, op.err)
+		status.Phase = controlplanev1.EtcdMemberDefragmentationFailedPhase
+		return ctrl.Result{RequeueAfter: etcdDefragmentationRetryPeriod}
+	}
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, "EtcdMemberDefragmented", "Defragmented etcd member %s", op.member)
+
+	if isEtcdDefragmentationPending(controlPlane.KCP) {
+		return ctrl.Result{RequeueAfter: etcdDefragmentationNextMemberPeriod}
+	}
+	return ctrl.Result{RequeueAfter: etcdDefragmentationCheckPeriod}
+}
+
+// defragmentEtcdMember defragments an etcd member and disarms its NOSPACE alarm, if any, once defragmentation freed space.
+// It returns true if the member has been defragmented, and the status of its database after defragmentation.
+func defragmentEtcdMember(ctx context.Context, workloadCluster pkg.WorkloadCluster, member *etcd.Member, noSpaceAlarm etcd.MemberAlarm, sizeBefore int64) (bool, *etcd.DBStatus, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("member", member.Name)
+
+	if err := workloadCluster.DefragmentEtcdMember(ctx, member.Name); err != nil {
+		return false, nil, err
+	}
+
+	dbStatus, err := workloadCluster.EtcdMemberDBStatus(ctx, member.Name)
+	if err != nil {
+		return true, nil, pkgerrors.Wrap(err, "failed to get etcd member status after defragmentation")
+	}
+	log.Info("Etcd member defragmented", "dbSizeBytesBefore", sizeBefore, "dbSizeBytes", dbStatus.DBSize)
+
+	if noSpaceAlarm.Type != etcd.AlarmNoSpace {
+		return true, dbStatus, nil
+	}
+
+	// Disarm the NOSPACE alarm only if defragmentation brought the database below etcdNoSpaceAlarmDisarmQuotaPercent
+	// of the quota; if the member does not report its quota, consider space freed if the database shrank.
+	spaceFreed := dbStatus.DBSize < sizeBefore
+	if dbStatus.DBSizeQuota > 0 {
+		spaceFreed = dbStatus.DBSize*100 <= dbStatus.DBSizeQuota*etcdNoSpaceAlarmDisarmQuotaPercent
+	}
+	if !spaceFreed {
+		return true, dbStatus, pkgerrors.Errorf("defragmentation did not free enough space to disarm the NOSPACE alarm (db size %d bytes)", dbStatus.DBSize)
+	}
+	if err := workloadCluster.DisarmEtcdAlarm(ctx, member.Name, noSpaceAlarm); err != nil {
+		return true, dbStatus, err
+	}
+	log.Info("Etcd NOSPACE alarm disarmed")
+	return true, dbStatus, nil
+}
+
+// mustDefragmentEtcdMember returns true if an etcd member must be defragmented.
+func mustDefragmentEtcdMember(spec controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec, status controlplanev1.EtcdMemberDefragmentationStatus, dbStatus *etcd.DBStatus, noSpace, inWindow bool, windowStart, now time.Time) bool {
+	lastDefragmentation := status.LastDefragmentationTime.Time
+
+	// Defragment each member once during the maintenance window.
+	if inWindow && lastDefragmentation.Before(windowStart) {
+		return true
+	}
+
+	// Do not defragment the same member over and over if defragmentation does not free enough space.
+	if now.Sub(lastDefragmentation) < etcdDefragmentationMinInterval {
+		return false
+	}
+
+	if noSpace {
+		return true
+	}
+
+	if spec.FragmentationThresholdPercent != nil && dbStatus.DBSize > 0 {
+		fragmentationPercent := (dbStatus.DBSize - dbStatus.DBSizeInUse) * 100 / dbStatus.DBSize
+		if fragmentationPercent >= int64(*spec.FragmentationThresholdPercent) {
+			return true
+		}
+	}
+	return false
+}
+
+// maintenanceWindow returns the start of the last maintenance window started before now, and true if now is within it.
+func maintenanceWindow(window controlplanev1.EtcdMaintenanceWindow, now time.Time) (time.Time, bool) {
+	if window.StartTime == "" {
+		return time.Time{}, false
+	}
+
+	now = now.UTC()
+	var hour, minute int
+	if _, err := fmt.Sscanf(window.StartTime, "%d:%d", &hour, &minute); err != nil {
+		// Note: This should never happen, startTime is validated by the API server.
+		return time.Time{}, false
+	}
+	start := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, time.UTC)
+	if start.After(now) {
+		start = start.Add(-24 * time.Hour)
+	}
+	end := start.Add(time.Duration(window.DurationMinutes) * time.Minute)
+	return start, now.Before(end)
+}
+
+// isEtcdDefragmentationPending returns true if any etcd member is waiting to be defragmented, or its last defragmentation failed.
+// Note: Members reported in progress are also considered, because defragmentation is interrupted e.g. by a restart of the controller.
+func isEtcdDefragmentationPending(kcp *controlplanev1.KubeadmControlPlane) bool {
+	for _, s := range kcp.Status.EtcdDefragmentation.Members {
+		if s.Phase == controlplanev1.EtcdMemberDefragmentationPendingPhase || s.Phase == controlplanev1.EtcdMemberDefragmentationInProgressPhase ||
+			s.Phase == controlplanev1.EtcdMemberDefragmentationFailedPhase {
+			return true
+		}
+	}
+	return false
+}
+
+// etcdMemberDefragmentationStatus returns the defragmentation status of an etcd member in KCP status.
+func etcdMemberDefragmentationStatus(kcp *controlplanev1.KubeadmControlPlane, name string) *controlplanev1.EtcdMemberDefragmentationStatus {
+	for i := range kcp.Status.EtcdDefragmentation.Members {
+		if kcp.Status.EtcdDefragmentation.Members[i].Name == name {
+			return &kcp.Status.EtcdDefragmentation.Members[i]
+		}
+	}
+	return nil
+}
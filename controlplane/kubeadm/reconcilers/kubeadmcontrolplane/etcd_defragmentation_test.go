@@ -0,0 +1,505 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+func TestReconcileEtcdDefragmentation(t *testing.T) {
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.30.0",
+			EtcdDefragmentation: controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{
+				FragmentationThresholdPercent: ptr.To[int32](50),
+			},
+		},
+	}
+
+	members := []*etcd.Member{
+		{ID: 1, Name: "m1"},
+		{ID: 2, Name: "m2"},
+		{ID: 3, Name: "m3"},
+	}
+	fragmented := func() *etcd.DBStatus { return &etcd.DBStatus{DBSize: 100, DBSizeInUse: 40} }
+	notFragmented := func() *etcd.DBStatus { return &etcd.DBStatus{DBSize: 100, DBSizeInUse: 80} }
+
+	tests := []struct {
+		name             string
+		kcp              func() *controlplanev1.KubeadmControlPlane
+		leader           *etcd.Member
+		alarms           []etcd.MemberAlarm
+		dbStatuses       map[string]*etcd.DBStatus
+		defragmentErr    error
+		wantRequeueAfter time.Duration
+		wantNotChecked   bool
+		wantDefragmented []string
+		wantDisarmed     []etcd.MemberAlarm
+		wantPhases       map[string]controlplanev1.EtcdMemberDefragmentationPhase
+	}{
+		{
+			name: "Does nothing if etcdDefragmentation is not set",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Spec.EtcdDefragmentation = controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{}
+				return kcp
+			},
+			leader:     members[0],
+			dbStatuses: map[string]*etcd.DBStatus{"m1": fragmented(), "m2": fragmented(), "m3": fragmented()},
+		},
+		{
+			name: "Does nothing if etcd is external",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External = bootstrapv1.ExternalEtcd{
+					Endpoints: []string{"https://etcd.example.com:2379"},
+				}
+				return kcp
+			},
+			leader:     members[0],
+			dbStatuses: map[string]*etcd.DBStatus{"m1": fragmented(), "m2": fragmented(), "m3": fragmented()},
+		},
+		{
+			name: "Reports member status if no member must be defragmented",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			leader:           members[0],
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": notFragmented(), "m2": notFragmented(), "m3": notFragmented()},
+			wantRequeueAfter: etcdDefragmentationCheckPeriod,
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+			},
+		},
+		{
+			name: "Defragments one member at a time, leaving the leader last",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			leader:           members[0],
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": fragmented(), "m2": fragmented(), "m3": notFragmented()},
+			wantRequeueAfter: etcdDefragmentationNextMemberPeriod,
+			wantDefragmented: []string{"m2"},
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationPendingPhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+			},
+		},
+		{
+			name: "Defragments the leader",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			leader:           members[0],
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": fragmented(), "m2": notFragmented(), "m3": notFragmented()},
+			wantRequeueAfter: etcdDefragmentationCheckPeriod,
+			wantDefragmented: []string{"m1"},
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+			},
+		},
+		{
+			name: "Does not defragment a member defragmented recently",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Status.EtcdDefragmentation.Members = []controlplanev1.EtcdMemberDefragmentationStatus{
+					{Name: "m2", LastDefragmentationTime: metav1.NewTime(time.Now().Add(-time.Minute))},
+				}
+				return kcp
+			},
+			leader:           members[0],
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": notFragmented(), "m2": fragmented(), "m3": notFragmented()},
+			wantRequeueAfter: etcdDefragmentationCheckPeriod,
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+			},
+		},
+		{
+			name: "Defragments members during the maintenance window",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Spec.EtcdDefragmentation = controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{
+					MaintenanceWindow: controlplanev1.EtcdMaintenanceWindow{
+						StartTime:       time.Now().UTC().Add(-10 * time.Minute).Format("15:04"),
+						DurationMinutes: 60,
+					},
+				}
+				return kcp
+			},
+			leader:           members[0],
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": notFragmented(), "m2": notFragmented(), "m3": notFragmented()},
+			wantRequeueAfter: etcdDefragmentationNextMemberPeriod,
+			wantDefragmented: []string{"m2"},
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationPendingPhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationPendingPhase,
+			},
+		},
+		{
+			name: "Defragments members with NOSPACE alarms and disarms them",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Spec.EtcdDefragmentation.FragmentationThresholdPercent = ptr.To[int32](90)
+				return kcp
+			},
+			leader:           members[0],
+			alarms:           []etcd.MemberAlarm{{MemberID: 3, Type: etcd.AlarmNoSpace}},
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": fragmented(), "m2": fragmented(), "m3": {DBSize: 100, DBSizeInUse: 60, DBSizeQuota: 80}},
+			wantRequeueAfter: etcdDefragmentationCheckPeriod,
+			wantDefragmented: []string{"m3"},
+			wantDisarmed:     []etcd.MemberAlarm{{MemberID: 3, Type: etcd.AlarmNoSpace}},
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+			},
+		},
+		{
+			name: "Does not disarm NOSPACE alarms if defragmentation does not free enough space",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			leader:           members[0],
+			alarms:           []etcd.MemberAlarm{{MemberID: 3, Type: etcd.AlarmNoSpace}},
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": notFragmented(), "m2": notFragmented(), "m3": {DBSize: 100, DBSizeInUse: 90, DBSizeQuota: 80}},
+			wantRequeueAfter: etcdDefragmentationRetryPeriod,
+			wantDefragmented: []string{"m3"},
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationFailedPhase,
+			},
+		},
+		{
+			name: "Does not disarm NOSPACE alarms if defragmentation does not bring the database below the quota by a margin",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			leader:           members[0],
+			alarms:           []etcd.MemberAlarm{{MemberID: 3, Type: etcd.AlarmNoSpace}},
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": notFragmented(), "m2": notFragmented(), "m3": {DBSize: 100, DBSizeInUse: 75, DBSizeQuota: 80}},
+			wantRequeueAfter: etcdDefragmentationRetryPeriod,
+			wantDefragmented: []string{"m3"},
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationFailedPhase,
+			},
+		},
+		{
+			name: "Does not check members if they have been checked recently",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Status.EtcdDefragmentation.LastCheckTime = metav1.NewTime(time.Now().Add(-time.Minute))
+				return kcp
+			},
+			leader:           members[0],
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": fragmented(), "m2": fragmented(), "m3": fragmented()},
+			wantRequeueAfter: etcdDefragmentationCheckPeriod - time.Minute,
+			wantNotChecked:   true,
+		},
+		{
+			name: "Checks members checked recently if a member must be defragmented",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Status.EtcdDefragmentation.LastCheckTime = metav1.NewTime(time.Now().Add(-time.Minute))
+				kcp.Status.EtcdDefragmentation.Members = []controlplanev1.EtcdMemberDefragmentationStatus{
+					{Name: "m1", Phase: controlplanev1.EtcdMemberDefragmentationPendingPhase},
+				}
+				return kcp
+			},
+			leader:           members[0],
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": fragmented(), "m2": notFragmented(), "m3": notFragmented()},
+			wantRequeueAfter: etcdDefragmentationCheckPeriod,
+			wantDefragmented: []string{"m1"},
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+			},
+		},
+		{
+			name: "Checks members checked recently if a member raised a NOSPACE alarm",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Status.EtcdDefragmentation.LastCheckTime = metav1.NewTime(time.Now().Add(-time.Minute))
+				return kcp
+			},
+			leader:           members[0],
+			alarms:           []etcd.MemberAlarm{{MemberID: 2, Type: etcd.AlarmNoSpace}},
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": notFragmented(), "m2": {DBSize: 100, DBSizeInUse: 60, DBSizeQuota: 80}, "m3": notFragmented()},
+			wantRequeueAfter: etcdDefragmentationCheckPeriod,
+			wantDefragmented: []string{"m2"},
+			wantDisarmed:     []etcd.MemberAlarm{{MemberID: 2, Type: etcd.AlarmNoSpace}},
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+			},
+		},
+		{
+			name: "Retries if defragmentation fails",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			leader:           members[0],
+			dbStatuses:       map[string]*etcd.DBStatus{"m1": notFragmented(), "m2": fragmented(), "m3": notFragmented()},
+			defragmentErr:    pkgerrors.New("context deadline exceeded"),
+			wantRequeueAfter: etcdDefragmentationRetryPeriod,
+			wantPhases: map[string]controlplanev1.EtcdMemberDefragmentationPhase{
+				"m1": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+				"m2": controlplanev1.EtcdMemberDefragmentationFailedPhase,
+				"m3": controlplanev1.EtcdMemberDefragmentationIdlePhase,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			workload := &fakeWorkloadCluster{
+				EtcdMemberDBStatuses:    tt.dbStatuses,
+				DefragmentEtcdMemberErr: tt.defragmentErr,
+			}
+			r := &Reconciler{
+				recorder: record.NewFakeRecorder(32),
+			}
+
+			controlPlane := &pkg.ControlPlane{
+				KCP:               tt.kcp(),
+				Cluster:           cluster,
+				Machines:          collections.New(),
+				EtcdMembers:       members,
+				EtcdLeader:        tt.leader,
+				EtcdMembersAlarms: tt.alarms,
+			}
+			controlPlane.InjectTestManagementCluster(&fakeManagementCluster{Workload: workload})
+
+			kcpBefore := controlPlane.KCP.DeepCopy()
+			res := r.reconcileEtcdDefragmentation(ctx, controlPlane)
+
+			// If defragmentation started, it runs in the background; wait for it to complete and reconcile again
+			// to record its result.
+			key := client.ObjectKeyFromObject(controlPlane.KCP)
+			if op, ok := r.etcdDefragmentations.get(key); ok {
+				g.Expect(res.RequeueAfter).To(Equal(etcdDefragmentationPollPeriod))
+				g.Expect(etcdMemberDefragmentationStatus(controlPlane.KCP, op.member).Phase).To(Equal(controlplanev1.EtcdMemberDefragmentationInProgressPhase))
+				g.Eventually(func() bool {
+					op, _ := r.etcdDefragmentations.get(key)
+					return op.done
+				}, 5*time.Second).Should(BeTrue())
+
+				res = r.reconcileEtcdDefragmentation(ctx, controlPlane)
+				_, ok := r.etcdDefragmentations.get(key)
+				g.Expect(ok).To(BeFalse())
+			}
+			if tt.wantNotChecked {
+				// Note: The requeue is computed from the time of the last check, so it cannot be exact.
+				g.Expect(res.RequeueAfter).To(BeNumerically("~", tt.wantRequeueAfter, 5*time.Second))
+				g.Expect(controlPlane.KCP.Status.EtcdDefragmentation).To(Equal(kcpBefore.Status.EtcdDefragmentation))
+			} else {
+				g.Expect(res.RequeueAfter).To(Equal(tt.wantRequeueAfter))
+				if tt.wantPhases != nil {
+					g.Expect(controlPlane.KCP.Status.EtcdDefragmentation.LastCheckTime.Time).To(BeTemporally(">", kcpBefore.Status.EtcdDefragmentation.LastCheckTime.Time))
+				}
+			}
+			g.Expect(workload.defragmentedEtcdMembers).To(Equal(tt.wantDefragmented))
+			g.Expect(workload.disarmedEtcdAlarms).To(Equal(tt.wantDisarmed))
+
+			phases := map[string]controlplanev1.EtcdMemberDefragmentationPhase{}
+			for _, s := range controlPlane.KCP.Status.EtcdDefragmentation.Members {
+				phases[s.Name] = s.Phase
+				g.Expect(s.DBSizeBytes).To(Equal(ptr.To(tt.dbStatuses[s.Name].DBSize)))
+				if slices.Contains(tt.wantDefragmented, s.Name) {
+					g.Expect(s.LastDefragmentationTime.IsZero()).To(BeFalse())
+				}
+			}
+			if tt.wantPhases == nil {
+				g.Expect(phases).To(BeEmpty())
+			} else {
+				g.Expect(phases).To(Equal(tt.wantPhases))
+			}
+		})
+	}
+}
+
+func TestReconcileEtcdDefragmentationOutlivesReconcile(t *testing.T) {
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.30.0",
+			EtcdDefragmentation: controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{
+				FragmentationThresholdPercent: ptr.To[int32](50),
+			},
+		},
+	}
+	members := []*etcd.Member{
+		{ID: 1, Name: "m1"},
+		{ID: 2, Name: "m2"},
+	}
+
+	setup := func() (*Reconciler, *fakeWorkloadCluster, *pkg.ControlPlane) {
+		workload := &fakeWorkloadCluster{
+			EtcdMemberDBStatuses: map[string]*etcd.DBStatus{
+				"m1": {DBSize: 100, DBSizeInUse: 80},
+				"m2": {DBSize: 100, DBSizeInUse: 40},
+			},
+			DefragmentEtcdMemberBlock: make(chan struct{}),
+		}
+		r := &Reconciler{
+			recorder: record.NewFakeRecorder(32),
+		}
+		controlPlane := &pkg.ControlPlane{
+			KCP:         kcp.DeepCopy(),
+			Cluster:     cluster,
+			Machines:    collections.New(),
+			EtcdMembers: members,
+			EtcdLeader:  members[0],
+		}
+		controlPlane.InjectTestManagementCluster(&fakeManagementCluster{Workload: workload})
+		return r, workload, controlPlane
+	}
+
+	// operationResult returns if the etcd defragmentation operation tracked for the KubeadmControlPlane is done and its error.
+	operationResult := func(r *Reconciler) (bool, error) {
+		op, ok := r.etcdDefragmentations.get(client.ObjectKeyFromObject(kcp))
+		if !ok {
+			return false, nil
+		}
+		return op.done, op.err
+	}
+
+	t.Run("The defragmentation completes after the reconcile returned", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, workload, controlPlane := setup()
+
+		// Cancel the context of the reconcile as soon as the reconcile returns, like controller-runtime does.
+		reconcileCtx, cancel := context.WithCancel(ctx)
+		res := r.reconcileEtcdDefragmentation(reconcileCtx, controlPlane)
+		cancel()
+		g.Expect(res.RequeueAfter).To(Equal(etcdDefragmentationPollPeriod))
+		g.Expect(etcdMemberDefragmentationStatus(controlPlane.KCP, "m2").Phase).To(Equal(controlplanev1.EtcdMemberDefragmentationInProgressPhase))
+
+		// While defragmentation is in progress, reconciles do not block.
+		res = r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(res.RequeueAfter).To(Equal(etcdDefragmentationPollPeriod))
+		g.Expect(etcdMemberDefragmentationStatus(controlPlane.KCP, "m2").Phase).To(Equal(controlplanev1.EtcdMemberDefragmentationInProgressPhase))
+
+		// Let the defragmentation complete.
+		close(workload.DefragmentEtcdMemberBlock)
+		g.Eventually(func() bool {
+			done, _ := operationResult(r)
+			return done
+		}, 5*time.Second).Should(BeTrue())
+		_, err := operationResult(r)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		res = r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(res.RequeueAfter).To(Equal(etcdDefragmentationCheckPeriod))
+		g.Expect(workload.defragmentedEtcdMembers).To(Equal([]string{"m2"}))
+		status := etcdMemberDefragmentationStatus(controlPlane.KCP, "m2")
+		g.Expect(status.Phase).To(Equal(controlplanev1.EtcdMemberDefragmentationIdlePhase))
+		g.Expect(status.LastDefragmentationTime.IsZero()).To(BeFalse())
+		g.Expect(status.DBSizeBytes).To(Equal(ptr.To[int64](40)))
+	})
+
+	t.Run("The defragmentation is cancelled when defragmentation is disabled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, workload, controlPlane := setup()
+
+		res := r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(res.RequeueAfter).To(Equal(etcdDefragmentationPollPeriod))
+		r.etcdDefragmentations.lock.Lock()
+		op := r.etcdDefragmentations.defragmentations[client.ObjectKeyFromObject(kcp)]
+		r.etcdDefragmentations.lock.Unlock()
+		g.Expect(op).ToNot(BeNil())
+
+		controlPlane.KCP.Spec.EtcdDefragmentation = controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{}
+		res = r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(res.IsZero()).To(BeTrue())
+		g.Expect(controlPlane.KCP.Status.EtcdDefragmentation).To(Equal(controlplanev1.KubeadmControlPlaneEtcdDefragmentationStatus{}))
+		_, ok := r.etcdDefragmentations.get(client.ObjectKeyFromObject(kcp))
+		g.Expect(ok).To(BeFalse())
+
+		// The defragmentation running in the background is cancelled.
+		g.Eventually(func() bool {
+			r.etcdDefragmentations.lock.Lock()
+			defer r.etcdDefragmentations.lock.Unlock()
+			return op.done
+		}, 5*time.Second).Should(BeTrue())
+		g.Expect(op.err).To(MatchError(context.Canceled))
+		g.Expect(workload.defragmentedEtcdMembers).To(BeEmpty())
+	})
+}
+
+func TestMaintenanceWindow(t *testing.T) {
+	g := NewWithT(t)
+
+	window := controlplanev1.EtcdMaintenanceWindow{StartTime: "23:00", DurationMinutes: 120}
+
+	start, inWindow := maintenanceWindow(window, time.Date(2026, 1, 2, 0, 30, 0, 0, time.UTC))
+	g.Expect(inWindow).To(BeTrue())
+	g.Expect(start).To(Equal(time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC)))
+
+	start, inWindow = maintenanceWindow(window, time.Date(2026, 1, 2, 1, 30, 0, 0, time.UTC))
+	g.Expect(inWindow).To(BeFalse())
+	g.Expect(start).To(Equal(time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC)))
+
+	start, inWindow = maintenanceWindow(window, time.Date(2026, 1, 2, 23, 30, 0, 0, time.UTC))
+	g.Expect(inWindow).To(BeTrue())
+	g.Expect(start).To(Equal(time.Date(2026, 1, 2, 23, 0, 0, 0, time.UTC)))
+
+	_, inWindow = maintenanceWindow(controlplanev1.EtcdMaintenanceWindow{}, time.Now())
+	g.Expect(inWindow).To(BeFalse())
+}
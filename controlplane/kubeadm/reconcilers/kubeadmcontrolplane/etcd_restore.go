@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/desiredstate"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd/snapshot"
+	"sigs.k8s.io/cluster-api/util/collections"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+)
+
+const (
+	// etcdRestoreRequeueAfter is the period after which KCP checks again the progress of the etcd restore.
+	etcdRestoreRequeueAfter = 30 * time.Second
+
+	// etcdRestoreSnapshotURLExpiration is the validity of the URL used by the Machine restoring etcd to download
+	// the snapshot; it must cover the time required to provision the Machine.
+	etcdRestoreSnapshotURLExpiration = 24 * time.Hour
+)
+
+// reconcileEtcdRestore restores the etcd cluster from the snapshot defined in the restore-etcd-from-snapshot annotation.
+// The restore must work when the etcd quorum is lost, so it never requires the API server of the workload cluster
+// to be reachable before etcd has been restored:
+//   - KCP scales down the control plane to a single surviving Machine, the oldest Machine with a Node, and then it
+//     deletes the surviving Machine too; drain, wait for volume detach and the KCP pre-terminate hook are skipped,
+//     because the etcd cluster of those Machines is going to be replaced. The surviving Machine is deleted before
+//     creating the new Machine, so the new Machine never serves the workload cluster together with a Machine
+//     using the etcd cluster being replaced.
+//   - KCP creates a Secret with a script downloading the snapshot, through a presigned URL of the S3 sink,
+//     and restoring it, and then it creates a new control plane Machine with the etcd-restored-from-snapshot
+//     annotation; the kubeadm bootstrap provider runs the script before kubeadm init on the new Machine.
+//     The script fails early if etcdutl is not available on the Machine, and in this case kubeadm init is not run.
+//   - Once the etcd cluster has a single member hosted on the new Machine, the annotation is removed from KCP,
+//     and KCP resumes its regular reconcile, scaling up the control plane again.
+//
+// Note: While the annotation is set, all the other KCP operations, e.g. scale up and rollout, are blocked.
+func (r *Reconciler) reconcileEtcdRestore(ctx context.Context, controlPlane *pkg.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	snapshotName := controlPlane.KCP.Annotations[controlplanev1.RestoreEtcdFromSnapshotAnnotation]
+	log = log.WithValues("snapshot", snapshotName)
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	if !controlPlane.IsEtcdManaged() {
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdRestoreFailed", "Cannot restore etcd from snapshot %s: etcd is not managed by KCP", snapshotName)
+		return ctrl.Result{}, nil
+	}
+	if errs := validation.IsDNS1123Subdomain(snapshotName); len(errs) > 0 {
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdRestoreFailed", "Cannot restore etcd from snapshot %q: invalid snapshot name: %s", snapshotName, strings.Join(errs, "; "))
+		return ctrl.Result{}, nil
+	}
+
+	restoreMachines := controlPlane.Machines.Filter(collections.Not(collections.HasDeletionTimestamp), func(machine *clusterv1.Machine) bool {
+		return machine.Annotations[controlplanev1.EtcdRestoredFromSnapshotAnnotation] == snapshotName
+	})
+	if restoreMachines.Len() > 0 {
+		return r.completeEtcdRestore(ctx, controlPlane, restoreMachines.Oldest(), snapshotName)
+	}
+
+	sink, err := snapshot.NewSink(ctx, r.Client, controlPlane.Cluster, controlPlane.KCP)
+	if err != nil {
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdRestoreFailed", "Cannot restore etcd from snapshot %s: %v", snapshotName, err)
+		return ctrl.Result{}, nil
+	}
+	s3Sink, ok := sink.(*snapshot.S3Sink)
+	if !ok {
+		// Note: The Machine restoring etcd downloads the snapshot before the workload cluster exists; it cannot read
+		// Secrets of the management cluster, while it can download snapshots from an S3 sink through a presigned URL.
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdRestoreFailed", "Cannot restore etcd from snapshot %s: restoring etcd requires an S3 sink", snapshotName)
+		return ctrl.Result{}, nil
+	}
+	return r.startEtcdRestore(ctx, controlPlane, s3Sink, snapshotName)
+}
+
+// startEtcdRestore deletes all the control plane Machines, the oldest Machine with a Node last, and then it creates
+// the control plane Machine restoring etcd.
+func (r *Reconciler) startEtcdRestore(ctx context.Context, controlPlane *pkg.ControlPlane, sink *snapshot.S3Sink, snapshotName string) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Make sure the snapshot exists before deleting any Machine.
+	names, err := sink.List(ctx)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to restore etcd from snapshot %s: failed to list etcd snapshots", snapshotName)
+	}
+	if !slices.Contains(names, snapshotName) {
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdRestoreFailed", "Cannot restore etcd from snapshot %s: snapshot not found", snapshotName)
+		return ctrl.Result{}, nil
+	}
+
+	if controlPlane.Machines.Len() > 0 {
+		survivingMachine := etcdRestoreSurvivingMachine(controlPlane.Machines)
+		machinesToDelete := controlPlane.Machines.Filter(func(machine *clusterv1.Machine) bool {
+			return survivingMachine == nil || machine.Name != survivingMachine.Name
+		})
+		if machinesToDelete.Len() == 0 {
+			// The control plane has been scaled down to the surviving Machine, delete it too.
+			machinesToDelete.Insert(survivingMachine)
+		}
+		for _, machine := range machinesToDelete {
+			if err := r.deleteMachineForEtcdRestore(ctx, controlPlane, machine); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+	}
+
+	if err := r.reconcileEtcdRestoreScriptSecret(ctx, controlPlane, sink, snapshotName); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	fd, err := controlPlane.NextFailureDomainForScaleUp(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// Note: The Machine is created with the etcd-restored-from-snapshot annotation, and its KubeadmConfig runs
+	// kubeadm init after the etcd restore script, because the restore-etcd-from-snapshot annotation is set on KCP.
+	newMachine, err := r.cloneConfigsAndGenerateMachine(ctx, controlPlane.Cluster, controlPlane.KCP, false, fd)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to create control plane Machine to restore etcd from snapshot %s", snapshotName)
+	}
+	log.Info(fmt.Sprintf("Machine %s created (etcd restore)", klog.KObj(newMachine)), "Machine", klog.KObj(newMachine))
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, "EtcdRestoreStarted", "Created Machine %s to restore etcd from snapshot %s", newMachine.Name, snapshotName)
+	return ctrl.Result{}, nil // No need to requeue here. Machine creation above triggers reconciliation.
+}
+
+// etcdRestoreSurvivingMachine returns the control plane Machine deleted last when restoring etcd from a snapshot,
+// i.e. the oldest Machine with a Node, if any, otherwise the oldest Machine.
+func etcdRestoreSurvivingMachine(machines collections.Machines) *clusterv1.Machine {
+	candidates := machines.Filter(collections.Not(collections.HasDeletionTimestamp))
+	if withNode := candidates.Filter(collections.HasNode()); withNode.Len() > 0 {
+		return withNode.Oldest()
+	}
+	return candidates.Oldest()
+}
+
+// reconcileEtcdRestoreScriptSecret creates or updates the Secret with the script restoring etcd from the snapshot,
+// which is run by the kubeadm bootstrap provider before kubeadm init on the Machine restoring etcd.
+// Note: The Secret contains a URL which allows to download the snapshot, so it is only stored in the management cluster.
+func (r *Reconciler) reconcileEtcdRestoreScriptSecret(ctx context.Context, controlPlane *pkg.ControlPlane, sink *snapshot.S3Sink, snapshotName string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: controlPlane.KCP.Namespace,
+			Name:      desiredstate.EtcdRestoreScriptSecretName(controlPlane.KCP.Name),
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: controlPlane.Cluster.Name,
+			},
+			Annotations: map[string]string{
+				controlplanev1.EtcdRestoredFromSnapshotAnnotation: snapshotName,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(controlPlane.KCP, controlplanev1.GroupVersion.WithKind("KubeadmControlPlane")),
+			},
+		},
+		Type: clusterv1.ClusterSecretType,
+		Data: map[string][]byte{
+			bootstrapv1.EtcdRestoreScriptSecretKey: snapshot.RestoreScript(
+				sink.PresignedURL(snapshotName, etcdRestoreSnapshotURLExpiration),
+				controlPlane.KCP.Spec.EtcdSnapshot.Sink.S3.CABundle,
+			),
+		},
+	}
+	err := r.Client.Create(ctx, secret)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return pkgerrors.Wrapf(err, "failed to create Secret %s to restore etcd from snapshot %s", klog.KObj(secret), snapshotName)
+	}
+
+	// The Secret has been created by a previous attempt, e.g. to restore another snapshot; update the script
+	// so the presigned URL is valid for the new Machine.
+	existing := &corev1.Secret{}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(secret), existing); err != nil {
+		return pkgerrors.Wrapf(err, "failed to get Secret %s to restore etcd from snapshot %s", klog.KObj(secret), snapshotName)
+	}
+	existingOriginal := existing.DeepCopy()
+	existing.Annotations = secret.Annotations
+	existing.Data = secret.Data
+	if err := r.Client.Patch(ctx, existing, client.MergeFrom(existingOriginal)); err != nil {
+		return pkgerrors.Wrapf(err, "failed to update Secret %s to restore etcd from snapshot %s", klog.KObj(secret), snapshotName)
+	}
+	return nil
+}
+
+// completeEtcdRestore removes the restore-etcd-from-snapshot annotation from KCP once the etcd cluster has a single
+// member hosted on the Machine restoring etcd.
+func (r *Reconciler) completeEtcdRestore(ctx context.Context, controlPlane *pkg.ControlPlane, machine *clusterv1.Machine, snapshotName string) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("Machine", klog.KObj(machine))
+
+	if !machine.Status.NodeRef.IsDefined() {
+		log.Info("Waiting for the Machine restoring etcd to have a Node")
+		return ctrl.Result{RequeueAfter: etcdRestoreRequeueAfter}, nil
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		log.Info("Waiting for the workload cluster to be reachable to check the restore of etcd", "err", err.Error())
+		return ctrl.Result{RequeueAfter: etcdRestoreRequeueAfter}, nil
+	}
+	completed, err := workloadCluster.IsEtcdRestoreCompleted(ctx, machine.Status.NodeRef.Name)
+	if err != nil {
+		log.Info("Failed to check the restore of etcd, retrying", "err", err.Error())
+		return ctrl.Result{RequeueAfter: etcdRestoreRequeueAfter}, nil
+	}
+	if !completed {
+		log.Info("Waiting for the restore of etcd to be completed")
+		return ctrl.Result{RequeueAfter: etcdRestoreRequeueAfter}, nil
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: controlPlane.KCP.Namespace,
+			Name:      desiredstate.EtcdRestoreScriptSecretName(controlPlane.KCP.Name),
+		},
+	}
+	if err := r.Client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to delete Secret %s used to restore etcd from snapshot %s", klog.KObj(secret), snapshotName)
+	}
+
+	// Note: Changes to KCP annotations are persisted by the patch at the end of the reconcile.
+	delete(controlPlane.KCP.Annotations, controlplanev1.RestoreEtcdFromSnapshotAnnotation)
+	log.Info("Etcd restore completed")
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, "EtcdRestoreCompleted", "Restored etcd from snapshot %s on Machine %s", snapshotName, machine.Name)
+	return ctrl.Result{}, nil
+}
+
+// deleteMachineForEtcdRestore deletes a control plane Machine, skipping drain, wait for volume detach and the KCP pre-terminate hook.
+func (r *Reconciler) deleteMachineForEtcdRestore(ctx context.Context, controlPlane *pkg.ControlPlane, machine *clusterv1.Machine) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !machine.DeletionTimestamp.IsZero() {
+		// Note: etcd members of deleted Machines are not removed, the etcd cluster is going to be replaced by the snapshot.
+		return r.removePreTerminateHookAnnotationFromMachine(ctx, machine)
+	}
+
+	_, skipDrain := machine.Annotations[clusterv1.ExcludeNodeDrainingAnnotation]
+	_, skipWaitForVolumeDetach := machine.Annotations[clusterv1.ExcludeWaitForNodeVolumeDetachAnnotation]
+	if !skipDrain || !skipWaitForVolumeDetach {
+		machineOriginal := machine.DeepCopy()
+		if machine.Annotations == nil {
+			machine.Annotations = map[string]string{}
+		}
+		machine.Annotations[clusterv1.ExcludeNodeDrainingAnnotation] = ""
+		machine.Annotations[clusterv1.ExcludeWaitForNodeVolumeDetachAnnotation] = ""
+		if err := r.Client.Patch(ctx, machine, client.MergeFrom(machineOriginal)); err != nil {
+			return pkgerrors.Wrapf(err, "failed to skip drain for control plane Machine %s", klog.KObj(machine))
+		}
+	}
+
+	if deletedMachine, err := r.machineClientWithDeleteResponse.Delete(ctx, machine); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return pkgerrors.Wrapf(err, "failed to delete control plane Machine %s", klog.KObj(machine))
+		}
+	} else if deletedMachine != nil {
+		r.controller.DeferNextReconcileUntilCacheUpToDate(controlPlane.KCP, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "Machine"), deletedMachine.GetResourceVersion())
+	}
+
+	// Note: We intentionally log after Delete because we want this log line to show up only after DeletionTimestamp has been set.
+	log.Info(fmt.Sprintf("Machine %s deleting (etcd restore)", klog.KObj(machine)), "Machine", klog.KObj(machine))
+	return nil
+}
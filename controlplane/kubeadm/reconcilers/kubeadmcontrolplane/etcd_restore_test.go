@@ -0,0 +1,477 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/desiredstate"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd/snapshot"
+	"sigs.k8s.io/cluster-api/util/collections"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestReconcileEtcdRestore(t *testing.T) {
+	const snapshotName = "foo-etcd-20260101000000"
+
+	// Note: The S3 server only supports listing objects, which is the only request sent by KCP;
+	// the snapshot is downloaded by the Machine restoring etcd through a presigned URL.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("list-type") != "2" {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		result := struct {
+			XMLName  xml.Name `xml:"ListBucketResult"`
+			Contents []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+		}{}
+		result.Contents = append(result.Contents, struct {
+			Key string `xml:"Key"`
+		}{Key: r.URL.Query().Get("prefix") + snapshotName})
+		_ = xml.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	cluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: "foo.example.com", Port: 6443}
+
+	genericMachineTemplate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind":       builder.GenericInfrastructureMachineTemplateKind,
+			"apiVersion": builder.InfrastructureGroupVersion.String(),
+			"metadata": map[string]interface{}{
+				"name":      "infra-foo",
+				"namespace": cluster.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"hello": "world",
+					},
+				},
+			},
+		},
+	}
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo-s3",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Data: map[string][]byte{
+			snapshot.S3AccessKeyIDKey:     []byte("access-key-id"),
+			snapshot.S3SecretAccessKeyKey: []byte("secret-access-key"),
+		},
+	}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: controlplanev1.GroupVersion.String(),
+			Kind:       "KubeadmControlPlane",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "kcp-uid",
+			Annotations: map[string]string{
+				controlplanev1.RestoreEtcdFromSnapshotAnnotation: snapshotName,
+			},
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version:  "v1.30.0",
+			Replicas: ptr.To[int32](3),
+			MachineTemplate: controlplanev1.KubeadmControlPlaneMachineTemplate{
+				Spec: controlplanev1.KubeadmControlPlaneMachineTemplateSpec{
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						Kind:     genericMachineTemplate.GetKind(),
+						APIGroup: genericMachineTemplate.GroupVersionKind().Group,
+						Name:     genericMachineTemplate.GetName(),
+					},
+				},
+			},
+			EtcdSnapshot: controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{
+				Sink: controlplanev1.EtcdSnapshotSink{
+					S3: &controlplanev1.EtcdSnapshotS3Sink{
+						Endpoint: server.URL,
+						Bucket:   "bucket",
+						CredentialsSecret: controlplanev1.EtcdSnapshotS3CredentialsSecretReference{
+							Name: credentials.Name,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	machine := func(name string, created time.Time, annotations map[string]string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         metav1.NamespaceDefault,
+				CreationTimestamp: metav1.Time{Time: created},
+				Annotations:       annotations,
+			},
+			Status: clusterv1.MachineStatus{
+				NodeRef: clusterv1.MachineNodeReference{Name: name},
+			},
+		}
+	}
+	now := time.Now()
+	oldestMachine := machine("oldest", now.Add(-2*time.Hour), nil)
+	newestMachine := machine("newest", now.Add(-time.Hour), nil)
+	restoreMachine := machine("restore", now, map[string]string{
+		controlplanev1.EtcdRestoredFromSnapshotAnnotation: snapshotName,
+	})
+	restoreMachineWithoutNode := restoreMachine.DeepCopy()
+	restoreMachineWithoutNode.Status.NodeRef = clusterv1.MachineNodeReference{}
+	previousRestoreMachine := machine("previous-restore", now.Add(-30*time.Minute), map[string]string{
+		controlplanev1.EtcdRestoredFromSnapshotAnnotation: "foo-etcd-20251231000000",
+	})
+	noNodeMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "no-node",
+			Namespace:         metav1.NamespaceDefault,
+			CreationTimestamp: metav1.Time{Time: now.Add(-4 * time.Hour)},
+		},
+	}
+	deletingMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "deleting",
+			Namespace:         metav1.NamespaceDefault,
+			CreationTimestamp: metav1.Time{Time: now.Add(-5 * time.Hour)},
+			DeletionTimestamp: &metav1.Time{Time: now},
+			Finalizers:        []string{clusterv1.MachineFinalizer},
+			Annotations: map[string]string{
+				controlplanev1.PreTerminateHookCleanupAnnotation: "",
+			},
+		},
+		Status: clusterv1.MachineStatus{
+			NodeRef: clusterv1.MachineNodeReference{Name: "deleting"},
+		},
+	}
+	restoreSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      desiredstate.EtcdRestoreScriptSecretName(kcp.Name),
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: cluster.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind("KubeadmControlPlane")),
+			},
+		},
+		Type: clusterv1.ClusterSecretType,
+		Data: map[string][]byte{
+			bootstrapv1.EtcdRestoreScriptSecretKey: []byte("previous"),
+		},
+	}
+
+	tests := []struct {
+		name                      string
+		kcp                       func() *controlplanev1.KubeadmControlPlane
+		machines                  []*clusterv1.Machine
+		restoreSecret             bool
+		workloadErr               error
+		restoreCompleted          bool
+		wantRequeueAfter          time.Duration
+		wantDeletedMachines       []string
+		wantRestoreMachineCreated bool
+		wantRestoreSecret         bool
+		wantCheckedNodes          []string
+		wantAnnotation            bool
+		wantEvent                 string
+		wantPreTerminateHook      bool
+	}{
+		{
+			name: "Does nothing if etcd is external",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External = bootstrapv1.ExternalEtcd{
+					Endpoints: []string{"https://etcd.example.com:2379"},
+				}
+				return kcp
+			},
+			machines:       []*clusterv1.Machine{oldestMachine, newestMachine},
+			wantAnnotation: true,
+			wantEvent:      "EtcdRestoreFailed",
+		},
+		{
+			name: "Does nothing if the snapshot name is invalid",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Annotations[controlplanev1.RestoreEtcdFromSnapshotAnnotation] = "Invalid_Name"
+				return kcp
+			},
+			machines:       []*clusterv1.Machine{oldestMachine, newestMachine},
+			wantAnnotation: true,
+			wantEvent:      "EtcdRestoreFailed",
+		},
+		{
+			name: "Does nothing if the sink is not S3",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Spec.EtcdSnapshot.Sink = controlplanev1.EtcdSnapshotSink{
+					Secret: &controlplanev1.EtcdSnapshotSecretSink{},
+				}
+				return kcp
+			},
+			machines:       []*clusterv1.Machine{oldestMachine, newestMachine},
+			wantAnnotation: true,
+			wantEvent:      "restoring etcd requires an S3 sink",
+		},
+		{
+			name: "Does nothing if the snapshot does not exist",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Annotations[controlplanev1.RestoreEtcdFromSnapshotAnnotation] = "foo-etcd-20251231000000"
+				return kcp
+			},
+			machines:             []*clusterv1.Machine{oldestMachine, newestMachine, deletingMachine},
+			wantAnnotation:       true,
+			wantEvent:            "snapshot not found",
+			wantPreTerminateHook: true,
+		},
+		{
+			name: "Scales down to the oldest Machine with a Node while the workload cluster is not reachable",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			machines:            []*clusterv1.Machine{noNodeMachine, newestMachine, oldestMachine, previousRestoreMachine, deletingMachine},
+			workloadErr:         pkgerrors.New("connection refused"),
+			wantRequeueAfter:    deleteRequeueAfter,
+			wantDeletedMachines: []string{"no-node", "newest", "previous-restore"},
+			wantAnnotation:      true,
+		},
+		{
+			name: "Deletes the oldest Machine once the other Machines are gone",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			machines:            []*clusterv1.Machine{oldestMachine},
+			workloadErr:         pkgerrors.New("connection refused"),
+			wantRequeueAfter:    deleteRequeueAfter,
+			wantDeletedMachines: []string{"oldest"},
+			wantAnnotation:      true,
+		},
+		{
+			name: "Creates the Machine restoring etcd while the workload cluster is not reachable",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			workloadErr:               pkgerrors.New("connection refused"),
+			wantRestoreMachineCreated: true,
+			wantRestoreSecret:         true,
+			wantAnnotation:            true,
+			wantEvent:                 "EtcdRestoreStarted",
+		},
+		{
+			name: "Updates the Secret left over by a previous restore",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			restoreSecret:             true,
+			wantRestoreMachineCreated: true,
+			wantRestoreSecret:         true,
+			wantAnnotation:            true,
+			wantEvent:                 "EtcdRestoreStarted",
+		},
+		{
+			name: "Waits for the Machine restoring etcd to have a Node",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			machines:          []*clusterv1.Machine{restoreMachineWithoutNode},
+			restoreSecret:     true,
+			workloadErr:       pkgerrors.New("connection refused"),
+			wantRequeueAfter:  etcdRestoreRequeueAfter,
+			wantRestoreSecret: true,
+			wantAnnotation:    true,
+		},
+		{
+			name: "Waits for the workload cluster to be reachable",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			machines:          []*clusterv1.Machine{restoreMachine},
+			restoreSecret:     true,
+			workloadErr:       pkgerrors.New("connection refused"),
+			wantRequeueAfter:  etcdRestoreRequeueAfter,
+			wantRestoreSecret: true,
+			wantAnnotation:    true,
+		},
+		{
+			name: "Waits for the restore to be completed",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			machines:          []*clusterv1.Machine{restoreMachine},
+			restoreSecret:     true,
+			wantRequeueAfter:  etcdRestoreRequeueAfter,
+			wantRestoreSecret: true,
+			wantCheckedNodes:  []string{"restore"},
+			wantAnnotation:    true,
+		},
+		{
+			name: "Completes the restore",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			machines:         []*clusterv1.Machine{restoreMachine},
+			restoreSecret:    true,
+			restoreCompleted: true,
+			wantCheckedNodes: []string{"restore"},
+			wantEvent:        "EtcdRestoreCompleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := tt.kcp()
+			objs := []client.Object{cluster.DeepCopy(), kcp.DeepCopy(), credentials.DeepCopy(), genericMachineTemplate.DeepCopy(), builder.GenericInfrastructureMachineTemplateCRD.DeepCopy()}
+			if tt.restoreSecret {
+				objs = append(objs, restoreSecret.DeepCopy())
+			}
+			machines := collections.New()
+			for _, m := range tt.machines {
+				m := m.DeepCopy()
+				objs = append(objs, m)
+				machines.Insert(m)
+			}
+			fakeClient := newFakeClient(objs...)
+
+			workload := &fakeWorkloadCluster{
+				EtcdRestoreCompleted: tt.restoreCompleted,
+			}
+			managementCluster := &fakeManagementCluster{Workload: workload, WorkloadErr: tt.workloadErr}
+			recorder := record.NewFakeRecorder(32)
+			r := &Reconciler{
+				Client:                          fakeClient,
+				SecretCachingClient:             fakeClient,
+				managementCluster:               managementCluster,
+				recorder:                        recorder,
+				machineClientWithDeleteResponse: capicontrollerutil.NewClientWithDeleteResponseFromClient(fakeClient),
+				controller:                      capicontrollerutil.NewFakeController(),
+				// Note: This field is only used for unit tests that use fake client because the fake client does not properly set resourceVersion
+				//       on BootstrapConfig/InfraMachine after ssa.Patch and then ssa.RemoveManagedFieldsForLabelsAndAnnotations would fail.
+				disableRemoveManagedFieldsForLabelsAndAnnotations: true,
+			}
+
+			controlPlane := &pkg.ControlPlane{
+				KCP:      kcp,
+				Cluster:  cluster,
+				Machines: machines,
+			}
+			controlPlane.InjectTestManagementCluster(managementCluster)
+
+			res, err := r.reconcileEtcdRestore(ctx, controlPlane)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.RequeueAfter).To(Equal(tt.wantRequeueAfter))
+
+			g.Expect(workload.etcdRestoreCheckedNodes).To(Equal(tt.wantCheckedNodes))
+			if tt.wantAnnotation {
+				g.Expect(kcp.Annotations).To(HaveKey(controlplanev1.RestoreEtcdFromSnapshotAnnotation))
+			} else {
+				g.Expect(kcp.Annotations).ToNot(HaveKey(controlplanev1.RestoreEtcdFromSnapshotAnnotation))
+			}
+			if tt.wantEvent != "" {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(tt.wantEvent)))
+			} else {
+				g.Expect(recorder.Events).ToNot(Receive())
+			}
+
+			for _, m := range tt.machines {
+				machine := &clusterv1.Machine{}
+				err := fakeClient.Get(ctx, client.ObjectKeyFromObject(m), machine)
+				if !m.DeletionTimestamp.IsZero() {
+					if tt.wantPreTerminateHook {
+						g.Expect(err).ToNot(HaveOccurred())
+						g.Expect(machine.Annotations).To(HaveKey(controlplanev1.PreTerminateHookCleanupAnnotation))
+					} else if err == nil {
+						g.Expect(machine.Annotations).ToNot(HaveKey(controlplanev1.PreTerminateHookCleanupAnnotation))
+					}
+					continue
+				}
+				if slices.Contains(tt.wantDeletedMachines, m.Name) {
+					g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+					continue
+				}
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			machineList := &clusterv1.MachineList{}
+			g.Expect(fakeClient.List(ctx, machineList, client.InNamespace(cluster.Namespace))).To(Succeed())
+			restoreMachines := []clusterv1.Machine{}
+			for _, m := range machineList.Items {
+				if !slices.ContainsFunc(tt.machines, func(machine *clusterv1.Machine) bool { return machine.Name == m.Name }) {
+					restoreMachines = append(restoreMachines, m)
+				}
+			}
+			if !tt.wantRestoreMachineCreated {
+				g.Expect(restoreMachines).To(BeEmpty())
+			} else {
+				g.Expect(restoreMachines).To(HaveLen(1))
+				g.Expect(restoreMachines[0].Annotations).To(HaveKeyWithValue(controlplanev1.EtcdRestoredFromSnapshotAnnotation, snapshotName))
+
+				kubeadmConfig := &bootstrapv1.KubeadmConfig{}
+				g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: restoreMachines[0].Spec.Bootstrap.ConfigRef.Name}, kubeadmConfig)).To(Succeed())
+				g.Expect(kubeadmConfig.Annotations).To(HaveKeyWithValue(bootstrapv1.EtcdRestoreScriptSecretAnnotation, restoreSecret.Name))
+			}
+
+			secret := &corev1.Secret{}
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(restoreSecret), secret)
+			if !tt.wantRestoreSecret {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			if !tt.wantRestoreMachineCreated {
+				return
+			}
+			g.Expect(secret.Type).To(Equal(clusterv1.ClusterSecretType))
+			g.Expect(secret.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, cluster.Name))
+			g.Expect(secret.OwnerReferences).To(ContainElement(HaveField("UID", kcp.UID)))
+			g.Expect(string(secret.Data[bootstrapv1.EtcdRestoreScriptSecretKey])).To(And(
+				ContainSubstring(server.URL+"/bucket/default/foo/"+snapshotName+"?"),
+				ContainSubstring("X-Amz-Signature="),
+				ContainSubstring("etcdutl snapshot restore"),
+			))
+		})
+	}
+}
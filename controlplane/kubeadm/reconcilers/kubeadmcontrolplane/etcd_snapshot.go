@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd/snapshot"
+)
+
+const (
+	// etcdSnapshotTimeout is the maximum duration of taking an etcd snapshot and storing it in the sink.
+	etcdSnapshotTimeout = 10 * time.Minute
+
+	// etcdSnapshotRetryPeriod is the period after which taking an etcd snapshot is retried after a failure.
+	etcdSnapshotRetryPeriod = 1 * time.Minute
+
+	// etcdSnapshotPollPeriod is the period after which KCP checks if an etcd snapshot in progress is completed.
+	etcdSnapshotPollPeriod = 15 * time.Second
+)
+
+// etcdSnapshotTracker tracks the etcd snapshots that are being taken in the background, so taking
+// a snapshot does not block the reconciliation of the KubeadmControlPlane.
+type etcdSnapshotTracker struct {
+	lock      sync.Mutex
+	snapshots map[types.NamespacedName]*etcdSnapshotOperation
+}
+
+// etcdSnapshotOperation is an etcd snapshot taken in the background.
+type etcdSnapshotOperation struct {
+	name      string
+	startTime time.Time
+	// cancel stops the operation, if it is still running.
+	cancel context.CancelFunc
+
+	// The following fields are set when the operation is completed.
+	done      bool
+	sizeBytes int64
+	deleted   []string
+	saveErr   error
+	pruneErr  error
+}
+
+// get returns a copy of the etcd snapshot operation for a KubeadmControlPlane, if any.
+func (t *etcdSnapshotTracker) get(key types.NamespacedName) (etcdSnapshotOperation, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	op, ok := t.snapshots[key]
+	if !ok {
+		return etcdSnapshotOperation{}, false
+	}
+	return *op, true
+}
+
+// start tracks a new etcd snapshot operation for a KubeadmControlPlane.
+func (t *etcdSnapshotTracker) start(key types.NamespacedName, op *etcdSnapshotOperation) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.snapshots == nil {
+		t.snapshots = map[types.NamespacedName]*etcdSnapshotOperation{}
+	}
+	t.snapshots[key] = op
+}
+
+// complete records the result of an etcd snapshot operation.
+func (t *etcdSnapshotTracker) complete(op *etcdSnapshotOperation, setResult func(op *etcdSnapshotOperation)) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	setResult(op)
+	op.done = true
+}
+
+// forget stops tracking the etcd snapshot operation for a KubeadmControlPlane; if the operation
+// is still running it is cancelled.
+func (t *etcdSnapshotTracker) forget(key types.NamespacedName) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if op, ok := t.snapshots[key]; ok && op.cancel != nil {
+		op.cancel()
+	}
+	delete(t.snapshots, key)
+}
+
+// reconcileEtcdSnapshot takes a snapshot of the etcd cluster and stores it in the sink defined in spec.etcdSnapshot
+// if intervalSeconds elapsed since the last successful snapshot; then it deletes snapshots exceeding retention.
+// Snapshots are taken in the background and tracked in status.etcdSnapshot.inProgress, so they do not block other
+// KCP operations; failures are surfaced as events and retried after etcdSnapshotRetryPeriod.
+func (r *Reconciler) reconcileEtcdSnapshot(ctx context.Context, controlPlane *pkg.ControlPlane) ctrl.Result {
+	log := ctrl.LoggerFrom(ctx)
+
+	key := client.ObjectKeyFromObject(controlPlane.KCP)
+	etcdSnapshot := controlPlane.KCP.Spec.EtcdSnapshot
+	if etcdSnapshot.IntervalSeconds == 0 || !controlPlane.IsEtcdManaged() {
+		// Note: a snapshot in progress, if any, is cancelled.
+		r.etcdSnapshots.forget(key)
+		controlPlane.KCP.Status.EtcdSnapshot.InProgress = controlplanev1.EtcdSnapshotStatus{}
+		return ctrl.Result{}
+	}
+
+	now := time.Now()
+	interval := time.Duration(etcdSnapshot.IntervalSeconds) * time.Second
+
+	if op, ok := r.etcdSnapshots.get(key); ok {
+		if !op.done {
+			controlPlane.KCP.Status.EtcdSnapshot.InProgress = controlplanev1.EtcdSnapshotStatus{
+				Name: op.name,
+				Time: metav1.NewTime(op.startTime),
+			}
+			return ctrl.Result{RequeueAfter: etcdSnapshotPollPeriod}
+		}
+
+		r.etcdSnapshots.forget(key)
+		controlPlane.KCP.Status.EtcdSnapshot.InProgress = controlplanev1.EtcdSnapshotStatus{}
+		if op.saveErr != nil {
+			log.Error(op.saveErr, "Failed to take etcd snapshot")
+			r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdSnapshotFailed", "Failed to take etcd snapshot: %v", op.saveErr)
+			return ctrl.Result{RequeueAfter: etcdSnapshotRetryPeriod}
+		}
+
+		log.Info("Etcd snapshot stored", "snapshot", op.name, "sizeBytes", op.sizeBytes)
+		controlPlane.KCP.Status.EtcdSnapshot.LastSuccessful = controlplanev1.EtcdSnapshotStatus{
+			Name:      op.name,
+			Time:      metav1.NewTime(op.startTime),
+			SizeBytes: ptr.To(op.sizeBytes),
+		}
+		if len(op.deleted) > 0 {
+			log.Info("Etcd snapshots exceeding retention deleted", "snapshots", op.deleted)
+		}
+		if op.pruneErr != nil {
+			log.Error(op.pruneErr, "Failed to delete etcd snapshots exceeding retention")
+			r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdSnapshotFailed", "Failed to delete etcd snapshots exceeding retention: %v", op.pruneErr)
+		}
+		return ctrl.Result{RequeueAfter: max(time.Until(op.startTime.Add(interval)), etcdSnapshotPollPeriod)}
+	}
+
+	// If status reports a snapshot in progress that is not tracked, the snapshot has been interrupted
+	// e.g. by a restart of the controller; a new snapshot is taken if required.
+	controlPlane.KCP.Status.EtcdSnapshot.InProgress = controlplanev1.EtcdSnapshotStatus{}
+
+	if last := controlPlane.KCP.Status.EtcdSnapshot.LastSuccessful.Time; !last.IsZero() {
+		if next := last.Add(interval); now.Before(next) {
+			return ctrl.Result{RequeueAfter: next.Sub(now)}
+		}
+	}
+
+	op, err := r.startEtcdSnapshot(ctx, controlPlane, now)
+	if err != nil {
+		log.Error(err, "Failed to take etcd snapshot")
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "EtcdSnapshotFailed", "Failed to take etcd snapshot: %v", err)
+		return ctrl.Result{RequeueAfter: etcdSnapshotRetryPeriod}
+	}
+	log.Info("Taking etcd snapshot", "snapshot", op.name)
+	controlPlane.KCP.Status.EtcdSnapshot.InProgress = controlplanev1.EtcdSnapshotStatus{
+		Name: op.name,
+		Time: metav1.NewTime(op.startTime),
+	}
+	return ctrl.Result{RequeueAfter: etcdSnapshotPollPeriod}
+}
+
+// startEtcdSnapshot starts taking a snapshot of the etcd cluster in the background; the snapshot is
+// stored in the sink, and then snapshots exceeding retention are deleted.
+// The returned operation is tracked in r.etcdSnapshots until its result is processed by reconcileEtcdSnapshot.
+func (r *Reconciler) startEtcdSnapshot(ctx context.Context, controlPlane *pkg.ControlPlane, now time.Time) (*etcdSnapshotOperation, error) {
+	sink, err := snapshot.NewSink(ctx, r.Client, controlPlane.Cluster, controlPlane.KCP)
+	if err != nil {
+		return nil, err
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create client to workload cluster")
+	}
+
+	op := &etcdSnapshotOperation{
+		name:      snapshot.Name(controlPlane.Cluster.Name, now),
+		startTime: now,
+	}
+	clusterName := controlPlane.Cluster.Name
+	retention := int(ptr.Deref(controlPlane.KCP.Spec.EtcdSnapshot.Retention, controlplanev1.DefaultEtcdSnapshotRetention))
+
+	// Note: The snapshot outlives the reconcile, so the context of the reconcile, which is cancelled when the
+	// reconcile returns, can't be used; the snapshot is instead cancelled when it is forgotten, e.g. when
+	// snapshots are disabled or the KubeadmControlPlane is deleted.
+	snapshotCtx, cancel := context.WithTimeoutCause(context.WithoutCancel(ctx), etcdSnapshotTimeout, pkgerrors.New("etcd snapshot timeout expired"))
+	op.cancel = cancel
+	r.etcdSnapshots.start(client.ObjectKeyFromObject(controlPlane.KCP), op)
+
+	go func() {
+		defer cancel()
+
+		size, err := takeEtcdSnapshot(snapshotCtx, workloadCluster, sink, op.name)
+		if err != nil {
+			r.etcdSnapshots.complete(op, func(op *etcdSnapshotOperation) {
+				op.saveErr = err
+			})
+			return
+		}
+
+		deleted, err := snapshot.Prune(snapshotCtx, sink, clusterName, retention)
+		r.etcdSnapshots.complete(op, func(op *etcdSnapshotOperation) {
+			op.sizeBytes = size
+			op.deleted = deleted
+			op.pruneErr = err
+		})
+	}()
+	return op, nil
+}
+
+// takeEtcdSnapshot takes a snapshot of the etcd cluster and stores it in the sink; it returns the size of the snapshot.
+// The snapshot is spooled to a temporary file, because sinks need to know the size of the snapshot upfront.
+func takeEtcdSnapshot(ctx context.Context, workloadCluster pkg.WorkloadCluster, sink snapshot.Sink, name string) (int64, error) {
+	f, err := os.CreateTemp("", "etcd-snapshot-")
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to create temporary file for etcd snapshot")
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	size, err := workloadCluster.SnapshotEtcd(ctx, f)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to read temporary file for etcd snapshot")
+	}
+
+	if err := sink.Save(ctx, name, f, size); err != nil {
+		return 0, pkgerrors.Wrapf(err, "failed to store etcd snapshot %s", name)
+	}
+	return size, nil
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd/snapshot"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+func TestReconcileEtcdSnapshot(t *testing.T) {
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "kcp-uid",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.30.0",
+			EtcdSnapshot: controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{
+				IntervalSeconds: 3600,
+				Retention:       ptr.To[int32](2),
+				Sink: controlplanev1.EtcdSnapshotSink{
+					Secret: &controlplanev1.EtcdSnapshotSecretSink{},
+				},
+			},
+		},
+	}
+
+	existingSnapshot := func(t time.Time) *corev1.Secret {
+		name := snapshot.Name(cluster.Name, t)
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-0",
+				Namespace: metav1.NamespaceDefault,
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: cluster.Name,
+					snapshot.SnapshotLabel:     "",
+				},
+				Annotations: map[string]string{
+					snapshot.SnapshotNameAnnotation: name,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		kcp                func() *controlplanev1.KubeadmControlPlane
+		objs               []client.Object
+		etcdSnapshotErr    error
+		wantSnapshotCalled bool
+		wantRequeueAfter   func(g *WithT, requeueAfter time.Duration)
+		wantSnapshots      int
+		wantLastSuccessful bool
+	}{
+		{
+			name: "Does nothing if etcdSnapshot is not set",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Spec.EtcdSnapshot = controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{}
+				return kcp
+			},
+			wantRequeueAfter: func(g *WithT, requeueAfter time.Duration) {
+				g.Expect(requeueAfter).To(BeZero())
+			},
+		},
+		{
+			name: "Does nothing if etcd is external",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External = bootstrapv1.ExternalEtcd{
+					Endpoints: []string{"https://etcd.example.com:2379"},
+				}
+				return kcp
+			},
+			wantRequeueAfter: func(g *WithT, requeueAfter time.Duration) {
+				g.Expect(requeueAfter).To(BeZero())
+			},
+		},
+		{
+			name: "Requeues if the interval since the last successful snapshot did not elapse",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Status.EtcdSnapshot.LastSuccessful = controlplanev1.EtcdSnapshotStatus{
+					Name: "foo-etcd-20260101000000",
+					Time: metav1.NewTime(time.Now().Add(-30 * time.Minute)),
+				}
+				return kcp
+			},
+			wantRequeueAfter: func(g *WithT, requeueAfter time.Duration) {
+				g.Expect(requeueAfter).To(BeNumerically("~", 30*time.Minute, time.Minute))
+			},
+			wantLastSuccessful: true,
+		},
+		{
+			name: "Clears a snapshot in progress which is not tracked anymore",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Status.EtcdSnapshot.LastSuccessful = controlplanev1.EtcdSnapshotStatus{
+					Name: "foo-etcd-20260101000000",
+					Time: metav1.NewTime(time.Now().Add(-30 * time.Minute)),
+				}
+				kcp.Status.EtcdSnapshot.InProgress = controlplanev1.EtcdSnapshotStatus{
+					Name: "foo-etcd-20260101010000",
+					Time: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+				}
+				return kcp
+			},
+			wantRequeueAfter: func(g *WithT, requeueAfter time.Duration) {
+				g.Expect(requeueAfter).To(BeNumerically("~", 30*time.Minute, time.Minute))
+			},
+			wantLastSuccessful: true,
+		},
+		{
+			name: "Takes a snapshot and deletes snapshots exceeding retention",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := kcp.DeepCopy()
+				kcp.Status.EtcdSnapshot.LastSuccessful = controlplanev1.EtcdSnapshotStatus{
+					Name: "foo-etcd-20260101000000",
+					Time: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				}
+				return kcp
+			},
+			objs: []client.Object{
+				existingSnapshot(time.Now().Add(-4 * time.Hour)),
+				existingSnapshot(time.Now().Add(-2 * time.Hour)),
+			},
+			wantSnapshotCalled: true,
+			wantRequeueAfter: func(g *WithT, requeueAfter time.Duration) {
+				g.Expect(requeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+			},
+			wantSnapshots:      2,
+			wantLastSuccessful: true,
+		},
+		{
+			name: "Takes the first snapshot",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			wantSnapshotCalled: true,
+			wantRequeueAfter: func(g *WithT, requeueAfter time.Duration) {
+				g.Expect(requeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+			},
+			wantSnapshots:      1,
+			wantLastSuccessful: true,
+		},
+		{
+			name: "Retries if taking the snapshot fails",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				return kcp.DeepCopy()
+			},
+			etcdSnapshotErr:    pkgerrors.New("etcd is not reachable"),
+			wantSnapshotCalled: true,
+			wantRequeueAfter: func(g *WithT, requeueAfter time.Duration) {
+				g.Expect(requeueAfter).To(Equal(etcdSnapshotRetryPeriod))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := tt.kcp()
+			fakeClient := newFakeClient(tt.objs...)
+			workload := &fakeWorkloadCluster{
+				EtcdSnapshot:    []byte("snapshot"),
+				EtcdSnapshotErr: tt.etcdSnapshotErr,
+			}
+			managementCluster := &fakeManagementCluster{Workload: workload}
+			r := &Reconciler{
+				Client:              fakeClient,
+				SecretCachingClient: fakeClient,
+				managementCluster:   managementCluster,
+				recorder:            record.NewFakeRecorder(32),
+			}
+
+			controlPlane, err := pkg.NewControlPlane(ctx, managementCluster, fakeClient, cluster, kcp, collections.New())
+			g.Expect(err).ToNot(HaveOccurred())
+
+			res := r.reconcileEtcdSnapshot(ctx, controlPlane)
+			if tt.wantSnapshotCalled {
+				// The snapshot is taken in the background; wait for it to complete and reconcile again to process the result.
+				g.Expect(res.RequeueAfter).To(Equal(etcdSnapshotPollPeriod))
+				g.Expect(kcp.Status.EtcdSnapshot.InProgress.Name).ToNot(BeEmpty())
+				g.Eventually(func() bool {
+					op, ok := r.etcdSnapshots.get(client.ObjectKeyFromObject(kcp))
+					return ok && op.done
+				}, 5*time.Second).Should(BeTrue())
+
+				res = r.reconcileEtcdSnapshot(ctx, controlPlane)
+			}
+			tt.wantRequeueAfter(g, res.RequeueAfter)
+			g.Expect(workload.snapshotEtcdCalled > 0).To(Equal(tt.wantSnapshotCalled))
+			g.Expect(kcp.Status.EtcdSnapshot.InProgress).To(BeZero())
+			_, tracked := r.etcdSnapshots.get(client.ObjectKeyFromObject(kcp))
+			g.Expect(tracked).To(BeFalse())
+
+			names, err := (&snapshot.SecretSink{Client: fakeClient, Namespace: cluster.Namespace, ClusterName: cluster.Name}).List(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(names).To(HaveLen(tt.wantSnapshots))
+
+			if !tt.wantLastSuccessful {
+				g.Expect(kcp.Status.EtcdSnapshot.LastSuccessful).To(BeZero())
+				return
+			}
+			g.Expect(kcp.Status.EtcdSnapshot.LastSuccessful.Name).ToNot(BeEmpty())
+			if tt.wantSnapshotCalled {
+				g.Expect(names).To(ContainElement(kcp.Status.EtcdSnapshot.LastSuccessful.Name))
+				g.Expect(kcp.Status.EtcdSnapshot.LastSuccessful.SizeBytes).To(Equal(ptr.To(int64(len("snapshot")))))
+			}
+		})
+	}
+}
+
+func TestReconcileEtcdSnapshotOutlivesReconcile(t *testing.T) {
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "kcp-uid",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.30.0",
+			EtcdSnapshot: controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{
+				IntervalSeconds: 3600,
+				Sink: controlplanev1.EtcdSnapshotSink{
+					Secret: &controlplanev1.EtcdSnapshotSecretSink{},
+				},
+			},
+		},
+	}
+
+	setup := func(g *WithT) (*Reconciler, *fakeWorkloadCluster, *pkg.ControlPlane, client.Client) {
+		fakeClient := newFakeClient()
+		workload := &fakeWorkloadCluster{
+			EtcdSnapshot:      []byte("snapshot"),
+			EtcdSnapshotBlock: make(chan struct{}),
+		}
+		managementCluster := &fakeManagementCluster{Workload: workload}
+		r := &Reconciler{
+			Client:              fakeClient,
+			SecretCachingClient: fakeClient,
+			managementCluster:   managementCluster,
+			recorder:            record.NewFakeRecorder(32),
+		}
+		controlPlane, err := pkg.NewControlPlane(ctx, managementCluster, fakeClient, cluster, kcp.DeepCopy(), collections.New())
+		g.Expect(err).ToNot(HaveOccurred())
+		return r, workload, controlPlane, fakeClient
+	}
+
+	// trackedOperation returns the etcd snapshot operation currently tracked for the KubeadmControlPlane.
+	trackedOperation := func(r *Reconciler) *etcdSnapshotOperation {
+		r.etcdSnapshots.lock.Lock()
+		defer r.etcdSnapshots.lock.Unlock()
+		return r.etcdSnapshots.snapshots[client.ObjectKeyFromObject(kcp)]
+	}
+	// operationResult returns if an etcd snapshot operation is done and its error.
+	operationResult := func(r *Reconciler, op *etcdSnapshotOperation) (bool, error) {
+		r.etcdSnapshots.lock.Lock()
+		defer r.etcdSnapshots.lock.Unlock()
+		return op.done, op.saveErr
+	}
+
+	t.Run("The snapshot completes after the reconcile returned", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, workload, controlPlane, fakeClient := setup(g)
+
+		// Cancel the context of the reconcile as soon as the reconcile returns, like controller-runtime does.
+		reconcileCtx, cancel := context.WithCancel(ctx)
+		res := r.reconcileEtcdSnapshot(reconcileCtx, controlPlane)
+		cancel()
+		g.Expect(res.RequeueAfter).To(Equal(etcdSnapshotPollPeriod))
+
+		// Let the snapshot complete.
+		close(workload.EtcdSnapshotBlock)
+		g.Eventually(func() bool {
+			op, ok := r.etcdSnapshots.get(client.ObjectKeyFromObject(kcp))
+			return ok && op.done
+		}, 5*time.Second).Should(BeTrue())
+
+		op, _ := r.etcdSnapshots.get(client.ObjectKeyFromObject(kcp))
+		g.Expect(op.saveErr).ToNot(HaveOccurred())
+
+		_ = r.reconcileEtcdSnapshot(ctx, controlPlane)
+		g.Expect(controlPlane.KCP.Status.EtcdSnapshot.LastSuccessful.Name).To(Equal(op.name))
+		names, err := (&snapshot.SecretSink{Client: fakeClient, Namespace: cluster.Namespace, ClusterName: cluster.Name}).List(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(names).To(ConsistOf(op.name))
+	})
+
+	t.Run("The snapshot is cancelled when snapshots are disabled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, _, controlPlane, _ := setup(g)
+
+		_ = r.reconcileEtcdSnapshot(ctx, controlPlane)
+		op := trackedOperation(r)
+		g.Expect(op).ToNot(BeNil())
+
+		controlPlane.KCP.Spec.EtcdSnapshot.IntervalSeconds = 0
+		_ = r.reconcileEtcdSnapshot(ctx, controlPlane)
+		g.Expect(trackedOperation(r)).To(BeNil())
+
+		g.Eventually(func() bool {
+			done, _ := operationResult(r, op)
+			return done
+		}, 5*time.Second).Should(BeTrue())
+		_, err := operationResult(r, op)
+		g.Expect(err).To(MatchError(ContainSubstring("context canceled")))
+	})
+}
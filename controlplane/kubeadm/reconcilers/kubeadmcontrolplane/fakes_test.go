@@ -18,12 +18,17 @@ package kubeadmcontrolplane
 
 import (
 	"context"
+	"io"
+	"slices"
 	"time"
 
 	"github.com/blang/semver/v4"
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd"
@@ -78,9 +83,24 @@ type fakeWorkloadCluster struct {
 	KubeadmConfigExist            bool
 	APIServerCertificateExpiry    *time.Time
 	OverrideForwardEtcdLeadership func(context.Context, string, string) error
+	OverrideUpdateKubeProxy       func(context.Context, *controlplanev1.KubeadmControlPlane) error
+	OverrideUpdateCoreDNS         func(context.Context, *controlplanev1.KubeadmControlPlane) error
+	EtcdSnapshot                  []byte
+	EtcdSnapshotErr               error
+	EtcdSnapshotBlock             chan struct{}
+	EtcdRestoreCompleted          bool
+	OutdatedNodes                 []string
+	EtcdMemberDBStatuses          map[string]*etcd.DBStatus
+	DefragmentEtcdMemberErr       error
+	DefragmentEtcdMemberBlock     chan struct{}
 
 	forwardEtcdLeadershipCalled int
 	removeEtcdMemberCalled      int
+	snapshotEtcdCalled          int
+	etcdRestoreCheckedNodes     []string
+	defragmentedEtcdMembers     []string
+	disarmedEtcdAlarms          []etcd.MemberAlarm
+	clusterInfoCAData           []byte
 }
 
 func (f *fakeWorkloadCluster) ForwardEtcdLeadership(ctx context.Context, member, leaderCandidate string) error {
@@ -91,6 +111,88 @@ func (f *fakeWorkloadCluster) ForwardEtcdLeadership(ctx context.Context, member,
 	return nil
 }
 
+func (f *fakeWorkloadCluster) UpdateKubeProxyImageInfo(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error {
+	if f.OverrideUpdateKubeProxy != nil {
+		return f.OverrideUpdateKubeProxy(ctx, kcp)
+	}
+	return f.Workload.UpdateKubeProxyImageInfo(ctx, kcp)
+}
+
+func (f *fakeWorkloadCluster) UpdateCoreDNS(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error {
+	if f.OverrideUpdateCoreDNS != nil {
+		return f.OverrideUpdateCoreDNS(ctx, kcp)
+	}
+	return f.Workload.UpdateCoreDNS(ctx, kcp)
+}
+
+func (f *fakeWorkloadCluster) SnapshotEtcd(ctx context.Context, w io.Writer) (int64, error) {
+	f.snapshotEtcdCalled++
+	if f.EtcdSnapshotBlock != nil {
+		select {
+		case <-f.EtcdSnapshotBlock:
+		case <-ctx.Done():
+			return 0, context.Cause(ctx)
+		}
+	}
+	if f.EtcdSnapshotErr != nil {
+		return 0, f.EtcdSnapshotErr
+	}
+	n, err := w.Write(f.EtcdSnapshot)
+	return int64(n), err
+}
+
+func (f *fakeWorkloadCluster) IsEtcdRestoreCompleted(_ context.Context, nodeName string) (bool, error) {
+	f.etcdRestoreCheckedNodes = append(f.etcdRestoreCheckedNodes, nodeName)
+	return f.EtcdRestoreCompleted, nil
+}
+
+func (f *fakeWorkloadCluster) EtcdMemberDBStatus(_ context.Context, nodeName string) (*etcd.DBStatus, error) {
+	status, ok := f.EtcdMemberDBStatuses[nodeName]
+	if !ok {
+		return nil, pkgerrors.Errorf("etcd member %s not found", nodeName)
+	}
+	return ptr.To(*status), nil
+}
+
+func (f *fakeWorkloadCluster) DefragmentEtcdMember(ctx context.Context, nodeName string) error {
+	if f.DefragmentEtcdMemberBlock != nil {
+		select {
+		case <-f.DefragmentEtcdMemberBlock:
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
+	}
+	if f.DefragmentEtcdMemberErr != nil {
+		return f.DefragmentEtcdMemberErr
+	}
+	f.defragmentedEtcdMembers = append(f.defragmentedEtcdMembers, nodeName)
+	// Defragmentation frees all the space not in use.
+	if status, ok := f.EtcdMemberDBStatuses[nodeName]; ok {
+		status.DBSize = status.DBSizeInUse
+	}
+	return nil
+}
+
+func (f *fakeWorkloadCluster) DisarmEtcdAlarm(_ context.Context, _ string, alarm etcd.MemberAlarm) error {
+	f.disarmedEtcdAlarms = append(f.disarmedEtcdAlarms, alarm)
+	return nil
+}
+
+func (f *fakeWorkloadCluster) UpdateClusterInfoCertificateAuthority(_ context.Context, caData []byte) error {
+	f.clusterInfoCAData = caData
+	return nil
+}
+
+func (f *fakeWorkloadCluster) GetNodesCreatedBefore(_ context.Context, nodeNames []string, _ time.Time) ([]string, error) {
+	outdatedNodes := []string{}
+	for _, name := range nodeNames {
+		if slices.Contains(f.OutdatedNodes, name) {
+			outdatedNodes = append(outdatedNodes, name)
+		}
+	}
+	return outdatedNodes, nil
+}
+
 func (f *fakeWorkloadCluster) HasKubeadmConfig(_ context.Context) (bool, error) {
 	return f.KubeadmConfigExist, nil
 }
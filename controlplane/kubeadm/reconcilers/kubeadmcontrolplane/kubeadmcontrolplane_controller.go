@@ -110,8 +110,10 @@ type Reconciler struct {
 
 	RemoteConditionsGracePeriod time.Duration
 
-	managementCluster pkg.ManagementCluster
-	ssaCache          ssa.Cache
+	managementCluster    pkg.ManagementCluster
+	ssaCache             ssa.Cache
+	etcdSnapshots        etcdSnapshotTracker
+	etcdDefragmentations etcdDefragmentationTracker
 
 	// Only used for testing.
 	overrideTryInPlaceUpdateFunc       func(ctx context.Context, controlPlane *pkg.ControlPlane, machineToInPlaceUpdate *clusterv1.Machine, machineUpToDateResult pkg.UpToDateResult) (bool, ctrl.Result, error)
@@ -388,6 +390,7 @@ func patchKubeadmControlPlane(ctx context.Context, patchHelper *patch.Helper, kc
 			controlplanev1.KubeadmControlPlaneScalingDownCondition,
 			controlplanev1.KubeadmControlPlaneRemediatingCondition,
 			controlplanev1.KubeadmControlPlaneDeletingCondition,
+			controlplanev1.KubeadmControlPlaneWorkerMachinesRolloutRequiredCondition,
 		}},
 	)
 
@@ -481,8 +484,18 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 
 	// Updates conditions reporting the status of static pods and the status of the etcd cluster.
 	// NOTE: Conditions reporting KCP operation progress like e.g. Resized or SpecUpToDate are inlined with the rest of the execution.
-	if err := r.reconcileControlPlaneAndMachinesConditions(ctx, controlPlane); err != nil {
-		return ctrl.Result{}, err
+	conditionsErr := r.reconcileControlPlaneAndMachinesConditions(ctx, controlPlane)
+
+	// If requested, restore etcd from a snapshot; this replaces all the control plane machines, so all the
+	// other operations are blocked until the restore has been completed.
+	// Note: The restore must proceed also when conditions cannot be computed, e.g. because the etcd quorum
+	// has been lost and the workload cluster is not reachable.
+	if _, ok := controlPlane.KCP.Annotations[controlplanev1.RestoreEtcdFromSnapshotAnnotation]; ok {
+		result, err := r.reconcileEtcdRestore(ctx, controlPlane)
+		return result, kerrors.NewAggregate([]error{conditionsErr, err})
+	}
+	if conditionsErr != nil {
+		return ctrl.Result{}, conditionsErr
 	}
 
 	// Ensures the number of etcd members is in sync with the number of machines/nodes.
@@ -525,6 +538,11 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 		return ctrl.Result{}, nil // Note: Changes to Machines trigger another reconcile.
 	}
 
+	// Renew in-place the certificates of Machines that are about to expire instead of rolling them out, if supported.
+	if renewing, result, err := r.reconcileCertificatesRenewal(ctx, controlPlane); err != nil || renewing {
+		return result, err
+	}
+
 	// Control plane machines rollout due to configuration changes (e.g. upgrades) takes precedence over other operations.
 	machinesNeedingRollout, machinesUpToDateResults := controlPlane.MachinesNeedingRollout()
 	switch {
@@ -540,6 +558,14 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 
 		log.Info(fmt.Sprintf("Machines need rollout: %s", strings.Join(machinesNeedingRolloutNames, ",")), "reason", strings.Join(allMessages, ", "))
 		v1beta1conditions.MarkFalse(controlPlane.KCP, controlplanev1.MachinesSpecUpToDateV1Beta1Condition, controlplanev1.RollingUpdateInProgressV1Beta1Reason, clusterv1.ConditionSeverityWarning, "Rolling %d replicas with outdated spec (%d replicas up to date)", len(machinesNeedingRollout), len(controlPlane.Machines)-len(machinesNeedingRollout))
+
+		// Before starting the upgrade to a new Kubernetes version, make sure addons have been upgraded to the
+		// current one, e.g. because an UpgradeControlPlaneAddons extension was not ready when the previous upgrade completed.
+		if currentVersion, ok := addonsUpgradeRequiredBeforeRollout(controlPlane); ok {
+			if result, err := r.reconcileAddons(ctx, controlPlane, currentVersion); err != nil || !result.IsZero() {
+				return result, err
+			}
+		}
 		return r.updateControlPlane(ctx, controlPlane, machinesNeedingRollout, machinesUpToDateResults)
 	default:
 		// make sure last upgrade operation is marked as completed.
@@ -578,21 +604,9 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 		return r.scaleDownControlPlane(ctx, controlPlane, machineToDelete)
 	}
 
-	// Get the workload cluster client.
-	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	// Update kube-proxy daemonset.
-	if err := workloadCluster.UpdateKubeProxyImageInfo(ctx, controlPlane.KCP); err != nil {
-		log.Error(err, "Failed to update kube-proxy daemonset")
-		return ctrl.Result{}, err
-	}
-
-	// Update CoreDNS deployment.
-	if err := workloadCluster.UpdateCoreDNS(ctx, controlPlane.KCP); err != nil {
-		return ctrl.Result{}, pkgerrors.Wrap(err, "failed to update CoreDNS deployment")
+	// Upgrade cluster-critical addons, e.g. kube-proxy and CoreDNS, or delegate their upgrade to extensions.
+	if result, err := r.reconcileAddons(ctx, controlPlane, controlPlane.KCP.Spec.Version); err != nil || !result.IsZero() {
+		return result, err
 	}
 
 	// Reconcile certificate expiry for Machines that don't have the expiry annotation on KubeadmConfig yet.
@@ -602,7 +616,20 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 	if err := r.reconcileCertificateExpiries(ctx, controlPlane); err != nil {
 		return ctrl.Result{}, err
 	}
-	return ctrl.Result{}, nil
+
+	// Rotate the certificate authorities if requested.
+	// Note: This is done at the end of the reconcile, so a phase of the rotation is completed only after all the
+	// control plane Machines have been rolled out.
+	result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Take etcd snapshots and defragment etcd members if configured.
+	// Note: This is done at the end of the reconcile, so snapshots and defragmentation do not happen while the control plane
+	// is initializing, scaling or rolling out Machines.
+	result = util.LowestNonZeroResult(result, r.reconcileEtcdSnapshot(ctx, controlPlane))
+	return util.LowestNonZeroResult(result, r.reconcileEtcdDefragmentation(ctx, controlPlane)), nil
 }
 
 // reconcileClusterCertificates ensures that all the cluster certificates exists and
@@ -693,6 +720,10 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, controlPlane *pkg.Cont
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Reconcile KubeadmControlPlane deletion")
 
+	// Stop taking etcd snapshots and defragmenting etcd members in the background, if any.
+	r.etcdSnapshots.forget(client.ObjectKeyFromObject(controlPlane.KCP))
+	r.etcdDefragmentations.forget(client.ObjectKeyFromObject(controlPlane.KCP))
+
 	// If no control plane machines remain, remove the finalizer
 	if len(controlPlane.Machines) == 0 {
 		controlPlane.DeletingReason = controlplanev1.KubeadmControlPlaneDeletingDeletionCompletedReason
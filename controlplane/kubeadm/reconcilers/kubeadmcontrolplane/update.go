@@ -18,14 +18,18 @@ package kubeadmcontrolplane
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/blang/semver/v4"
 	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util/collections"
@@ -87,18 +91,22 @@ func (r *Reconciler) updateControlPlane(
 		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to update control plane")
 	}
 
+	var res ctrl.Result
 	switch controlPlane.KCP.Spec.Rollout.Strategy.Type {
 	case controlplanev1.RollingUpdateStrategyType:
-		// RolloutStrategy is currently defaulted and validated to be RollingUpdate
-		res, err := r.rollingUpdate(ctx, controlPlane, machinesNeedingRollout, machinesUpToDateResults)
-		if err != nil {
-			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to update control plane")
-		}
-		return res, nil
+		res, err = r.rollingUpdate(ctx, controlPlane, machinesNeedingRollout, machinesUpToDateResults)
+	case controlplanev1.ScaleDownFirstStrategyType:
+		res, err = r.scaleDownFirstUpdate(ctx, controlPlane, machinesNeedingRollout, machinesUpToDateResults)
+	case controlplanev1.OnDeleteStrategyType:
+		res, err = r.onDeleteUpdate(ctx, controlPlane, machinesNeedingRollout, machinesUpToDateResults)
 	default:
-		log.Info("RolloutStrategy type is not set to RollingUpdate, unable to determine the strategy for rolling out machines")
+		log.Info(fmt.Sprintf("RolloutStrategy type %q is not supported, unable to determine the strategy for rolling out machines", controlPlane.KCP.Spec.Rollout.Strategy.Type))
 		return ctrl.Result{}, nil
 	}
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to update control plane")
+	}
+	return res, nil
 }
 
 func (r *Reconciler) rollingUpdate(
@@ -108,7 +116,6 @@ func (r *Reconciler) rollingUpdate(
 	machinesUpToDateResults map[string]pkg.UpToDateResult,
 ) (ctrl.Result, error) {
 	currentReplicas := int32(controlPlane.Machines.Len())
-	currentUpToDateReplicas := int32(controlPlane.UpToDateMachines().Len())
 	desiredReplicas := *controlPlane.KCP.Spec.Replicas
 	maxSurge := int32(controlPlane.KCP.Spec.Rollout.Strategy.RollingUpdate.MaxSurge.IntValue())
 	// Note: As MaxSurge is validated to be either 0 or 1, maxReplicas will be either desiredReplicas or desiredReplicas+1.
@@ -130,6 +137,83 @@ func (r *Reconciler) rollingUpdate(
 	if err != nil {
 		return ctrl.Result{}, pkgerrors.Wrap(err, "failed to select next Machine for rollout")
 	}
+	return r.inPlaceUpdateOrScaleDown(ctx, controlPlane, machineToInPlaceUpdateOrScaleDown, machinesUpToDateResults)
+}
+
+// scaleDownFirstUpdate replaces outdated Machines without ever exceeding the desired number of replicas, i.e.
+// it deletes (or in-place updates) an outdated Machine first, and then it creates its replacement.
+func (r *Reconciler) scaleDownFirstUpdate(
+	ctx context.Context,
+	controlPlane *pkg.ControlPlane,
+	machinesNeedingRollout collections.Machines,
+	machinesUpToDateResults map[string]pkg.UpToDateResult,
+) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	currentReplicas := int32(controlPlane.Machines.Len())
+	desiredReplicas := *controlPlane.KCP.Spec.Replicas
+
+	// If currentReplicas < desiredReplicas we have to scale up, e.g. to create the replacement of the Machine deleted before.
+	if currentReplicas < desiredReplicas {
+		return r.scaleUpControlPlane(ctx, controlPlane)
+	}
+
+	// Pick the Machine that we should in-place update or scale down.
+	machineToInPlaceUpdateOrScaleDown, err := selectMachineForInPlaceUpdateOrScaleDown(ctx, controlPlane, machinesNeedingRollout)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrap(err, "failed to select next Machine for rollout")
+	}
+
+	// If currentReplicas > desiredReplicas we are scaling down, and the Machine can be deleted as usual.
+	// Otherwise, the Machine is going to be replaced; ensure the control plane can tolerate its removal until
+	// the replacement is up and running.
+	if currentReplicas == desiredReplicas && !r.canSafelyScaleDownFirst(ctx, controlPlane, machineToInPlaceUpdateOrScaleDown) {
+		log.Info(fmt.Sprintf("Waiting for the control plane to tolerate the removal of Machine %s before replacing it", machineToInPlaceUpdateOrScaleDown.Name), "Machine", klog.KObj(machineToInPlaceUpdateOrScaleDown))
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "ScaleDownFirstBlocked",
+			"Machine %s cannot be deleted before creating its replacement: removing it could result in losing Kubernetes control plane components or etcd quorum", machineToInPlaceUpdateOrScaleDown.Name)
+		return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+	}
+	return r.inPlaceUpdateOrScaleDown(ctx, controlPlane, machineToInPlaceUpdateOrScaleDown, machinesUpToDateResults)
+}
+
+// onDeleteUpdate replaces outdated Machines only when they are deleted by the user, i.e. it only creates new Machines
+// to get back to the desired number of replicas; outdated Machines are deleted only when scaling down.
+func (r *Reconciler) onDeleteUpdate(
+	ctx context.Context,
+	controlPlane *pkg.ControlPlane,
+	machinesNeedingRollout collections.Machines,
+	_ map[string]pkg.UpToDateResult,
+) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	currentReplicas := int32(controlPlane.Machines.Len())
+	desiredReplicas := *controlPlane.KCP.Spec.Replicas
+
+	switch {
+	case currentReplicas < desiredReplicas:
+		return r.scaleUpControlPlane(ctx, controlPlane)
+	case currentReplicas > desiredReplicas:
+		machineToDelete, err := selectMachineForInPlaceUpdateOrScaleDown(ctx, controlPlane, machinesNeedingRollout)
+		if err != nil {
+			return ctrl.Result{}, pkgerrors.Wrap(err, "failed to select machine for scale down")
+		}
+		return r.scaleDownControlPlane(ctx, controlPlane, machineToDelete)
+	default:
+		log.V(4).Info("Waiting for outdated Machines to be deleted", "machines", strings.Join(machinesNeedingRollout.Names(), ", "))
+		return ctrl.Result{}, nil
+	}
+}
+
+// inPlaceUpdateOrScaleDown in-place updates the given Machine if possible, otherwise it deletes it.
+func (r *Reconciler) inPlaceUpdateOrScaleDown(
+	ctx context.Context,
+	controlPlane *pkg.ControlPlane,
+	machineToInPlaceUpdateOrScaleDown *clusterv1.Machine,
+	machinesUpToDateResults map[string]pkg.UpToDateResult,
+) (ctrl.Result, error) {
+	currentUpToDateReplicas := int32(controlPlane.UpToDateMachines().Len())
+	desiredReplicas := *controlPlane.KCP.Spec.Replicas
+
 	machineUpToDateResult, ok := machinesUpToDateResults[machineToInPlaceUpdateOrScaleDown.Name]
 	if !ok {
 		// Note: This should never happen as we store results for all Machines in machinesUpToDateResults.
@@ -157,3 +241,47 @@ func (r *Reconciler) rollingUpdate(
 	}
 	return r.scaleDownControlPlane(ctx, controlPlane, machineToInPlaceUpdateOrScaleDown)
 }
+
+// canSafelyScaleDownFirst determines if deleting a Machine before creating its replacement will leave the Kubernetes
+// control plane components and the etcd cluster in operational state or not, also while the replacement joins.
+func (r *Reconciler) canSafelyScaleDownFirst(ctx context.Context, controlPlane *pkg.ControlPlane, machineToDelete *clusterv1.Machine) bool {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Never delete the only control plane Machine, this would result in losing the control plane.
+	// Note: ScaleDownFirst is validated to require at least 3 replicas, but replicas could still be changed
+	// e.g. via the scale subresource.
+	desiredReplicas := int(*controlPlane.KCP.Spec.Replicas)
+	if desiredReplicas < 2 {
+		log.Info("cannot delete a control plane Machine before creating its replacement when replicas is less than 2", "desiredReplicas", desiredReplicas)
+		return false
+	}
+
+	// Check if the target Kubernetes control plane will have at least one set of operational Kubernetes control plane components.
+	if !r.targetKubernetesControlPlaneComponentsHealthy(ctx, controlPlane, false, machineToDelete.Name) {
+		return false
+	}
+
+	// If etcd is not managed, no other checks are required.
+	if !controlPlane.IsEtcdManaged() {
+		return true
+	}
+
+	if len(controlPlane.EtcdMembers) == 0 {
+		log.Info("cannot check etcd cluster health before scale down, etcd member list is empty")
+		return false
+	}
+
+	// The remaining etcd members must be enough to preserve the quorum of the desired etcd cluster, because the
+	// replacement is going to join as an additional member, which in the worst case won't be healthy.
+	etcdMemberToBeDeleted := r.tryGetEtcdMemberName(ctx, controlPlane, machineToDelete)
+	remainingMembers := len(controlPlane.EtcdMembers)
+	if etcdMemberToBeDeleted != "" {
+		remainingMembers--
+	}
+	if desiredQuorum := desiredReplicas/2 + 1; remainingMembers < desiredQuorum {
+		log.Info("cannot delete a control plane Machine before creating its replacement, the remaining etcd members are not enough to preserve quorum",
+			"remainingMembers", remainingMembers, "quorum", desiredQuorum)
+		return false
+	}
+	return r.targetEtcdClusterHealthy(ctx, controlPlane, false, etcdMemberToBeDeleted)
+}
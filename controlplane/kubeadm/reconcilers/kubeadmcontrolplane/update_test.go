@@ -516,6 +516,185 @@ func Test_rollingUpdate(t *testing.T) {
 	}
 }
 
+func Test_scaleDownFirstUpdate(t *testing.T) {
+	tests := []struct {
+		name                string
+		currentReplicas     int32
+		desiredReplicas     int32
+		unhealthyMachines   int32
+		wantScaleDownCalled bool
+		wantScaleUpCalled   bool
+		wantRes             ctrl.Result
+	}{
+		{
+			name:                "scale down first when the remaining etcd members preserve quorum",
+			currentReplicas:     3,
+			desiredReplicas:     3,
+			wantScaleDownCalled: true,
+		},
+		{
+			name:              "scale up to replace the Machine deleted before",
+			currentReplicas:   2,
+			desiredReplicas:   3,
+			wantScaleUpCalled: true,
+		},
+		{
+			name:                "scale down when there are more replicas than desired",
+			currentReplicas:     4,
+			desiredReplicas:     3,
+			unhealthyMachines:   1,
+			wantScaleDownCalled: true,
+		},
+		{
+			name:              "do not scale down first when another etcd member is unhealthy",
+			currentReplicas:   3,
+			desiredReplicas:   3,
+			unhealthyMachines: 1,
+			wantRes:           ctrl.Result{RequeueAfter: preflightFailedRequeueAfter},
+		},
+		{
+			name:            "do not scale down first the only control plane Machine",
+			currentReplicas: 1,
+			desiredReplicas: 1,
+			wantRes:         ctrl.Result{RequeueAfter: preflightFailedRequeueAfter},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var scaleDownCalled bool
+			var scaleUpCalled bool
+			r := &Reconciler{
+				recorder: record.NewFakeRecorder(32),
+				overrideScaleDownControlPlaneFunc: func(_ context.Context, _ *pkg.ControlPlane, _ *clusterv1.Machine) (ctrl.Result, error) {
+					scaleDownCalled = true
+					return ctrl.Result{}, nil
+				},
+				overrideScaleUpControlPlaneFunc: func(_ context.Context, _ *pkg.ControlPlane) (ctrl.Result, error) {
+					scaleUpCalled = true
+					return ctrl.Result{}, nil
+				},
+			}
+
+			// Only healthy Machines are outdated, so the Machine to be deleted is never an unhealthy one.
+			machines := collections.Machines{}
+			outdatedMachines := collections.Machines{}
+			for i := range tt.currentReplicas {
+				name := fmt.Sprintf("machine-%d", i)
+				if i < tt.currentReplicas-tt.unhealthyMachines {
+					machines[name] = getMachine(metav1.NamespaceDefault, name, withHealthyK8sControlPlane(), withHealthyEtcdMember())
+					outdatedMachines[name] = machines[name]
+					continue
+				}
+				machines[name] = getMachine(metav1.NamespaceDefault, name, withHealthyK8sControlPlane(), withUnhealthyEtcdMember())
+			}
+
+			controlPlane := &pkg.ControlPlane{
+				KCP: &controlplanev1.KubeadmControlPlane{
+					Spec: controlplanev1.KubeadmControlPlaneSpec{
+						Replicas: ptr.To(tt.desiredReplicas),
+						Rollout: controlplanev1.KubeadmControlPlaneRolloutSpec{
+							Strategy: controlplanev1.KubeadmControlPlaneRolloutStrategy{
+								Type: controlplanev1.ScaleDownFirstStrategyType,
+							},
+						},
+					},
+				},
+				Cluster:             &clusterv1.Cluster{},
+				Machines:            machines,
+				MachinesNotUpToDate: outdatedMachines,
+				EtcdMembers:         etcdMembers(machines),
+			}
+			machinesNeedingRollout, _ := controlPlane.MachinesNeedingRollout()
+			machinesUpToDateResults := map[string]pkg.UpToDateResult{}
+			for _, m := range machinesNeedingRollout {
+				machinesUpToDateResults[m.Name] = pkg.UpToDateResult{}
+			}
+			res, err := r.scaleDownFirstUpdate(ctx, controlPlane, machinesNeedingRollout, machinesUpToDateResults)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res).To(Equal(tt.wantRes))
+
+			g.Expect(scaleDownCalled).To(Equal(tt.wantScaleDownCalled), "scaleDownCalled: actual: %t expected: %t", scaleDownCalled, tt.wantScaleDownCalled)
+			g.Expect(scaleUpCalled).To(Equal(tt.wantScaleUpCalled), "scaleUpCalled: actual: %t expected: %t", scaleUpCalled, tt.wantScaleUpCalled)
+		})
+	}
+}
+
+func Test_onDeleteUpdate(t *testing.T) {
+	tests := []struct {
+		name                string
+		currentReplicas     int32
+		desiredReplicas     int32
+		wantScaleDownCalled bool
+		wantScaleUpCalled   bool
+	}{
+		{
+			name:            "do not delete outdated Machines",
+			currentReplicas: 3,
+			desiredReplicas: 3,
+		},
+		{
+			name:              "scale up to replace a Machine deleted by the user",
+			currentReplicas:   2,
+			desiredReplicas:   3,
+			wantScaleUpCalled: true,
+		},
+		{
+			name:                "scale down when there are more replicas than desired",
+			currentReplicas:     5,
+			desiredReplicas:     3,
+			wantScaleDownCalled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var scaleDownCalled bool
+			var scaleUpCalled bool
+			r := &Reconciler{
+				overrideScaleDownControlPlaneFunc: func(_ context.Context, _ *pkg.ControlPlane, _ *clusterv1.Machine) (ctrl.Result, error) {
+					scaleDownCalled = true
+					return ctrl.Result{}, nil
+				},
+				overrideScaleUpControlPlaneFunc: func(_ context.Context, _ *pkg.ControlPlane) (ctrl.Result, error) {
+					scaleUpCalled = true
+					return ctrl.Result{}, nil
+				},
+			}
+
+			machines := collections.Machines{}
+			for i := range tt.currentReplicas {
+				machines[fmt.Sprintf("machine-%d", i)] = machine(fmt.Sprintf("machine-%d", i))
+			}
+
+			controlPlane := &pkg.ControlPlane{
+				KCP: &controlplanev1.KubeadmControlPlane{
+					Spec: controlplanev1.KubeadmControlPlaneSpec{
+						Replicas: ptr.To(tt.desiredReplicas),
+						Rollout: controlplanev1.KubeadmControlPlaneRolloutSpec{
+							Strategy: controlplanev1.KubeadmControlPlaneRolloutStrategy{
+								Type: controlplanev1.OnDeleteStrategyType,
+							},
+						},
+					},
+				},
+				Cluster:             &clusterv1.Cluster{},
+				Machines:            machines,
+				MachinesNotUpToDate: machines,
+			}
+			machinesNeedingRollout, machinesUpToDateResults := controlPlane.MachinesNeedingRollout()
+			res, err := r.onDeleteUpdate(ctx, controlPlane, machinesNeedingRollout, machinesUpToDateResults)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res).To(Equal(ctrl.Result{}))
+
+			g.Expect(scaleDownCalled).To(Equal(tt.wantScaleDownCalled), "scaleDownCalled: actual: %t expected: %t", scaleDownCalled, tt.wantScaleDownCalled)
+			g.Expect(scaleUpCalled).To(Equal(tt.wantScaleUpCalled), "scaleUpCalled: actual: %t expected: %t", scaleUpCalled, tt.wantScaleUpCalled)
+		})
+	}
+}
+
 type machineOpt func(*clusterv1.Machine)
 
 func machine(name string, opts ...machineOpt) *clusterv1.Machine {
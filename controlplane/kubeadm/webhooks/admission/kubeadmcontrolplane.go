@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"reflect"
 	"strings"
 
@@ -75,9 +76,13 @@ func (webhook *KubeadmControlPlane) Default(_ context.Context, k *controlplanev1
 		k.Spec.Version = "v" + k.Spec.Version
 	}
 
-	// Enforce RollingUpdate strategy and default MaxSurge if not set.
-	k.Spec.Rollout.Strategy.Type = controlplanev1.RollingUpdateStrategyType
-	k.Spec.Rollout.Strategy.RollingUpdate.MaxSurge = intstr.ValueOrDefault(k.Spec.Rollout.Strategy.RollingUpdate.MaxSurge, intstr.FromInt32(1))
+	// Default to RollingUpdate strategy if not set, and default MaxSurge when using RollingUpdate.
+	if k.Spec.Rollout.Strategy.Type == "" {
+		k.Spec.Rollout.Strategy.Type = controlplanev1.RollingUpdateStrategyType
+	}
+	if k.Spec.Rollout.Strategy.Type == controlplanev1.RollingUpdateStrategyType {
+		k.Spec.Rollout.Strategy.RollingUpdate.MaxSurge = intstr.ValueOrDefault(k.Spec.Rollout.Strategy.RollingUpdate.MaxSurge, intstr.FromInt32(1))
+	}
 	return nil
 }
 
@@ -198,6 +203,12 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldK, newK
 		{spec, "machineNaming", "*"},
 		{spec, "rollout"},
 		{spec, "rollout", "*"},
+		{spec, "etcdSnapshot"},
+		{spec, "etcdSnapshot", "*"},
+		{spec, "etcdDefragmentation"},
+		{spec, "etcdDefragmentation", "*"},
+		{spec, "certificateAuthorityRotation"},
+		{spec, "certificateAuthorityRotation", "*"},
 	}
 
 	allErrs := validateKubeadmControlPlaneSpec(newK.Spec, field.NewPath("spec"))
@@ -330,6 +341,14 @@ func validateKubeadmControlPlaneSpec(s controlplanev1.KubeadmControlPlaneSpec, p
 
 	allErrs = append(allErrs, validateRolloutAndCertValidityFields(s.Rollout, s.KubeadmConfigSpec.ClusterConfiguration, s.Replicas, pathPrefix)...)
 	allErrs = append(allErrs, validateNaming(s.MachineNaming, pathPrefix.Child("machineNaming"))...)
+	allErrs = append(allErrs, validateEtcdSnapshot(s.EtcdSnapshot, externalEtcd, pathPrefix.Child("etcdSnapshot"))...)
+	if externalEtcd && !reflect.DeepEqual(s.EtcdDefragmentation, controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{}) {
+		allErrs = append(allErrs,
+			field.Forbidden(
+				pathPrefix.Child("etcdDefragmentation"),
+				"cannot be set when using external etcd",
+			))
+	}
 	return allErrs
 }
 
@@ -342,12 +361,40 @@ func validateRolloutAndCertValidityFields(rolloutSpec controlplanev1.KubeadmCont
 		return nil
 	}
 
-	if rolloutStrategy.Type != controlplanev1.RollingUpdateStrategyType {
+	switch rolloutStrategy.Type {
+	case controlplanev1.RollingUpdateStrategyType:
+		// rollingUpdate is validated below.
+	case controlplanev1.ScaleDownFirstStrategyType, controlplanev1.OnDeleteStrategyType:
+		if !reflect.DeepEqual(rolloutStrategy.RollingUpdate, controlplanev1.KubeadmControlPlaneRolloutStrategyRollingUpdate{}) {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(
+					pathPrefix.Child("rollout", "strategy", "rollingUpdate"),
+					fmt.Sprintf("cannot be set when type is %s", rolloutStrategy.Type),
+				),
+			)
+		}
+		// Deleting a Machine before creating its replacement is safe only if the remaining etcd members preserve quorum.
+		if rolloutStrategy.Type == controlplanev1.ScaleDownFirstStrategyType && replicas != nil && *replicas < int32(3) {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(
+					pathPrefix.Child("rollout", "strategy", "type"),
+					"when KubeadmControlPlane is configured with the ScaleDownFirst strategy, replica count needs to be at least 3",
+				),
+			)
+		}
+	default:
 		allErrs = append(
 			allErrs,
-			field.Required(
+			field.NotSupported(
 				pathPrefix.Child("rollout", "strategy", "type"),
-				"only RollingUpdate is supported",
+				rolloutStrategy.Type,
+				[]controlplanev1.KubeadmControlPlaneRolloutStrategyType{
+					controlplanev1.RollingUpdateStrategyType,
+					controlplanev1.ScaleDownFirstStrategyType,
+					controlplanev1.OnDeleteStrategyType,
+				},
 			),
 		)
 	}
@@ -445,6 +492,36 @@ func validateNaming(machineNaming controlplanev1.MachineNamingSpec, pathPrefix *
 	return allErrs
 }
 
+func validateEtcdSnapshot(etcdSnapshot controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec, externalEtcd bool, pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if reflect.DeepEqual(etcdSnapshot, controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{}) {
+		return nil
+	}
+
+	if externalEtcd {
+		allErrs = append(allErrs,
+			field.Forbidden(
+				pathPrefix,
+				"cannot be set when using external etcd",
+			))
+	}
+
+	if s3 := etcdSnapshot.Sink.S3; s3 != nil {
+		endpoint, err := url.Parse(s3.Endpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			allErrs = append(allErrs,
+				field.Invalid(
+					pathPrefix.Child("sink", "s3", "endpoint"),
+					s3.Endpoint,
+					"must be a valid http or https URL",
+				))
+		}
+	}
+
+	return allErrs
+}
+
 func validateClusterConfiguration(oldClusterConfiguration, newClusterConfiguration *bootstrapv1.ClusterConfiguration, pathPrefix *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
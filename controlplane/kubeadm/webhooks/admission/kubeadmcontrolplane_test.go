@@ -74,6 +74,13 @@ func TestKubeadmControlPlaneDefault(t *testing.T) {
 	g.Expect(kcp.Spec.Version).To(Equal("v1.18.3"))
 	g.Expect(kcp.Spec.Rollout.Strategy.Type).To(Equal(controlplanev1.RollingUpdateStrategyType))
 	g.Expect(kcp.Spec.Rollout.Strategy.RollingUpdate.MaxSurge.IntVal).To(Equal(int32(1)))
+
+	onDeleteKCP := updateDefaultingValidationKCP.DeepCopy()
+	onDeleteKCP.Spec.Rollout.Strategy.Type = controlplanev1.OnDeleteStrategyType
+	g.Expect(webhook.Default(ctx, onDeleteKCP)).To(Succeed())
+
+	g.Expect(onDeleteKCP.Spec.Rollout.Strategy.Type).To(Equal(controlplanev1.OnDeleteStrategyType))
+	g.Expect(onDeleteKCP.Spec.Rollout.Strategy.RollingUpdate.MaxSurge).To(BeNil())
 }
 
 func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
@@ -113,6 +120,39 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 	invalidMaxSurge := valid.DeepCopy()
 	invalidMaxSurge.Spec.Rollout.Strategy.RollingUpdate.MaxSurge.IntVal = int32(3)
 
+	validEtcdSnapshot := valid.DeepCopy()
+	validEtcdSnapshot.Spec.EtcdSnapshot = controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{
+		IntervalSeconds: 3600,
+		Sink: controlplanev1.EtcdSnapshotSink{
+			S3: &controlplanev1.EtcdSnapshotS3Sink{
+				Endpoint:          "https://s3.example.com",
+				Bucket:            "bucket",
+				CredentialsSecret: controlplanev1.EtcdSnapshotS3CredentialsSecretReference{Name: "credentials"},
+			},
+		},
+	}
+
+	invalidEtcdSnapshotEndpoint := validEtcdSnapshot.DeepCopy()
+	invalidEtcdSnapshotEndpoint.Spec.EtcdSnapshot.Sink.S3.Endpoint = "s3.example.com"
+
+	invalidEtcdSnapshotExternalEtcd := validEtcdSnapshot.DeepCopy()
+	invalidEtcdSnapshotExternalEtcd.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External = bootstrapv1.ExternalEtcd{
+		Endpoints: []string{"https://etcd.example.com:2379"},
+	}
+
+	validEtcdDefragmentation := valid.DeepCopy()
+	validEtcdDefragmentation.Spec.EtcdDefragmentation = controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{
+		MaintenanceWindow: controlplanev1.EtcdMaintenanceWindow{
+			StartTime:       "02:00",
+			DurationMinutes: 60,
+		},
+	}
+
+	invalidEtcdDefragmentationExternalEtcd := validEtcdDefragmentation.DeepCopy()
+	invalidEtcdDefragmentationExternalEtcd.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External = bootstrapv1.ExternalEtcd{
+		Endpoints: []string{"https://etcd.example.com:2379"},
+	}
+
 	stringMaxSurge := valid.DeepCopy()
 	val := intstr.FromString("1")
 	stringMaxSurge.Spec.Rollout.Strategy.RollingUpdate.MaxSurge = &val
@@ -300,6 +340,31 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 			expectErr: true,
 			kcp:       invalidRolloutBeforeCertificatesExpiryDays,
 		},
+		{
+			name:      "should succeed when etcdSnapshot is valid",
+			expectErr: false,
+			kcp:       validEtcdSnapshot,
+		},
+		{
+			name:      "should return error when etcdSnapshot S3 endpoint is not a valid URL",
+			expectErr: true,
+			kcp:       invalidEtcdSnapshotEndpoint,
+		},
+		{
+			name:      "should return error when etcdSnapshot is set with external etcd",
+			expectErr: true,
+			kcp:       invalidEtcdSnapshotExternalEtcd,
+		},
+		{
+			name:      "should succeed when etcdDefragmentation is valid",
+			expectErr: false,
+			kcp:       validEtcdDefragmentation,
+		},
+		{
+			name:      "should return error when etcdDefragmentation is set with external etcd",
+			expectErr: true,
+			kcp:       invalidEtcdDefragmentationExternalEtcd,
+		},
 	}
 
 	for _, tt := range tests {
@@ -427,6 +492,23 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 	wrongReplicaCountForScaleIn := before.DeepCopy()
 	wrongReplicaCountForScaleIn.Spec.Rollout.Strategy.RollingUpdate.MaxSurge.IntVal = int32(0)
 
+	updateStrategyToScaleDownFirst := before.DeepCopy()
+	updateStrategyToScaleDownFirst.Spec.Replicas = ptr.To[int32](3)
+	updateStrategyToScaleDownFirst.Spec.Rollout.Strategy = controlplanev1.KubeadmControlPlaneRolloutStrategy{
+		Type: controlplanev1.ScaleDownFirstStrategyType,
+	}
+
+	wrongReplicaCountForScaleDownFirst := updateStrategyToScaleDownFirst.DeepCopy()
+	wrongReplicaCountForScaleDownFirst.Spec.Replicas = ptr.To[int32](1)
+
+	updateStrategyToOnDelete := before.DeepCopy()
+	updateStrategyToOnDelete.Spec.Rollout.Strategy = controlplanev1.KubeadmControlPlaneRolloutStrategy{
+		Type: controlplanev1.OnDeleteStrategyType,
+	}
+
+	invalidRollingUpdateForOnDelete := updateStrategyToOnDelete.DeepCopy()
+	invalidRollingUpdateForOnDelete.Spec.Rollout.Strategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromInt32(1))
+
 	validUpdateKubeadmConfigInit := before.DeepCopy()
 	validUpdateKubeadmConfigInit.Spec.KubeadmConfigSpec.InitConfiguration.NodeRegistration = bootstrapv1.NodeRegistrationOptions{}
 
@@ -487,6 +569,19 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 		RetryPeriodSeconds:      ptr.To[int32](10 * 60),
 	}
 	validUpdate.Spec.KubeadmConfigSpec.Format = bootstrapv1.CloudConfig
+	validUpdate.Spec.EtcdSnapshot = controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{
+		IntervalSeconds: 3600,
+		Retention:       ptr.To[int32](3),
+		Sink: controlplanev1.EtcdSnapshotSink{
+			Secret: &controlplanev1.EtcdSnapshotSecretSink{},
+		},
+	}
+	validUpdate.Spec.EtcdDefragmentation = controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{
+		FragmentationThresholdPercent: ptr.To[int32](50),
+	}
+	validUpdate.Spec.CertificateAuthorityRotation = controlplanev1.KubeadmControlPlaneCertificateAuthorityRotationSpec{
+		After: metav1.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
 
 	scaleToZero := before.DeepCopy()
 	scaleToZero.Spec.Replicas = ptr.To[int32](0)
@@ -1041,6 +1136,30 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 			before:    before,
 			kcp:       wrongReplicaCountForScaleIn,
 		},
+		{
+			name:      "should not return an error when strategy is updated to ScaleDownFirst",
+			expectErr: false,
+			before:    before,
+			kcp:       updateStrategyToScaleDownFirst,
+		},
+		{
+			name:      "should return an error when strategy is updated to ScaleDownFirst, but replica count is < 3",
+			expectErr: true,
+			before:    before,
+			kcp:       wrongReplicaCountForScaleDownFirst,
+		},
+		{
+			name:      "should not return an error when strategy is updated to OnDelete",
+			expectErr: false,
+			before:    before,
+			kcp:       updateStrategyToOnDelete,
+		},
+		{
+			name:      "should return an error when rollingUpdate is set and strategy is OnDelete",
+			expectErr: true,
+			before:    before,
+			kcp:       invalidRollingUpdateForOnDelete,
+		},
 		{
 			name:      "should pass if NTP servers are updated",
 			expectErr: false,
@@ -72,6 +72,12 @@ func ConvertKubeadmControlPlaneV1Beta1ToHub(ctx context.Context, src *controlpla
 	// Recover other values
 	if ok {
 		bootstrapconversion.RestoreKubeadmConfigSpec(&restored.Spec.KubeadmConfigSpec, &dst.Spec.KubeadmConfigSpec)
+		dst.Spec.EtcdSnapshot = restored.Spec.EtcdSnapshot
+		dst.Status.EtcdSnapshot = restored.Status.EtcdSnapshot
+		dst.Spec.EtcdDefragmentation = restored.Spec.EtcdDefragmentation
+		dst.Status.EtcdDefragmentation = restored.Status.EtcdDefragmentation
+		dst.Spec.CertificateAuthorityRotation = restored.Spec.CertificateAuthorityRotation
+		dst.Status.CertificateAuthorityRotation = restored.Status.CertificateAuthorityRotation
 	}
 
 	if src.Spec.RemediationStrategy != nil {
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclass
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/util/cache"
+)
+
+// SetupForDryRun prepares the Reconciler to compute the variables of ClusterClasses without a manager,
+// e.g. to plan changes to Cluster topologies with clusterctl.
+func (r *Reconciler) SetupForDryRun(ctx context.Context) error {
+	if feature.Gates.Enabled(feature.RuntimeSDK) && r.RuntimeClient == nil {
+		return pkgerrors.New("RuntimeClient must not be nil")
+	}
+
+	r.discoverVariablesCache = cache.New[runtimeclient.CallExtensionCacheEntry](ctx, cache.DefaultTTL)
+	return nil
+}
+
+// ComputeVariables sets the variables of a ClusterClass in its status like reconcile does, i.e. including the
+// variables discovered by calling the DiscoverVariables hook of the Runtime Extensions of external patches.
+func (r *Reconciler) ComputeVariables(ctx context.Context, clusterClass *clusterv1.ClusterClass) error {
+	if r.discoverVariablesCache == nil {
+		return pkgerrors.New("Reconciler must be setup for dry run")
+	}
+
+	_, err := r.reconcileVariables(ctx, &scope{clusterClass: clusterClass})
+	return err
+}
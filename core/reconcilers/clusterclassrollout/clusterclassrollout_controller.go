@@ -0,0 +1,531 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclassrollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/finalizers"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/paused"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclassrollouts;clusterclassrollouts/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch;update;patch
+
+// Reconciler reconciles the ClusterClassRollout object.
+type Reconciler struct {
+	Client client.Client
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+}
+
+// SetupWithManager sets up the reconciler with the Manager.
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil {
+		return pkgerrors.New("Client must not be nil")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "clusterclassrollout")
+	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+		For(&clusterv1.ClusterClassRollout{}).
+		WithOptions(options).
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterToClusterClassRollouts),
+		).
+		Watches(
+			&clusterv1.ClusterClass{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterClassToClusterClassRollouts),
+		).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(ctx, r)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+	return nil
+}
+
+// Reconcile reconciles the passed in object.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ctrl.Result, reterr error) {
+	rollout := &clusterv1.ClusterClassRollout{}
+	if err := r.Client.Get(ctx, req.NamespacedName, rollout); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object  - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	// Add finalizer first if not set to avoid the race condition between init and delete.
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, rollout, clusterv1.ClusterClassRolloutFinalizer); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	patchHelper, err := patch.NewHelper(rollout, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, nil, rollout); err != nil || isPaused || requeue {
+		return ctrl.Result{}, err
+	}
+
+	if !rollout.DeletionTimestamp.IsZero() {
+		if err := r.reconcileDelete(ctx, rollout); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, patchHelper.Patch(ctx, rollout)
+	}
+
+	s := &scope{
+		rollout: rollout,
+	}
+
+	defer func() {
+		s.reconcileError = reterr
+		updateStatus(ctx, s)
+
+		patchOpts := []patch.Option{
+			patch.WithOwnedConditions{Conditions: []string{
+				clusterv1.PausedCondition,
+				clusterv1.ClusterClassRolloutRollingOutCondition,
+				clusterv1.ClusterClassRolloutHaltedCondition,
+			}},
+		}
+
+		// Patch ObservedGeneration only if the reconciliation completed successfully
+		if reterr == nil {
+			patchOpts = append(patchOpts, patch.WithStatusObservedGeneration{})
+		}
+		if err := patchHelper.Patch(ctx, rollout, patchOpts...); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+			return
+		}
+	}()
+
+	return r.reconcile(ctx, s)
+}
+
+// scope holds the different objects that are read and used during the reconcile.
+type scope struct {
+	// rollout is the ClusterClassRollout object being reconciled.
+	// It is set at the beginning of the reconcile function.
+	rollout *clusterv1.ClusterClassRollout
+
+	// batches are the Clusters selected by the ClusterClassRollout, grouped in batches.
+	// It is set by reconcile.
+	batches [][]*clusterv1.Cluster
+
+	// clusters, updatedClusters and upToDateClusters are the counters surfaced in status.
+	// They are set by reconcile.
+	clusters, updatedClusters, upToDateClusters int32
+
+	// completedBatches is the number of leading batches whose Clusters are all up-to-date.
+	// It is set by reconcile.
+	completedBatches int32
+
+	// failingClusters are the Clusters using the target ClusterClass which are failing.
+	// It is set by reconcile.
+	failingClusters []string
+
+	// waitingForPause is true if the next batch can't be started until pauseBetweenBatchesSeconds elapses.
+	// It is set by reconcile.
+	waitingForPause bool
+
+	// reconcileError is the error returned by reconcile, if any.
+	reconcileError error
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	rollout := s.rollout
+
+	to := rollout.GetToClassKey()
+	toClass := &clusterv1.ClusterClass{}
+	if err := r.Client.Get(ctx, to, toClass); err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to get ClusterClass %s", to)
+	}
+	generations := map[types.NamespacedName]int64{to: toClass.Generation}
+
+	// Note: the from ClusterClass can be deleted once all the Clusters have been moved to the target ClusterClass.
+	if from := rollout.GetFromClassKey(); from != to {
+		fromClass := &clusterv1.ClusterClass{}
+		if err := r.Client.Get(ctx, from, fromClass); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to get ClusterClass %s", from)
+			}
+		} else {
+			generations[from] = fromClass.Generation
+		}
+	}
+
+	clusters, err := r.getClusters(ctx, rollout)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Unpin the Clusters not selected anymore by the ClusterClassRollout, e.g. because the clusterSelector changed.
+	if err := r.unpinClusters(ctx, rollout, clusters); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Pin the selected Clusters to the generation of the ClusterClass they are using, so changes applied to the
+	// from or to ClusterClass are applied to the Clusters only when their batch is reached.
+	for _, cluster := range clusters {
+		if err := r.pinCluster(ctx, rollout, cluster, generations[cluster.GetClassKey()]); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	s.batches, err = computeBatches(rollout, clusters)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	s.completedBatches = int32(len(s.batches))
+	var unavailableClusters int32
+	for i, batch := range s.batches {
+		for _, cluster := range batch {
+			s.clusters++
+			if !isUpdated(cluster, to, toClass.Generation) {
+				if int32(i) < s.completedBatches {
+					s.completedBatches = int32(i)
+				}
+				continue
+			}
+			s.updatedClusters++
+			if isUpToDate(cluster, toClass.Generation) {
+				s.upToDateClusters++
+				continue
+			}
+			unavailableClusters++
+			if int32(i) < s.completedBatches {
+				s.completedBatches = int32(i)
+			}
+			if isFailing(cluster) {
+				s.failingClusters = append(s.failingClusters, klog.KObj(cluster).String())
+			}
+		}
+	}
+
+	// Record when a new batch is completed, so it is possible to wait for pauseBetweenBatchesSeconds before starting
+	// the next batch.
+	if s.completedBatches > 0 && (rollout.Status.CompletedBatches == nil || s.completedBatches > *rollout.Status.CompletedBatches) {
+		rollout.Status.LastBatchCompletionTime = metav1.Now()
+	}
+
+	if len(s.failingClusters) > 0 {
+		log.Info("Rollout halted, Clusters using the target ClusterClass are failing", "clusters", s.failingClusters)
+		return ctrl.Result{}, nil
+	}
+
+	if s.completedBatches == int32(len(s.batches)) {
+		return ctrl.Result{}, nil
+	}
+
+	if s.completedBatches > 0 && rollout.Spec.Strategy.PauseBetweenBatchesSeconds != nil {
+		pause := time.Duration(*rollout.Spec.Strategy.PauseBetweenBatchesSeconds) * time.Second
+		if wait := time.Until(rollout.Status.LastBatchCompletionTime.Add(pause)); wait > 0 {
+			s.waitingForPause = true
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	// Wait for the target ClusterClass to be reconciled before rolling out its current generation.
+	// Note: This doesn't require requeue as a change to the ClusterClass observedGeneration will cause an additional reconcile.
+	if toClass.Status.ObservedGeneration != toClass.Generation {
+		log.Info(fmt.Sprintf("Waiting for ClusterClass %s to be reconciled", to))
+		return ctrl.Result{}, nil
+	}
+
+	// Move the Clusters of the current batch to the current generation of the target ClusterClass,
+	// without exceeding maxUnavailableClusters.
+	maxUnavailableClusters := ptr.Deref(rollout.Spec.Strategy.MaxUnavailableClusters, 1)
+	for _, cluster := range s.batches[s.completedBatches] {
+		if unavailableClusters >= maxUnavailableClusters {
+			break
+		}
+		if isUpdated(cluster, to, toClass.Generation) {
+			continue
+		}
+
+		log.Info(fmt.Sprintf("Moving Cluster to generation %d of ClusterClass %s", toClass.Generation, to), "Cluster", klog.KObj(cluster))
+		clusterPatchHelper, err := patch.NewHelper(cluster, r.Client)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		cluster.Spec.Topology.ClassRef.Name = rollout.Spec.To.Name
+		cluster.Spec.Topology.ClassRef.Namespace = rollout.Spec.To.Namespace
+		if cluster.Annotations == nil {
+			cluster.Annotations = map[string]string{}
+		}
+		cluster.Annotations[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation] = strconv.FormatInt(toClass.Generation, 10)
+		if err := clusterPatchHelper.Patch(ctx, cluster); err != nil {
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to move Cluster %s to ClusterClass %s", klog.KObj(cluster), to)
+		}
+		s.updatedClusters++
+		unavailableClusters++
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileDelete unpins all the Clusters pinned by the ClusterClassRollout and removes the finalizer.
+func (r *Reconciler) reconcileDelete(ctx context.Context, rollout *clusterv1.ClusterClassRollout) error {
+	if err := r.unpinClusters(ctx, rollout, nil); err != nil {
+		return err
+	}
+	controllerutil.RemoveFinalizer(rollout, clusterv1.ClusterClassRolloutFinalizer)
+	return nil
+}
+
+// pinCluster pins a Cluster selected by the ClusterClassRollout to the given generation of the ClusterClass it is using.
+// NOTE: If the Cluster is already pinned, e.g. by the user before creating the ClusterClassRollout, the existing pin is preserved.
+func (r *Reconciler) pinCluster(ctx context.Context, rollout *clusterv1.ClusterClassRollout, cluster *clusterv1.Cluster, generation int64) error {
+	if cluster.Labels[clusterv1.ClusterClassRolloutNameLabel] == rollout.Name {
+		return nil
+	}
+
+	clusterPatchHelper, err := patch.NewHelper(cluster, r.Client)
+	if err != nil {
+		return err
+	}
+	if cluster.Labels == nil {
+		cluster.Labels = map[string]string{}
+	}
+	cluster.Labels[clusterv1.ClusterClassRolloutNameLabel] = rollout.Name
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	if _, ok := cluster.Annotations[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation]; !ok {
+		cluster.Annotations[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation] = strconv.FormatInt(generation, 10)
+	}
+	if err := clusterPatchHelper.Patch(ctx, cluster); err != nil {
+		return pkgerrors.Wrapf(err, "failed to pin Cluster %s", klog.KObj(cluster))
+	}
+	return nil
+}
+
+// unpinClusters unpins the Clusters pinned by the ClusterClassRollout which are not in the list of selected Clusters.
+func (r *Reconciler) unpinClusters(ctx context.Context, rollout *clusterv1.ClusterClassRollout, selected []*clusterv1.Cluster) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	clusterList := &clusterv1.ClusterList{}
+	if err := r.Client.List(ctx, clusterList, client.InNamespace(rollout.Namespace), client.MatchingLabels{clusterv1.ClusterClassRolloutNameLabel: rollout.Name}); err != nil {
+		return pkgerrors.Wrap(err, "failed to list Clusters")
+	}
+
+	selectedNames := map[string]bool{}
+	for _, cluster := range selected {
+		selectedNames[cluster.Name] = true
+	}
+
+	errs := []error{}
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if selectedNames[cluster.Name] {
+			continue
+		}
+
+		log.Info("Unpinning Cluster", "Cluster", klog.KObj(cluster))
+		clusterPatchHelper, err := patch.NewHelper(cluster, r.Client)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(cluster.Labels, clusterv1.ClusterClassRolloutNameLabel)
+		delete(cluster.Annotations, clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation)
+		if err := clusterPatchHelper.Patch(ctx, cluster); err != nil {
+			errs = append(errs, pkgerrors.Wrapf(err, "failed to unpin Cluster %s", klog.KObj(cluster)))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// getClusters returns the Clusters selected by the ClusterClassRollout, i.e. the Clusters in the namespace of the
+// ClusterClassRollout using the from or the to ClusterClass and matching the clusterSelector.
+// Clusters pinned by another ClusterClassRollout are ignored.
+func (r *Reconciler) getClusters(ctx context.Context, rollout *clusterv1.ClusterClassRollout) ([]*clusterv1.Cluster, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&rollout.Spec.ClusterSelector)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to parse clusterSelector")
+	}
+
+	clusterList := &clusterv1.ClusterList{}
+	if err := r.Client.List(ctx, clusterList, client.InNamespace(rollout.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Clusters")
+	}
+
+	from := rollout.GetFromClassKey()
+	to := rollout.GetToClassKey()
+	clusters := []*clusterv1.Cluster{}
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if !cluster.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if clusterClassKey := cluster.GetClassKey(); clusterClassKey != from && clusterClassKey != to {
+			continue
+		}
+		if name, ok := cluster.Labels[clusterv1.ClusterClassRolloutNameLabel]; ok && name != rollout.Name {
+			continue
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+// computeBatches groups the Clusters in batches: the Clusters in the canary group first, then the other Clusters
+// in alphabetical order, batchSize Clusters at a time.
+func computeBatches(rollout *clusterv1.ClusterClassRollout, clusters []*clusterv1.Cluster) ([][]*clusterv1.Cluster, error) {
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	batches := [][]*clusterv1.Cluster{}
+	if rollout.Spec.Strategy.Canary.IsDefined() {
+		canarySelector, err := metav1.LabelSelectorAsSelector(&rollout.Spec.Strategy.Canary.ClusterSelector)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to parse canary clusterSelector")
+		}
+		canary := []*clusterv1.Cluster{}
+		others := []*clusterv1.Cluster{}
+		for _, cluster := range clusters {
+			if canarySelector.Matches(labels.Set(cluster.Labels)) {
+				canary = append(canary, cluster)
+				continue
+			}
+			others = append(others, cluster)
+		}
+		if len(canary) > 0 {
+			batches = append(batches, canary)
+		}
+		clusters = others
+	}
+
+	batchSize := int(ptr.Deref(rollout.Spec.Strategy.BatchSize, 1))
+	for i := 0; i < len(clusters); i += batchSize {
+		batches = append(batches, clusters[i:min(i+batchSize, len(clusters))])
+	}
+	return batches, nil
+}
+
+// isUpdated returns true if the Cluster is using the target ClusterClass and it is pinned to its current generation.
+func isUpdated(cluster *clusterv1.Cluster, to types.NamespacedName, generation int64) bool {
+	return cluster.GetClassKey() == to &&
+		cluster.Annotations[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation] == strconv.FormatInt(generation, 10)
+}
+
+// isUpToDate returns true if the topology of the Cluster is reconciled with the current generation of the target ClusterClass,
+// the Cluster is not rolling out and it is available.
+func isUpToDate(cluster *clusterv1.Cluster, generation int64) bool {
+	if cluster.Annotations[clusterv1.ClusterTopologyReconciledClusterClassGenerationAnnotation] != strconv.FormatInt(generation, 10) {
+		return false
+	}
+	topologyReconciled := conditions.Get(cluster, clusterv1.ClusterTopologyReconciledCondition)
+	if topologyReconciled == nil || topologyReconciled.Status != metav1.ConditionTrue || topologyReconciled.ObservedGeneration < cluster.Generation {
+		return false
+	}
+	return !conditions.IsTrue(cluster, clusterv1.ClusterRollingOutCondition) && conditions.IsTrue(cluster, clusterv1.ClusterAvailableCondition)
+}
+
+// isFailing returns true if the Cluster is not available or if its topology failed to reconcile.
+// NOTE: TopologyReconciled is false also while the topology controller is progressing, e.g. while an upgrade is pending;
+// only the ReconcileFailed reason is considered a failure.
+func isFailing(cluster *clusterv1.Cluster) bool {
+	if conditions.IsFalse(cluster, clusterv1.ClusterAvailableCondition) {
+		return true
+	}
+	return conditions.IsFalse(cluster, clusterv1.ClusterTopologyReconciledCondition) &&
+		conditions.GetReason(cluster, clusterv1.ClusterTopologyReconciledCondition) == clusterv1.ClusterTopologyReconciledFailedReason
+}
+
+// clusterToClusterClassRollouts maps a Cluster to the ClusterClassRollouts in its namespace rolling out from or to
+// the ClusterClass used by the Cluster, or pinning the Cluster.
+func (r *Reconciler) clusterToClusterClassRollouts(ctx context.Context, o client.Object) []reconcile.Request {
+	cluster, ok := o.(*clusterv1.Cluster)
+	if !ok {
+		panic(fmt.Sprintf("Expected a Cluster but got a %T", o))
+	}
+	if !cluster.Spec.Topology.IsDefined() {
+		return nil
+	}
+
+	rolloutList := &clusterv1.ClusterClassRolloutList{}
+	if err := r.Client.List(ctx, rolloutList, client.InNamespace(cluster.Namespace)); err != nil {
+		return nil
+	}
+
+	res := []reconcile.Request{}
+	for _, rollout := range rolloutList.Items {
+		if clusterClassKey := cluster.GetClassKey(); clusterClassKey != rollout.GetFromClassKey() && clusterClassKey != rollout.GetToClassKey() &&
+			cluster.Labels[clusterv1.ClusterClassRolloutNameLabel] != rollout.Name {
+			continue
+		}
+		res = append(res, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rollout)})
+	}
+	return res
+}
+
+// clusterClassToClusterClassRollouts maps a ClusterClass to the ClusterClassRollouts rolling out from or to it.
+func (r *Reconciler) clusterClassToClusterClassRollouts(ctx context.Context, o client.Object) []reconcile.Request {
+	clusterClass, ok := o.(*clusterv1.ClusterClass)
+	if !ok {
+		panic(fmt.Sprintf("Expected a ClusterClass but got a %T", o))
+	}
+
+	// Note: ClusterClassRollouts can reference ClusterClasses in other namespaces.
+	rolloutList := &clusterv1.ClusterClassRolloutList{}
+	if err := r.Client.List(ctx, rolloutList); err != nil {
+		return nil
+	}
+
+	clusterClassKey := client.ObjectKeyFromObject(clusterClass)
+	res := []reconcile.Request{}
+	for _, rollout := range rolloutList.Items {
+		if clusterClassKey != rollout.GetFromClassKey() && clusterClassKey != rollout.GetToClassKey() {
+			continue
+		}
+		res = append(res, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rollout)})
+	}
+	return res
+}
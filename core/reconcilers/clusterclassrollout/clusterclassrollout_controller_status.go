@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclassrollout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func updateStatus(_ context.Context, s *scope) {
+	if s.reconcileError == nil {
+		s.rollout.Status.Clusters = ptr.To(s.clusters)
+		s.rollout.Status.UpdatedClusters = ptr.To(s.updatedClusters)
+		s.rollout.Status.UpToDateClusters = ptr.To(s.upToDateClusters)
+		s.rollout.Status.Batches = ptr.To(int32(len(s.batches)))
+		s.rollout.Status.CompletedBatches = ptr.To(s.completedBatches)
+	}
+
+	setHaltedCondition(s.rollout, s.failingClusters, s.reconcileError)
+	setRollingOutCondition(s.rollout, s)
+}
+
+func setHaltedCondition(rollout *clusterv1.ClusterClassRollout, failingClusters []string, reconcileError error) {
+	if reconcileError != nil {
+		// Preserve the Halted condition computed in the previous reconcile.
+		return
+	}
+
+	if len(failingClusters) > 0 {
+		conditions.Set(rollout, metav1.Condition{
+			Type:    clusterv1.ClusterClassRolloutHaltedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  clusterv1.ClusterClassRolloutHaltedReason,
+			Message: fmt.Sprintf("%s failing", clusterNames(failingClusters)),
+		})
+		return
+	}
+
+	conditions.Set(rollout, metav1.Condition{
+		Type:   clusterv1.ClusterClassRolloutHaltedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.ClusterClassRolloutNotHaltedReason,
+	})
+}
+
+func setRollingOutCondition(rollout *clusterv1.ClusterClassRollout, s *scope) {
+	if s.reconcileError != nil {
+		conditions.Set(rollout, metav1.Condition{
+			Type:    clusterv1.ClusterClassRolloutRollingOutCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  clusterv1.ClusterClassRolloutRollingOutInternalErrorReason,
+			Message: "Please check controller logs for errors",
+		})
+		return
+	}
+
+	if s.completedBatches == int32(len(s.batches)) {
+		conditions.Set(rollout, metav1.Condition{
+			Type:   clusterv1.ClusterClassRolloutRollingOutCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.ClusterClassRolloutNotRollingOutReason,
+		})
+		return
+	}
+
+	message := fmt.Sprintf("Rolling out batch %d of %d, %d of %d Clusters up-to-date", s.completedBatches+1, len(s.batches), s.upToDateClusters, s.clusters)
+	reason := clusterv1.ClusterClassRolloutRollingOutReason
+	if s.waitingForPause {
+		message = fmt.Sprintf("Waiting for pauseBetweenBatchesSeconds to elapse before starting batch %d of %d", s.completedBatches+1, len(s.batches))
+		reason = clusterv1.ClusterClassRolloutWaitingForPauseReason
+	}
+	conditions.Set(rollout, metav1.Condition{
+		Type:    clusterv1.ClusterClassRolloutRollingOutCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// clusterNames returns a message listing at most three Cluster names.
+func clusterNames(names []string) string {
+	if len(names) == 1 {
+		return fmt.Sprintf("Cluster %s is", names[0])
+	}
+	if len(names) > 3 {
+		return fmt.Sprintf("Clusters %s, ... (%d more) are", strings.Join(names[:3], ", "), len(names)-3)
+	}
+	return fmt.Sprintf("Clusters %s are", strings.Join(names, ", "))
+}
@@ -0,0 +1,442 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclassrollout
+
+import (
+	"cmp"
+	"maps"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+var fakeScheme = runtime.NewScheme()
+
+func init() {
+	_ = clusterv1.AddToScheme(fakeScheme)
+}
+
+func TestReconcile(t *testing.T) {
+	upToDate := func(c *clusterv1.Cluster) {
+		c.Status.Conditions = []metav1.Condition{
+			{Type: clusterv1.ClusterTopologyReconciledCondition, Status: metav1.ConditionTrue, ObservedGeneration: c.Generation},
+			{Type: clusterv1.ClusterAvailableCondition, Status: metav1.ConditionTrue},
+		}
+	}
+	upgrading := func(c *clusterv1.Cluster) {
+		c.Status.Conditions = []metav1.Condition{
+			{Type: clusterv1.ClusterTopologyReconciledCondition, Status: metav1.ConditionFalse, Reason: clusterv1.ClusterTopologyReconciledClusterUpgradingReason, ObservedGeneration: c.Generation},
+			{Type: clusterv1.ClusterAvailableCondition, Status: metav1.ConditionTrue},
+		}
+	}
+	failing := func(c *clusterv1.Cluster) {
+		c.Status.Conditions = []metav1.Condition{
+			{Type: clusterv1.ClusterTopologyReconciledCondition, Status: metav1.ConditionFalse, Reason: clusterv1.ClusterTopologyReconciledFailedReason, ObservedGeneration: c.Generation},
+			{Type: clusterv1.ClusterAvailableCondition, Status: metav1.ConditionTrue},
+		}
+	}
+
+	tests := []struct {
+		name                 string
+		to                   string
+		toGeneration         int64
+		toObservedGeneration int64
+		strategy             clusterv1.ClusterClassRolloutStrategy
+		status               clusterv1.ClusterClassRolloutStatus
+		clusters             []*clusterv1.Cluster
+		wantUpdatedClusters  []string
+		wantPins             map[string]string
+		wantRollingOutReason string
+		wantHalted           bool
+		wantRequeue          bool
+	}{
+		{
+			name: "moves the canary group first",
+			strategy: clusterv1.ClusterClassRolloutStrategy{
+				Canary:                 clusterv1.ClusterClassRolloutCanary{ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+				BatchSize:              ptr.To[int32](2),
+				MaxUnavailableClusters: ptr.To[int32](2),
+			},
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v1"),
+				testCluster("b", "class-v1"),
+				testCluster("z", "class-v1", withLabels(map[string]string{"canary": "true"})),
+			},
+			wantUpdatedClusters:  []string{"z"},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutRollingOutReason,
+		},
+		{
+			name: "moves the next batch when the previous batches are completed",
+			strategy: clusterv1.ClusterClassRolloutStrategy{
+				Canary:                 clusterv1.ClusterClassRolloutCanary{ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+				BatchSize:              ptr.To[int32](2),
+				MaxUnavailableClusters: ptr.To[int32](2),
+			},
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v1"),
+				testCluster("b", "class-v1"),
+				testCluster("c", "class-v1"),
+				testCluster("z", "class-v2", withLabels(map[string]string{"canary": "true"}), pinnedTo("1"), reconciledWith("1"), upToDate),
+			},
+			wantUpdatedClusters:  []string{"a", "b", "z"},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutRollingOutReason,
+		},
+		{
+			name: "does not exceed maxUnavailableClusters",
+			strategy: clusterv1.ClusterClassRolloutStrategy{
+				BatchSize: ptr.To[int32](3),
+			},
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), upgrading),
+				testCluster("b", "class-v1"),
+				testCluster("c", "class-v1"),
+			},
+			wantUpdatedClusters:  []string{"a"},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutRollingOutReason,
+		},
+		{
+			name: "halts if a moved Cluster is failing",
+			strategy: clusterv1.ClusterClassRolloutStrategy{
+				MaxUnavailableClusters: ptr.To[int32](2),
+			},
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), failing),
+				testCluster("b", "class-v1"),
+			},
+			wantUpdatedClusters:  []string{"a"},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutRollingOutReason,
+			wantHalted:           true,
+		},
+		{
+			name: "waits for pauseBetweenBatchesSeconds before starting the next batch",
+			strategy: clusterv1.ClusterClassRolloutStrategy{
+				PauseBetweenBatchesSeconds: ptr.To[int32](600),
+			},
+			status: clusterv1.ClusterClassRolloutStatus{
+				CompletedBatches:        ptr.To[int32](1),
+				LastBatchCompletionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), reconciledWith("1"), upToDate),
+				testCluster("b", "class-v1"),
+			},
+			wantUpdatedClusters:  []string{"a"},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutWaitingForPauseReason,
+			wantRequeue:          true,
+		},
+		{
+			name: "completes when all the Clusters are up-to-date",
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), reconciledWith("1"), upToDate),
+				testCluster("b", "class-v2", pinnedTo("1"), reconciledWith("1"), upToDate),
+				testCluster("other", "other-class"),
+			},
+			wantUpdatedClusters:  []string{"a", "b"},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutNotRollingOutReason,
+		},
+		{
+			name: "does not consider up-to-date Clusters not yet reconciled with the current generation of the target ClusterClass",
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), upToDate),
+				testCluster("b", "class-v1"),
+			},
+			wantUpdatedClusters:  []string{"a"},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutRollingOutReason,
+		},
+		{
+			name: "pins the selected Clusters to the generation of the ClusterClass they are using",
+			strategy: clusterv1.ClusterClassRolloutStrategy{
+				BatchSize: ptr.To[int32](2),
+			},
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), upgrading),
+				testCluster("b", "class-v1"),
+				testCluster("c", "class-v1", withAnnotations(map[string]string{clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation: "1"})),
+				testCluster("other", "other-class"),
+			},
+			wantUpdatedClusters: []string{"a"},
+			wantPins: map[string]string{
+				"a":     "1",
+				"b":     "3",
+				"c":     "1",
+				"other": "",
+			},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutRollingOutReason,
+		},
+		{
+			name:                 "rolls out changes to the target ClusterClass in batches",
+			to:                   "class-v1",
+			toGeneration:         4,
+			toObservedGeneration: 4,
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v1", pinnedTo("3"), reconciledWith("3"), upToDate),
+				testCluster("b", "class-v1", pinnedTo("3"), reconciledWith("3"), upToDate),
+			},
+			wantUpdatedClusters: []string{"a"},
+			wantPins: map[string]string{
+				"a": "4",
+				"b": "3",
+			},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutRollingOutReason,
+		},
+		{
+			name:                 "waits for the target ClusterClass to be reconciled before moving Clusters",
+			toGeneration:         2,
+			toObservedGeneration: 1,
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), reconciledWith("1"), upToDate),
+				testCluster("b", "class-v1"),
+			},
+			wantUpdatedClusters: []string{},
+			wantPins: map[string]string{
+				"a": "1",
+				"b": "3",
+			},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutRollingOutReason,
+		},
+		{
+			name: "unpins the Clusters not selected anymore",
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), reconciledWith("1"), upToDate),
+				testCluster("other", "other-class", pinnedTo("1")),
+			},
+			wantUpdatedClusters: []string{"a"},
+			wantPins: map[string]string{
+				"a":     "1",
+				"other": "",
+			},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutNotRollingOutReason,
+		},
+		{
+			name: "ignores the Clusters pinned by another ClusterClassRollout",
+			clusters: []*clusterv1.Cluster{
+				testCluster("a", "class-v2", pinnedTo("1"), reconciledWith("1"), upToDate),
+				testCluster("b", "class-v1", withLabels(map[string]string{clusterv1.ClusterClassRolloutNameLabel: "other-rollout"}), withAnnotations(map[string]string{clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation: "2"})),
+			},
+			wantUpdatedClusters: []string{"a"},
+			wantPins: map[string]string{
+				"a": "1",
+				"b": "2",
+			},
+			wantRollingOutReason: clusterv1.ClusterClassRolloutNotRollingOutReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			to := cmp.Or(tt.to, "class-v2")
+			toGeneration := cmp.Or(tt.toGeneration, 1)
+			rollout := &clusterv1.ClusterClassRollout{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "rollout",
+					Namespace:  metav1.NamespaceDefault,
+					Finalizers: []string{clusterv1.ClusterClassRolloutFinalizer},
+				},
+				Spec: clusterv1.ClusterClassRolloutSpec{
+					From:     clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+					To:       clusterv1.ClusterClassRolloutClassRef{Name: to},
+					Strategy: tt.strategy,
+				},
+				Status: tt.status,
+			}
+			// Set the Paused condition, so the reconcile is not requeued to set it.
+			conditions.Set(rollout, metav1.Condition{Type: clusterv1.PausedCondition, Status: metav1.ConditionFalse, Reason: clusterv1.NotPausedReason})
+			objs := []client.Object{
+				rollout,
+				testClusterClass("class-v1", 3, 3),
+				testClusterClass("class-v2", 1, 1),
+			}
+			if to == "class-v2" {
+				objs[2] = testClusterClass("class-v2", toGeneration, cmp.Or(tt.toObservedGeneration, toGeneration))
+			} else {
+				objs[1] = testClusterClass("class-v1", toGeneration, cmp.Or(tt.toObservedGeneration, toGeneration))
+			}
+			for _, cluster := range tt.clusters {
+				objs = append(objs, cluster)
+			}
+			c := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(objs...).WithStatusSubresource(&clusterv1.ClusterClassRollout{}).Build()
+
+			ctx := t.Context()
+			r := &Reconciler{Client: c}
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(rollout)})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.RequeueAfter > 0).To(Equal(tt.wantRequeue))
+
+			clusterList := &clusterv1.ClusterList{}
+			g.Expect(c.List(ctx, clusterList)).To(Succeed())
+			updatedClusters := []string{}
+			for _, cluster := range clusterList.Items {
+				if cluster.Spec.Topology.ClassRef.Name == to && cluster.Annotations[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation] == strconv.FormatInt(toGeneration, 10) {
+					updatedClusters = append(updatedClusters, cluster.Name)
+				}
+				if wantPin, ok := tt.wantPins[cluster.Name]; ok {
+					g.Expect(cluster.Annotations[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation]).To(Equal(wantPin), "pin of Cluster %s", cluster.Name)
+					if wantPin == "" {
+						g.Expect(cluster.Labels).ToNot(HaveKey(clusterv1.ClusterClassRolloutNameLabel))
+					}
+				}
+			}
+			g.Expect(updatedClusters).To(ConsistOf(tt.wantUpdatedClusters))
+
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(rollout), rollout)).To(Succeed())
+			g.Expect(conditions.GetReason(rollout, clusterv1.ClusterClassRolloutRollingOutCondition)).To(Equal(tt.wantRollingOutReason))
+			g.Expect(conditions.IsTrue(rollout, clusterv1.ClusterClassRolloutHaltedCondition)).To(Equal(tt.wantHalted))
+		})
+	}
+}
+
+func TestReconcileDelete(t *testing.T) {
+	g := NewWithT(t)
+
+	rollout := &clusterv1.ClusterClassRollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "rollout",
+			Namespace:         metav1.NamespaceDefault,
+			Finalizers:        []string{clusterv1.ClusterClassRolloutFinalizer},
+			DeletionTimestamp: ptr.To(metav1.Now()),
+		},
+		Spec: clusterv1.ClusterClassRolloutSpec{
+			From: clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+			To:   clusterv1.ClusterClassRolloutClassRef{Name: "class-v2"},
+		},
+	}
+	// Set the Paused condition, so the reconcile is not requeued to set it.
+	conditions.Set(rollout, metav1.Condition{Type: clusterv1.PausedCondition, Status: metav1.ConditionFalse, Reason: clusterv1.NotPausedReason})
+	c := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(
+		rollout,
+		testCluster("a", "class-v2", pinnedTo("1"), reconciledWith("1")),
+		testCluster("b", "class-v1", pinnedTo("3")),
+		testCluster("c", "class-v1", withLabels(map[string]string{clusterv1.ClusterClassRolloutNameLabel: "other-rollout"}), withAnnotations(map[string]string{clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation: "2"})),
+	).WithStatusSubresource(&clusterv1.ClusterClassRollout{}).Build()
+
+	ctx := t.Context()
+	r := &Reconciler{Client: c}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(rollout)})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, name := range []string{"a", "b"} {
+		cluster := &clusterv1.Cluster{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: name}, cluster)).To(Succeed())
+		g.Expect(cluster.Labels).ToNot(HaveKey(clusterv1.ClusterClassRolloutNameLabel))
+		g.Expect(cluster.Annotations).ToNot(HaveKey(clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation))
+	}
+	cluster := &clusterv1.Cluster{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "c"}, cluster)).To(Succeed())
+	g.Expect(cluster.Annotations).To(HaveKeyWithValue(clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation, "2"))
+
+	// The ClusterClassRollout is gone as soon as the finalizer is removed.
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(rollout), rollout))).To(BeTrue())
+}
+
+func TestComputeBatches(t *testing.T) {
+	g := NewWithT(t)
+
+	rollout := &clusterv1.ClusterClassRollout{
+		Spec: clusterv1.ClusterClassRolloutSpec{
+			Strategy: clusterv1.ClusterClassRolloutStrategy{
+				Canary:    clusterv1.ClusterClassRolloutCanary{ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+				BatchSize: ptr.To[int32](2),
+			},
+		},
+	}
+	clusters := []*clusterv1.Cluster{
+		testCluster("e", "class-v1"),
+		testCluster("d", "class-v1", withLabels(map[string]string{"canary": "true"})),
+		testCluster("c", "class-v1"),
+		testCluster("b", "class-v1"),
+		testCluster("a", "class-v1", withLabels(map[string]string{"canary": "true"})),
+	}
+
+	batches, err := computeBatches(rollout, clusters)
+	g.Expect(err).ToNot(HaveOccurred())
+	names := [][]string{}
+	for _, batch := range batches {
+		batchNames := []string{}
+		for _, cluster := range batch {
+			batchNames = append(batchNames, cluster.Name)
+		}
+		names = append(names, batchNames)
+	}
+	g.Expect(names).To(Equal([][]string{{"a", "d"}, {"b", "c"}, {"e"}}))
+}
+
+func testCluster(name, class string, opts ...func(*clusterv1.Cluster)) *clusterv1.Cluster {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, Generation: 1},
+		Spec: clusterv1.ClusterSpec{
+			Topology: clusterv1.Topology{
+				ClassRef: clusterv1.ClusterClassRef{Name: class},
+				Version:  "v1.33.0",
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(cluster)
+	}
+	return cluster
+}
+
+func testClusterClass(name string, generation, observedGeneration int64) *clusterv1.ClusterClass {
+	return &clusterv1.ClusterClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, Generation: generation},
+		Status:     clusterv1.ClusterClassStatus{ObservedGeneration: observedGeneration},
+	}
+}
+
+func withLabels(labels map[string]string) func(*clusterv1.Cluster) {
+	return func(c *clusterv1.Cluster) {
+		if c.Labels == nil {
+			c.Labels = map[string]string{}
+		}
+		maps.Copy(c.Labels, labels)
+	}
+}
+
+func withAnnotations(annotations map[string]string) func(*clusterv1.Cluster) {
+	return func(c *clusterv1.Cluster) {
+		if c.Annotations == nil {
+			c.Annotations = map[string]string{}
+		}
+		maps.Copy(c.Annotations, annotations)
+	}
+}
+
+// pinnedTo pins the Cluster to a generation of its ClusterClass on behalf of the "rollout" ClusterClassRollout.
+func pinnedTo(generation string) func(*clusterv1.Cluster) {
+	return func(c *clusterv1.Cluster) {
+		withLabels(map[string]string{clusterv1.ClusterClassRolloutNameLabel: "rollout"})(c)
+		withAnnotations(map[string]string{clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation: generation})(c)
+	}
+}
+
+// reconciledWith marks the topology of the Cluster as reconciled with a generation of its ClusterClass.
+func reconciledWith(generation string) func(*clusterv1.Cluster) {
+	return withAnnotations(map[string]string{clusterv1.ClusterTopologyReconciledClusterClassGenerationAnnotation: generation})
+}
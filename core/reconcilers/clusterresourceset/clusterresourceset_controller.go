@@ -19,6 +19,7 @@ package clusterresourceset
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
@@ -27,8 +28,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -57,6 +58,16 @@ import (
 // ErrSecretTypeNotSupported signals that a Secret is not supported.
 var ErrSecretTypeNotSupported = pkgerrors.New("unsupported secret type")
 
+// clusterResourceSetManagerName is the field manager used to apply objects with the ApplyAndPrune strategy.
+const clusterResourceSetManagerName = "capi-clusterresourceset"
+
+// applyAndPruneResyncPeriod is the interval used to re-apply objects with the ApplyAndPrune strategy, so
+// changes made out of band in the workload clusters are detected even if no event triggers a reconcile.
+const applyAndPruneResyncPeriod = 10 * time.Minute
+
+// waveHealthCheckRequeuePeriod is the interval used to evaluate again the health checks of a wave which is not ready yet.
+const waveHealthCheckRequeuePeriod = 20 * time.Second
+
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;patch;update;delete
 // +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
@@ -67,6 +78,10 @@ type Reconciler struct {
 	Client       client.Client
 	ClusterCache clustercache.ClusterCache
 
+	// ResourceResolvers are used to resolve ClusterResourceSet resources, keyed by resource kind.
+	// If not set, DefaultResourceResolvers are used.
+	ResourceResolvers map[string]ResourceResolver
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 }
@@ -76,6 +91,9 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	if r.Client == nil || r.ClusterCache == nil {
 		return pkgerrors.New("Client and ClusterCache must not be nil")
 	}
+	if r.ResourceResolvers == nil {
+		r.ResourceResolvers = DefaultResourceResolvers()
+	}
 
 	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "clusterresourceset")
 	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
@@ -88,7 +106,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		WatchesMetadata(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(
-				resourceToClusterResourceSetFunc[client.Object](r.Client),
+				resourceToClusterResourceSetFunc[client.Object](r.Client, r.ResourceResolvers),
 			),
 			resourcepredicates.TypedResourceCreateOrUpdate[client.Object](predicateLog),
 		).
@@ -101,7 +119,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 				},
 			},
 			handler.TypedEnqueueRequestsFromMapFunc(
-				resourceToClusterResourceSetFunc[*metav1.PartialObjectMetadata](r.Client),
+				resourceToClusterResourceSetFunc[*metav1.PartialObjectMetadata](r.Client, r.ResourceResolvers),
 			),
 			predicates.TypedAll(mgr.GetScheme(), predicateLog,
 				predicates.TypedResourceIsChanged[*metav1.PartialObjectMetadata](mgr.GetScheme(), predicateLog),
@@ -188,10 +206,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 	}
 
 	errs := []error{}
+	res := ctrl.Result{}
 	for _, cluster := range clusters {
-		if err := r.ApplyClusterResourceSet(ctx, cluster, clusterResourceSet); err != nil {
+		result, err := r.ApplyClusterResourceSet(ctx, cluster, clusterResourceSet)
+		if err != nil {
 			errs = append(errs, err)
 		}
+		res = util.LowestNonZeroResult(res, result)
 	}
 
 	// Return an aggregated error if errors occurred.
@@ -215,7 +236,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		return ctrl.Result{}, kerrors.NewAggregate(errs)
 	}
 
-	return ctrl.Result{}, nil
+	if addonsv1.ClusterResourceSetStrategy(clusterResourceSet.Spec.Strategy) == addonsv1.ClusterResourceSetStrategyApplyAndPrune {
+		res = util.LowestNonZeroResult(res, ctrl.Result{RequeueAfter: applyAndPruneResyncPeriod})
+	}
+	return res, nil
 }
 
 // reconcileDelete removes the deleted ClusterResourceSet from all the ClusterResourceSetBindings it is added to.
@@ -295,8 +319,13 @@ func (r *Reconciler) getClustersByClusterResourceSetSelector(ctx context.Context
 // It applies resources best effort and continue on scenarios like: unsupported resource types, failure during creation, missing resources.
 // In Reconcile strategy, resources are re-applied to a particular cluster when their definition changes. The hash in ClusterResourceSetBinding is used to check
 // if a resource has changed or not.
+// With the GoTemplate rendering, resources are rendered against the cluster before being applied, and the hash is computed on
+// the rendered data.
+// In ApplyAndPrune strategy, resources are re-applied with server-side apply at every reconcile, and the objects applied to a particular cluster
+// are tracked in the ClusterResourceSetBinding. Objects which are not defined by any resource anymore are deleted, but only if all
+// resources have been applied successfully.
 // TODO: If a resource already exists in the cluster but not applied by ClusterResourceSet, the resource will be updated ?
-func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clusterv1.Cluster, clusterResourceSet *addonsv1.ClusterResourceSet) (rerr error) {
+func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clusterv1.Cluster, clusterResourceSet *addonsv1.ClusterResourceSet) (_ ctrl.Result, rerr error) {
 	log := ctrl.LoggerFrom(ctx, "Cluster", klog.KObj(cluster))
 	ctx = ctrl.LoggerInto(ctx, log)
 
@@ -304,9 +333,9 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 	// NOTE: we have to do this before getting a remote client, otherwise owner reference won't be created until it is
 	// possible to connect to the remote cluster.
 	errList := []error{}
-	objList := make([]*unstructured.Unstructured, len(clusterResourceSet.Spec.Resources))
+	resolvedResources := make([]*ResolvedResource, len(clusterResourceSet.Spec.Resources))
 	for i, resource := range clusterResourceSet.Spec.Resources {
-		unstructuredObj, err := r.getResource(ctx, resource, cluster.GetNamespace())
+		resolvedResource, err := r.getResource(ctx, resource, cluster.GetNamespace())
 		if err != nil {
 			if pkgerrors.Is(err, ErrSecretTypeNotSupported) {
 				v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.WrongSecretTypeV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
@@ -335,21 +364,40 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 		}
 
 		// Ensure an ownerReference to the clusterResourceSet is on the resource.
-		if err := r.ensureResourceOwnerRef(ctx, clusterResourceSet, unstructuredObj); err != nil {
+		if err := r.ensureResourceOwnerRef(ctx, clusterResourceSet, resolvedResource.Source); err != nil {
 			log.Error(err, "Failed to add ClusterResourceSet as resource owner reference",
-				"Resource type", unstructuredObj.GetKind(), "Resource name", unstructuredObj.GetName())
+				"Resource type", resolvedResource.Source.GetKind(), "Resource name", resolvedResource.Source.GetName())
 			errList = append(errList, err)
 		}
-		objList[i] = unstructuredObj
+
+		// Render the resource for the cluster; the hash is computed on the rendered data, so changes to the
+		// Cluster fields used in templates trigger a re-apply.
+		if addonsv1.ClusterResourceSetRendering(clusterResourceSet.Spec.Rendering) == addonsv1.ClusterResourceSetRenderingGoTemplate {
+			renderedData, err := renderManifests(cluster, resolvedResource.Data)
+			if err != nil {
+				log.Error(err, "Failed to render ClusterResourceSet resource", resource.Kind, klog.KRef(clusterResourceSet.Namespace, resource.Name))
+				v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.RenderingFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+				conditions.Set(clusterResourceSet, metav1.Condition{
+					Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  addonsv1.ClusterResourceSetResourcesNotRenderedReason,
+					Message: fmt.Sprintf("Failed to render resource %s for Cluster %s: %s", resource.Name, cluster.Name, err.Error()),
+				})
+				errList = append(errList, pkgerrors.Wrapf(err, "failed to render %s %s", resource.Kind, resource.Name))
+				continue
+			}
+			resolvedResource.Data = renderedData
+		}
+		resolvedResources[i] = resolvedResource
 	}
 	if len(errList) > 0 {
-		return kerrors.NewAggregate(errList)
+		return ctrl.Result{}, kerrors.NewAggregate(errList)
 	}
 
 	// Get ClusterResourceSetBinding object for the cluster.
 	clusterResourceSetBinding, err := r.getOrCreateClusterResourceSetBinding(ctx, cluster, clusterResourceSet)
 	if err != nil {
-		return err
+		return ctrl.Result{}, err
 	}
 
 	patch := client.MergeFromWithOptions(clusterResourceSetBinding.DeepCopy(), client.MergeFromWithOptimisticLock{})
@@ -373,6 +421,11 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 
 	resourceSetBinding := clusterResourceSetBinding.GetOrCreateBinding(clusterResourceSet)
 
+	// Collect the objects which are still defined by resources; objects tracked in the ClusterResourceSetBinding but not
+	// included in this set are pruned with the ApplyAndPrune strategy.
+	isApplyAndPrune := addonsv1.ClusterResourceSetStrategy(clusterResourceSet.Spec.Strategy) == addonsv1.ClusterResourceSetStrategyApplyAndPrune
+	desiredObjects := sets.Set[addonsv1.ResourceBindingObject]{}
+
 	remoteClient, err := r.ClusterCache.GetClient(ctx, util.ObjectKey(cluster))
 	if err != nil {
 		v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.RemoteClusterClientFailedV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
@@ -382,68 +435,155 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 			Reason:  clusterv1.InternalErrorReason,
 			Message: "Please check controller logs for errors",
 		})
-		return err
+		return ctrl.Result{}, err
 	}
 
-	// Iterate all resources and apply them to the cluster and update the resource status in the ClusterResourceSetBinding object.
-	for i, resource := range clusterResourceSet.Spec.Resources {
-		unstructuredObj := objList[i]
-		if unstructuredObj == nil {
-			// Continue without adding the error to the aggregate if we can't find the resource.
-			continue
-		}
+	// Iterate all waves in order, apply their resources to the cluster and update the resource status in the ClusterResourceSetBinding object.
+	// The rollout stops at the first wave which fails to apply or whose health checks do not pass yet.
+	waveBindings := []addonsv1.WaveBinding{}
+	waitingForWave, waitingForWaveMessage := "", ""
+	for _, wave := range resourceWaves(clusterResourceSet) {
+		waveApplied := false
+		missingResources := []string{}
+		for _, i := range wave.resources {
+			resource := clusterResourceSet.Spec.Resources[i]
+			var previousObjects []addonsv1.ResourceBindingObject
+			if resourceBinding := resourceSetBinding.GetResource(resource); resourceBinding != nil {
+				previousObjects = resourceBinding.Objects
+			}
 
-		resourceScope, err := reconcileScopeForResource(clusterResourceSet, resource, resourceSetBinding, unstructuredObj)
-		if err != nil {
+			resolvedResource := resolvedResources[i]
+			if resolvedResource == nil {
+				// Do not prune objects of a resource we can't find, they could still be desired.
+				for _, object := range previousObjects {
+					desiredObjects.Insert(inventoryKey(object))
+				}
+				missingResources = append(missingResources, resource.Name)
+				// Continue without adding the error to the aggregate if we can't find the resource.
+				continue
+			}
+
+			resourceScope, err := reconcileScopeForResource(clusterResourceSet, resource, resourceSetBinding, resolvedResource.Data)
+			if err != nil {
+				resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
+					ResourceRef:     resource,
+					Hash:            "",
+					Applied:         ptr.To(false),
+					LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
+					Objects:         previousObjects,
+				})
+
+				errList = append(errList, err)
+				continue
+			}
+
+			if !resourceScope.needsApply() {
+				continue
+			}
+			waveApplied = true
+
+			// Set status in ClusterResourceSetBinding in case of early continue due to a failure.
+			// Set only when resource is retrieved successfully.
 			resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
 				ResourceRef:     resource,
 				Hash:            "",
 				Applied:         ptr.To(false),
 				LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
+				Objects:         previousObjects,
 			})
 
-			errList = append(errList, err)
-			continue
+			// Apply all values in the key-value pair of the resource to the cluster.
+			// As there can be multiple key-value pairs in a resource, each value may have multiple objects in it.
+			isSuccessful := true
+			if err := resourceScope.apply(ctx, remoteClient); err != nil {
+				isSuccessful = false
+				log.Error(err, "Failed to apply ClusterResourceSet resource", resource.Kind, klog.KRef(clusterResourceSet.Namespace, resource.Name))
+				v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.ApplyFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+				conditions.Set(clusterResourceSet, metav1.Condition{
+					Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  addonsv1.ClusterResourceSetResourcesNotAppliedReason,
+					Message: "Failed to apply ClusterResourceSet resources to Cluster",
+				})
+				errList = append(errList, err)
+			}
+
+			resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
+				ResourceRef:     resource,
+				Hash:            resourceScope.hash(),
+				Applied:         ptr.To(isSuccessful),
+				LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
+				Objects:         resourceScope.inventory(),
+			})
+			objs := resourceScope.objs()
+			for j := range objs {
+				desiredObjects.Insert(inventoryKey(resourceBindingObjectFromUnstructured(&objs[j])))
+			}
 		}
 
-		if !resourceScope.needsApply() {
+		// Resources which are not part of any wave are not gated.
+		if wave.name == "" {
 			continue
 		}
 
-		// Set status in ClusterResourceSetBinding in case of early continue due to a failure.
-		// Set only when resource is retrieved successfully.
-		resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
-			ResourceRef:     resource,
-			Hash:            "",
-			Applied:         ptr.To(false),
-			LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
+		waveBinding := addonsv1.WaveBinding{Name: wave.name, Ready: ptr.To(false)}
+		switch {
+		case len(errList) > 0:
+			waveBinding.Message = "Failed to apply resources"
+		case len(missingResources) > 0:
+			waveBinding.Message = fmt.Sprintf("Resources %s not found", strings.Join(missingResources, ", "))
+		case !waveApplied && resourceSetBinding.IsWaveReady(wave.name):
+			// Health checks are evaluated until they pass once, and again only when resources of the wave are re-applied.
+			waveBinding.Ready = ptr.To(true)
+		default:
+			message, err := r.checkWaveHealth(ctx, cluster, wave.healthChecks)
+			if err != nil {
+				waveBinding.Message = "Failed to evaluate health checks"
+				errList = append(errList, err)
+				break
+			}
+			waveBinding.Ready = ptr.To(message == "")
+			waveBinding.Message = message
+		}
+		waveBindings = append(waveBindings, waveBinding)
+		if !*waveBinding.Ready {
+			waitingForWave, waitingForWaveMessage = wave.name, waveBinding.Message
+			break
+		}
+	}
+	resourceSetBinding.Waves = nil
+	if len(waveBindings) > 0 {
+		resourceSetBinding.Waves = waveBindings
+	}
+	if len(errList) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errList)
+	}
+
+	if waitingForWave != "" {
+		log.Info("Waiting for ClusterResourceSet wave to be ready", "wave", waitingForWave, "reason", waitingForWaveMessage)
+		v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.WaitingForWaveV1Beta1Reason, clusterv1.ConditionSeverityInfo, "Waiting for wave %s on Cluster %s: %s", waitingForWave, cluster.Name, waitingForWaveMessage)
+		conditions.Set(clusterResourceSet, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  addonsv1.ClusterResourceSetResourcesWaitingForWaveReason,
+			Message: fmt.Sprintf("Waiting for wave %s on Cluster %s: %s", waitingForWave, cluster.Name, waitingForWaveMessage),
 		})
+		// Changes in the workload cluster do not trigger a reconcile, so poll until the wave is ready.
+		return ctrl.Result{RequeueAfter: waveHealthCheckRequeuePeriod}, nil
+	}
 
-		// Apply all values in the key-value pair of the resource to the cluster.
-		// As there can be multiple key-value pairs in a resource, each value may have multiple objects in it.
-		isSuccessful := true
-		if err := resourceScope.apply(ctx, remoteClient); err != nil {
-			isSuccessful = false
-			log.Error(err, "Failed to apply ClusterResourceSet resource", resource.Kind, klog.KRef(clusterResourceSet.Namespace, resource.Name))
-			v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.ApplyFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+	if isApplyAndPrune {
+		if err := pruneObjects(ctx, remoteClient, clusterResourceSet, resourceSetBinding, desiredObjects); err != nil {
+			log.Error(err, "Failed to prune objects removed from ClusterResourceSet resources")
+			v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.PruneFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 			conditions.Set(clusterResourceSet, metav1.Condition{
 				Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
 				Status:  metav1.ConditionFalse,
-				Reason:  addonsv1.ClusterResourceSetResourcesNotAppliedReason,
-				Message: "Failed to apply ClusterResourceSet resources to Cluster",
+				Reason:  addonsv1.ClusterResourceSetResourcesNotPrunedReason,
+				Message: "Failed to delete objects removed from ClusterResourceSet resources from Cluster",
 			})
-			errList = append(errList, err)
+			return ctrl.Result{}, err
 		}
-
-		resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
-			ResourceRef:     resource,
-			Hash:            resourceScope.hash(),
-			Applied:         ptr.To(isSuccessful),
-			LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
-		})
-	}
-	if len(errList) > 0 {
-		return kerrors.NewAggregate(errList)
 	}
 
 	v1beta1conditions.MarkTrue(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition)
@@ -453,43 +593,39 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 		Reason: addonsv1.ClusterResourceSetResourcesAppliedReason,
 	})
 
-	return nil
+	return ctrl.Result{}, nil
 }
 
-// getResource retrieves the requested resource and convert it to unstructured type.
-// Unsupported resource kinds are not denied by validation webhook, hence no need to check here.
-// Only supports Secrets/Configmaps as resource types and allow using resources in the same namespace with the cluster.
-func (r *Reconciler) getResource(ctx context.Context, resourceRef addonsv1.ResourceRef, namespace string) (*unstructured.Unstructured, error) {
-	resourceName := types.NamespacedName{Name: resourceRef.Name, Namespace: namespace}
-
-	var resourceInterface interface{}
-	switch resourceRef.Kind {
-	case string(addonsv1.ConfigMapClusterResourceSetResourceKind):
-		resourceConfigMap, err := getConfigMap(ctx, r.Client, resourceName)
-		if err != nil {
-			return nil, err
-		}
-
-		resourceInterface = resourceConfigMap.DeepCopyObject()
-	case string(addonsv1.SecretClusterResourceSetResourceKind):
-		resourceSecret, err := getSecret(ctx, r.Client, resourceName)
-		if err != nil {
-			return nil, err
-		}
-
-		if resourceSecret.Type != addonsv1.ClusterResourceSetSecretType {
-			return nil, ErrSecretTypeNotSupported
-		}
-		resourceInterface = resourceSecret.DeepCopyObject()
+// checkWaveHealth evaluates the health checks of a wave in the cluster using a live client, so the state of
+// the objects is read without starting informers for arbitrary kinds.
+func (r *Reconciler) checkWaveHealth(ctx context.Context, cluster *clusterv1.Cluster, healthChecks []addonsv1.ClusterResourceSetHealthCheck) (string, error) {
+	if len(healthChecks) == 0 {
+		return "", nil
 	}
-
-	raw := &unstructured.Unstructured{}
-	err := r.Client.Scheme().Convert(resourceInterface, raw, nil)
+	remoteClient, err := r.ClusterCache.GetUncachedClient(ctx, util.ObjectKey(cluster))
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	return checkWaveHealth(ctx, remoteClient, healthChecks)
+}
+
+// getResource retrieves the requested resource and resolves it using the ResourceResolver for its kind.
+// Unsupported resource kinds are not denied by validation webhook, hence no need to check here.
+// Only allows using resources in the same namespace with the cluster.
+func (r *Reconciler) getResource(ctx context.Context, resourceRef addonsv1.ResourceRef, namespace string) (*ResolvedResource, error) {
+	resolver, ok := r.resourceResolvers()[resourceRef.Kind]
+	if !ok {
+		return nil, pkgerrors.Errorf("unsupported resource kind %q", resourceRef.Kind)
 	}
+	return resolver.Resolve(ctx, r.Client, resourceRef, namespace)
+}
 
-	return raw, nil
+// resourceResolvers returns the ResourceResolvers used by the Reconciler.
+func (r *Reconciler) resourceResolvers() map[string]ResourceResolver {
+	if r.ResourceResolvers == nil {
+		return DefaultResourceResolvers()
+	}
+	return r.ResourceResolvers
 }
 
 // ensureResourceOwnerRef adds the ClusterResourceSet as a OwnerReference to the resource.
@@ -548,7 +684,7 @@ func (r *Reconciler) clusterToClusterResourceSet(ctx context.Context, o client.O
 }
 
 // resourceToClusterResourceSetFunc returns a typed mapper function that maps resources to ClusterResourceSet.
-func resourceToClusterResourceSetFunc[T client.Object](ctrlClient client.Client) handler.TypedMapFunc[T, ctrl.Request] {
+func resourceToClusterResourceSetFunc[T client.Object](ctrlClient client.Client, resolvers map[string]ResourceResolver) handler.TypedMapFunc[T, ctrl.Request] {
 	return func(ctx context.Context, o T) []ctrl.Request {
 		result := []ctrl.Request{}
 
@@ -581,7 +717,11 @@ func resourceToClusterResourceSetFunc[T client.Object](ctrlClient client.Client)
 		}
 		for _, crs := range crsList.Items {
 			for _, resource := range crs.Spec.Resources {
-				if resource.Kind == objKind.Kind && resource.Name == o.GetName() {
+				resolver, ok := resolvers[resource.Kind]
+				if !ok {
+					continue
+				}
+				if resolver.SourceKind() == objKind.Kind && resource.Name == o.GetName() {
 					name := client.ObjectKey{Namespace: o.GetNamespace(), Name: crs.Name}
 					result = append(result, ctrl.Request{NamespacedName: name})
 					break
@@ -749,6 +749,79 @@ metadata:
 		g.Eventually(configMapHasBeenUpdated(env, resourceConfigMap2Key, resourceConfigMap2), timeout).Should(Succeed())
 	})
 
+	t.Run("Should prune objects of resources removed from a ClusterResourceSet with ApplyAndPrune strategy", func(t *testing.T) {
+		g := NewWithT(t)
+		ns := setup(t, g)
+		defer teardown(t, g, ns)
+
+		t.Log("Updating the cluster with labels")
+		testCluster.SetLabels(labels)
+		g.Expect(env.Update(ctx, testCluster)).To(Succeed())
+
+		t.Log("Creating a ClusterResourceSet instance that has same labels as selector")
+		clusterResourceSet := &addonsv1.ClusterResourceSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      clusterResourceSetName,
+				Namespace: ns.Name,
+			},
+			Spec: addonsv1.ClusterResourceSetSpec{
+				Strategy: string(addonsv1.ClusterResourceSetStrategyApplyAndPrune),
+				ClusterSelector: metav1.LabelSelector{
+					MatchLabels: labels,
+				},
+				Resources: []addonsv1.ResourceRef{{Name: configmapName, Kind: "ConfigMap"}, {Name: secretName, Kind: "Secret"}},
+			},
+		}
+
+		g.Expect(env.Create(ctx, clusterResourceSet)).To(Succeed())
+
+		t.Log("Verifying ClusterResourceSetBinding is created with the inventory of applied objects")
+		clusterResourceSetBindingKey := client.ObjectKey{
+			Namespace: testCluster.Namespace,
+			Name:      testCluster.Name,
+		}
+		g.Eventually(clusterResourceSetBindingReady(env, testCluster), timeout).Should(BeTrue())
+		g.Eventually(func(g Gomega) {
+			binding := &addonsv1.ClusterResourceSetBinding{}
+			g.Expect(env.Get(ctx, clusterResourceSetBindingKey, binding)).To(Succeed())
+			g.Expect(binding.Spec.Bindings).To(HaveLen(1))
+			g.Expect(binding.Spec.Bindings[0].Resources).To(HaveLen(2))
+			for _, r := range binding.Spec.Bindings[0].Resources {
+				g.Expect(r.Objects).To(HaveLen(1))
+			}
+		}, timeout).Should(Succeed())
+
+		resourceConfigMap1Key := client.ObjectKey{
+			Namespace: resourceConfigMapsNamespace,
+			Name:      resourceConfigMap1Name,
+		}
+		resourceConfigMap2Key := client.ObjectKey{
+			Namespace: resourceConfigMapsNamespace,
+			Name:      resourceConfigMap2Name,
+		}
+		g.Expect(env.Get(ctx, resourceConfigMap1Key, &corev1.ConfigMap{})).To(Succeed())
+		g.Expect(env.Get(ctx, resourceConfigMap2Key, &corev1.ConfigMap{})).To(Succeed())
+
+		t.Log("Removing the Secret from the ClusterResourceSet resources")
+		patch := client.MergeFrom(clusterResourceSet.DeepCopy())
+		clusterResourceSet.Spec.Resources = []addonsv1.ResourceRef{{Name: configmapName, Kind: "ConfigMap"}}
+		g.Expect(env.Patch(ctx, clusterResourceSet, patch)).To(Succeed())
+
+		t.Log("Verifying resource ConfigMap 2 has been deleted")
+		g.Eventually(func() bool {
+			return apierrors.IsNotFound(env.Get(ctx, resourceConfigMap2Key, &corev1.ConfigMap{}))
+		}, timeout).Should(BeTrue())
+		g.Expect(env.Get(ctx, resourceConfigMap1Key, &corev1.ConfigMap{})).To(Succeed())
+
+		t.Log("Verifying the Secret has been dropped from the ClusterResourceSetBinding")
+		g.Eventually(func(g Gomega) {
+			binding := &addonsv1.ClusterResourceSetBinding{}
+			g.Expect(env.Get(ctx, clusterResourceSetBindingKey, binding)).To(Succeed())
+			g.Expect(binding.Spec.Bindings[0].Resources).To(HaveLen(1))
+			g.Expect(binding.Spec.Bindings[0].Resources[0].Name).To(Equal(configmapName))
+		}, timeout).Should(Succeed())
+	})
+
 	t.Run("Should reconcile a ClusterResourceSet with ApplyOnce strategy even when one of the resources already exist", func(t *testing.T) {
 		g := NewWithT(t)
 		ns := setup(t, g)
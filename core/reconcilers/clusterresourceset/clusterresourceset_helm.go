@@ -0,0 +1,380 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/Masterminds/sprig/v3"
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+// helmChart is a packaged Helm chart.
+type helmChart struct {
+	// metadata is the content of Chart.yaml.
+	metadata map[string]interface{}
+	// values are the default values of the chart.
+	values map[string]interface{}
+	// templates are the templates of the chart, keyed by path.
+	templates map[string]string
+}
+
+// renderHelmChart renders the templates of a packaged Helm chart and returns the rendered manifests ordered by template path.
+// Values are merged into the default values of the chart.
+// NOTE: This supports the subset of the Helm template engine required to render self-contained charts; charts using
+// sub-charts, hooks, the lookup function, .Capabilities, .Files or functions not supported by this renderer are rejected,
+// because they would render differently than with Helm.
+func renderHelmChart(archive, values []byte, releaseName, releaseNamespace string) ([][]byte, error) {
+	chart, err := loadHelmChart(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := map[string]interface{}{}
+	if err := yaml.Unmarshal(values, &overrides); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to parse values")
+	}
+	mergeValues(chart.values, overrides)
+
+	chartName, _ := chart.metadata["name"].(string)
+	renderValues := map[string]interface{}{
+		"Values": chart.values,
+		"Release": map[string]interface{}{
+			"Name":      releaseName,
+			"Namespace": releaseNamespace,
+			"Service":   "Helm",
+			"IsInstall": true,
+			"IsUpgrade": false,
+			"Revision":  1,
+		},
+		"Chart": map[string]interface{}{
+			"Name":        chartName,
+			"Version":     chart.metadata["version"],
+			"AppVersion":  chart.metadata["appVersion"],
+			"Description": chart.metadata["description"],
+		},
+	}
+
+	// Parse all the templates into a single template set, so templates defined in helpers can be included.
+	t := template.New(chartName).Option("missingkey=zero")
+	t.Funcs(helmFuncMap(t))
+	paths := make([]string, 0, len(chart.templates))
+	for p := range chart.templates {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		if _, err := t.New(p).Parse(chart.templates[p]); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to parse template %s", p)
+		}
+	}
+	for _, p := range paths {
+		if err := validateHelmTemplate(t.Lookup(p).Tree); err != nil {
+			return nil, pkgerrors.Wrapf(err, "template %s is not supported", p)
+		}
+	}
+
+	rendered := [][]byte{}
+	for _, p := range paths {
+		// Templates starting with _ only contain definitions, and only manifests are applied (e.g. NOTES.txt is skipped).
+		if strings.HasPrefix(path.Base(p), "_") || !isManifestFile(p) {
+			continue
+		}
+
+		renderValues["Template"] = map[string]interface{}{
+			"Name":     path.Join(chartName, p),
+			"BasePath": path.Join(chartName, "templates"),
+		}
+		var buf bytes.Buffer
+		if err := t.ExecuteTemplate(&buf, p, renderValues); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to render template %s", p)
+		}
+		// Drop the placeholder written for missing values, as Helm does.
+		out := strings.ReplaceAll(buf.String(), "<no value>", "")
+		if strings.TrimSpace(out) == "" {
+			continue
+		}
+		if err := validateHelmManifest([]byte(out)); err != nil {
+			return nil, pkgerrors.Wrapf(err, "template %s is not supported", p)
+		}
+		rendered = append(rendered, []byte(out))
+	}
+	return rendered, nil
+}
+
+// helmHookAnnotation is the annotation which marks a Helm hook.
+const helmHookAnnotation = "helm.sh/hook"
+
+// unsupportedHelmFields are the built-in objects of Helm templates which are not supported.
+var unsupportedHelmFields = sets.New("Capabilities", "Files", "Subcharts")
+
+// unsupportedHelmFunctions are the functions of Helm templates which are not supported; they are added to the function
+// map so templates using them can be parsed and then rejected with a meaningful error.
+var unsupportedHelmFunctions = sets.New("lookup", "toToml", "fromYamlArray", "fromJsonArray", "mustToYaml", "mustToJson", "mustFromYaml", "mustFromJson")
+
+// validateHelmTemplate returns an error if a template uses a built-in object or a function which is not supported.
+// NOTE: References to built-in objects are detected regardless of the value of dot, so a value named e.g. Files
+// accessed inside a with or range block is rejected too.
+func validateHelmTemplate(tree *parse.Tree) error {
+	if tree == nil {
+		return nil
+	}
+	var err error
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		if err != nil || node == nil {
+			return
+		}
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd)
+			}
+		case *parse.CommandNode:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		case *parse.ChainNode:
+			walk(n.Node)
+		case *parse.FieldNode:
+			if unsupportedHelmFields.Has(n.Ident[0]) {
+				err = pkgerrors.Errorf("the built-in object .%s is not supported", n.Ident[0])
+			}
+		case *parse.VariableNode:
+			if len(n.Ident) > 1 && n.Ident[0] == "$" && unsupportedHelmFields.Has(n.Ident[1]) {
+				err = pkgerrors.Errorf("the built-in object $.%s is not supported", n.Ident[1])
+			}
+		case *parse.IdentifierNode:
+			if unsupportedHelmFunctions.Has(n.Ident) {
+				err = pkgerrors.Errorf("the function %s is not supported", n.Ident)
+			}
+		}
+	}
+	walk(tree.Root)
+	return err
+}
+
+// validateHelmManifest returns an error if a rendered manifest contains Helm hooks, which are not supported.
+func validateHelmManifest(data []byte) error {
+	objs, err := utilyaml.ToUnstructured(data)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to parse rendered manifest")
+	}
+	for _, obj := range objs {
+		if _, ok := obj.GetAnnotations()[helmHookAnnotation]; ok {
+			return pkgerrors.Errorf("%s %s is a Helm hook, hooks are not supported", obj.GetKind(), obj.GetName())
+		}
+	}
+	return nil
+}
+
+// loadHelmChart loads a Helm chart from a gzip-compressed tar archive.
+func loadHelmChart(archive []byte) (*helmChart, error) {
+	files, err := extractArchive(archive)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to extract chart")
+	}
+
+	chart := &helmChart{
+		values:    map[string]interface{}{},
+		templates: map[string]string{},
+	}
+	for p, content := range files {
+		// Files in a packaged chart are nested in a directory named after the chart.
+		_, p, ok := strings.Cut(p, "/")
+		if !ok {
+			continue
+		}
+
+		switch {
+		case p == "Chart.yaml":
+			if err := yaml.Unmarshal(content, &chart.metadata); err != nil {
+				return nil, pkgerrors.Wrap(err, "failed to parse Chart.yaml")
+			}
+		case p == "values.yaml":
+			if err := yaml.Unmarshal(content, &chart.values); err != nil {
+				return nil, pkgerrors.Wrap(err, "failed to parse values.yaml")
+			}
+		case strings.HasPrefix(p, "templates/"):
+			chart.templates[p] = string(content)
+		case strings.HasPrefix(p, "charts/"):
+			return nil, pkgerrors.New("charts with sub-charts are not supported")
+		}
+	}
+
+	if chart.metadata == nil {
+		return nil, pkgerrors.New("failed to find Chart.yaml")
+	}
+	if dependencies, ok := chart.metadata["dependencies"].([]interface{}); ok && len(dependencies) > 0 {
+		return nil, pkgerrors.New("charts with dependencies are not supported")
+	}
+	if chart.values == nil {
+		chart.values = map[string]interface{}{}
+	}
+	return chart, nil
+}
+
+// mergeValues deep merges src into dst; values in src take precedence.
+func mergeValues(dst, src map[string]interface{}) {
+	for key, srcValue := range src {
+		srcMap, srcIsMap := srcValue.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeValues(dstMap, srcMap)
+			continue
+		}
+		dst[key] = srcValue
+	}
+}
+
+// helmIncludeMaxDepth is the maximum number of nested calls of a template via include or tpl, like in Helm.
+// NOTE: text/template limits the depth of nested template calls only within a single execution, while include and
+// tpl start a new execution, so without this limit a self-including template would overflow the stack.
+const helmIncludeMaxDepth = 1000
+
+// helmFuncMap returns the functions available in Helm templates.
+// NOTE: The returned function map must be used for a single rendering, because it tracks the depth of nested
+// include and tpl calls.
+func helmFuncMap(t *template.Template) template.FuncMap {
+	funcMap := sprig.HermeticTxtFuncMap()
+
+	// includedNames counts the nested calls of each template via include or tpl.
+	includedNames := map[string]int{}
+	enter := func(name string) error {
+		if includedNames[name] >= helmIncludeMaxDepth {
+			return pkgerrors.Errorf("rendering template %s has a nested reference name depth of more than %d", name, helmIncludeMaxDepth)
+		}
+		includedNames[name]++
+		return nil
+	}
+	exit := func(name string) {
+		includedNames[name]--
+	}
+
+	for name := range unsupportedHelmFunctions {
+		funcMap[name] = func(...interface{}) (interface{}, error) {
+			return nil, pkgerrors.Errorf("the function %s is not supported", name)
+		}
+	}
+
+	funcMap["toYaml"] = func(v interface{}) string {
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSuffix(string(data), "\n")
+	}
+	funcMap["fromYaml"] = func(s string) map[string]interface{} {
+		m := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(s), &m); err != nil {
+			m["Error"] = err.Error()
+		}
+		return m
+	}
+	funcMap["toJson"] = func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+	funcMap["fromJson"] = func(s string) map[string]interface{} {
+		m := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			m["Error"] = err.Error()
+		}
+		return m
+	}
+	funcMap["required"] = func(msg string, v interface{}) (interface{}, error) {
+		if v == nil {
+			return nil, pkgerrors.New(msg)
+		}
+		if s, ok := v.(string); ok && s == "" {
+			return nil, pkgerrors.New(msg)
+		}
+		return v, nil
+	}
+	funcMap["include"] = func(name string, data interface{}) (string, error) {
+		if err := enter(name); err != nil {
+			return "", err
+		}
+		defer exit(name)
+
+		var buf bytes.Buffer
+		if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+	funcMap["tpl"] = func(text string, data interface{}) (string, error) {
+		if err := enter("tpl"); err != nil {
+			return "", err
+		}
+		defer exit("tpl")
+
+		clone, err := t.Clone()
+		if err != nil {
+			return "", err
+		}
+		tpl, err := clone.New("tpl").Parse(text)
+		if err != nil {
+			return "", err
+		}
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, data); err != nil {
+			return "", err
+		}
+		return strings.ReplaceAll(buf.String(), "<no value>", ""), nil
+	}
+	return funcMap
+}
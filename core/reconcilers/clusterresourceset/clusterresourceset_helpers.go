@@ -32,8 +32,12 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -213,3 +217,80 @@ func normalizeData(resource *unstructured.Unstructured) ([][]byte, error) {
 
 	return dataList, nil
 }
+
+// resourceBindingObjectFromUnstructured returns the ResourceBindingObject identifying an object.
+func resourceBindingObjectFromUnstructured(obj *unstructured.Unstructured) addonsv1.ResourceBindingObject {
+	gvk := obj.GroupVersionKind()
+	return addonsv1.ResourceBindingObject{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+}
+
+// inventoryKey returns the key identifying an object in the inventory.
+// NOTE: The version is dropped because the same object can be served by different versions, and
+// changing the apiVersion of an object in a resource must not lead to the object being pruned.
+func inventoryKey(object addonsv1.ResourceBindingObject) addonsv1.ResourceBindingObject {
+	object.Version = ""
+	return object
+}
+
+// pruneObjects deletes objects tracked in the ResourceSetBinding which are not desired anymore,
+// and drops the objects which have been deleted from the inventory.
+// Resources which have been removed from the ClusterResourceSet are dropped from the ResourceSetBinding
+// once all their objects have been deleted.
+func pruneObjects(ctx context.Context, c client.Client, clusterResourceSet *addonsv1.ClusterResourceSet, resourceSetBinding *addonsv1.ResourceSetBinding, desired sets.Set[addonsv1.ResourceBindingObject]) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	errList := []error{}
+	deleted := sets.Set[addonsv1.ResourceBindingObject]{}
+	for _, resourceBinding := range resourceSetBinding.Resources {
+		for _, object := range resourceBinding.Objects {
+			key := inventoryKey(object)
+			if desired.Has(key) || deleted.Has(key) {
+				continue
+			}
+
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(schema.GroupVersionKind{Group: object.Group, Version: object.Version, Kind: object.Kind})
+			obj.SetNamespace(object.Namespace)
+			obj.SetName(object.Name)
+			log.Info("Deleting object removed from ClusterResourceSet resources", object.Kind, klog.KObj(obj))
+			if err := c.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				errList = append(errList, pkgerrors.Wrapf(
+					err,
+					"deleting object %s %s",
+					obj.GroupVersionKind(),
+					klog.KObj(obj),
+				))
+				continue
+			}
+			deleted.Insert(key)
+		}
+	}
+
+	resourceRefs := sets.New(clusterResourceSet.Spec.Resources...)
+	resources := make([]addonsv1.ResourceBinding, 0, len(resourceSetBinding.Resources))
+	for _, resourceBinding := range resourceSetBinding.Resources {
+		objects := []addonsv1.ResourceBindingObject{}
+		for _, object := range resourceBinding.Objects {
+			if !deleted.Has(inventoryKey(object)) {
+				objects = append(objects, object)
+			}
+		}
+		if !resourceRefs.Has(resourceBinding.ResourceRef) && len(objects) == 0 {
+			continue
+		}
+		if len(objects) == 0 {
+			objects = nil
+		}
+		resourceBinding.Objects = objects
+		resources = append(resources, resourceBinding)
+	}
+	resourceSetBinding.Resources = resources
+
+	return kerrors.NewAggregate(errList)
+}
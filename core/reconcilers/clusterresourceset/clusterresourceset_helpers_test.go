@@ -23,10 +23,13 @@ import (
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
@@ -218,3 +221,46 @@ func TestGetConfigMapFromNamespacedName(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	keptConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kept", Namespace: notDefaultNamespace}}
+	prunedConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "pruned", Namespace: notDefaultNamespace}}
+	prunedSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "pruned", Namespace: notDefaultNamespace}}
+
+	keptObject := addonsv1.ResourceBindingObject{Version: "v1", Kind: "ConfigMap", Namespace: notDefaultNamespace, Name: "kept"}
+	prunedObject := addonsv1.ResourceBindingObject{Version: "v1", Kind: "ConfigMap", Namespace: notDefaultNamespace, Name: "pruned"}
+	removedResourceObject := addonsv1.ResourceBindingObject{Version: "v1", Kind: "Secret", Namespace: notDefaultNamespace, Name: "pruned"}
+	alreadyDeletedObject := addonsv1.ResourceBindingObject{Version: "v1", Kind: "ConfigMap", Namespace: notDefaultNamespace, Name: "already-deleted"}
+
+	clusterResourceSet := &addonsv1.ClusterResourceSet{
+		Spec: addonsv1.ClusterResourceSetSpec{
+			Resources: []addonsv1.ResourceRef{{Name: "resource", Kind: "ConfigMap"}},
+		},
+	}
+	resourceSetBinding := &addonsv1.ResourceSetBinding{
+		Resources: []addonsv1.ResourceBinding{
+			{
+				ResourceRef: addonsv1.ResourceRef{Name: "resource", Kind: "ConfigMap"},
+				Applied:     ptr.To(true),
+				Objects:     []addonsv1.ResourceBindingObject{keptObject, prunedObject, alreadyDeletedObject},
+			},
+			{
+				ResourceRef: addonsv1.ResourceRef{Name: "removed-resource", Kind: "Secret"},
+				Applied:     ptr.To(true),
+				Objects:     []addonsv1.ResourceBindingObject{removedResourceObject},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithObjects(keptConfigMap, prunedConfigMap, prunedSecret).Build()
+	g.Expect(pruneObjects(ctx, c, clusterResourceSet, resourceSetBinding, sets.New(inventoryKey(keptObject)))).To(Succeed())
+
+	g.Expect(resourceSetBinding.Resources).To(HaveLen(1))
+	g.Expect(resourceSetBinding.Resources[0].Objects).To(Equal([]addonsv1.ResourceBindingObject{keptObject}))
+
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(keptConfigMap), &corev1.ConfigMap{})).To(Succeed())
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(prunedConfigMap), &corev1.ConfigMap{}))).To(BeTrue())
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(prunedSecret), &corev1.Secret{}))).To(BeTrue())
+}
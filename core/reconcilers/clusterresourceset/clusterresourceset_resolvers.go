@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+)
+
+// maxArchiveSize is the maximum size of the content extracted from an archive.
+const maxArchiveSize = 64 * 1024 * 1024
+
+// ResolvedResource is a ClusterResourceSet resource resolved into the data to be applied to a Cluster.
+type ResolvedResource struct {
+	// Source is the object the resource has been read from.
+	// The ClusterResourceSet is added as an owner of this object.
+	Source *unstructured.Unstructured
+
+	// Data is the list of yaml or json documents to be applied to a Cluster.
+	// Data must be consistent between runs, because it is used to compute the hash of the resource.
+	Data [][]byte
+}
+
+// ResourceResolver resolves ClusterResourceSet resources of a given kind.
+type ResourceResolver interface {
+	// SourceKind returns the kind of the object resources are read from, either ConfigMap or Secret.
+	// Changes to those objects trigger a reconcile of the ClusterResourceSets using them.
+	SourceKind() string
+
+	// Resolve reads the resource from the given namespace and resolves it.
+	Resolve(ctx context.Context, c client.Client, resourceRef addonsv1.ResourceRef, namespace string) (*ResolvedResource, error)
+}
+
+// DefaultResourceResolvers returns the ResourceResolvers for all the resource kinds supported
+// by the ClusterResourceSet API.
+func DefaultResourceResolvers() map[string]ResourceResolver {
+	return map[string]ResourceResolver{
+		string(addonsv1.ConfigMapClusterResourceSetResourceKind):       configMapResolver{},
+		string(addonsv1.SecretClusterResourceSetResourceKind):          secretResolver{},
+		string(addonsv1.ManifestArchiveClusterResourceSetResourceKind): manifestArchiveResolver{},
+		string(addonsv1.HelmChartClusterResourceSetResourceKind):       helmChartResolver{},
+	}
+}
+
+// configMapResolver resolves ConfigMaps where each value contains one or more yaml or json documents.
+type configMapResolver struct{}
+
+func (configMapResolver) SourceKind() string {
+	return "ConfigMap"
+}
+
+func (configMapResolver) Resolve(ctx context.Context, c client.Client, resourceRef addonsv1.ResourceRef, namespace string) (*ResolvedResource, error) {
+	configMap, err := getConfigMap(ctx, c, types.NamespacedName{Name: resourceRef.Name, Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := toUnstructured(c, configMap)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := normalizeData(source)
+	if err != nil {
+		return nil, err
+	}
+	return &ResolvedResource{Source: source, Data: data}, nil
+}
+
+// secretResolver resolves Secrets where each value contains one or more yaml or json documents.
+type secretResolver struct{}
+
+func (secretResolver) SourceKind() string {
+	return "Secret"
+}
+
+func (secretResolver) Resolve(ctx context.Context, c client.Client, resourceRef addonsv1.ResourceRef, namespace string) (*ResolvedResource, error) {
+	secret, err := getResourceSecret(ctx, c, types.NamespacedName{Name: resourceRef.Name, Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := toUnstructured(c, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := normalizeData(source)
+	if err != nil {
+		return nil, err
+	}
+	return &ResolvedResource{Source: source, Data: data}, nil
+}
+
+// manifestArchiveResolver resolves Secrets where each value is a gzip-compressed tar archive of yaml or json manifests.
+// Values are read ordered by key, and files in each archive are read ordered by path; files without
+// a .yaml, .yml or .json extension are ignored.
+type manifestArchiveResolver struct{}
+
+func (manifestArchiveResolver) SourceKind() string {
+	return "Secret"
+}
+
+func (manifestArchiveResolver) Resolve(ctx context.Context, c client.Client, resourceRef addonsv1.ResourceRef, namespace string) (*ResolvedResource, error) {
+	secret, err := getResourceSecret(ctx, c, types.NamespacedName{Name: resourceRef.Name, Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	data := [][]byte{}
+	for _, key := range keys {
+		files, err := extractArchive(secret.Data[key])
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to extract archive %s from Secret %s/%s", key, secret.Namespace, secret.Name)
+		}
+
+		paths := make([]string, 0, len(files))
+		for p := range files {
+			if isManifestFile(p) {
+				paths = append(paths, p)
+			}
+		}
+		sort.Strings(paths)
+		for _, p := range paths {
+			data = append(data, files[p])
+		}
+	}
+
+	source, err := toUnstructured(c, secret)
+	if err != nil {
+		return nil, err
+	}
+	return &ResolvedResource{Source: source, Data: data}, nil
+}
+
+// helmChartResolver resolves Secrets containing a packaged Helm chart, and optionally values and
+// the namespace to be used when rendering the chart.
+type helmChartResolver struct{}
+
+func (helmChartResolver) SourceKind() string {
+	return "Secret"
+}
+
+func (helmChartResolver) Resolve(ctx context.Context, c client.Client, resourceRef addonsv1.ResourceRef, namespace string) (*ResolvedResource, error) {
+	secret, err := getResourceSecret(ctx, c, types.NamespacedName{Name: resourceRef.Name, Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	chart, ok := secret.Data[addonsv1.HelmChartResourceChartKey]
+	if !ok {
+		return nil, pkgerrors.Errorf("failed to get %s from Secret %s/%s", addonsv1.HelmChartResourceChartKey, secret.Namespace, secret.Name)
+	}
+	releaseNamespace := metav1.NamespaceDefault
+	if ns := string(secret.Data[addonsv1.HelmChartResourceNamespaceKey]); ns != "" {
+		releaseNamespace = ns
+	}
+
+	data, err := renderHelmChart(chart, secret.Data[addonsv1.HelmChartResourceValuesKey], secret.Name, releaseNamespace)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to render Helm chart from Secret %s/%s", secret.Namespace, secret.Name)
+	}
+
+	source, err := toUnstructured(c, secret)
+	if err != nil {
+		return nil, err
+	}
+	return &ResolvedResource{Source: source, Data: data}, nil
+}
+
+// getResourceSecret retrieves a Secret and checks it has the type supported by ClusterResourceSets.
+func getResourceSecret(ctx context.Context, c client.Client, secretName types.NamespacedName) (*corev1.Secret, error) {
+	secret, err := getSecret(ctx, c, secretName)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret.Type != addonsv1.ClusterResourceSetSecretType {
+		return nil, ErrSecretTypeNotSupported
+	}
+	return secret, nil
+}
+
+// toUnstructured converts a typed object to unstructured.
+func toUnstructured(c client.Client, obj client.Object) (*unstructured.Unstructured, error) {
+	raw := &unstructured.Unstructured{}
+	if err := c.Scheme().Convert(obj, raw, nil); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// extractArchive returns the content of the regular files in a gzip-compressed tar archive, keyed by path.
+func extractArchive(archive []byte) (map[string][]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	files := map[string][]byte{}
+	size := int64(0)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// Limit the size of the extracted content to protect from decompression bombs.
+		size += header.Size
+		if size > maxArchiveSize {
+			return nil, pkgerrors.Errorf("archive content exceeds the maximum size of %d bytes", maxArchiveSize)
+		}
+		content, err := io.ReadAll(io.LimitReader(tarReader, header.Size))
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(strings.TrimPrefix(header.Name, "./"))] = content
+	}
+	return files, nil
+}
+
+// isManifestFile returns true if the file has a yaml or json extension.
+func isManifestFile(p string) bool {
+	switch strings.ToLower(path.Ext(p)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+)
+
+func TestManifestArchiveResolver(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+	archive := newArchive(g, map[string]string{
+		"manifests/b.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n  namespace: default\n",
+		"manifests/a.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n  namespace: default\n",
+		"README.md":        "not a manifest",
+	})
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "archive",
+			Namespace: notDefaultNamespace,
+		},
+		Type: addonsv1.ClusterResourceSetSecretType,
+		Data: map[string][]byte{
+			"manifests.tar.gz": archive,
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	resolved, err := manifestArchiveResolver{}.Resolve(ctx, c, addonsv1.ResourceRef{Name: "archive", Kind: "ManifestArchive"}, notDefaultNamespace)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resolved.Source.GetName()).To(Equal("archive"))
+	g.Expect(resolved.Data).To(HaveLen(2))
+
+	objs, err := objsFromYamlData(resolved.Data)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objs).To(HaveLen(2))
+	g.Expect(objs[0].GetName()).To(Equal("a"))
+	g.Expect(objs[1].GetName()).To(Equal("b"))
+
+	t.Log("Secrets with the wrong type are rejected")
+	secret.Type = corev1.SecretTypeOpaque
+	g.Expect(c.Update(ctx, secret)).To(Succeed())
+	_, err = manifestArchiveResolver{}.Resolve(ctx, c, addonsv1.ResourceRef{Name: "archive", Kind: "ManifestArchive"}, notDefaultNamespace)
+	g.Expect(err).To(MatchError(ErrSecretTypeNotSupported))
+}
+
+func TestRenderHelmChart(t *testing.T) {
+	chart := map[string]string{
+		"my-chart/Chart.yaml":  "apiVersion: v2\nname: my-chart\nversion: 1.0.0\nappVersion: 2.0.0\n",
+		"my-chart/values.yaml": "replicas: 1\nimage:\n  repository: registry.k8s.io/my-image\n  tag: v1\n",
+		"my-chart/templates/_helpers.tpl": `{{- define "my-chart.labels" -}}
+app.kubernetes.io/name: {{ .Chart.Name }}
+app.kubernetes.io/version: {{ .Chart.AppVersion | quote }}
+{{- end -}}`,
+		"my-chart/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+  labels:
+    {{- include "my-chart.labels" . | nindent 4 }}
+data:
+  image: {{ .Values.image.repository }}:{{ .Values.image.tag }}
+  replicas: {{ .Values.replicas | quote }}
+`,
+		"my-chart/templates/disabled.yaml": `{{- if .Values.disabled }}
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: disabled
+{{- end }}
+`,
+		"my-chart/templates/NOTES.txt": "Thanks for installing {{ .Chart.Name }}",
+	}
+
+	tests := []struct {
+		name    string
+		chart   map[string]string
+		values  string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:  "render chart with default values",
+			chart: chart,
+			want: []string{`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-release
+  namespace: my-ns
+  labels:
+    app.kubernetes.io/name: my-chart
+    app.kubernetes.io/version: "2.0.0"
+data:
+  image: registry.k8s.io/my-image:v1
+  replicas: "1"
+`},
+		},
+		{
+			name:   "render chart with values",
+			chart:  chart,
+			values: "image:\n  tag: v2\n",
+			want: []string{`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-release
+  namespace: my-ns
+  labels:
+    app.kubernetes.io/name: my-chart
+    app.kubernetes.io/version: "2.0.0"
+data:
+  image: registry.k8s.io/my-image:v2
+  replicas: "1"
+`},
+		},
+		{
+			name: "fail for charts with sub-charts",
+			chart: map[string]string{
+				"my-chart/Chart.yaml":                  "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+				"my-chart/charts/sub-chart/Chart.yaml": "apiVersion: v2\nname: sub-chart\nversion: 1.0.0\n",
+			},
+			wantErr: "charts with sub-charts are not supported",
+		},
+		{
+			name: "fail for charts using the lookup function",
+			chart: map[string]string{
+				"my-chart/Chart.yaml":               "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+				"my-chart/templates/configmap.yaml": `{{- if lookup "v1" "ConfigMap" "my-ns" "foo" }}{{ end }}`,
+			},
+			wantErr: "the function lookup is not supported",
+		},
+		{
+			name: "fail for charts using .Capabilities",
+			chart: map[string]string{
+				"my-chart/Chart.yaml":               "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+				"my-chart/templates/configmap.yaml": `{{- if semverCompare ">=1.30" .Capabilities.KubeVersion.Version }}{{ end }}`,
+			},
+			wantErr: "the built-in object .Capabilities is not supported",
+		},
+		{
+			name: "fail for charts using .Files with the root variable",
+			chart: map[string]string{
+				"my-chart/Chart.yaml":               "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+				"my-chart/templates/configmap.yaml": `{{ range .Values.items }}{{ $.Files.Get "foo" }}{{ end }}`,
+			},
+			wantErr: "the built-in object $.Files is not supported",
+		},
+		{
+			name: "fail for charts using functions not supported",
+			chart: map[string]string{
+				"my-chart/Chart.yaml":               "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+				"my-chart/templates/configmap.yaml": `{{ .Values | doesNotExist }}`,
+			},
+			wantErr: "function \"doesNotExist\" not defined",
+		},
+		{
+			name: "fail for charts with hooks",
+			chart: map[string]string{
+				"my-chart/Chart.yaml": "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+				"my-chart/templates/job.yaml": `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install
+`,
+			},
+			wantErr: "Job migrate is a Helm hook, hooks are not supported",
+		},
+		{
+			name: "fail for charts with a self-including template",
+			chart: map[string]string{
+				"my-chart/Chart.yaml":             "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+				"my-chart/templates/_helpers.tpl": `{{- define "my-chart.recurse" -}}{{ include "my-chart.recurse" . }}{{- end -}}`,
+				"my-chart/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ include "my-chart.recurse" . }}
+`,
+			},
+			wantErr: "rendering template my-chart.recurse has a nested reference name depth of more than 1000",
+		},
+		{
+			name: "fail for charts with a self-referencing tpl value",
+			chart: map[string]string{
+				"my-chart/Chart.yaml":  "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+				"my-chart/values.yaml": "name: \"{{ tpl .Values.name . }}\"\n",
+				"my-chart/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ tpl .Values.name . }}
+`,
+			},
+			wantErr: "rendering template tpl has a nested reference name depth of more than 1000",
+		},
+		{
+			name: "fail for charts without Chart.yaml",
+			chart: map[string]string{
+				"my-chart/values.yaml": "replicas: 1\n",
+			},
+			wantErr: "failed to find Chart.yaml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := renderHelmChart(newArchive(g, tt.chart), []byte(tt.values), "my-release", "my-ns")
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gotStrings := []string{}
+			for _, data := range got {
+				gotStrings = append(gotStrings, string(data))
+			}
+			g.Expect(gotStrings).To(Equal(tt.want))
+		})
+	}
+}
+
+func newArchive(g *WithT, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		g.Expect(tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})).To(Succeed())
+		_, err := tarWriter.Write([]byte(content))
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	g.Expect(tarWriter.Close()).To(Succeed())
+	g.Expect(gzipWriter.Close()).To(Succeed())
+	return buf.Bytes()
+}
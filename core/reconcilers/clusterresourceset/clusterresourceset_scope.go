@@ -23,6 +23,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,22 +39,22 @@ type resourceReconcileScope interface {
 	needsApply() bool
 	// apply reconciles all objects defined by the resource following the proper strategy for the CRS.
 	apply(ctx context.Context, c client.Client) error
+	// objs returns the objects defined in the resource.
+	objs() []unstructured.Unstructured
 	// hash returns a computed hash of the defined objects in the resource. It is consistent
 	// between runs.
 	hash() string
+	// inventory returns the objects to be tracked in the ResourceBinding after apply.
+	// It is nil for strategies which do not track objects.
+	inventory() []addonsv1.ResourceBindingObject
 }
 
 func reconcileScopeForResource(
 	crs *addonsv1.ClusterResourceSet,
 	resourceRef addonsv1.ResourceRef,
 	resourceSetBinding *addonsv1.ResourceSetBinding,
-	resource *unstructured.Unstructured,
+	normalizedData [][]byte,
 ) (resourceReconcileScope, error) {
-	normalizedData, err := normalizeData(resource)
-	if err != nil {
-		return nil, err
-	}
-
 	objs, err := objsFromYamlData(normalizedData)
 	if err != nil {
 		return nil, err
@@ -82,6 +83,8 @@ func newResourceReconcileScope(
 		return &reconcileApplyOnceScope{base}, nil
 	case addonsv1.ClusterResourceSetStrategyReconcile:
 		return &reconcileStrategyScope{base}, nil
+	case addonsv1.ClusterResourceSetStrategyApplyAndPrune:
+		return &reconcileApplyAndPruneScope{base}, nil
 	default:
 		return nil, pkgerrors.Errorf("unsupported or empty resource strategy: %q", clusterResourceSet.Spec.Strategy)
 	}
@@ -103,6 +106,10 @@ func (b baseResourceReconcileScope) hash() string {
 	return b.computedHash
 }
 
+func (b baseResourceReconcileScope) inventory() []addonsv1.ResourceBindingObject {
+	return nil
+}
+
 type reconcileStrategyScope struct {
 	baseResourceReconcileScope
 }
@@ -170,6 +177,65 @@ func (r *reconcileApplyOnceScope) applyObj(ctx context.Context, c client.Client,
 	return nil
 }
 
+type reconcileApplyAndPruneScope struct {
+	baseResourceReconcileScope
+}
+
+// needsApply always returns true, so objects are re-applied on every reconcile and changes
+// made out of band to the fields owned by the ClusterResourceSet are reverted.
+// NOTE: Server-side apply is a no-op on the API server if nothing changed.
+func (r *reconcileApplyAndPruneScope) needsApply() bool {
+	return true
+}
+
+func (r *reconcileApplyAndPruneScope) apply(ctx context.Context, c client.Client) error {
+	return apply(ctx, c, r.applyObj, r.objs())
+}
+
+func (r *reconcileApplyAndPruneScope) applyObj(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+	// Drop fields which are not part of an apply intent.
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+
+	if err := c.Apply(ctx, client.ApplyConfigurationFromUnstructured(obj), client.FieldOwner(clusterResourceSetManagerName), client.ForceOwnership); err != nil {
+		return pkgerrors.Wrapf(
+			err,
+			"applying object %s %s",
+			obj.GroupVersionKind(),
+			klog.KObj(obj),
+		)
+	}
+
+	return nil
+}
+
+// inventory returns the objects defined in the resource merged with the objects tracked
+// by the previous apply; objects which are not defined in the resource anymore are
+// dropped from the inventory only once they have been pruned from the cluster.
+func (r *reconcileApplyAndPruneScope) inventory() []addonsv1.ResourceBindingObject {
+	objects := []addonsv1.ResourceBindingObject{}
+	seen := sets.Set[addonsv1.ResourceBindingObject]{}
+	for i := range r.normalizedObjs {
+		object := resourceBindingObjectFromUnstructured(&r.normalizedObjs[i])
+		if seen.Has(inventoryKey(object)) {
+			continue
+		}
+		seen.Insert(inventoryKey(object))
+		objects = append(objects, object)
+	}
+
+	if resourceBinding := r.resourceSetBinding.GetResource(r.resourceRef); resourceBinding != nil {
+		for _, object := range resourceBinding.Objects {
+			if seen.Has(inventoryKey(object)) {
+				continue
+			}
+			seen.Insert(inventoryKey(object))
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
 type applyObj func(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error
 
 // apply reconciles unstructured objects using applyObj and aggregates the error if present.
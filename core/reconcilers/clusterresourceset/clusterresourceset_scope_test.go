@@ -250,3 +250,94 @@ func TestReconcileApplyOnceScopeApplyObj(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileApplyAndPruneScopeInventory(t *testing.T) {
+	resourceRef := addonsv1.ResourceRef{
+		Name: "cp",
+		Kind: "ConfigMap",
+	}
+	newObj := func(apiVersion, kind, namespace, name string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+
+	tests := []struct {
+		name               string
+		resourceSetBinding *addonsv1.ResourceSetBinding
+		objs               []unstructured.Unstructured
+		want               []addonsv1.ResourceBindingObject
+	}{
+		{
+			name:               "no ResourceBinding",
+			resourceSetBinding: &addonsv1.ResourceSetBinding{},
+			objs: []unstructured.Unstructured{
+				newObj("v1", "ConfigMap", "that-ns", "my-cm"),
+				newObj("rbac.authorization.k8s.io/v1", "ClusterRole", "", "my-role"),
+			},
+			want: []addonsv1.ResourceBindingObject{
+				{Version: "v1", Kind: "ConfigMap", Namespace: "that-ns", Name: "my-cm"},
+				{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole", Name: "my-role"},
+			},
+		},
+		{
+			name: "ResourceBinding with objects not defined in the resource anymore",
+			resourceSetBinding: &addonsv1.ResourceSetBinding{
+				Resources: []addonsv1.ResourceBinding{
+					{
+						ResourceRef: resourceRef,
+						Applied:     ptr.To(true),
+						Objects: []addonsv1.ResourceBindingObject{
+							{Version: "v1", Kind: "ConfigMap", Namespace: "that-ns", Name: "my-cm"},
+							{Version: "v1", Kind: "ConfigMap", Namespace: "that-ns", Name: "removed-cm"},
+						},
+					},
+				},
+			},
+			objs: []unstructured.Unstructured{
+				newObj("v1", "ConfigMap", "that-ns", "my-cm"),
+			},
+			want: []addonsv1.ResourceBindingObject{
+				{Version: "v1", Kind: "ConfigMap", Namespace: "that-ns", Name: "my-cm"},
+				{Version: "v1", Kind: "ConfigMap", Namespace: "that-ns", Name: "removed-cm"},
+			},
+		},
+		{
+			name: "ResourceBinding with objects with a different version",
+			resourceSetBinding: &addonsv1.ResourceSetBinding{
+				Resources: []addonsv1.ResourceBinding{
+					{
+						ResourceRef: resourceRef,
+						Applied:     ptr.To(true),
+						Objects: []addonsv1.ResourceBindingObject{
+							{Group: "example.com", Version: "v1alpha1", Kind: "Foo", Namespace: "that-ns", Name: "my-foo"},
+						},
+					},
+				},
+			},
+			objs: []unstructured.Unstructured{
+				newObj("example.com/v1", "Foo", "that-ns", "my-foo"),
+			},
+			want: []addonsv1.ResourceBindingObject{
+				{Group: "example.com", Version: "v1", Kind: "Foo", Namespace: "that-ns", Name: "my-foo"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := NewWithT(t)
+			scope := &reconcileApplyAndPruneScope{
+				baseResourceReconcileScope: baseResourceReconcileScope{
+					resourceSetBinding: tt.resourceSetBinding,
+					resourceRef:        resourceRef,
+					normalizedObjs:     tt.objs,
+				},
+			}
+			gs.Expect(scope.needsApply()).To(BeTrue())
+			gs.Expect(scope.inventory()).To(Equal(tt.want))
+		})
+	}
+}
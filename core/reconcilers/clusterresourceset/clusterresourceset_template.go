@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	pkgerrors "github.com/pkg/errors"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// manifestTemplateData is the data available when rendering manifests with the GoTemplate rendering.
+type manifestTemplateData struct {
+	// Cluster is the Cluster the manifests are applied to, e.g. {{ .Cluster.Spec.ControlPlaneEndpoint.Host }}.
+	Cluster *clusterv1.Cluster
+
+	// Variables are the values of the topology variables of the Cluster, keyed by variable name,
+	// e.g. {{ .Variables.myVariable }}.
+	Variables map[string]interface{}
+}
+
+// renderManifests renders each document as a Go template using the Cluster and its topology variables as data.
+// Missing keys are reported as errors, so a typo in a template does not silently end up in the applied manifests.
+func renderManifests(cluster *clusterv1.Cluster, data [][]byte) ([][]byte, error) {
+	templateData, err := calculateManifestTemplateData(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := make([][]byte, 0, len(data))
+	for i, doc := range data {
+		tpl, err := template.New("manifest").Option("missingkey=error").Funcs(sprig.HermeticTxtFuncMap()).Parse(string(doc))
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to parse template of document %d", i)
+		}
+
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, templateData); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to render template of document %d", i)
+		}
+		rendered = append(rendered, buf.Bytes())
+	}
+	return rendered, nil
+}
+
+// calculateManifestTemplateData calculates the data for rendering manifests.
+// NOTE: Variable values are converted to their Go types as they cannot be directly consumed as byte arrays.
+func calculateManifestTemplateData(cluster *clusterv1.Cluster) (*manifestTemplateData, error) {
+	templateData := &manifestTemplateData{
+		Cluster:   cluster,
+		Variables: map[string]interface{}{},
+	}
+	for _, variable := range cluster.Spec.Topology.Variables {
+		// Variables set using valueFrom are resolved only when computing topology patches, so they are not exposed to templates.
+		if variable.ValueFrom.IsDefined() {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(variable.Value.Raw, &value); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to unmarshal value of variable %q", variable.Name)
+		}
+		templateData.Variables[variable.Name] = value
+	}
+	return templateData, nil
+}
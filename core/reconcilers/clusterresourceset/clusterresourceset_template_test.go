@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestRenderManifests(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				"cloud": "openstack",
+			},
+		},
+		Spec: clusterv1.ClusterSpec{
+			ClusterNetwork: clusterv1.ClusterNetwork{
+				Pods: clusterv1.NetworkRanges{
+					CIDRBlocks: []string{"192.168.0.0/16"},
+				},
+			},
+			ControlPlaneEndpoint: clusterv1.APIEndpoint{
+				Host: "10.0.0.1",
+				Port: 6443,
+			},
+			Topology: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{
+					{
+						Name:  "mtu",
+						Value: apiextensionsv1.JSON{Raw: []byte(`1450`)},
+					},
+					{
+						Name:  "proxy",
+						Value: apiextensionsv1.JSON{Raw: []byte(`{"enabled":true,"url":"http://proxy:3128"}`)},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		data    []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "render Cluster fields",
+			data: []string{
+				`cluster: {{ .Cluster.Name }}
+cloud: {{ index .Cluster.Labels "cloud" }}
+podCIDR: {{ index .Cluster.Spec.ClusterNetwork.Pods.CIDRBlocks 0 }}
+endpoint: {{ .Cluster.Spec.ControlPlaneEndpoint.Host }}:{{ .Cluster.Spec.ControlPlaneEndpoint.Port }}`,
+			},
+			want: []string{
+				`cluster: cluster1
+cloud: openstack
+podCIDR: 192.168.0.0/16
+endpoint: 10.0.0.1:6443`,
+			},
+		},
+		{
+			name: "render topology variables",
+			data: []string{
+				`mtu: {{ .Variables.mtu }}`,
+				`{{- if .Variables.proxy.enabled }}proxy: {{ .Variables.proxy.url | quote }}{{ end }}`,
+			},
+			want: []string{
+				`mtu: 1450`,
+				`proxy: "http://proxy:3128"`,
+			},
+		},
+		{
+			name:    "fail for missing variables",
+			data:    []string{`value: {{ .Variables.notDefined }}`},
+			wantErr: true,
+		},
+		{
+			name:    "fail for invalid templates",
+			data:    []string{`value: {{ .Cluster.Name `},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			data := [][]byte{}
+			for _, d := range tt.data {
+				data = append(data, []byte(d))
+			}
+
+			got, err := renderManifests(cluster, data)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gotStrings := []string{}
+			for _, d := range got {
+				gotStrings = append(gotStrings, string(d))
+			}
+			g.Expect(gotStrings).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"context"
+	"fmt"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+)
+
+// resourceWave is a group of resources applied together to a Cluster.
+type resourceWave struct {
+	// name of the wave, empty for the implicit wave of the resources which are not part of any wave.
+	name string
+
+	// healthChecks must pass after the resources of the wave have been applied, before applying the next wave.
+	healthChecks []addonsv1.ClusterResourceSetHealthCheck
+
+	// resources are the indexes of the resources of the wave in ClusterResourceSet.spec.resources.
+	resources []int
+}
+
+// resourceWaves returns the waves of a ClusterResourceSet in order, followed by the implicit wave of the resources
+// which are not part of any wave. If the ClusterResourceSet does not define waves, all resources are part of the
+// implicit wave.
+func resourceWaves(clusterResourceSet *addonsv1.ClusterResourceSet) []resourceWave {
+	waves := []resourceWave{}
+	resourcesInWaves := map[addonsv1.ResourceRef]bool{}
+	for _, wave := range clusterResourceSet.Spec.Waves {
+		w := resourceWave{
+			name:         wave.Name,
+			healthChecks: wave.HealthChecks,
+		}
+		for _, waveResource := range wave.Resources {
+			for i, resource := range clusterResourceSet.Spec.Resources {
+				if resource == waveResource && !resourcesInWaves[resource] {
+					w.resources = append(w.resources, i)
+					resourcesInWaves[resource] = true
+				}
+			}
+		}
+		waves = append(waves, w)
+	}
+
+	implicitWave := resourceWave{}
+	for i, resource := range clusterResourceSet.Spec.Resources {
+		if !resourcesInWaves[resource] {
+			implicitWave.resources = append(implicitWave.resources, i)
+		}
+	}
+	if len(implicitWave.resources) > 0 {
+		waves = append(waves, implicitWave)
+	}
+	return waves
+}
+
+// checkWaveHealth evaluates the health checks of a wave in a Cluster.
+// It returns a message describing the first failing health check, or an empty message if all health checks passed.
+func checkWaveHealth(ctx context.Context, c client.Reader, healthChecks []addonsv1.ClusterResourceSetHealthCheck) (string, error) {
+	for _, healthCheck := range healthChecks {
+		gv, err := schema.ParseGroupVersion(healthCheck.APIVersion)
+		if err != nil {
+			return "", pkgerrors.Wrapf(err, "failed to parse apiVersion %q of health check", healthCheck.APIVersion)
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gv.WithKind(healthCheck.Kind))
+		key := client.ObjectKey{Namespace: healthCheck.Namespace, Name: healthCheck.Name}
+		if err := c.Get(ctx, key, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Sprintf("%s %s does not exist", healthCheck.Kind, klog.KRef(key.Namespace, key.Name)), nil
+			}
+			return "", pkgerrors.Wrapf(err, "failed to get %s %s", healthCheck.Kind, klog.KRef(key.Namespace, key.Name))
+		}
+
+		if healthCheck.ConditionType == "" {
+			continue
+		}
+		if status := conditionStatus(obj, healthCheck.ConditionType); status != metav1.ConditionTrue {
+			return fmt.Sprintf("%s %s does not have condition %s with status True", healthCheck.Kind, klog.KRef(key.Namespace, key.Name), healthCheck.ConditionType), nil
+		}
+	}
+	return "", nil
+}
+
+// conditionStatus returns the status of a condition in status.conditions of an object, or Unknown if the
+// condition does not exist.
+func conditionStatus(obj *unstructured.Unstructured, conditionType string) metav1.ConditionStatus {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != conditionType {
+			continue
+		}
+		if status, ok := condition["status"].(string); ok {
+			return metav1.ConditionStatus(status)
+		}
+	}
+	return metav1.ConditionUnknown
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+)
+
+func TestResourceWaves(t *testing.T) {
+	crds := addonsv1.ResourceRef{Name: "crds", Kind: "ConfigMap"}
+	operator := addonsv1.ResourceRef{Name: "operator", Kind: "Secret"}
+	customResources := addonsv1.ResourceRef{Name: "custom-resources", Kind: "ConfigMap"}
+	other := addonsv1.ResourceRef{Name: "other", Kind: "ConfigMap"}
+
+	tests := []struct {
+		name  string
+		waves []addonsv1.ClusterResourceSetWave
+		want  []resourceWave
+	}{
+		{
+			name: "all resources are part of the implicit wave without waves",
+			want: []resourceWave{
+				{resources: []int{0, 1, 2, 3}},
+			},
+		},
+		{
+			name: "waves are returned in order, followed by the implicit wave",
+			waves: []addonsv1.ClusterResourceSetWave{
+				{Name: "crds", Resources: []addonsv1.ResourceRef{crds}},
+				{Name: "operator", Resources: []addonsv1.ResourceRef{operator}, HealthChecks: []addonsv1.ClusterResourceSetHealthCheck{{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "operator-system", Name: "operator", ConditionType: "Available"}}},
+				{Name: "custom-resources", Resources: []addonsv1.ResourceRef{customResources}},
+			},
+			want: []resourceWave{
+				{name: "crds", resources: []int{1}},
+				{name: "operator", resources: []int{2}, healthChecks: []addonsv1.ClusterResourceSetHealthCheck{{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "operator-system", Name: "operator", ConditionType: "Available"}}},
+				{name: "custom-resources", resources: []int{0}},
+				{resources: []int{3}},
+			},
+		},
+		{
+			name: "no implicit wave when all resources are part of a wave, resources are ordered as in the wave",
+			waves: []addonsv1.ClusterResourceSetWave{
+				{Name: "all", Resources: []addonsv1.ResourceRef{other, operator, crds, customResources}},
+			},
+			want: []resourceWave{
+				{name: "all", resources: []int{3, 2, 1, 0}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					Resources: []addonsv1.ResourceRef{customResources, crds, operator, other},
+					Waves:     tt.waves,
+				},
+			}
+			g.Expect(resourceWaves(clusterResourceSet)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestCheckWaveHealth(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	deploymentHealthCheck := addonsv1.ClusterResourceSetHealthCheck{
+		APIVersion:    "apps/v1",
+		Kind:          "Deployment",
+		Namespace:     "operator-system",
+		Name:          "operator",
+		ConditionType: string(appsv1.DeploymentAvailable),
+	}
+	deployment := func(status corev1.ConditionStatus) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "operator",
+				Namespace: "operator-system",
+			},
+			Status: appsv1.DeploymentStatus{
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: status},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		objs         []client.Object
+		healthChecks []addonsv1.ClusterResourceSetHealthCheck
+		wantMessage  string
+	}{
+		{
+			name: "pass without health checks",
+		},
+		{
+			name:         "pass when the condition is true",
+			objs:         []client.Object{deployment(corev1.ConditionTrue)},
+			healthChecks: []addonsv1.ClusterResourceSetHealthCheck{deploymentHealthCheck},
+		},
+		{
+			name: "pass when the object exists and no condition is required",
+			objs: []client.Object{&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "operator-system"}}},
+			healthChecks: []addonsv1.ClusterResourceSetHealthCheck{
+				{APIVersion: "v1", Kind: "Namespace", Name: "operator-system"},
+			},
+		},
+		{
+			name:         "fail when the condition is false",
+			objs:         []client.Object{deployment(corev1.ConditionFalse)},
+			healthChecks: []addonsv1.ClusterResourceSetHealthCheck{deploymentHealthCheck},
+			wantMessage:  "Deployment operator-system/operator does not have condition Available with status True",
+		},
+		{
+			name:         "fail when the object does not exist",
+			healthChecks: []addonsv1.ClusterResourceSetHealthCheck{deploymentHealthCheck},
+			wantMessage:  "Deployment operator-system/operator does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objs...).Build()
+			message, err := checkWaveHealth(ctx, c, tt.healthChecks)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(message).To(Equal(tt.wantMessage))
+		})
+	}
+}
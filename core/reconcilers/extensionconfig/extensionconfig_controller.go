@@ -52,6 +52,10 @@ import (
 const (
 	// tlsCAKey is used as a data key in Secret resources to store a CA certificate.
 	tlsCAKey = "ca.crt"
+
+	// handlersAvailableResyncPeriod is the period used to update the HandlersAvailable condition
+	// of ExtensionConfigs defining a circuit breaker.
+	handlersAvailableResyncPeriod = 1 * time.Minute
 )
 
 // +kubebuilder:rbac:groups=runtime.cluster.x-k8s.io,resources=extensionconfigs;extensionconfigs/status,verbs=get;list;watch;patch;update
@@ -191,6 +195,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if err = r.RuntimeClient.Register(extensionConfig); err != nil {
 			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to register ExtensionConfig %s/%s", extensionConfig.Namespace, extensionConfig.Name)
 		}
+
+		// Circuit breakers are opened and closed by calls from other controllers, so requeue
+		// to keep the HandlersAvailable condition up to date.
+		if requeueAfter, ok := handlersAvailableRequeueAfter(r.RuntimeClient, extensionConfig, time.Now()); ok {
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
 	}
 
 	return ctrl.Result{}, nil
@@ -209,6 +219,7 @@ func patchExtensionConfig(ctx context.Context, client client.Client, original, m
 		patch.WithOwnedConditions{Conditions: []string{
 			clusterv1.PausedCondition,
 			runtimev1.ExtensionConfigDiscoveredCondition,
+			runtimev1.ExtensionConfigHandlersAvailableCondition,
 		}},
 	)
 	return patchHelper.Patch(ctx, modified, options...)
@@ -274,6 +285,63 @@ func discoverExtensionConfig(ctx context.Context, runtimeClient runtimeclient.Cl
 	return discoveredExtension, nil
 }
 
+// setHandlersAvailableCondition sets the HandlersAvailable condition based on the state of the circuit breakers
+// of the ExtensionHandlers of the ExtensionConfig.
+// Note: The condition only surfaces the state of the circuit breakers in the RuntimeClient of this controller;
+// other components calling the same ExtensionHandlers, e.g. KCP, track circuit breakers independently.
+func setHandlersAvailableCondition(runtimeClient runtimeclient.Client, extensionConfig *runtimev1.ExtensionConfig) {
+	if extensionConfig.Spec.CallPolicy.CircuitBreaker.FailureThreshold == 0 {
+		conditions.Delete(extensionConfig, runtimev1.ExtensionConfigHandlersAvailableCondition)
+		return
+	}
+
+	openHandlers := []string{}
+	for _, state := range runtimeClient.GetExtensionHandlerCallStates(extensionConfig) {
+		if state.CircuitBreakerOpen {
+			openHandlers = append(openHandlers, fmt.Sprintf("* %s: circuit breaker is open after %d consecutive failed calls", state.Name, state.ConsecutiveFailures))
+		}
+	}
+
+	if len(openHandlers) > 0 {
+		conditions.Set(extensionConfig, metav1.Condition{
+			Type:    runtimev1.ExtensionConfigHandlersAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  runtimev1.ExtensionConfigHandlersCircuitBreakerOpenReason,
+			Message: strings.Join(openHandlers, "\n"),
+		})
+		return
+	}
+
+	conditions.Set(extensionConfig, metav1.Condition{
+		Type:   runtimev1.ExtensionConfigHandlersAvailableCondition,
+		Status: metav1.ConditionTrue,
+		Reason: runtimev1.ExtensionConfigHandlersAvailableReason,
+	})
+}
+
+// handlersAvailableRequeueAfter returns after how long the ExtensionConfig should be reconciled again to
+// keep the HandlersAvailable condition up to date.
+// It returns false if the ExtensionConfig does not define a circuit breaker.
+func handlersAvailableRequeueAfter(runtimeClient runtimeclient.Client, extensionConfig *runtimev1.ExtensionConfig, now time.Time) (time.Duration, bool) {
+	circuitBreaker := extensionConfig.Spec.CallPolicy.CircuitBreaker
+	if circuitBreaker.FailureThreshold == 0 {
+		return 0, false
+	}
+
+	requeueAfter := handlersAvailableResyncPeriod
+	for _, state := range runtimeClient.GetExtensionHandlerCallStates(extensionConfig) {
+		if !state.CircuitBreakerOpen {
+			continue
+		}
+		// Reconcile shortly after the circuit breaker allows a trial call, so the condition
+		// reflects the result of the trial call as soon as possible.
+		if openFor := state.CircuitBreakerOpenUntil.Sub(now) + time.Second; openFor > 0 && openFor < requeueAfter {
+			requeueAfter = openFor
+		}
+	}
+	return requeueAfter, true
+}
+
 // reconcileCABundle reconciles the CA bundle for the ExtensionConfig.
 // Note: This was implemented to behave similar to the cert-manager cainjector.
 // We couldn't use the cert-manager cainjector because it doesn't work with CustomResources.
@@ -363,6 +431,8 @@ func reconcileExtensionConfig(ctx context.Context, c client.Client, runtimeClien
 		errs = append(errs, err)
 	}
 
+	setHandlersAvailableCondition(runtimeClient, extensionConfig)
+
 	// Note: Intentionally always patching ExtensionConfig even if discoverExtensionConfig failed.
 	if err := patchExtensionConfig(ctx, c, original, extensionConfig); err != nil {
 		errs = append(errs, err)
@@ -40,8 +40,10 @@ import (
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/feature"
 	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
 	fakev1alpha1 "sigs.k8s.io/cluster-api/internal/runtime/test/v1alpha1"
 	"sigs.k8s.io/cluster-api/util"
@@ -506,6 +508,87 @@ func Test_validateExtensionConfig(t *testing.T) {
 	}
 }
 
+func Test_setHandlersAvailableCondition(t *testing.T) {
+	now := time.Now()
+	circuitBreaker := runtimev1.ExtensionCircuitBreaker{FailureThreshold: 3}
+
+	tests := []struct {
+		name             string
+		circuitBreaker   runtimev1.ExtensionCircuitBreaker
+		callStates       []runtimeclient.ExtensionHandlerCallState
+		wantCondition    *metav1.Condition
+		wantRequeue      bool
+		wantRequeueAfter time.Duration
+	}{
+		{
+			name:           "no condition if circuit breaker is not configured",
+			circuitBreaker: runtimev1.ExtensionCircuitBreaker{},
+			callStates: []runtimeclient.ExtensionHandlerCallState{
+				{Name: "handler-1.ext", CircuitBreakerOpen: true},
+			},
+			wantCondition: nil,
+			wantRequeue:   false,
+		},
+		{
+			name:           "condition true if all circuit breakers are closed",
+			circuitBreaker: circuitBreaker,
+			callStates: []runtimeclient.ExtensionHandlerCallState{
+				{Name: "handler-1.ext"},
+				{Name: "handler-2.ext", ConsecutiveFailures: 2},
+			},
+			wantCondition: &metav1.Condition{
+				Type:               runtimev1.ExtensionConfigHandlersAvailableCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             runtimev1.ExtensionConfigHandlersAvailableReason,
+				ObservedGeneration: 1, // ExtensionConfig has generation 1.
+			},
+			wantRequeue:      true,
+			wantRequeueAfter: handlersAvailableResyncPeriod,
+		},
+		{
+			name:           "condition false if a circuit breaker is open",
+			circuitBreaker: circuitBreaker,
+			callStates: []runtimeclient.ExtensionHandlerCallState{
+				{Name: "handler-1.ext"},
+				{Name: "handler-2.ext", CircuitBreakerOpen: true, ConsecutiveFailures: 3, CircuitBreakerOpenUntil: now.Add(10 * time.Second)},
+			},
+			wantCondition: &metav1.Condition{
+				Type:               runtimev1.ExtensionConfigHandlersAvailableCondition,
+				Status:             metav1.ConditionFalse,
+				Reason:             runtimev1.ExtensionConfigHandlersCircuitBreakerOpenReason,
+				Message:            "* handler-2.ext: circuit breaker is open after 3 consecutive failed calls",
+				ObservedGeneration: 1, // ExtensionConfig has generation 1.
+			},
+			wantRequeue:      true,
+			wantRequeueAfter: 11 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithExtensionHandlerCallStates(tt.callStates).
+				Build()
+			config := extensionConfig([]byte("caBundle"))
+			config.Spec.CallPolicy.CircuitBreaker = tt.circuitBreaker
+
+			setHandlersAvailableCondition(runtimeClient, config)
+			condition := conditions.Get(config, runtimev1.ExtensionConfigHandlersAvailableCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(*condition).To(conditions.MatchCondition(*tt.wantCondition, conditions.IgnoreLastTransitionTime(true)))
+			}
+
+			requeueAfter, requeue := handlersAvailableRequeueAfter(runtimeClient, config, now)
+			g.Expect(requeue).To(Equal(tt.wantRequeue))
+			g.Expect(requeueAfter).To(Equal(tt.wantRequeueAfter))
+		})
+	}
+}
+
 func discoveryHandler(handlerList ...string) func(http.ResponseWriter, *http.Request) {
 	handlers := []runtimehooksv1.ExtensionHandler{}
 	for _, name := range handlerList {
@@ -61,6 +61,11 @@ type Helper struct {
 	// DeletionTimeStamp > N seconds. This can be used e.g. when a Node is unreachable
 	// and the Pods won't drain because of that.
 	SkipWaitForDeleteTimeoutSeconds int
+
+	// NodeDrainStartTime is the time when the drain of the Node started.
+	// It is used to evaluate if the timeoutSeconds of MachineDrainRules have been reached;
+	// if it is not set, timeouts of MachineDrainRules are never reached.
+	NodeDrainStartTime metav1.Time
 }
 
 // CordonNode cordons a Node.
@@ -329,6 +334,7 @@ func (d *Helper) EvictPods(ctx context.Context, podDeleteList *PodDeleteList) Ev
 
 		log.V(4).Info("Skip evicting Pod because it should be ignored")
 		res.PodsIgnored = append(res.PodsIgnored, pd.Pod)
+		res.addEscalation(pd)
 	}
 
 	for _, pd := range podsWithDeletionTimestamp {
@@ -336,6 +342,7 @@ func (d *Helper) EvictPods(ctx context.Context, podDeleteList *PodDeleteList) Ev
 
 		log.V(4).Info("Skip triggering Pod eviction because it already has a deletionTimestamp")
 		res.PodsDeletionTimestampSet = append(res.PodsDeletionTimestampSet, pd.Pod)
+		res.addEscalation(pd)
 	}
 
 evictionLoop:
@@ -356,6 +363,26 @@ evictionLoop:
 		default:
 		}
 
+		res.addEscalation(pd)
+
+		if pd.Status.DrainAfterTimeout == clusterv1.MachineDrainRuleDrainAfterTimeoutForceDelete {
+			log.V(4).Info(fmt.Sprintf("Deleting Pod without eviction, because timeout of MachineDrainRule %s has been reached", pd.Status.MachineDrainRule))
+
+			err := d.deletePod(ctx, pd.Pod)
+			switch {
+			case err == nil:
+				log.V(4).Info("Pod deletion successfully triggered")
+				res.PodsDeletionTimestampSet = append(res.PodsDeletionTimestampSet, pd.Pod)
+			case apierrors.IsNotFound(err):
+				log.V(4).Info("Deletion not needed, Pod doesn't exist anymore")
+				res.PodsNotFound = append(res.PodsNotFound, pd.Pod)
+			default:
+				log.V(4).Info("Error when deleting Pod", "err", err)
+				res.PodsFailedEviction[err.Error()] = append(res.PodsFailedEviction[err.Error()], pd.Pod)
+			}
+			continue
+		}
+
 		log.V(4).Info("Evicting Pod")
 
 		err := d.evictPod(ctx, pd.Pod)
@@ -447,6 +474,16 @@ func (d *Helper) evictPod(ctx context.Context, pod *corev1.Pod) error {
 	return d.RemoteClient.SubResource("eviction").Create(ctx, pod, eviction)
 }
 
+// deletePod deletes the given Pod without using the eviction API, i.e. without respecting PodDisruptionBudgets.
+func (d *Helper) deletePod(ctx context.Context, pod *corev1.Pod) error {
+	var opts []client.DeleteOption
+	if d.GracePeriodSeconds >= 0 {
+		opts = append(opts, client.GracePeriodSeconds(int64(d.GracePeriodSeconds)))
+	}
+
+	return d.RemoteClient.Delete(ctx, pod, opts...)
+}
+
 // EvictionResult contains the results of an eviction.
 type EvictionResult struct {
 	PodsDeletionTimestampSet   []*corev1.Pod
@@ -456,6 +493,32 @@ type EvictionResult struct {
 	PodsToWaitCompletedLater   []*corev1.Pod
 	PodsNotFound               []*corev1.Pod
 	PodsIgnored                []*corev1.Pod
+
+	// PodsEscalated contains the Pods for which the timeout of a MachineDrainRule has been reached,
+	// grouped by a message describing the MachineDrainRule and the action applied to the Pods.
+	PodsEscalated map[string][]*corev1.Pod
+}
+
+// addEscalation adds a Pod to PodsEscalated if the timeout of its MachineDrainRule has been reached.
+func (r *EvictionResult) addEscalation(pd PodDelete) {
+	if pd.Status.DrainAfterTimeout == "" {
+		return
+	}
+
+	var action string
+	switch pd.Status.DrainAfterTimeout {
+	case clusterv1.MachineDrainRuleDrainAfterTimeoutForceDelete:
+		action = "deleting without eviction"
+	case clusterv1.MachineDrainRuleDrainAfterTimeoutSkip:
+		action = "skipping drain"
+	default:
+		action = "drain blocked until removed from the Node"
+	}
+	msg := fmt.Sprintf("MachineDrainRule %s timeout of %ds reached, %s", pd.Status.MachineDrainRule, ptr.Deref(pd.Status.DrainTimeoutSeconds, 0), action)
+	if r.PodsEscalated == nil {
+		r.PodsEscalated = map[string][]*corev1.Pod{}
+	}
+	r.PodsEscalated[msg] = append(r.PodsEscalated[msg], pd.Pod)
 }
 
 // DrainCompleted returns if a Node is entirely drained, i.e. if all relevant Pods have gone away.
@@ -528,6 +591,14 @@ func (r EvictionResult) ConditionMessage(nodeDrainStartTime metav1.Time) string
 		conditionMessage = fmt.Sprintf("%s\n* %s %s: waiting for completion",
 			conditionMessage, kind, PodListToString(r.PodsToWaitCompletedNow, 3))
 	}
+	for _, escalationMessage := range slices.Sorted(maps.Keys(r.PodsEscalated)) {
+		pods := r.PodsEscalated[escalationMessage]
+		kind := "Pod"
+		if len(pods) > 1 {
+			kind = "Pods"
+		}
+		conditionMessage = fmt.Sprintf("%s\n* %s %s: %s", conditionMessage, kind, PodListToString(pods, 3), escalationMessage)
+	}
 	if len(r.PodsToTriggerEvictionLater) > 0 {
 		conditionMessage = fmt.Sprintf("%s\nAfter above Pods have been removed from the Node, the following Pods will be evicted: %s",
 			conditionMessage, PodListToString(r.PodsToTriggerEvictionLater, 3))
@@ -1637,6 +1637,103 @@ func TestEvictPods(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EvictPods with MachineDrainRule timeouts reached",
+			podDeleteList: &PodDeleteList{items: []PodDelete{
+				{
+					Pod: &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-10-timeout-skip",
+						},
+					},
+					Status: PodDeleteStatus{
+						DrainBehavior:       clusterv1.MachineDrainRuleDrainBehaviorSkip, // Will be skipped because the timeout has been reached
+						MachineDrainRule:    "mdr-skip",
+						DrainTimeoutSeconds: ptr.To[int32](60),
+						DrainAfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutSkip,
+						Reason:              PodDeleteStatusTypeSkip,
+					},
+				},
+				{
+					Pod: &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-11-timeout-force-delete",
+						},
+					},
+					Status: PodDeleteStatus{
+						DrainBehavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+						DrainOrder:          ptr.To[int32](0), // Will be deleted without eviction because the timeout has been reached
+						MachineDrainRule:    "mdr-force-delete",
+						DrainTimeoutSeconds: ptr.To[int32](120),
+						DrainAfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutForceDelete,
+						Reason:              PodDeleteStatusTypeOkay,
+					},
+				},
+				{
+					Pod: &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-12-timeout-block",
+						},
+					},
+					Status: PodDeleteStatus{
+						DrainBehavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+						DrainOrder:          ptr.To[int32](0), // Will still be evicted because afterTimeout is Block
+						MachineDrainRule:    "mdr-block",
+						DrainTimeoutSeconds: ptr.To[int32](180),
+						DrainAfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutBlock,
+						Reason:              PodDeleteStatusTypeOkay,
+					},
+				},
+			}},
+			wantEvictionResult: EvictionResult{
+				PodsIgnored: []*corev1.Pod{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-10-timeout-skip",
+						},
+					},
+				},
+				PodsDeletionTimestampSet: []*corev1.Pod{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-11-timeout-force-delete",
+						},
+					},
+				},
+				PodsFailedEviction: map[string][]*corev1.Pod{
+					"Cannot evict pod as it would violate the pod's disruption budget. The disruption budget pod-12-pdb needs 3 healthy pods and has 2 currently": {
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-12-timeout-block",
+							},
+						},
+					},
+				},
+				PodsEscalated: map[string][]*corev1.Pod{
+					"MachineDrainRule mdr-skip timeout of 60s reached, skipping drain": {
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-10-timeout-skip",
+							},
+						},
+					},
+					"MachineDrainRule mdr-force-delete timeout of 120s reached, deleting without eviction": {
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-11-timeout-force-delete",
+							},
+						},
+					},
+					"MachineDrainRule mdr-block timeout of 180s reached, drain blocked until removed from the Node": {
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-12-timeout-block",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1694,11 +1791,37 @@ func TestEvictPods(t *testing.T) {
 						}
 					case "pod-7-to-trigger-eviction-some-other-error":
 						return apierrors.NewBadRequest("some other error")
+					case "pod-12-timeout-block":
+						return &apierrors.StatusError{
+							ErrStatus: metav1.Status{
+								Status:  metav1.StatusFailure,
+								Code:    http.StatusTooManyRequests,
+								Reason:  metav1.StatusReasonTooManyRequests,
+								Message: "Cannot evict pod as it would violate the pod's disruption budget.",
+								Details: &metav1.StatusDetails{
+									Causes: []metav1.StatusCause{
+										{
+											Type:    "DisruptionBudget",
+											Message: "The disruption budget pod-12-pdb needs 3 healthy pods and has 2 currently",
+										},
+									},
+								},
+							},
+						}
 					}
 
 					g.Fail(fmt.Sprintf("eviction behavior for Pod %q not implemented", obj.GetName()))
 					return nil
 				},
+				Delete: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.DeleteOption) error {
+					switch name := obj.GetName(); name {
+					case "pod-11-timeout-force-delete":
+						return nil // Successful deletion.
+					}
+
+					g.Fail(fmt.Sprintf("deletion behavior for Pod %q not implemented", obj.GetName()))
+					return nil
+				},
 			})
 
 			drainer := &Helper{
@@ -1781,6 +1904,48 @@ func TestEvictionResult_ConditionMessage(t *testing.T) {
 * Pod pod-5-to-trigger-eviction-pdb-violated-1: cannot evict pod as it would violate the pod's disruption budget. The disruption budget pod-5-pdb needs 20 healthy pods and has 20 currently
 * Pod pod-6-to-trigger-eviction-some-other-error: failed to evict Pod, some other error 1
 After above Pods have been removed from the Node, the following Pods will be evicted: pod-7-eviction-later, pod-8-eviction-later`,
+		},
+		{
+			name: "Compute condition message correctly with MachineDrainRule timeouts reached",
+			evictionResult: EvictionResult{
+				PodsDeletionTimestampSet: []*corev1.Pod{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-1-timeout-force-delete",
+						},
+					},
+				},
+				PodsFailedEviction: map[string][]*corev1.Pod{
+					"Cannot evict pod as it would violate the pod's disruption budget. The disruption budget pod-2-pdb needs 20 healthy pods and has 20 currently": {
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-2-timeout-block",
+							},
+						},
+					},
+				},
+				PodsEscalated: map[string][]*corev1.Pod{
+					"MachineDrainRule mdr-force-delete timeout of 120s reached, deleting without eviction": {
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-1-timeout-force-delete",
+							},
+						},
+					},
+					"MachineDrainRule mdr-block timeout of 180s reached, drain blocked until removed from the Node": {
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-2-timeout-block",
+							},
+						},
+					},
+				},
+			},
+			wantConditionMessage: `Drain not completed yet (started at 2024-10-09T16:13:59Z):
+* Pod pod-1-timeout-force-delete: deletionTimestamp set, but still not removed from the Node
+* Pod pod-2-timeout-block: cannot evict pod as it would violate the pod's disruption budget. The disruption budget pod-2-pdb needs 20 healthy pods and has 20 currently
+* Pod pod-2-timeout-block: MachineDrainRule mdr-block timeout of 180s reached, drain blocked until removed from the Node
+* Pod pod-1-timeout-force-delete: MachineDrainRule mdr-force-delete timeout of 120s reached, deleting without eviction`,
 		},
 		{
 			name: "Compute long condition message correctly",
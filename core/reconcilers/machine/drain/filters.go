@@ -113,6 +113,16 @@ type PodDeleteStatus struct {
 	// DrainOrder is only used if DrainBehavior is "Drain".
 	DrainOrder *int32
 
+	// MachineDrainRule is the name of the MachineDrainRule whose timeout has been reached for the Pod.
+	// MachineDrainRule, DrainTimeoutSeconds and DrainAfterTimeout are only set if the timeout has been reached.
+	MachineDrainRule string
+
+	// DrainTimeoutSeconds is the timeoutSeconds of the MachineDrainRule.
+	DrainTimeoutSeconds *int32
+
+	// DrainAfterTimeout defines what happens to the Pod after the timeout of the MachineDrainRule has been reached.
+	DrainAfterTimeout clusterv1.MachineDrainRuleDrainAfterTimeout
+
 	Reason  string
 	Message string
 }
@@ -316,7 +326,22 @@ func (d *Helper) machineDrainRulesFilter(machineDrainRules []*clusterv1.MachineD
 			log := ctrl.LoggerFrom(ctx, "Pod", klog.KObj(pod))
 			switch mdr.Spec.Drain.Behavior {
 			case clusterv1.MachineDrainRuleDrainBehaviorDrain:
-				return MakePodDeleteStatusOkayWithOrder(mdr.Spec.Drain.Order)
+				status := MakePodDeleteStatusOkayWithOrder(mdr.Spec.Drain.Order)
+				if d.drainTimeoutReached(mdr) {
+					status.MachineDrainRule = mdr.Name
+					status.DrainTimeoutSeconds = mdr.Spec.Drain.TimeoutSeconds
+					status.DrainAfterTimeout = mdr.Spec.Drain.AfterTimeout
+					if status.DrainAfterTimeout == "" {
+						status.DrainAfterTimeout = clusterv1.MachineDrainRuleDrainAfterTimeoutBlock
+					}
+					if status.DrainAfterTimeout == clusterv1.MachineDrainRuleDrainAfterTimeoutSkip {
+						log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because timeout of MachineDrainRule %s has been reached", mdr.Name))
+						status.DrainBehavior = clusterv1.MachineDrainRuleDrainBehaviorSkip
+						status.DrainOrder = nil
+						status.Reason = PodDeleteStatusTypeSkip
+					}
+				}
+				return status
 			case clusterv1.MachineDrainRuleDrainBehaviorSkip:
 				log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because MachineDrainRule %s with behavior %s applies to the Pod", mdr.Name, clusterv1.MachineDrainRuleDrainBehaviorSkip))
 				return MakePodDeleteStatusSkip()
@@ -335,6 +360,16 @@ func (d *Helper) machineDrainRulesFilter(machineDrainRules []*clusterv1.MachineD
 	}
 }
 
+// drainTimeoutReached evaluates if the timeout of a MachineDrainRule has been reached, i.e. if more than
+// timeoutSeconds passed since the Node drain started.
+func (d *Helper) drainTimeoutReached(mdr *clusterv1.MachineDrainRule) bool {
+	if mdr.Spec.Drain.TimeoutSeconds == nil || d.NodeDrainStartTime.IsZero() {
+		return false
+	}
+	timeout := time.Duration(*mdr.Spec.Drain.TimeoutSeconds) * time.Second
+	return time.Since(d.NodeDrainStartTime.Time) > timeout
+}
+
 // machineDrainRuleAppliesToPod evaluates if a MachineDrainRule applies to a Pod.
 func machineDrainRuleAppliesToPod(mdr *clusterv1.MachineDrainRule, pod *corev1.Pod, namespace *corev1.Namespace) bool {
 	// If pods is empty, the MachineDrainRule applies to all Pods.
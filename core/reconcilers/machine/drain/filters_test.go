@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -74,6 +75,132 @@ func TestSkipDeletedFilter(t *testing.T) {
 	}
 }
 
+func TestMachineDrainRulesFilterTimeout(t *testing.T) {
+	tests := []struct {
+		name               string
+		nodeDrainStartTime metav1.Time
+		drain              clusterv1.MachineDrainRuleDrainConfig
+		wantStatus         PodDeleteStatus
+	}{
+		{
+			name:               "Drain Pod if timeout is not set",
+			nodeDrainStartTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				Order:    ptr.To[int32](5),
+			},
+			wantStatus: PodDeleteStatus{
+				DrainBehavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				DrainOrder:    ptr.To[int32](5),
+				Reason:        PodDeleteStatusTypeOkay,
+			},
+		},
+		{
+			name:               "Drain Pod if timeout is not reached",
+			nodeDrainStartTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				TimeoutSeconds: ptr.To[int32](300),
+				AfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutSkip,
+			},
+			wantStatus: PodDeleteStatus{
+				DrainBehavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				Reason:        PodDeleteStatusTypeOkay,
+			},
+		},
+		{
+			name: "Drain Pod if node drain start time is not set",
+			drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				TimeoutSeconds: ptr.To[int32](300),
+				AfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutSkip,
+			},
+			wantStatus: PodDeleteStatus{
+				DrainBehavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				Reason:        PodDeleteStatusTypeOkay,
+			},
+		},
+		{
+			name:               "Skip Pod if timeout is reached and afterTimeout is Skip",
+			nodeDrainStartTime: metav1.NewTime(time.Now().Add(-10 * time.Minute)),
+			drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				Order:          ptr.To[int32](5),
+				TimeoutSeconds: ptr.To[int32](300),
+				AfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutSkip,
+			},
+			wantStatus: PodDeleteStatus{
+				DrainBehavior:       clusterv1.MachineDrainRuleDrainBehaviorSkip,
+				MachineDrainRule:    "mdr",
+				DrainTimeoutSeconds: ptr.To[int32](300),
+				DrainAfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutSkip,
+				Reason:              PodDeleteStatusTypeSkip,
+			},
+		},
+		{
+			name:               "Drain Pod with ForceDelete if timeout is reached and afterTimeout is ForceDelete",
+			nodeDrainStartTime: metav1.NewTime(time.Now().Add(-10 * time.Minute)),
+			drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				TimeoutSeconds: ptr.To[int32](300),
+				AfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutForceDelete,
+			},
+			wantStatus: PodDeleteStatus{
+				DrainBehavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				MachineDrainRule:    "mdr",
+				DrainTimeoutSeconds: ptr.To[int32](300),
+				DrainAfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutForceDelete,
+				Reason:              PodDeleteStatusTypeOkay,
+			},
+		},
+		{
+			name:               "Drain Pod with Block if timeout is reached and afterTimeout is not set",
+			nodeDrainStartTime: metav1.NewTime(time.Now().Add(-10 * time.Minute)),
+			drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				TimeoutSeconds: ptr.To[int32](300),
+			},
+			wantStatus: PodDeleteStatus{
+				DrainBehavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				MachineDrainRule:    "mdr",
+				DrainTimeoutSeconds: ptr.To[int32](300),
+				DrainAfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutBlock,
+				Reason:              PodDeleteStatusTypeOkay,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			h := &Helper{
+				NodeDrainStartTime: tt.nodeDrainStartTime,
+			}
+			mdr := &clusterv1.MachineDrainRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mdr",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: clusterv1.MachineDrainRuleSpec{
+					Drain: tt.drain,
+				},
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod",
+					Namespace: metav1.NamespaceDefault,
+				},
+			}
+			namespaces := map[string]*corev1.Namespace{
+				metav1.NamespaceDefault: {ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault}},
+			}
+
+			status := h.machineDrainRulesFilter([]*clusterv1.MachineDrainRule{mdr}, namespaces)(context.Background(), pod)
+			g.Expect(status).To(BeComparableTo(tt.wantStatus))
+		})
+	}
+}
+
 func Test_machineDrainRuleAppliesToPod(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -47,6 +47,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
@@ -54,6 +55,7 @@ import (
 	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/internal/hooks"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/cache"
@@ -132,6 +134,9 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	if feature.Gates.Enabled(feature.InPlaceUpdates) && r.RuntimeClient == nil {
 		return pkgerrors.New("RuntimeClient must not be nil when InPlaceUpdates feature gate is enabled")
 	}
+	if feature.Gates.Enabled(feature.RuntimeSDK) && r.RuntimeClient == nil {
+		return pkgerrors.New("RuntimeClient must not be nil when RuntimeSDK feature gate is enabled")
+	}
 
 	r.predicateLog = ptr.To(ctrl.LoggerFrom(ctx).WithValues("controller", "machine"))
 	clusterToMachines, err := util.ClusterToTypedObjectsMapper(mgr.GetClient(), &clusterv1.MachineList{}, mgr.GetScheme())
@@ -518,6 +523,22 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 		// Drain node before deletion and issue a patch in order to make this operation visible to the users.
 		// In case the preTerminateHook is started or the infra machine is not found the Node drain is skipped.
 		if r.isNodeDrainAllowed(m, s.infraMachine) {
+			// Call the BeforeMachineDrain hook before the drain is started, i.e. before the Node is cordoned.
+			// Note: The hook is not called anymore once the drain has been started, i.e. status.deletion.nodeDrainStartTime is set.
+			if feature.Gates.Enabled(feature.RuntimeSDK) && (m.Status.Deletion == nil || m.Status.Deletion.NodeDrainStartTime.IsZero()) {
+				result, message, err := r.callBeforeMachineDrainHook(ctx, s)
+				if err != nil {
+					s.deletingReason = clusterv1.MachineDeletingWaitingForBeforeMachineDrainHookReason
+					s.deletingMessage = "Error calling BeforeMachineDrain hook, please check controller logs for errors"
+					return ctrl.Result{}, err
+				}
+				if !result.IsZero() {
+					s.deletingReason = clusterv1.MachineDeletingWaitingForBeforeMachineDrainHookReason
+					s.deletingMessage = hookMessage("Waiting for BeforeMachineDrain hook to succeed", message)
+					return result, nil
+				}
+			}
+
 			patchHelper, err := patch.NewHelper(m, r.Client)
 			if err != nil {
 				s.deletingReason = clusterv1.MachineDeletingInternalErrorReason
@@ -530,6 +551,10 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 			}
 			if m.Status.Deletion.NodeDrainStartTime.IsZero() {
 				m.Status.Deletion.NodeDrainStartTime = metav1.Now()
+				if feature.Gates.Enabled(feature.RuntimeSDK) {
+					// Track that the AfterMachineDrain hook has to be called once the drain is completed.
+					hooks.MarkObjectAsPending(m, runtimehooksv1.AfterMachineDrain)
+				}
 			}
 
 			// The DrainingSucceededCondition never exists before the node is drained for the first time.
@@ -558,8 +583,16 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 				return result, nil
 			}
 
+			if result, err := r.reconcileAfterMachineDrainHook(ctx, s); err != nil || !result.IsZero() {
+				return result, err
+			}
+
 			v1beta1conditions.MarkTrue(m, clusterv1.DrainingSucceededV1Beta1Condition)
 			r.recorder.Eventf(m, corev1.EventTypeNormal, "SuccessfulDrainNode", "success draining Machine's node %q", m.Status.NodeRef.Name)
+		} else if result, err := r.reconcileAfterMachineDrainHook(ctx, s); err != nil || !result.IsZero() {
+			// The drain has been started but it is now skipped, e.g. because nodeDrainTimeout expired;
+			// the AfterMachineDrain hook is called anyway so extensions are not left waiting for it.
+			return result, err
 		}
 
 		// After node draining is completed, and if isNodeVolumeDetachingAllowed returns True, make sure all
@@ -880,6 +913,9 @@ func (r *Reconciler) drainNode(ctx context.Context, s *scope) (ctrl.Result, erro
 		RemoteClient:       remoteClient,
 		GracePeriodSeconds: -1,
 	}
+	if machine.Status.Deletion != nil {
+		drainer.NodeDrainStartTime = machine.Status.Deletion.NodeDrainStartTime
+	}
 
 	if noderefutil.IsNodeUnreachable(node) {
 		// Kubelet is unreachable, pods will never disappear.
@@ -1214,6 +1250,9 @@ func getPersistentVolumeClaimsToIgnore(ctx context.Context, c client.Client, rem
 		Client:       c,
 		RemoteClient: remoteClient,
 	}
+	if machine.Status.Deletion != nil {
+		drainHelper.NodeDrainStartTime = machine.Status.Deletion.NodeDrainStartTime
+	}
 
 	pods, err := drainHelper.GetPodsForEviction(ctx, cluster, machine, nodeName)
 	if err != nil {
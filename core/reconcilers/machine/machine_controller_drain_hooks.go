@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/hooks"
+	"sigs.k8s.io/cluster-api/util/cache"
+	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
+)
+
+// callBeforeMachineDrainHook calls the BeforeMachineDrain hook for the Machine.
+// It returns a non-zero Result if the hook requested to retry later, together with the message of the hook.
+func (r *Reconciler) callBeforeMachineDrainHook(ctx context.Context, s *scope) (ctrl.Result, string, error) {
+	request := &runtimehooksv1.BeforeMachineDrainRequest{
+		Cluster:  *cleanupClusterForHook(s.cluster),
+		Machine:  *cleanupMachineForHook(s.machine),
+		NodeName: s.machine.Status.NodeRef.Name,
+	}
+	return r.callDrainHook(ctx, s, runtimehooksv1.BeforeMachineDrain, request, &runtimehooksv1.BeforeMachineDrainResponse{})
+}
+
+// callAfterMachineDrainHook calls the AfterMachineDrain hook for the Machine.
+// It returns a non-zero Result if the hook requested to retry later, together with the message of the hook.
+func (r *Reconciler) callAfterMachineDrainHook(ctx context.Context, s *scope) (ctrl.Result, string, error) {
+	request := &runtimehooksv1.AfterMachineDrainRequest{
+		Cluster:  *cleanupClusterForHook(s.cluster),
+		Machine:  *cleanupMachineForHook(s.machine),
+		NodeName: s.machine.Status.NodeRef.Name,
+	}
+	return r.callDrainHook(ctx, s, runtimehooksv1.AfterMachineDrain, request, &runtimehooksv1.AfterMachineDrainResponse{})
+}
+
+// reconcileAfterMachineDrainHook calls the AfterMachineDrain hook if it is pending, i.e. if the drain has been started,
+// and marks it as done once it does not block anymore.
+// It returns a non-zero Result if the hook requested to retry later.
+func (r *Reconciler) reconcileAfterMachineDrainHook(ctx context.Context, s *scope) (ctrl.Result, error) {
+	m := s.machine
+	if !feature.Gates.Enabled(feature.RuntimeSDK) || !hooks.IsPending(runtimehooksv1.AfterMachineDrain, m) {
+		return ctrl.Result{}, nil
+	}
+
+	result, message, err := r.callAfterMachineDrainHook(ctx, s)
+	if err != nil {
+		s.deletingReason = clusterv1.MachineDeletingWaitingForAfterMachineDrainHookReason
+		s.deletingMessage = "Error calling AfterMachineDrain hook, please check controller logs for errors"
+		return ctrl.Result{}, err
+	}
+	if !result.IsZero() {
+		s.deletingReason = clusterv1.MachineDeletingWaitingForAfterMachineDrainHookReason
+		s.deletingMessage = hookMessage("Waiting for AfterMachineDrain hook to succeed", message)
+		return result, nil
+	}
+	// Note: This call will not update the resourceVersion on machine, so that the patchHelper in the main
+	// Reconcile func won't get a conflict.
+	if err := hooks.MarkAsDone(ctx, r.Client, m, false, runtimehooksv1.AfterMachineDrain); err != nil {
+		s.deletingReason = clusterv1.MachineDeletingInternalErrorReason
+		s.deletingMessage = "Please check controller logs for errors"
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) callDrainHook(ctx context.Context, s *scope, hook runtimecatalog.Hook, request runtimehooksv1.RequestObject, response runtimehooksv1.RetryResponseObject) (ctrl.Result, string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	hookName := runtimecatalog.HookName(hook)
+
+	if cacheEntry, ok := r.hookCache.Has(cache.NewHookEntryKey(s.machine, hook)); ok {
+		if requeueAfter, requeue := cacheEntry.ShouldRequeue(time.Now()); requeue {
+			log.V(5).Info(fmt.Sprintf("Skip calling %s hook, retry after %s", hookName, requeueAfter))
+			return ctrl.Result{RequeueAfter: requeueAfter}, cacheEntry.ResponseMessage, nil
+		}
+	}
+
+	if err := r.RuntimeClient.CallAllExtensions(ctx, hook, s.machine, request, response); err != nil {
+		return ctrl.Result{}, "", err
+	}
+
+	if response.GetRetryAfterSeconds() != 0 {
+		requeueAfter := time.Duration(response.GetRetryAfterSeconds()) * time.Second
+		r.hookCache.Add(cache.NewHookEntry(s.machine, hook, time.Now().Add(requeueAfter), response.GetMessage()))
+		log.Info(fmt.Sprintf("Machine deletion is blocked by %s hook, retry after %ds", hookName, response.GetRetryAfterSeconds()))
+		return ctrl.Result{RequeueAfter: requeueAfter}, response.GetMessage(), nil
+	}
+
+	log.Info(fmt.Sprintf("Machine deletion is unblocked by %s hook", hookName))
+	return ctrl.Result{}, response.GetMessage(), nil
+}
+
+// hookMessage returns a message for the Deleting condition while waiting for a hook,
+// including the message returned by the hook if any.
+func hookMessage(prefix, message string) string {
+	if message == "" {
+		return prefix
+	}
+	return fmt.Sprintf("%s: %s", prefix, message)
+}
+
+// cleanupClusterForHook returns a copy of the Cluster without status, managedFields and some specific annotations
+// to optimize the size of hook requests.
+func cleanupClusterForHook(cluster *clusterv1.Cluster) *clusterv1.Cluster {
+	cluster = cluster.DeepCopy()
+	// Set GVK because object is later marshalled with json.Marshal when the hook request is sent.
+	cluster.SetGroupVersionKind(clusterv1.GroupVersion.WithKind("Cluster"))
+	cluster.SetManagedFields(nil)
+	if cluster.Annotations != nil {
+		annotations := maps.Clone(cluster.Annotations)
+		delete(annotations, corev1.LastAppliedConfigAnnotation)
+		delete(annotations, conversionutil.DataAnnotation)
+		cluster.Annotations = annotations
+	}
+	cluster.Status = clusterv1.ClusterStatus{}
+	return cluster
+}
+
+// cleanupMachineForHook returns a copy of the Machine without managedFields and some specific annotations
+// to optimize the size of hook requests.
+// Note: Contrary to cleanupMachine the status is preserved, so extensions can e.g. use status.nodeRef and status.deletion.
+func cleanupMachineForHook(machine *clusterv1.Machine) *clusterv1.Machine {
+	machine = machine.DeepCopy()
+	// Set GVK because object is later marshalled with json.Marshal when the hook request is sent.
+	machine.SetGroupVersionKind(clusterv1.GroupVersion.WithKind("Machine"))
+	machine.SetManagedFields(nil)
+	if machine.Annotations != nil {
+		annotations := maps.Clone(machine.Annotations)
+		delete(annotations, corev1.LastAppliedConfigAnnotation)
+		delete(annotations, conversionutil.DataAnnotation)
+		machine.Annotations = annotations
+	}
+	return machine
+}
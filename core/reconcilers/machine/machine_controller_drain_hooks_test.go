@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/hooks"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
+	"sigs.k8s.io/cluster-api/util/cache"
+)
+
+func TestCallMachineDrainHooks(t *testing.T) {
+	catalog := runtimecatalog.New()
+	if err := runtimehooksv1.AddToCatalog(catalog); err != nil {
+		t.Fatalf("failed to add hooks to catalog: %v", err)
+	}
+	beforeGVH, err := catalog.GroupVersionHook(runtimehooksv1.BeforeMachineDrain)
+	if err != nil {
+		t.Fatalf("failed to determine BeforeMachineDrain hook: %v", err)
+	}
+	afterGVH, err := catalog.GroupVersionHook(runtimehooksv1.AfterMachineDrain)
+	if err != nil {
+		t.Fatalf("failed to determine AfterMachineDrain hook: %v", err)
+	}
+
+	retryResponse := runtimehooksv1.CommonRetryResponse{
+		CommonResponse: runtimehooksv1.CommonResponse{
+			Status:  runtimehooksv1.ResponseStatusSuccess,
+			Message: "waiting for storage replication",
+		},
+		RetryAfterSeconds: 30,
+	}
+	successResponse := runtimehooksv1.CommonRetryResponse{
+		CommonResponse: runtimehooksv1.CommonResponse{
+			Status: runtimehooksv1.ResponseStatusSuccess,
+		},
+	}
+	failureResponse := runtimehooksv1.CommonRetryResponse{
+		CommonResponse: runtimehooksv1.CommonResponse{
+			Status: runtimehooksv1.ResponseStatusFailure,
+		},
+	}
+
+	tests := []struct {
+		name                      string
+		hook                      runtimecatalog.Hook
+		callAllExtensionResponses map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject
+		wantResult                ctrl.Result
+		wantMessage               string
+		wantErr                   bool
+	}{
+		{
+			name: "BeforeMachineDrain blocks when hook returns retryAfterSeconds",
+			hook: runtimehooksv1.BeforeMachineDrain,
+			callAllExtensionResponses: map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+				beforeGVH: &runtimehooksv1.BeforeMachineDrainResponse{CommonRetryResponse: retryResponse},
+			},
+			wantResult:  ctrl.Result{RequeueAfter: 30 * time.Second},
+			wantMessage: "waiting for storage replication",
+		},
+		{
+			name: "BeforeMachineDrain does not block when hook succeeds",
+			hook: runtimehooksv1.BeforeMachineDrain,
+			callAllExtensionResponses: map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+				beforeGVH: &runtimehooksv1.BeforeMachineDrainResponse{CommonRetryResponse: successResponse},
+			},
+			wantResult: ctrl.Result{},
+		},
+		{
+			name: "BeforeMachineDrain fails when hook fails",
+			hook: runtimehooksv1.BeforeMachineDrain,
+			callAllExtensionResponses: map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+				beforeGVH: &runtimehooksv1.BeforeMachineDrainResponse{CommonRetryResponse: failureResponse},
+			},
+			wantErr: true,
+		},
+		{
+			name: "AfterMachineDrain blocks when hook returns retryAfterSeconds",
+			hook: runtimehooksv1.AfterMachineDrain,
+			callAllExtensionResponses: map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+				afterGVH: &runtimehooksv1.AfterMachineDrainResponse{CommonRetryResponse: retryResponse},
+			},
+			wantResult:  ctrl.Result{RequeueAfter: 30 * time.Second},
+			wantMessage: "waiting for storage replication",
+		},
+		{
+			name: "AfterMachineDrain does not block when hook succeeds",
+			hook: runtimehooksv1.AfterMachineDrain,
+			callAllExtensionResponses: map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+				afterGVH: &runtimehooksv1.AfterMachineDrainResponse{CommonRetryResponse: successResponse},
+			},
+			wantResult: ctrl.Result{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCatalog(catalog).
+				WithCallAllExtensionResponses(tt.callAllExtensionResponses).
+				Build()
+
+			r := &Reconciler{
+				RuntimeClient: runtimeClient,
+				hookCache:     cache.New[cache.HookEntry](ctx, cache.HookCacheDefaultTTL),
+			}
+			machine := newTestMachine()
+			machine.Status.NodeRef = clusterv1.MachineNodeReference{Name: "node"}
+			s := &scope{
+				cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+				machine: machine,
+			}
+
+			callHook := r.callBeforeMachineDrainHook
+			if runtimecatalog.HookName(tt.hook) == runtimecatalog.HookName(runtimehooksv1.AfterMachineDrain) {
+				callHook = r.callAfterMachineDrainHook
+			}
+
+			result, message, err := callHook(t.Context(), s)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result).To(Equal(tt.wantResult))
+			g.Expect(message).To(Equal(tt.wantMessage))
+			g.Expect(runtimeClient.CallAllCount(tt.hook)).To(Equal(1))
+
+			if !result.IsZero() {
+				// Call the hook again and verify the hook is not called again before retryAfterSeconds.
+				secondResult, secondMessage, err := callHook(t.Context(), s)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(secondResult.RequeueAfter).To(BeNumerically("<=", tt.wantResult.RequeueAfter))
+				g.Expect(secondMessage).To(Equal(tt.wantMessage))
+				g.Expect(runtimeClient.CallAllCount(tt.hook)).To(Equal(1))
+			}
+		})
+	}
+}
+
+func TestReconcileAfterMachineDrainHook(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	catalog := runtimecatalog.New()
+	if err := runtimehooksv1.AddToCatalog(catalog); err != nil {
+		t.Fatalf("failed to add hooks to catalog: %v", err)
+	}
+	afterGVH, err := catalog.GroupVersionHook(runtimehooksv1.AfterMachineDrain)
+	if err != nil {
+		t.Fatalf("failed to determine AfterMachineDrain hook: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		pending          bool
+		response         runtimehooksv1.CommonRetryResponse
+		wantResult       ctrl.Result
+		wantCalls        int
+		wantStillPending bool
+	}{
+		{
+			name:      "does not call the hook if it is not pending",
+			pending:   false,
+			wantCalls: 0,
+		},
+		{
+			name:    "calls the hook and marks it as done if it succeeds",
+			pending: true,
+			response: runtimehooksv1.CommonRetryResponse{
+				CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+			},
+			wantCalls: 1,
+		},
+		{
+			name:    "calls the hook and keeps it pending if it blocks",
+			pending: true,
+			response: runtimehooksv1.CommonRetryResponse{
+				CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+				RetryAfterSeconds: 30,
+			},
+			wantResult:       ctrl.Result{RequeueAfter: 30 * time.Second},
+			wantCalls:        1,
+			wantStillPending: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := newTestMachine()
+			machine.Status.NodeRef = clusterv1.MachineNodeReference{Name: "node"}
+			if tt.pending {
+				hooks.MarkObjectAsPending(machine, runtimehooksv1.AfterMachineDrain)
+			}
+
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCatalog(catalog).
+				WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+					afterGVH: &runtimehooksv1.AfterMachineDrainResponse{CommonRetryResponse: tt.response},
+				}).
+				Build()
+			r := &Reconciler{
+				Client:        fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(machine).Build(),
+				RuntimeClient: runtimeClient,
+				hookCache:     cache.New[cache.HookEntry](ctx, cache.HookCacheDefaultTTL),
+			}
+			s := &scope{
+				cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+				machine: machine,
+			}
+
+			result, err := r.reconcileAfterMachineDrainHook(t.Context(), s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result).To(Equal(tt.wantResult))
+			g.Expect(runtimeClient.CallAllCount(runtimehooksv1.AfterMachineDrain)).To(Equal(tt.wantCalls))
+			g.Expect(hooks.IsPending(runtimehooksv1.AfterMachineDrain, machine)).To(Equal(tt.wantStillPending))
+		})
+	}
+}
+
+func TestHookMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(hookMessage("Waiting for BeforeMachineDrain hook to succeed", "")).To(Equal("Waiting for BeforeMachineDrain hook to succeed"))
+	g.Expect(hookMessage("Waiting for BeforeMachineDrain hook to succeed", "waiting for storage replication")).
+		To(Equal("Waiting for BeforeMachineDrain hook to succeed: waiting for storage replication"))
+}
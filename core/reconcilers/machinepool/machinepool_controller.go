@@ -111,6 +111,12 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			predicates.ClusterPausedTransitions(mgr.GetScheme(), *r.predicateLog),
 			predicates.ResourceHasFilterLabel(mgr.GetScheme(), *r.predicateLog, r.WatchFilterValue),
 		).
+		Watches(
+			&clusterv1.Machine{},
+			// Note: MachinePool Machines are watched to react to MachineHealthCheck marking them for owner remediation.
+			handler.EnqueueRequestsFromMapFunc(r.infraMachineToMachinePoolMapper),
+			predicates.ResourceHasFilterLabel(mgr.GetScheme(), *r.predicateLog, r.WatchFilterValue),
+		).
 		WatchesRawSource(r.ClusterCache.GetClusterSource("machinepool", clusterToMachinePools)).
 		Build(ctx, r)
 	if err != nil {
@@ -231,6 +237,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		wrapErrMachinePoolReconcileFunc(r.reconcileBootstrap, "failed to reconcile bootstrap config"),
 		wrapErrMachinePoolReconcileFunc(r.reconcileInfrastructure, "failed to reconcile infrastructure"),
 		wrapErrMachinePoolReconcileFunc(r.getMachinesForMachinePool, "failed to get Machines for MachinePool"),
+		wrapErrMachinePoolReconcileFunc(r.reconcileUnhealthyMachines, "failed to reconcile unhealthy Machines"),
 		wrapErrMachinePoolReconcileFunc(r.reconcileNodeRefs, "failed to reconcile nodeRefs"),
 		wrapErrMachinePoolReconcileFunc(r.setMachinesUptoDate, "failed to set machines up to date"),
 	)
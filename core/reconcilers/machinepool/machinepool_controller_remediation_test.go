@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+)
+
+func TestReconcileUnhealthyMachines(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	newMachine := func(name string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  metav1.NamespaceDefault,
+				Finalizers: []string{clusterv1.MachineFinalizer},
+				Labels: map[string]string{
+					clusterv1.MachinePoolNameLabel: "mp",
+					clusterv1.ClusterNameLabel:     "cluster",
+				},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName: "cluster",
+			},
+		}
+	}
+
+	// unhealthyMachine has been marked for owner remediation by a MachineHealthCheck.
+	unhealthyMachine := newMachine("unhealthy")
+	conditions.Set(unhealthyMachine, metav1.Condition{
+		Type:   clusterv1.MachineHealthCheckSucceededCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineHealthCheckUnhealthyNodeReason,
+	})
+	conditions.Set(unhealthyMachine, metav1.Condition{
+		Type:   clusterv1.MachineOwnerRemediatedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineOwnerRemediatedWaitingForRemediationReason,
+	})
+	v1beta1conditions.MarkFalse(unhealthyMachine, clusterv1.MachineOwnerRemediatedV1Beta1Condition, clusterv1.WaitingForRemediationV1Beta1Reason, clusterv1.ConditionSeverityWarning, "")
+
+	// recoveredMachine has been marked for owner remediation but is now healthy again.
+	recoveredMachine := newMachine("recovered")
+	conditions.Set(recoveredMachine, metav1.Condition{
+		Type:   clusterv1.MachineHealthCheckSucceededCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.MachineHealthCheckSucceededReason,
+	})
+	conditions.Set(recoveredMachine, metav1.Condition{
+		Type:   clusterv1.MachineOwnerRemediatedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineOwnerRemediatedWaitingForRemediationReason,
+	})
+
+	healthyMachine := newMachine("healthy")
+	conditions.Set(healthyMachine, metav1.Condition{
+		Type:   clusterv1.MachineHealthCheckSucceededCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.MachineHealthCheckSucceededReason,
+	})
+
+	// deletingMachine has been marked for owner remediation but is already deleting.
+	deletingMachine := newMachine("deleting")
+	deletingMachine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	conditions.Set(deletingMachine, metav1.Condition{
+		Type:   clusterv1.MachineOwnerRemediatedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineOwnerRemediatedWaitingForRemediationReason,
+	})
+
+	machines := []*clusterv1.Machine{unhealthyMachine, recoveredMachine, healthyMachine, deletingMachine}
+	objs := []client.Object{}
+	for _, m := range machines {
+		objs = append(objs, m)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(&clusterv1.Machine{}).Build()
+
+	r := &Reconciler{Client: c}
+	s := &scope{
+		machinePool: &clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: "mp", Namespace: metav1.NamespaceDefault}},
+		machines:    machines,
+	}
+
+	res, err := r.reconcileUnhealthyMachines(ctx, s)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsZero()).To(BeTrue())
+
+	// The unhealthy Machine has been deleted (the fake client sets the deletionTimestamp because of the finalizer)
+	// and the OwnerRemediated condition has been set accordingly.
+	m := &clusterv1.Machine{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(unhealthyMachine), m)).To(Succeed())
+	g.Expect(m.DeletionTimestamp.IsZero()).To(BeFalse())
+	c1 := conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition)
+	g.Expect(c1).ToNot(BeNil())
+	g.Expect(c1.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(c1.Reason).To(Equal(clusterv1.MachinePoolMachineRemediationMachineDeletingReason))
+	g.Expect(v1beta1conditions.Get(m, clusterv1.MachineOwnerRemediatedV1Beta1Condition).Status).To(Equal(corev1.ConditionTrue))
+
+	// The OwnerRemediated condition has been removed from the recovered Machine and it has not been deleted.
+	m = &clusterv1.Machine{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(recoveredMachine), m)).To(Succeed())
+	g.Expect(m.DeletionTimestamp.IsZero()).To(BeTrue())
+	g.Expect(conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeNil())
+
+	// The healthy Machine has not been touched.
+	m = &clusterv1.Machine{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(healthyMachine), m)).To(Succeed())
+	g.Expect(m.DeletionTimestamp.IsZero()).To(BeTrue())
+
+	// The deleting Machine has not been remediated again.
+	m = &clusterv1.Machine{}
+	err = c.Get(ctx, client.ObjectKeyFromObject(deletingMachine), m)
+	if !apierrors.IsNotFound(err) {
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition).Reason).To(Equal(clusterv1.MachineOwnerRemediatedWaitingForRemediationReason))
+	}
+}
@@ -18,8 +18,10 @@ package cluster
 
 import (
 	"context"
+	"encoding/json"
 
 	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog/v2"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -35,6 +37,7 @@ func (r *Reconciler) getBlueprint(ctx context.Context, cluster *clusterv1.Cluste
 		ClusterClass:       clusterClass,
 		MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{},
 		MachinePools:       map[string]*scope.MachinePoolBlueprint{},
+		AuxiliaryResources: map[string]*unstructured.Unstructured{},
 	}
 
 	var err error
@@ -112,8 +115,39 @@ func (r *Reconciler) getBlueprint(ctx context.Context, cluster *clusterv1.Cluste
 			return nil, pkgerrors.Wrapf(err, "failed to get bootstrap config for ClusterClass %s, MachinePool class %q", klog.KObj(blueprint.ClusterClass), machinePoolClass.Class)
 		}
 
+		machinePoolBlueprint.HealthCheck = machinePoolClass.HealthCheck
 		blueprint.MachinePools[machinePoolClass.Class] = machinePoolBlueprint
 	}
 
+	// Loop over the auxiliary resources in ClusterClass
+	// and compute the related templates.
+	for _, auxiliaryResourceClass := range blueprint.ClusterClass.Spec.AuxiliaryResources {
+		template, err := getAuxiliaryResourceTemplate(auxiliaryResourceClass)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get template for ClusterClass %s, auxiliary resource %q", klog.KObj(blueprint.ClusterClass), auxiliaryResourceClass.Name)
+		}
+		blueprint.AuxiliaryResources[auxiliaryResourceClass.Name] = template
+	}
+
 	return blueprint, nil
 }
+
+// getAuxiliaryResourceTemplate returns the template of an auxiliary resource as an Unstructured object.
+// NOTE: Templates of auxiliary resources are defined inline in the ClusterClass, so differently from other templates
+// they are not read from the API server.
+func getAuxiliaryResourceTemplate(auxiliaryResourceClass clusterv1.AuxiliaryResourceClass) (*unstructured.Unstructured, error) {
+	template := &unstructured.Unstructured{}
+	template.SetAPIVersion(auxiliaryResourceClass.Template.APIVersion)
+	template.SetKind(auxiliaryResourceClass.Template.Kind)
+	template.SetLabels(auxiliaryResourceClass.Template.Metadata.Labels)
+	template.SetAnnotations(auxiliaryResourceClass.Template.Metadata.Annotations)
+
+	if auxiliaryResourceClass.Template.Spec != nil && len(auxiliaryResourceClass.Template.Spec.Raw) > 0 {
+		spec := map[string]interface{}{}
+		if err := json.Unmarshal(auxiliaryResourceClass.Template.Spec.Raw, &spec); err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to unmarshal spec")
+		}
+		template.Object["spec"] = spec
+	}
+	return template, nil
+}
@@ -21,7 +21,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -90,6 +92,18 @@ func TestGetBlueprint(t *testing.T) {
 		Build()
 	mps := []clusterv1.MachinePoolClass{*machinePools}
 
+	drainRuleClass := clusterv1.AuxiliaryResourceClass{
+		Name: "drain-rule",
+		Template: clusterv1.AuxiliaryResourceClassTemplate{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       "MachineDrainRule",
+			Metadata: clusterv1.ObjectMeta{
+				Labels: map[string]string{"foo": "bar"},
+			},
+			Spec: &apiextensionsv1.JSON{Raw: []byte(`{"drain":{"behavior":"Skip"}}`)},
+		},
+	}
+
 	// Define test cases.
 	tests := []struct {
 		name         string
@@ -159,6 +173,7 @@ func TestGetBlueprint(t *testing.T) {
 				},
 				MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{},
 				MachinePools:       map[string]*scope.MachinePoolBlueprint{},
+				AuxiliaryResources: map[string]*unstructured.Unstructured{},
 			},
 		},
 		{
@@ -186,6 +201,7 @@ func TestGetBlueprint(t *testing.T) {
 				},
 				MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{},
 				MachinePools:       map[string]*scope.MachinePoolBlueprint{},
+				AuxiliaryResources: map[string]*unstructured.Unstructured{},
 			},
 		},
 		{
@@ -236,7 +252,8 @@ func TestGetBlueprint(t *testing.T) {
 						HealthCheck:                   mdMachineHealthCheck,
 					},
 				},
-				MachinePools: map[string]*scope.MachinePoolBlueprint{},
+				MachinePools:       map[string]*scope.MachinePoolBlueprint{},
+				AuxiliaryResources: map[string]*unstructured.Unstructured{},
 			},
 		},
 		{
@@ -297,6 +314,7 @@ func TestGetBlueprint(t *testing.T) {
 				},
 				MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{},
 				MachinePools:       map[string]*scope.MachinePoolBlueprint{},
+				AuxiliaryResources: map[string]*unstructured.Unstructured{},
 			},
 		},
 		{
@@ -333,6 +351,7 @@ func TestGetBlueprint(t *testing.T) {
 						BootstrapTemplate:                 workerBootstrapTemplate,
 					},
 				},
+				AuxiliaryResources: map[string]*unstructured.Unstructured{},
 			},
 		},
 		{
@@ -365,6 +384,49 @@ func TestGetBlueprint(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should read a ClusterClass with auxiliary resources",
+			clusterClass: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(infraClusterTemplate).
+				WithControlPlaneTemplate(controlPlaneTemplate).
+				WithAuxiliaryResources(drainRuleClass).
+				Build(),
+			objects: []client.Object{
+				infraClusterTemplate,
+				controlPlaneTemplate,
+			},
+			want: &scope.ClusterBlueprint{
+				ClusterClass: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+					WithInfrastructureClusterTemplate(infraClusterTemplate).
+					WithControlPlaneTemplate(controlPlaneTemplate).
+					WithAuxiliaryResources(drainRuleClass).
+					Build(),
+				InfrastructureClusterTemplate: infraClusterTemplate,
+				ControlPlane: &scope.ControlPlaneBlueprint{
+					Template: controlPlaneTemplate,
+				},
+				MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{},
+				MachinePools:       map[string]*scope.MachinePoolBlueprint{},
+				AuxiliaryResources: map[string]*unstructured.Unstructured{
+					"drain-rule": {
+						Object: map[string]interface{}{
+							"apiVersion": clusterv1.GroupVersion.String(),
+							"kind":       "MachineDrainRule",
+							"metadata": map[string]interface{}{
+								"labels": map[string]interface{}{
+									"foo": "bar",
+								},
+							},
+							"spec": map[string]interface{}{
+								"drain": map[string]interface{}{
+									"behavior": "Skip",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -420,6 +482,7 @@ func TestGetBlueprint(t *testing.T) {
 			g.Expect(got.ControlPlane).To(BeComparableTo(tt.want.ControlPlane), cmp.Diff(got.ControlPlane, tt.want.ControlPlane))
 			g.Expect(tt.want.MachineDeployments).To(BeComparableTo(got.MachineDeployments), cmp.Diff(got.MachineDeployments, tt.want.MachineDeployments))
 			g.Expect(tt.want.MachinePools).To(BeComparableTo(got.MachinePools), cmp.Diff(got.MachinePools, tt.want.MachinePools))
+			g.Expect(tt.want.AuxiliaryResources).To(BeComparableTo(got.AuxiliaryResources), cmp.Diff(got.AuxiliaryResources, tt.want.AuxiliaryResources))
 		})
 	}
 }
@@ -21,12 +21,14 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -42,6 +45,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
@@ -72,19 +76,25 @@ import (
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinehealthchecks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedrainrules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
-// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;create;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;delete
 
 // Reconciler reconciles a managed topology for a Cluster object.
 type Reconciler struct {
 	Client       client.Client
 	ClusterCache clustercache.ClusterCache
 	// APIReader is used to list MachineSets directly via the API server to avoid
-	// race conditions caused by an outdated cache.
+	// race conditions caused by an outdated cache, and to list auxiliary resources
+	// without caching objects of arbitrary kinds.
 	APIReader client.Reader
 
 	RuntimeClient runtimeclient.Client
 
+	// PartialSecretCache is used to watch metadata of the Secrets referenced by variables, so Clusters are
+	// reconciled when the values of those variables change.
+	PartialSecretCache ctrlcache.Cache
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
@@ -102,8 +112,8 @@ type Reconciler struct {
 
 // SetupWithManager sets up the reconciler with the Manager.
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
-	if r.Client == nil || r.APIReader == nil || r.ClusterCache == nil {
-		return pkgerrors.New("Client, APIReader and ClusterCache must not be nil")
+	if r.Client == nil || r.APIReader == nil || r.ClusterCache == nil || r.PartialSecretCache == nil {
+		return pkgerrors.New("Client, APIReader, ClusterCache and PartialSecretCache must not be nil")
 	}
 
 	if feature.Gates.Enabled(feature.RuntimeSDK) && r.RuntimeClient == nil {
@@ -138,6 +148,17 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			// Only trigger Cluster reconciliation if the MachinePool is topology owned, the resource is changed.
 			predicates.ResourceIsTopologyOwned(mgr.GetScheme(), predicateLog),
 		).
+		WatchesRawSource(source.Kind(
+			r.PartialSecretCache,
+			&metav1.PartialObjectMetadata{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Secret",
+					APIVersion: "v1",
+				},
+			},
+			handler.TypedEnqueueRequestsFromMapFunc(r.secretToCluster),
+			predicates.TypedResourceIsChanged[*metav1.PartialObjectMetadata](mgr.GetScheme(), predicateLog),
+		)).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
 		Build(ctx, r)
@@ -146,6 +167,10 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
 	}
 
+	if err := indexByVariableSecretName(ctx, mgr); err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+
 	r.externalTracker = external.ObjectTracker{
 		Controller:      c,
 		Cache:           mgr.GetCache(),
@@ -354,6 +379,17 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result
 		return ctrl.Result{}, pkgerrors.Errorf("ClusterClass is not successfully reconciled: ClusterClass.status.observedGeneration must be %d, but is %d", clusterClass.GetGeneration(), clusterClass.Status.ObservedGeneration)
 	}
 
+	// If the Cluster is pinned to a previous generation of the ClusterClass, e.g. by a ClusterClassRollout, return
+	// without applying the changes to the ClusterClass to the Cluster topology.
+	// Note: This doesn't require requeue as updating the annotation will cause an additional reconcile in the Cluster.
+	pinned, err := isPinnedToPreviousClusterClassGeneration(s.Current.Cluster, clusterClass)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if pinned {
+		return ctrl.Result{}, nil
+	}
+
 	// Default and Validate the Cluster variables based on information from the ClusterClass.
 	// This step is needed as if the ClusterClass does not exist at Cluster creation some fields may not be defaulted or
 	// validated in the webhook.
@@ -405,12 +441,32 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result
 	}
 
 	// Reconciles current and desired state of the Cluster
+	// NOTE: The values of sensitive variables are masked, because errors are surfaced in conditions.
 	if err := r.reconcileState(ctx, s); err != nil {
-		return ctrl.Result{}, pkgerrors.Wrap(err, "error reconciling the Cluster topology")
+		return ctrl.Result{}, pkgerrors.Wrap(s.Masker.MaskError(err), "error reconciling the Cluster topology")
+	}
+
+	// Track the generation of the ClusterClass the topology of a pinned Cluster has been reconciled with, so it is
+	// possible to tell when the changes to the ClusterClass have been applied after the Cluster has been unpinned.
+	if _, ok := s.Current.Cluster.GetAnnotations()[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation]; ok {
+		annotations.AddAnnotations(s.Current.Cluster, map[string]string{
+			clusterv1.ClusterTopologyReconciledClusterClassGenerationAnnotation: strconv.FormatInt(clusterClass.GetGeneration(), 10),
+		})
+	} else {
+		delete(s.Current.Cluster.Annotations, clusterv1.ClusterTopologyReconciledClusterClassGenerationAnnotation)
 	}
 
 	// requeueAfter will not be 0 if any of the runtime hooks returns a blocking response.
 	requeueAfter := s.HookResponseTracker.AggregateRetryAfter()
+
+	// If maintenance windows are holding an upgrade, make sure to reconcile again when the next maintenance window opens.
+	if s.UpgradeTracker.MaintenanceWindow.IsHoldingUpgrade && !s.UpgradeTracker.MaintenanceWindow.NextOpen.IsZero() {
+		untilNextOpen := max(time.Until(s.UpgradeTracker.MaintenanceWindow.NextOpen), time.Second)
+		if requeueAfter == 0 || untilNextOpen < requeueAfter {
+			requeueAfter = untilNextOpen
+		}
+	}
+
 	if requeueAfter != 0 {
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
@@ -515,6 +571,26 @@ func (r *Reconciler) clusterClassToCluster(ctx context.Context, o client.Object)
 	return requests
 }
 
+// secretToCluster is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
+// for Clusters with variables referencing the Secret.
+func (r *Reconciler) secretToCluster(ctx context.Context, secret *metav1.PartialObjectMetadata) []ctrl.Request {
+	clusterList := &clusterv1.ClusterList{}
+	if err := r.Client.List(
+		ctx,
+		clusterList,
+		client.InNamespace(secret.GetNamespace()),
+		client.MatchingFields{variableSecretNameField: secret.GetName()},
+	); err != nil {
+		return nil
+	}
+
+	requests := []ctrl.Request{}
+	for i := range clusterList.Items {
+		requests = append(requests, ctrl.Request{NamespacedName: util.ObjectKey(&clusterList.Items[i])})
+	}
+	return requests
+}
+
 // machineDeploymentToCluster is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
 // for Cluster to update when one of its own MachineDeployments gets updated.
 func (r *Reconciler) machineDeploymentToCluster(_ context.Context, o client.Object) []ctrl.Request {
@@ -24,6 +24,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +32,8 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/internal/topology/auxiliaryresources"
+	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/labels"
 )
 
@@ -77,6 +80,14 @@ func (r *Reconciler) getCurrentState(ctx context.Context, s *scope.Scope) (*scop
 	}
 	currentState.MachinePools = mp
 
+	// A Cluster may have zero or more auxiliary resources and a Cluster is expected to have zero auxiliary resources on
+	// first reconcile.
+	auxiliaryResources, err := r.getCurrentAuxiliaryResourcesState(ctx, s.Blueprint.AuxiliaryResources, currentState.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	currentState.AuxiliaryResources = auxiliaryResources
+
 	return currentState, nil
 }
 
@@ -409,10 +420,24 @@ func (r *Reconciler) getCurrentMachinePoolState(ctx context.Context, blueprintMa
 			return nil, fmt.Errorf("%s %s referenced from MachinePool %s is not topology owned", infraMachinePoolObject.GetKind(), klog.KObj(infraMachinePoolObject), klog.KObj(m))
 		}
 
+		// Gets the MachineHealthCheck.
+		mhc := &clusterv1.MachineHealthCheck{}
+		// MachineHealthCheck always has the same name and namespace as the MachinePool it belongs to.
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: m.Namespace, Name: m.Name}, mhc); err != nil {
+			// reset the machineHealthCheck to nil if there is an error.
+			mhc = nil
+
+			// Each MachinePool isn't required to have a MachineHealthCheck. Ignore the error if it's of the type not found, but return any other error.
+			if !apierrors.IsNotFound(err) {
+				return nil, pkgerrors.Wrap(err, fmt.Sprintf("failed to get MachineHealthCheck for MachinePool %s", klog.KObj(m)))
+			}
+		}
+
 		state[mpTopologyName] = &scope.MachinePoolState{
 			Object:                          m,
 			BootstrapObject:                 bootstrapObject,
 			InfrastructureMachinePoolObject: infraMachinePoolObject,
+			MachineHealthCheck:              mhc,
 		}
 	}
 	return state, nil
@@ -476,3 +501,73 @@ func getMPClassName(cluster *clusterv1.Cluster, mpTopologyName string) (bool, st
 	}
 	return false, ""
 }
+
+// getCurrentAuxiliaryResourcesState queries for all auxiliary resources of a Cluster and returns them in a map
+// keyed by the name of the auxiliary resource in the ClusterClass.
+// NOTE: Auxiliary resources are looked up for the kinds defined in the ClusterClass and for the kinds tracked in
+// the Cluster, so it is possible to find auxiliary resources of kinds that are not anymore defined in the ClusterClass.
+// NOTE: Auxiliary resources can be of any kind, so they are read via the APIReader to avoid starting an informer
+// and caching all the objects of every kind used in a ClusterClass.
+func (r *Reconciler) getCurrentAuxiliaryResourcesState(ctx context.Context, blueprintAuxiliaryResources map[string]*unstructured.Unstructured, cluster *clusterv1.Cluster) (map[string]*unstructured.Unstructured, error) {
+	state := map[string]*unstructured.Unstructured{}
+
+	gvks, err := auxiliaryresources.Kinds(blueprintAuxiliaryResources, cluster.Annotations)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to read auxiliary resources for managed topology")
+	}
+
+	for _, gvk := range gvks {
+		// List all the auxiliary resources of a kind in the current cluster and in a managed topology.
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		err := r.APIReader.List(ctx, list,
+			client.MatchingLabels{
+				clusterv1.ClusterNameLabel:          cluster.Name,
+				clusterv1.ClusterTopologyOwnedLabel: "",
+			},
+			client.HasLabels{clusterv1.ClusterTopologyAuxiliaryResourceNameLabel},
+			client.InNamespace(cluster.Namespace),
+		)
+		if err != nil {
+			// If the kind does not exist anymore, e.g. because the corresponding CRD has been deleted,
+			// there are no auxiliary resources of this kind.
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, pkgerrors.Wrapf(err, "failed to read %s auxiliary resources for managed topology", gvk.Kind)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+
+			// Skip auxiliary resources that are being deleted.
+			// NOTE: This allows to create a new auxiliary resource with the same name, but of a different kind,
+			// while the previous one is still deleting.
+			if !obj.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+
+			// Skip objects which are not owned by the Cluster, even if they have the auxiliary resource labels.
+			// NOTE: This ensures objects created by users or by other controllers are never adopted as auxiliary resources.
+			if !util.IsOwnedByObject(obj, cluster, clusterv1.GroupVersion.WithKind("Cluster").GroupKind()) {
+				continue
+			}
+
+			// Retrieve the name of the auxiliary resource in the ClusterClass from a well-defined label.
+			name := obj.GetLabels()[clusterv1.ClusterTopologyAuxiliaryResourceNameLabel]
+			if name == "" {
+				return nil, fmt.Errorf("failed to find label %s in %s %s", clusterv1.ClusterTopologyAuxiliaryResourceNameLabel, obj.GetKind(), klog.KObj(obj))
+			}
+
+			// Make sure that the name of the auxiliary resource stays unique.
+			// If we've already seen an auxiliary resource with the same name
+			// this is an error, probably caused from manual modifications or a race condition.
+			if _, ok := state[name]; ok {
+				return nil, fmt.Errorf("duplicate %s %s found for label %s: %s", obj.GetKind(), klog.KObj(obj), clusterv1.ClusterTopologyAuxiliaryResourceNameLabel, name)
+			}
+			state[name] = obj
+		}
+	}
+
+	return state, nil
+}
@@ -1229,6 +1229,133 @@ func testGetCurrentState(t *testing.T, controlPlaneContractVersion string) {
 	}
 }
 
+func TestGetCurrentAuxiliaryResourcesState(t *testing.T) {
+	newDrainRule := func(name, clusterName string, labels map[string]string) *clusterv1.MachineDrainRule {
+		drainRule := &clusterv1.MachineDrainRule{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel:          clusterName,
+					clusterv1.ClusterTopologyOwnedLabel: "",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       clusterName,
+					},
+				},
+			},
+			Spec: clusterv1.MachineDrainRuleSpec{
+				Drain: clusterv1.MachineDrainRuleDrainConfig{
+					Behavior: clusterv1.MachineDrainRuleDrainBehaviorSkip,
+				},
+			},
+		}
+		for k, v := range labels {
+			drainRule.Labels[k] = v
+		}
+		return drainRule
+	}
+	drainRuleTemplate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": clusterv1.GroupVersion.String(),
+			"kind":       "MachineDrainRule",
+		},
+	}
+
+	tests := []struct {
+		name               string
+		auxiliaryResources map[string]*unstructured.Unstructured
+		annotations        map[string]string
+		objects            []client.Object
+		want               []string
+		wantErr            bool
+	}{
+		{
+			name:               "Should read auxiliary resources of the kinds defined in the ClusterClass",
+			auxiliaryResources: map[string]*unstructured.Unstructured{"drain-rule": drainRuleTemplate},
+			objects: []client.Object{
+				newDrainRule("cluster1-drain-rule", "cluster1", map[string]string{clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule"}),
+				// Auxiliary resource of another Cluster.
+				newDrainRule("cluster2-drain-rule", "cluster2", map[string]string{clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule"}),
+				// MachineDrainRule which is not an auxiliary resource.
+				newDrainRule("cluster1-other", "cluster1", nil),
+			},
+			want: []string{"drain-rule"},
+		},
+		{
+			name: "Should read auxiliary resources of the kinds tracked in the Cluster",
+			annotations: map[string]string{
+				clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation: "cluster.x-k8s.io/v1beta2/MachineDrainRule",
+			},
+			objects: []client.Object{
+				newDrainRule("cluster1-drain-rule", "cluster1", map[string]string{clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule"}),
+			},
+			want: []string{"drain-rule"},
+		},
+		{
+			name: "Should ignore kinds which do not exist",
+			annotations: map[string]string{
+				clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation: "does-not-exist.cluster.x-k8s.io/v1beta2/Foo",
+			},
+			want: []string{},
+		},
+		{
+			name:               "Should ignore objects with the auxiliary resource labels which are not owned by the Cluster",
+			auxiliaryResources: map[string]*unstructured.Unstructured{"drain-rule": drainRuleTemplate},
+			objects: []client.Object{
+				func() client.Object {
+					drainRule := newDrainRule("cluster1-drain-rule", "cluster1", map[string]string{clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule"})
+					drainRule.OwnerReferences = nil
+					return drainRule
+				}(),
+			},
+			want: []string{},
+		},
+		{
+			name:               "Fails if there are duplicate auxiliary resources",
+			auxiliaryResources: map[string]*unstructured.Unstructured{"drain-rule": drainRuleTemplate},
+			objects: []client.Object{
+				newDrainRule("cluster1-drain-rule", "cluster1", map[string]string{clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule"}),
+				newDrainRule("cluster1-drain-rule-copy", "cluster1", map[string]string{clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule"}),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := builder.Cluster(metav1.NamespaceDefault, "cluster1").
+				WithAnnotations(tt.annotations).
+				Build()
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(fakeScheme).
+				WithObjects(tt.objects...).
+				Build()
+
+			r := &Reconciler{
+				Client:    fakeClient,
+				APIReader: fakeClient,
+			}
+			got, err := r.getCurrentAuxiliaryResourcesState(ctx, tt.auxiliaryResources, cluster)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(slices.Collect(maps.Keys(got))).To(ConsistOf(tt.want))
+		})
+	}
+}
+
 func TestAlignRefAPIVersion(t *testing.T) {
 	tests := []struct {
 		name                     string
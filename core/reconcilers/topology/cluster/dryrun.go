@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	coreadmission "sigs.k8s.io/cluster-api/core/webhooks/admission"
+	"sigs.k8s.io/cluster-api/exp/topology/desiredstate"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/util/cache"
+)
+
+// SetupForDryRun prepares the Reconciler to compute the desired state of Cluster topologies without a manager,
+// e.g. to plan changes to Cluster topologies with clusterctl.
+func (r *Reconciler) SetupForDryRun(ctx context.Context) error {
+	if r.Client == nil || r.APIReader == nil || r.ClusterCache == nil {
+		return pkgerrors.New("Client, APIReader and ClusterCache must not be nil")
+	}
+
+	var err error
+	r.hookCache = cache.New[cache.HookEntry](ctx, cache.HookCacheDefaultTTL)
+	r.desiredStateGenerator, err = desiredstate.NewGenerator(
+		r.Client,
+		r.ClusterCache,
+		r.RuntimeClient,
+		r.hookCache,
+		cache.New[desiredstate.GenerateUpgradePlanCacheEntry](ctx, 10*time.Minute),
+	)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed creating desired state generator")
+	}
+	return nil
+}
+
+// ComputeDesiredState computes the blueprint, the current state and the desired state of the topology of a Cluster
+// without reconciling them; the returned scope can be used to compare the current and the desired state.
+// NOTE: Differently from reconcile, the ClusterClass is not required to be reconciled, because it might not
+// exist yet in the management cluster; the ClusterClass variables are expected to be set in its status.
+func (r *Reconciler) ComputeDesiredState(ctx context.Context, cluster *clusterv1.Cluster) (*scope.Scope, error) {
+	if r.desiredStateGenerator == nil {
+		return nil, pkgerrors.New("Reconciler must be setup for dry run")
+	}
+	if !cluster.Spec.Topology.IsDefined() {
+		return nil, pkgerrors.Errorf("Cluster %s does not have a managed topology", cluster.Name)
+	}
+
+	s := scope.New(cluster)
+
+	clusterClass := &clusterv1.ClusterClass{}
+	key := s.Current.Cluster.GetClassKey()
+	if err := r.Client.Get(ctx, key, clusterClass); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to retrieve ClusterClass %s", key)
+	}
+
+	if errs := (&coreadmission.Cluster{}).DefaultAndValidateVariables(ctx, s.Current.Cluster, nil, clusterClass); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Cluster").GroupKind(), s.Current.Cluster.Name, errs)
+	}
+
+	var err error
+	s.Blueprint, err = r.getBlueprint(ctx, s.Current.Cluster, clusterClass)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error reading the ClusterClass")
+	}
+
+	s.Current, err = r.getCurrentState(ctx, s)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error reading current state of the Cluster topology")
+	}
+
+	s.Desired, err = r.desiredStateGenerator.Generate(ctx, s)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error computing the desired state of the Cluster topology")
+	}
+	return s, nil
+}
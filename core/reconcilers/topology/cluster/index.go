@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	// variableSecretNameField is used by the topology controller for indexing Clusters
+	// by the names of the Secrets referenced by their variables.
+	variableSecretNameField = "spec.topology.variables.valueFrom.secretKeyRef.name"
+)
+
+// indexByVariableSecretName adds the index by the names of the Secrets referenced by variables to the
+// managers cache.
+func indexByVariableSecretName(ctx context.Context, mgr ctrl.Manager) error {
+	if err := mgr.GetCache().IndexField(ctx, &clusterv1.Cluster{},
+		variableSecretNameField,
+		clusterByVariableSecretName,
+	); err != nil {
+		return pkgerrors.Wrap(err, "error setting index field for Secrets referenced by variables")
+	}
+	return nil
+}
+
+func clusterByVariableSecretName(o client.Object) []string {
+	cluster, ok := o.(*clusterv1.Cluster)
+	if !ok {
+		panic(fmt.Sprintf("Expected Cluster but got a %T", o))
+	}
+	if !cluster.Spec.Topology.IsDefined() {
+		return nil
+	}
+
+	secretNames := sets.Set[string]{}
+	addSecretNames := func(variables []clusterv1.ClusterVariable) {
+		for _, variable := range variables {
+			if variable.ValueFrom.IsDefined() {
+				secretNames.Insert(variable.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+	addSecretNames(cluster.Spec.Topology.Variables)
+	addSecretNames(cluster.Spec.Topology.ControlPlane.Variables.Overrides)
+	for _, md := range cluster.Spec.Topology.Workers.MachineDeployments {
+		addSecretNames(md.Variables.Overrides)
+	}
+	for _, mp := range cluster.Spec.Topology.Workers.MachinePools {
+		addSecretNames(mp.Variables.Overrides)
+	}
+	return sets.List(secretNames)
+}
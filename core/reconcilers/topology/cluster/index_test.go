@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestClusterByVariableSecretName(t *testing.T) {
+	secretVariable := func(name, secretName string) clusterv1.ClusterVariable {
+		return clusterv1.ClusterVariable{
+			Name: name,
+			ValueFrom: clusterv1.ClusterVariableValueSource{
+				SecretKeyRef: clusterv1.ClusterVariableSecretKeyReference{Name: secretName, Key: "value"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		object   client.Object
+		expected []string
+	}{
+		{
+			name:     "when cluster has no Topology",
+			object:   &clusterv1.Cluster{},
+			expected: nil,
+		},
+		{
+			name: "when cluster has no variables referencing Secrets",
+			object: &clusterv1.Cluster{
+				Spec: clusterv1.ClusterSpec{
+					Topology: clusterv1.Topology{
+						ClassRef: clusterv1.ClusterClassRef{Name: "class"},
+						Version:  "v1.35.0",
+						Variables: []clusterv1.ClusterVariable{
+							{Name: "plain"},
+						},
+					},
+				},
+			},
+			expected: []string{},
+		},
+		{
+			name: "when cluster has variables and overrides referencing Secrets",
+			object: &clusterv1.Cluster{
+				Spec: clusterv1.ClusterSpec{
+					Topology: clusterv1.Topology{
+						ClassRef: clusterv1.ClusterClassRef{Name: "class"},
+						Version:  "v1.35.0",
+						Variables: []clusterv1.ClusterVariable{
+							secretVariable("token", "token-secret"),
+							secretVariable("password", "shared-secret"),
+						},
+						ControlPlane: clusterv1.ControlPlaneTopology{
+							Variables: clusterv1.ControlPlaneVariables{
+								Overrides: []clusterv1.ClusterVariable{secretVariable("token", "cp-secret")},
+							},
+						},
+						Workers: clusterv1.WorkersTopology{
+							MachineDeployments: []clusterv1.MachineDeploymentTopology{
+								{
+									Name: "md",
+									Variables: clusterv1.MachineDeploymentVariables{
+										Overrides: []clusterv1.ClusterVariable{secretVariable("token", "shared-secret")},
+									},
+								},
+							},
+							MachinePools: []clusterv1.MachinePoolTopology{
+								{
+									Name: "mp",
+									Variables: clusterv1.MachinePoolVariables{
+										Overrides: []clusterv1.ClusterVariable{secretVariable("token", "mp-secret")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"cp-secret", "mp-secret", "shared-secret", "token-secret"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := clusterByVariableSecretName(test.object)
+			g.Expect(got).To(Equal(test.expected))
+		})
+	}
+}
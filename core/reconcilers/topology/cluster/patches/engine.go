@@ -45,8 +45,9 @@ import (
 )
 
 // Engine is a patch engine which applies patches defined in a ClusterBlueprint to a ClusterState.
+// Apply also returns a Masker for the values of sensitive variables, which can be used to mask them e.g. in logs.
 type Engine interface {
-	Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) error
+	Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) (*variables.Masker, error)
 }
 
 // NewEngine creates a new patch engine.
@@ -69,10 +70,10 @@ type engine struct {
 //   - Then for all ClusterClassPatches of a ClusterClass, JSON or JSON merge patches are generated
 //     and successively applied to the templates in the GeneratePatchesRequest.
 //   - Eventually the patched templates are used to update the specs of the desired objects.
-func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) error {
+func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) (_ *variables.Masker, reterr error) {
 	// Return if there are no patches.
 	if len(blueprint.ClusterClass.Spec.Patches) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	log := ctrl.LoggerFrom(ctx)
@@ -80,15 +81,28 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 	// Determine contract version used by the ControlPlane.
 	controlPlaneContractVersion, err := contract.GetContractVersionForVersion(ctx, e.client, desired.ControlPlane.Object.GroupVersionKind().GroupKind(), desired.ControlPlane.Object.GroupVersionKind().Version)
 	if err != nil {
-		return pkgerrors.Wrapf(err, "failed to generate patch request: failed to get contract version for the ControlPlane object")
+		return nil, pkgerrors.Wrapf(err, "failed to generate patch request: failed to get contract version for the ControlPlane object")
 	}
 
 	// Create a patch generation request.
 	req, err := createRequest(ctx, blueprint, desired, controlPlaneContractVersion)
 	if err != nil {
-		return pkgerrors.Wrapf(err, "failed to generate patch request")
+		return nil, pkgerrors.Wrapf(err, "failed to generate patch request")
 	}
 
+	// Resolve the values of variables set using valueFrom.
+	// NOTE: Resolved values are only used to compute variables for patches; the values of sensitive variables
+	// are masked in errors and logs.
+	resolvedTopology, masker, err := variables.ResolveValuesFrom(ctx, e.client, desired.Cluster.Namespace, blueprint.Topology, blueprint.ClusterClass.Status.Variables)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to resolve variables")
+	}
+	defer func() {
+		reterr = masker.MaskError(reterr)
+	}()
+	resolvedBlueprint := *blueprint
+	resolvedBlueprint.Topology = resolvedTopology
+
 	// Loop over patches in ClusterClass, generate patches and apply them to the request,
 	// respecting the order in which they are defined.
 	for i := range blueprint.ClusterClass.Spec.Patches {
@@ -101,15 +115,15 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 		if clusterClassPatch.External == nil {
 			definitionFrom = clusterv1.VariableDefinitionFromInline
 		}
-		if err := addVariablesForPatch(blueprint, desired, req, definitionFrom, controlPlaneContractVersion); err != nil {
-			return pkgerrors.Wrapf(err, "failed to calculate variables for patch %q", clusterClassPatch.Name)
+		if err := addVariablesForPatch(&resolvedBlueprint, desired, req, definitionFrom, controlPlaneContractVersion); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to calculate variables for patch %q", clusterClassPatch.Name)
 		}
 		log.V(5).Info("Applying patch to templates")
 
 		// Create patch generator for the current patch.
-		generator, err := createPatchGenerator(e.runtimeClient, &clusterClassPatch)
+		generator, err := createPatchGenerator(e.runtimeClient, &clusterClassPatch, blueprint.ClusterClass.Spec.Variables)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Generate patches.
@@ -118,12 +132,12 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 		// version of the request (including the patched version of the templates).
 		resp, err := generator.Generate(ctx, desired.Cluster, req)
 		if err != nil {
-			return pkgerrors.Wrapf(err, "failed to generate patches for patch %q", clusterClassPatch.Name)
+			return nil, pkgerrors.Wrapf(err, "failed to generate patches for patch %q", clusterClassPatch.Name)
 		}
 
 		// Apply patches to the request.
-		if err := applyPatchesToRequest(ctx, req, resp); err != nil {
-			return pkgerrors.Wrapf(err, "failed to apply patches for patch %q", clusterClassPatch.Name)
+		if err := applyPatchesToRequest(ctx, req, resp, masker); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to apply patches for patch %q", clusterClassPatch.Name)
 		}
 	}
 
@@ -148,17 +162,17 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 
 		_, err := validator.Validate(ctx, desired.Cluster, validationRequest)
 		if err != nil {
-			return pkgerrors.Wrapf(err, "validation of patch %q failed", clusterClassPatch.Name)
+			return nil, pkgerrors.Wrapf(err, "validation of patch %q failed", clusterClassPatch.Name)
 		}
 	}
 
 	// Use patched templates to update the desired state objects.
 	log.V(5).Info("Applying patched templates to desired state")
 	if err := updateDesiredState(ctx, req, blueprint, desired, controlPlaneContractVersion); err != nil {
-		return pkgerrors.Wrapf(err, "failed to apply patches to desired state")
+		return nil, pkgerrors.Wrapf(err, "failed to apply patches to desired state")
 	}
 
-	return nil
+	return masker, nil
 }
 
 // addVariablesForPatch adds variables for a given ClusterClassPatch to the items in the PatchRequest.
@@ -443,6 +457,32 @@ func createRequest(ctx context.Context, blueprint *scope.ClusterBlueprint, desir
 		req.Items = append(req.Items, *t)
 	}
 
+	// Add the templates for all the auxiliary resources in the Cluster.
+	// NOTE: Auxiliary resources are held by the Cluster, and they are identified in the request by the
+	// auxiliary resource name label.
+	for name, auxiliaryResource := range desired.AuxiliaryResources {
+		// Get corresponding auxiliary resource template from the ClusterClass.
+		template, ok := blueprint.AuxiliaryResources[name]
+		if !ok {
+			return nil, pkgerrors.Errorf("failed to lookup auxiliary resource %q in ClusterClass", name)
+		}
+
+		// Syncing labels/annotations added (during desired state computation) to the desired state back into the template, so the patch engine can consider them.
+		if err := patchUnstructured(ctx, template, auxiliaryResource, []patchUnstructuredFields{
+			{Src: []string{"metadata", "labels"}, Dest: []string{"metadata", "labels"}},
+			{Src: []string{"metadata", "annotations"}, Dest: []string{"metadata", "annotations"}},
+		}); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to prepare %s for auxiliary resource %q for patching", template.GetKind(), name)
+		}
+		t, err := newRequestItemBuilder(template).
+			WithHolder(desired.Cluster, clusterv1.GroupVersion.WithKind("Cluster"), "").
+			Build()
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to prepare %s for auxiliary resource %q for patching", template.GetKind(), name)
+		}
+		req.Items = append(req.Items, *t)
+	}
+
 	return req, nil
 }
 
@@ -469,10 +509,10 @@ func lookupMPTopology(topology clusterv1.Topology, mpTopologyName string) (*clus
 // createPatchGenerator creates a patch generator for the given patch.
 // NOTE: Currently only inline JSON patches are supported; in the future we will add
 // external patches as well.
-func createPatchGenerator(runtimeClient runtimeclient.Client, patch *clusterv1.ClusterClassPatch) (api.Generator, error) {
+func createPatchGenerator(runtimeClient runtimeclient.Client, patch *clusterv1.ClusterClassPatch, definitions []clusterv1.ClusterClassVariable) (api.Generator, error) {
 	// Return a jsonPatchGenerator if there are PatchDefinitions in the patch.
 	if len(patch.Definitions) > 0 {
-		return inline.NewGenerator(patch), nil
+		return inline.NewGenerator(patch, definitions), nil
 	}
 	// Return an externalPatchGenerator if there is an external configuration in the patch.
 	if patch.External != nil && patch.External.GeneratePatchesExtension != "" {
@@ -490,16 +530,16 @@ func createPatchGenerator(runtimeClient runtimeclient.Client, patch *clusterv1.C
 
 // applyPatchesToRequest updates the templates of a GeneratePatchesRequest by applying the patches
 // of a GeneratePatchesResponse.
-func applyPatchesToRequest(ctx context.Context, req *runtimehooksv1.GeneratePatchesRequest, resp *runtimehooksv1.GeneratePatchesResponse) error {
+func applyPatchesToRequest(ctx context.Context, req *runtimehooksv1.GeneratePatchesRequest, resp *runtimehooksv1.GeneratePatchesResponse, masker *variables.Masker) error {
 	for _, patch := range resp.Items {
-		if err := applyPatchToRequest(ctx, req, patch); err != nil {
+		if err := applyPatchToRequest(ctx, req, patch, masker); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func applyPatchToRequest(ctx context.Context, req *runtimehooksv1.GeneratePatchesRequest, patch runtimehooksv1.GeneratePatchesResponseItem) (reterr error) {
+func applyPatchToRequest(ctx context.Context, req *runtimehooksv1.GeneratePatchesRequest, patch runtimehooksv1.GeneratePatchesResponseItem, masker *variables.Masker) (reterr error) {
 	log := ctrl.LoggerFrom(ctx).WithValues("uid", patch.UID)
 
 	defer func() {
@@ -524,10 +564,10 @@ func applyPatchToRequest(ctx context.Context, req *runtimehooksv1.GeneratePatche
 
 	switch patch.PatchType {
 	case runtimehooksv1.JSONPatchType:
-		log.V(5).Info("Accumulating JSON patch", "patch", string(patch.Patch))
+		log.V(5).Info("Accumulating JSON patch", "patch", masker.Mask(string(patch.Patch)))
 		jsonPatch, err := jsonpatch.DecodePatch(patch.Patch)
 		if err != nil {
-			log.Error(err, fmt.Sprintf("Failed to apply patch with uid %q: error decoding json patch (RFC6902)", requestItem.UID), "patch", string(patch.Patch))
+			log.Error(err, fmt.Sprintf("Failed to apply patch with uid %q: error decoding json patch (RFC6902)", requestItem.UID), "patch", masker.Mask(string(patch.Patch)))
 			return pkgerrors.Wrap(err, "failed to apply patch: error decoding json patch (RFC6902)")
 		}
 
@@ -540,7 +580,7 @@ func applyPatchToRequest(ctx context.Context, req *runtimehooksv1.GeneratePatche
 
 		patchedTemplate, err = jsonPatch.Apply(requestItem.Object.Raw)
 		if err != nil {
-			log.Error(err, fmt.Sprintf("Failed to apply patch with uid %q: error applying json patch (RFC6902)", requestItem.UID), "patch", string(patch.Patch))
+			log.Error(err, fmt.Sprintf("Failed to apply patch with uid %q: error applying json patch (RFC6902)", requestItem.UID), "patch", masker.Mask(string(patch.Patch)))
 			return pkgerrors.Wrap(err, "failed to apply patch: error applying json patch (RFC6902)")
 		}
 	case runtimehooksv1.JSONMergePatchType:
@@ -551,10 +591,10 @@ func applyPatchToRequest(ctx context.Context, req *runtimehooksv1.GeneratePatche
 			return nil
 		}
 
-		log.V(5).Info("Accumulating JSON merge patch", "patch", string(patch.Patch))
+		log.V(5).Info("Accumulating JSON merge patch", "patch", masker.Mask(string(patch.Patch)))
 		patchedTemplate, err = jsonpatch.MergePatch(requestItem.Object.Raw, patch.Patch)
 		if err != nil {
-			log.Error(err, fmt.Sprintf("Failed to apply patch with uid %q: error applying json merge patch (RFC7386)", requestItem.UID), "patch", string(patch.Patch))
+			log.Error(err, fmt.Sprintf("Failed to apply patch with uid %q: error applying json merge patch (RFC7386)", requestItem.UID), "patch", masker.Mask(string(patch.Patch)))
 			return pkgerrors.Wrap(err, "failed to apply patch: error applying json merge patch (RFC7386)")
 		}
 	}
@@ -598,6 +638,7 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 		{"metadata", "labels", clusterv1.ClusterTopologyOwnedLabel},
 		{"metadata", "labels", clusterv1.ClusterTopologyMachineDeploymentNameLabel},
 		{"metadata", "labels", clusterv1.ClusterTopologyMachinePoolNameLabel},
+		{"metadata", "labels", clusterv1.ClusterTopologyAuxiliaryResourceNameLabel},
 		{"metadata", "annotations", clusterv1.TemplateClonedFromNameAnnotation},
 		{"metadata", "annotations", clusterv1.TemplateClonedFromGroupKindAnnotation},
 	}
@@ -695,6 +736,17 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 		}
 	}
 
+	// Update all the auxiliary resources.
+	for name, auxiliaryResource := range desired.AuxiliaryResources {
+		auxiliaryResourceTemplate, err := getTemplateAsUnstructured(req, "Cluster", "", requestTopologyName{auxiliaryResourceName: name})
+		if err != nil {
+			return err
+		}
+		if err := patchAuxiliaryResource(ctx, auxiliaryResource, auxiliaryResourceTemplate, PreserveFields(alwaysPreserveLabelsAndAnnotations)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -56,6 +56,7 @@ func TestApply(t *testing.T) {
 		machineDeploymentInfrastructureMachineTemplate map[string]map[string]interface{}
 		machinePoolBootstrapConfig                     map[string]map[string]interface{}
 		machinePoolInfrastructureMachinePool           map[string]map[string]interface{}
+		auxiliaryResources                             map[string]map[string]interface{}
 	}
 
 	tests := []struct {
@@ -1151,6 +1152,57 @@ func TestApply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Should apply JSON patches to auxiliary resources",
+			patches: []clusterv1.ClusterClassPatch{
+				{
+					Name: "fake-patch1",
+					Definitions: []clusterv1.PatchDefinition{
+						{
+							Selector: clusterv1.PatchSelector{
+								APIVersion: clusterv1.GroupVersion.String(),
+								Kind:       "MachineDrainRule",
+								MatchResources: clusterv1.PatchSelectorMatch{
+									AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+										Names: []string{"drain-rule"},
+									},
+								},
+							},
+							JSONPatches: []clusterv1.JSONPatch{
+								{
+									Op:    "replace",
+									Path:  "/spec/drain/behavior",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`"Skip"`)},
+								},
+								{
+									Op:   "add",
+									Path: "/spec/machines",
+									ValueFrom: &clusterv1.JSONPatchValue{
+										Template: `[{"selector":{"matchLabels":{"cluster.x-k8s.io/cluster-name":"{{ .builtin.cluster.name }}"}}}]`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedFields: expectedFields{
+				auxiliaryResources: map[string]map[string]interface{}{
+					"drain-rule": {
+						"spec.drain.behavior": "Skip",
+						"spec.machines": []interface{}{
+							map[string]interface{}{
+								"selector": map[string]interface{}{
+									"matchLabels": map[string]interface{}{
+										"cluster.x-k8s.io/cluster-name": "cluster1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		for _, controlPlaneContractVersion := range []string{"v1beta1", "v1beta2"} {
@@ -1163,6 +1215,7 @@ func TestApply(t *testing.T) {
 				//     * ControlPlaneTemplate with a corresponding ControlPlane InfrastructureMachineTemplate.
 				//     * MachineDeploymentClass "default-worker" with corresponding BootstrapTemplate and InfrastructureMachineTemplate.
 				//     * MachinePoolClass "default-mp-worker" with corresponding BootstrapTemplate and InfrastructureMachinePoolTemplate.
+				//     * A "drain-rule" auxiliary resource of kind MachineDrainRule.
 				//   * The corresponding Cluster.spec.topology:
 				//     * with 3 ControlPlane replicas
 				//     * with a "default-worker-topo1" MachineDeploymentTopology without replicas (based on "default-worker")
@@ -1251,6 +1304,10 @@ func TestApply(t *testing.T) {
 					expectedBootstrapConfig[mpTopology] = mp.BootstrapObject.DeepCopy()
 					expectedInfrastructureMachinePool[mpTopology] = mp.InfrastructureMachinePoolObject.DeepCopy()
 				}
+				expectedAuxiliaryResources := map[string]*unstructured.Unstructured{}
+				for name, auxiliaryResource := range desired.AuxiliaryResources {
+					expectedAuxiliaryResources[name] = auxiliaryResource.DeepCopy()
+				}
 
 				// Set expected fields on the copy of the objects, so they can be used for comparison with the result of Apply.
 				if tt.expectedFields.infrastructureCluster != nil {
@@ -1274,9 +1331,12 @@ func TestApply(t *testing.T) {
 				for mpTopology, expectedFields := range tt.expectedFields.machinePoolInfrastructureMachinePool {
 					setFields(expectedInfrastructureMachinePool[mpTopology], expectedFields)
 				}
+				for name, expectedFields := range tt.expectedFields.auxiliaryResources {
+					setFields(expectedAuxiliaryResources[name], expectedFields)
+				}
 
 				// Apply patches.
-				if err := patchEngine.Apply(context.Background(), blueprint, desired); err != nil {
+				if _, err := patchEngine.Apply(context.Background(), blueprint, desired); err != nil {
 					if !tt.wantErr {
 						t.Fatal(err)
 					}
@@ -1300,6 +1360,9 @@ func TestApply(t *testing.T) {
 				for mpTopology, infrastructureMachinePool := range expectedInfrastructureMachinePool {
 					g.Expect(desired.MachinePools[mpTopology].InfrastructureMachinePoolObject).To(EqualObject(infrastructureMachinePool))
 				}
+				for name, auxiliaryResource := range expectedAuxiliaryResources {
+					g.Expect(desired.AuxiliaryResources[name]).To(EqualObject(auxiliaryResource))
+				}
 			})
 		}
 	}
@@ -1332,6 +1395,17 @@ func setupTestObjects() (*scope.ClusterBlueprint, *scope.ClusterState) {
 		WithInfrastructureTemplate(workerInfrastructureMachinePoolTemplate).
 		WithBootstrapTemplate(workerBootstrapTemplate).
 		Build()
+	drainRuleTemplate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": clusterv1.GroupVersion.String(),
+			"kind":       "MachineDrainRule",
+			"spec": map[string]interface{}{
+				"drain": map[string]interface{}{
+					"behavior": "Drain",
+				},
+			},
+		},
+	}
 
 	clusterClass := builder.ClusterClass(metav1.NamespaceDefault, "clusterClass1").
 		WithInfrastructureClusterTemplate(infrastructureClusterTemplate).
@@ -1471,6 +1545,9 @@ func setupTestObjects() (*scope.ClusterBlueprint, *scope.ClusterState) {
 				BootstrapTemplate:                 workerBootstrapTemplate,
 			},
 		},
+		AuxiliaryResources: map[string]*unstructured.Unstructured{
+			"drain-rule": drainRuleTemplate,
+		},
 	}
 
 	// Create a Cluster using the ClusterClass from above with multiple MachineDeployments
@@ -1492,6 +1569,16 @@ func setupTestObjects() (*scope.ClusterBlueprint, *scope.ClusterState) {
 		WithInfrastructureMachineTemplate(controlPlaneInfrastructureMachineTemplate.DeepCopy(), "v1beta2").
 		Build()
 
+	// Make sure we're using an independent instance of the template.
+	drainRule := drainRuleTemplate.DeepCopy()
+	drainRule.SetName("cluster1-drain-rule")
+	drainRule.SetNamespace(metav1.NamespaceDefault)
+	labels.AddLabels(drainRule, map[string]string{
+		clusterv1.ClusterNameLabel:                          cluster.Name,
+		clusterv1.ClusterTopologyOwnedLabel:                 "",
+		clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule",
+	})
+
 	desired := &scope.ClusterState{
 		Cluster:               desiredCluster,
 		InfrastructureCluster: addStandardLabelsAndAnnotations(infrastructureCluster, cluster.Name, "", "", infrastructureClusterTemplate),
@@ -1542,6 +1629,9 @@ func setupTestObjects() (*scope.ClusterBlueprint, *scope.ClusterState) {
 				BootstrapObject:                 addStandardLabelsAndAnnotations(workerBootstrapConfig.DeepCopy(), cluster.Name, "", "default-mp-worker-topo2", workerBootstrapTemplate),
 			},
 		},
+		AuxiliaryResources: map[string]*unstructured.Unstructured{
+			"drain-rule": drainRule,
+		},
 	}
 	return blueprint, desired
 }
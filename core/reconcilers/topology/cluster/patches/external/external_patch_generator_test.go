@@ -132,6 +132,10 @@ func (f *fakeRuntimeClient) CallAllExtensions(_ context.Context, _ runtimecatalo
 	panic("implement me")
 }
 
+func (f *fakeRuntimeClient) GetExtensionHandlerCallStates(_ *runtimev1.ExtensionConfig) []runtimeclient.ExtensionHandlerCallState {
+	panic("implement me")
+}
+
 func (f *fakeRuntimeClient) CallExtension(_ context.Context, _ runtimecatalog.Hook, _ client.Object, _ string, request runtimehooksv1.RequestObject, _ runtimehooksv1.ResponseObject, _ ...runtimeclient.CallExtensionOption) error {
 	// Keep a copy of the request object.
 	// We keep a copy because the request is modified after the call is made. So we keep a copy to perform assertions.
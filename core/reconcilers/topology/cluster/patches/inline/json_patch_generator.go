@@ -26,9 +26,12 @@ import (
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
+	celgo "github.com/google/cel-go/cel"
 	pkgerrors "github.com/pkg/errors"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apiserver/pkg/cel/environment"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
@@ -39,26 +42,35 @@ import (
 	patchvariables "sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/patches/variables"
 	"sigs.k8s.io/cluster-api/exp/runtime/topologymutation"
 	"sigs.k8s.io/cluster-api/internal/contract"
+	topologyvariables "sigs.k8s.io/cluster-api/internal/topology/variables"
 )
 
 // jsonPatchGenerator generates JSON patches for a GeneratePatchesRequest based on a ClusterClassPatch.
 type jsonPatchGenerator struct {
 	patch *clusterv1.ClusterClassPatch
+	// definitions are the variables defined in the ClusterClass, they are used to type
+	// variables in CEL expressions.
+	definitions []clusterv1.ClusterClassVariable
 }
 
-// NewGenerator returns a new inline Generator from a given ClusterClassPatch object.
-func NewGenerator(patch *clusterv1.ClusterClassPatch) api.Generator {
+// NewGenerator returns a new inline Generator from a given ClusterClassPatch object
+// and the variables defined in the ClusterClass.
+func NewGenerator(patch *clusterv1.ClusterClassPatch, definitions []clusterv1.ClusterClassVariable) api.Generator {
 	return &jsonPatchGenerator{
-		patch: patch,
+		patch:       patch,
+		definitions: definitions,
 	}
 }
 
 // Generate generates JSON patches for the given GeneratePatchesRequest based on a ClusterClassPatch.
-func (j *jsonPatchGenerator) Generate(_ context.Context, _ client.Object, req *runtimehooksv1.GeneratePatchesRequest) (*runtimehooksv1.GeneratePatchesResponse, error) {
+func (j *jsonPatchGenerator) Generate(ctx context.Context, _ client.Object, req *runtimehooksv1.GeneratePatchesRequest) (*runtimehooksv1.GeneratePatchesResponse, error) {
 	resp := &runtimehooksv1.GeneratePatchesResponse{}
 
 	globalVariables := topologymutation.ToMap(req.Variables)
 
+	// CEL expressions are compiled once and then evaluated for every template.
+	expressions := newPatchExpressionCache(j.definitions)
+
 	// Loop over all templates.
 	errs := []error{}
 	for i := range req.Items {
@@ -88,7 +100,7 @@ func (j *jsonPatchGenerator) Generate(_ context.Context, _ client.Object, req *r
 			continue
 		}
 
-		enabled, err := patchIsEnabled(j.patch.EnabledIf, variables)
+		enabled, err := patchIsEnabled(ctx, j.patch.EnabledIf, j.patch.EnabledIfExpression, expressions, variables)
 		if err != nil {
 			errs = append(errs, pkgerrors.Wrapf(err, "failed to calculate if patch is enabled for %q", objectKind))
 			continue
@@ -101,7 +113,7 @@ func (j *jsonPatchGenerator) Generate(_ context.Context, _ client.Object, req *r
 		// Loop over all PatchDefinitions.
 		for _, patch := range matchingPatches {
 			// Generate JSON patches.
-			jsonPatches, err := generateJSONPatches(patch.JSONPatches, variables)
+			jsonPatches, err := generateJSONPatches(ctx, patch.JSONPatches, expressions, variables)
 			if err != nil {
 				errs = append(errs, pkgerrors.Wrapf(err, "failed to generate JSON patches for %q", objectKind))
 				continue
@@ -222,10 +234,53 @@ func matchesSelector(req *runtimehooksv1.GeneratePatchesRequestItem, templateVar
 		}
 	}
 
+	// Check if the request is for one of the configured auxiliary resources.
+	if selector.MatchResources.AuxiliaryResource != nil {
+		// Auxiliary resources are held by the Cluster without a field path, and they are identified
+		// by the auxiliary resource name label.
+		if req.HolderReference.Kind == "Cluster" && req.HolderReference.FieldPath == "" {
+			var auxiliaryResourceName string
+			if obj, ok := req.Object.Object.(metav1.Object); ok {
+				auxiliaryResourceName = obj.GetLabels()[clusterv1.ClusterTopologyAuxiliaryResourceNameLabel]
+			}
+			if auxiliaryResourceName != "" {
+				// If auxiliaryResourceName matches one of the configured auxiliary resources.
+				for _, name := range selector.MatchResources.AuxiliaryResource.Names {
+					if name == "*" || name == auxiliaryResourceName {
+						return true
+					}
+					if strings.HasPrefix(name, "*") && strings.HasSuffix(auxiliaryResourceName, strings.TrimPrefix(name, "*")) {
+						return true
+					}
+					if strings.HasSuffix(name, "*") && strings.HasPrefix(auxiliaryResourceName, strings.TrimSuffix(name, "*")) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
 	return false
 }
 
-func patchIsEnabled(enabledIf string, variables map[string]apiextensionsv1.JSON) (bool, error) {
+func patchIsEnabled(ctx context.Context, enabledIf, enabledIfExpression string, expressions *patchExpressionCache, variables map[string]apiextensionsv1.JSON) (bool, error) {
+	if enabledIf != "" && enabledIfExpression != "" {
+		return false, pkgerrors.Errorf("failed to calculate if patch is enabled: both enabledIf and enabledIfExpression are set")
+	}
+
+	// Evaluate the CEL expression, the patch is enabled if it evaluates to true.
+	if enabledIfExpression != "" {
+		expression, err := expressions.compile(enabledIfExpression, celgo.BoolType)
+		if err != nil {
+			return false, pkgerrors.Wrapf(err, "failed to calculate value for enabledIfExpression")
+		}
+		enabled, err := expression.EvalBool(ctx, variables)
+		if err != nil {
+			return false, pkgerrors.Wrapf(err, "failed to calculate value for enabledIfExpression")
+		}
+		return enabled, nil
+	}
+
 	// If enabledIf is not set, patch is enabled.
 	if enabledIf == "" {
 		return true, nil
@@ -241,6 +296,46 @@ func patchIsEnabled(enabledIf string, variables map[string]apiextensionsv1.JSON)
 	return bytes.Equal(value.Raw, []byte(`true`)), nil
 }
 
+// patchExpressionCache caches the CEL expressions of a patch compiled against the variables
+// defined in the ClusterClass, so that each expression is compiled only once per generation
+// instead of once for every template it is evaluated for.
+// NOTE: The definitions are the same for all the expressions in the cache, so the expression
+// and its output type are enough to identify a compiled expression.
+type patchExpressionCache struct {
+	definitions []clusterv1.ClusterClassVariable
+	entries     map[patchExpressionCacheKey]patchExpressionCacheEntry
+}
+
+type patchExpressionCacheKey struct {
+	expression string
+	outputType *celgo.Type
+}
+
+type patchExpressionCacheEntry struct {
+	expression *topologyvariables.PatchExpression
+	err        error
+}
+
+func newPatchExpressionCache(definitions []clusterv1.ClusterClassVariable) *patchExpressionCache {
+	return &patchExpressionCache{
+		definitions: definitions,
+		entries:     map[patchExpressionCacheKey]patchExpressionCacheEntry{},
+	}
+}
+
+// compile returns the compiled expression, compiling it if it is not in the cache yet.
+// Compile errors are cached as well, so an invalid expression is not compiled again for every template.
+func (c *patchExpressionCache) compile(expression string, outputType *celgo.Type) (*topologyvariables.PatchExpression, error) {
+	key := patchExpressionCacheKey{expression: expression, outputType: outputType}
+	if entry, ok := c.entries[key]; ok {
+		return entry.expression, entry.err
+	}
+
+	compiled, err := topologyvariables.CompilePatchExpression(expression, c.definitions, outputType, environment.StoredExpressions)
+	c.entries[key] = patchExpressionCacheEntry{expression: compiled, err: err}
+	return compiled, err
+}
+
 // jsonPatchRFC6902 is used to render the generated JSONPatches.
 type jsonPatchRFC6902 struct {
 	Op    string                `json:"op"`
@@ -249,14 +344,14 @@ type jsonPatchRFC6902 struct {
 }
 
 // generateJSONPatches generates JSON patches based on the given JSONPatches and variables.
-func generateJSONPatches(jsonPatches []clusterv1.JSONPatch, variables map[string]apiextensionsv1.JSON) ([]byte, error) {
+func generateJSONPatches(ctx context.Context, jsonPatches []clusterv1.JSONPatch, expressions *patchExpressionCache, variables map[string]apiextensionsv1.JSON) ([]byte, error) {
 	res := []jsonPatchRFC6902{}
 
 	for _, jsonPatch := range jsonPatches {
 		var value *apiextensionsv1.JSON
 		if jsonPatch.Op == "add" || jsonPatch.Op == "replace" {
 			var err error
-			value, err = calculateValue(jsonPatch, variables)
+			value, err = calculateValue(ctx, jsonPatch, expressions, variables)
 			if err != nil {
 				return nil, err
 			}
@@ -279,7 +374,7 @@ func generateJSONPatches(jsonPatches []clusterv1.JSONPatch, variables map[string
 }
 
 // calculateValue calculates a value for a JSON patch.
-func calculateValue(patch clusterv1.JSONPatch, variables map[string]apiextensionsv1.JSON) (*apiextensionsv1.JSON, error) {
+func calculateValue(ctx context.Context, patch clusterv1.JSONPatch, expressions *patchExpressionCache, variables map[string]apiextensionsv1.JSON) (*apiextensionsv1.JSON, error) {
 	// Return if values are set incorrectly.
 	if patch.Value == nil && patch.ValueFrom == nil {
 		return nil, pkgerrors.Errorf("failed to calculate value: neither .value nor .valueFrom are set")
@@ -287,11 +382,11 @@ func calculateValue(patch clusterv1.JSONPatch, variables map[string]apiextension
 	if patch.Value != nil && patch.ValueFrom != nil {
 		return nil, pkgerrors.Errorf("failed to calculate value: both .value and .valueFrom are set")
 	}
-	if patch.ValueFrom != nil && patch.ValueFrom.Variable == "" && patch.ValueFrom.Template == "" {
-		return nil, pkgerrors.Errorf("failed to calculate value: .valueFrom is set, but neither .valueFrom.variable nor .valueFrom.template are set")
+	if patch.ValueFrom != nil && patch.ValueFrom.Variable == "" && patch.ValueFrom.Template == "" && patch.ValueFrom.Expression == "" {
+		return nil, pkgerrors.Errorf("failed to calculate value: .valueFrom is set, but none of .valueFrom.variable, .valueFrom.template and .valueFrom.expression are set")
 	}
-	if patch.ValueFrom != nil && patch.ValueFrom.Variable != "" && patch.ValueFrom.Template != "" {
-		return nil, pkgerrors.Errorf("failed to calculate value: .valueFrom is set, but both .valueFrom.variable and .valueFrom.template are set")
+	if patch.ValueFrom != nil && countNonEmpty(patch.ValueFrom.Variable, patch.ValueFrom.Template, patch.ValueFrom.Expression) > 1 {
+		return nil, pkgerrors.Errorf("failed to calculate value: .valueFrom is set, but more than one of .valueFrom.variable, .valueFrom.template and .valueFrom.expression are set")
 	}
 
 	// Return raw value.
@@ -308,6 +403,19 @@ func calculateValue(patch clusterv1.JSONPatch, variables map[string]apiextension
 		return value, nil
 	}
 
+	// Return evaluated expression.
+	if patch.ValueFrom.Expression != "" {
+		expression, err := expressions.compile(patch.ValueFrom.Expression, nil)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to calculate value for expression")
+		}
+		value, err := expression.EvalJSON(ctx, variables)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to calculate value for expression")
+		}
+		return value, nil
+	}
+
 	// Return rendered value template.
 	value, err := renderValueTemplate(patch.ValueFrom.Template, variables)
 	if err != nil {
@@ -316,6 +424,17 @@ func calculateValue(patch clusterv1.JSONPatch, variables map[string]apiextension
 	return value, nil
 }
 
+// countNonEmpty returns the number of non-empty strings.
+func countNonEmpty(values ...string) int {
+	count := 0
+	for _, v := range values {
+		if v != "" {
+			count++
+		}
+	}
+	return count
+}
+
 // renderValueTemplate renders a template with the given variables as data.
 func renderValueTemplate(valueTemplate string, variables map[string]apiextensionsv1.JSON) (*apiextensionsv1.JSON, error) {
 	// Parse the template.
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	celgo "github.com/google/cel-go/cel"
 	. "github.com/onsi/gomega"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -415,7 +416,7 @@ func TestGenerate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			got, err := NewGenerator(tt.patch).Generate(context.Background(), &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}, tt.req)
+			got, err := NewGenerator(tt.patch, nil).Generate(context.Background(), &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}, tt.req)
 
 			g.Expect(got).To(BeComparableTo(tt.want))
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1269,6 +1270,172 @@ func TestMatchesSelector(t *testing.T) {
 			},
 			match: true,
 		},
+		{
+			name: "Match auxiliary resource",
+			req: &runtimehooksv1.GeneratePatchesRequestItem{
+				Object: runtime.RawExtension{
+					Object: &unstructured.Unstructured{
+						Object: map[string]interface{}{
+							"apiVersion": clusterv1.GroupVersion.String(),
+							"kind":       "MachineDrainRule",
+							"metadata": map[string]interface{}{
+								"labels": map[string]interface{}{
+									clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule",
+								},
+							},
+						},
+					},
+				},
+				HolderReference: runtimehooksv1.HolderReference{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Cluster",
+					Name:       "my-cluster",
+					Namespace:  "default",
+				},
+			},
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"drain-rule"},
+					},
+				},
+			},
+			match: true,
+		},
+		{
+			name: "Match auxiliary resource with prefix wildcard",
+			req: &runtimehooksv1.GeneratePatchesRequestItem{
+				Object: runtime.RawExtension{
+					Object: &unstructured.Unstructured{
+						Object: map[string]interface{}{
+							"apiVersion": clusterv1.GroupVersion.String(),
+							"kind":       "MachineDrainRule",
+							"metadata": map[string]interface{}{
+								"labels": map[string]interface{}{
+									clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule",
+								},
+							},
+						},
+					},
+				},
+				HolderReference: runtimehooksv1.HolderReference{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Cluster",
+					Name:       "my-cluster",
+					Namespace:  "default",
+				},
+			},
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"drain-*"},
+					},
+				},
+			},
+			match: true,
+		},
+		{
+			name: "Match all auxiliary resources",
+			req: &runtimehooksv1.GeneratePatchesRequestItem{
+				Object: runtime.RawExtension{
+					Object: &unstructured.Unstructured{
+						Object: map[string]interface{}{
+							"apiVersion": clusterv1.GroupVersion.String(),
+							"kind":       "MachineDrainRule",
+							"metadata": map[string]interface{}{
+								"labels": map[string]interface{}{
+									clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule",
+								},
+							},
+						},
+					},
+				},
+				HolderReference: runtimehooksv1.HolderReference{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Cluster",
+					Name:       "my-cluster",
+					Namespace:  "default",
+				},
+			},
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"*"},
+					},
+				},
+			},
+			match: true,
+		},
+		{
+			name: "Don't match auxiliary resource with a different name",
+			req: &runtimehooksv1.GeneratePatchesRequestItem{
+				Object: runtime.RawExtension{
+					Object: &unstructured.Unstructured{
+						Object: map[string]interface{}{
+							"apiVersion": clusterv1.GroupVersion.String(),
+							"kind":       "MachineDrainRule",
+							"metadata": map[string]interface{}{
+								"labels": map[string]interface{}{
+									clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule",
+								},
+							},
+						},
+					},
+				},
+				HolderReference: runtimehooksv1.HolderReference{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Cluster",
+					Name:       "my-cluster",
+					Namespace:  "default",
+				},
+			},
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"other-rule"},
+					},
+				},
+			},
+			match: false,
+		},
+		{
+			name: "Don't match auxiliary resource: InfrastructureCluster held by the Cluster",
+			req: &runtimehooksv1.GeneratePatchesRequestItem{
+				Object: runtime.RawExtension{
+					Object: &unstructured.Unstructured{
+						Object: map[string]interface{}{
+							"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+							"kind":       "AzureClusterTemplate",
+						},
+					},
+				},
+				HolderReference: runtimehooksv1.HolderReference{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Cluster",
+					Name:       "my-cluster",
+					Namespace:  "default",
+					FieldPath:  "spec.infrastructureRef",
+				},
+			},
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersionInfrastructure.String(),
+				Kind:       "AzureClusterTemplate",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"*"},
+					},
+				},
+			},
+			match: false,
+		},
 		{
 			name: "Don't match: unknown field path",
 			req: &runtimehooksv1.GeneratePatchesRequestItem{
@@ -1309,11 +1476,13 @@ func TestMatchesSelector(t *testing.T) {
 
 func TestPatchIsEnabled(t *testing.T) {
 	tests := []struct {
-		name      string
-		enabledIf string
-		variables map[string]apiextensionsv1.JSON
-		want      bool
-		wantErr   bool
+		name                string
+		enabledIf           string
+		enabledIfExpression string
+		definitions         []clusterv1.ClusterClassVariable
+		variables           map[string]apiextensionsv1.JSON
+		want                bool
+		wantErr             bool
 	}{
 		{
 			name:      "Enabled if enabledIf is not set",
@@ -1456,12 +1625,75 @@ true
 			},
 			want: false,
 		},
+		// CEL expressions.
+		{
+			name:                "Fail if both enabledIf and enabledIfExpression are set",
+			enabledIf:           `true`,
+			enabledIfExpression: `true`,
+			wantErr:             true,
+		},
+		{
+			name:                "Fail if expression is invalid",
+			enabledIfExpression: `httpProxy.`,
+			definitions:         []clusterv1.ClusterClassVariable{httpProxyVariableDefinition},
+			wantErr:             true,
+		},
+		{
+			name:                "Fail if expression does not evaluate to bool",
+			enabledIfExpression: `httpProxy.url`,
+			definitions:         []clusterv1.ClusterClassVariable{httpProxyVariableDefinition},
+			variables: map[string]apiextensionsv1.JSON{
+				"httpProxy": {Raw: []byte(`{"enabled": true, "url": "localhost:3128"}`)},
+			},
+			wantErr: true,
+		},
+		{
+			name:                "Fail if expression references a variable which is not set",
+			enabledIfExpression: `httpProxy.enabled`,
+			definitions:         []clusterv1.ClusterClassVariable{httpProxyVariableDefinition},
+			wantErr:             true,
+		},
+		{
+			name:                "Enabled if expression with complex variable evaluates to true",
+			enabledIfExpression: `httpProxy.enabled`,
+			definitions:         []clusterv1.ClusterClassVariable{httpProxyVariableDefinition},
+			variables: map[string]apiextensionsv1.JSON{
+				"httpProxy": {Raw: []byte(`{"enabled": true, "url": "localhost:3128", "noProxy": "internal.example.com"}`)},
+			},
+			want: true,
+		},
+		{
+			name:                "Disabled if expression with complex variable evaluates to false",
+			enabledIfExpression: `httpProxy.enabled`,
+			definitions:         []clusterv1.ClusterClassVariable{httpProxyVariableDefinition},
+			variables: map[string]apiextensionsv1.JSON{
+				"httpProxy": {Raw: []byte(`{"enabled": false, "url": "localhost:3128", "noProxy": "internal.example.com"}`)},
+			},
+			want: false,
+		},
+		{
+			name:                "Enabled if expression with builtin and escaped variable evaluates to true",
+			enabledIfExpression: `builtin.controlPlane.replicas > 1 && builtin.cluster.topology.version == "v1.21.1" && http__dash__proxy__dash__enabled`,
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name: "http-proxy-enabled",
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "boolean"},
+					},
+				},
+			},
+			variables: map[string]apiextensionsv1.JSON{
+				"builtin":            {Raw: []byte(`{"cluster":{"name":"cluster-name","topology":{"version":"v1.21.1"}},"controlPlane":{"replicas":3}}`)},
+				"http-proxy-enabled": {Raw: []byte(`true`)},
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			got, err := patchIsEnabled(tt.enabledIf, tt.variables)
+			got, err := patchIsEnabled(t.Context(), tt.enabledIf, tt.enabledIfExpression, newPatchExpressionCache(tt.definitions), tt.variables)
 			if tt.wantErr {
 				g.Expect(err).To(HaveOccurred())
 				return
@@ -1475,11 +1707,12 @@ true
 
 func TestCalculateValue(t *testing.T) {
 	tests := []struct {
-		name      string
-		patch     clusterv1.JSONPatch
-		variables map[string]apiextensionsv1.JSON
-		want      *apiextensionsv1.JSON
-		wantErr   bool
+		name        string
+		patch       clusterv1.JSONPatch
+		definitions []clusterv1.ClusterClassVariable
+		variables   map[string]apiextensionsv1.JSON
+		want        *apiextensionsv1.JSON
+		wantErr     bool
 	}{
 		{
 			name:    "Fails if neither .value nor .valueFrom are set",
@@ -1897,12 +2130,92 @@ func TestCalculateValue(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		// CEL expressions.
+		{
+			name: "Fails if .valueFrom.template and .valueFrom.expression are set",
+			patch: clusterv1.JSONPatch{
+				ValueFrom: &clusterv1.JSONPatchValue{
+					Template:   "template",
+					Expression: "expression",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if expression references an undefined variable",
+			patch: clusterv1.JSONPatch{
+				ValueFrom: &clusterv1.JSONPatchValue{
+					Expression: `variableA`,
+				},
+			},
+			variables: map[string]apiextensionsv1.JSON{
+				"variableA": {Raw: []byte(`"value"`)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return evaluated expression with builtin variable",
+			patch: clusterv1.JSONPatch{
+				ValueFrom: &clusterv1.JSONPatchValue{
+					Expression: `"%s-lb".format([builtin.cluster.name])`,
+				},
+			},
+			variables: map[string]apiextensionsv1.JSON{
+				"builtin": {Raw: []byte(`{"cluster":{"name":"cluster-name","namespace":"default"}}`)},
+			},
+			want: &apiextensionsv1.JSON{Raw: []byte(`"cluster-name-lb"`)},
+		},
+		{
+			name: "Should return evaluated expression with integer arithmetic on builtin variable",
+			patch: clusterv1.JSONPatch{
+				ValueFrom: &clusterv1.JSONPatchValue{
+					Expression: `builtin.controlPlane.replicas + 1`,
+				},
+			},
+			variables: map[string]apiextensionsv1.JSON{
+				"builtin": {Raw: []byte(`{"controlPlane":{"replicas":3}}`)},
+			},
+			want: &apiextensionsv1.JSON{Raw: []byte(`4`)},
+		},
+		{
+			name: "Should return evaluated expression with object value",
+			patch: clusterv1.JSONPatch{
+				ValueFrom: &clusterv1.JSONPatchValue{
+					Expression: `{"url": httpProxy.url, "noProxy": [httpProxy.noProxy, "localhost"].join(",")}`,
+				},
+			},
+			definitions: []clusterv1.ClusterClassVariable{httpProxyVariableDefinition},
+			variables: map[string]apiextensionsv1.JSON{
+				"httpProxy": {Raw: []byte(`{"enabled": true, "url": "localhost:3128", "noProxy": "internal.example.com"}`)},
+			},
+			want: &apiextensionsv1.JSON{Raw: []byte(`{"noProxy":"internal.example.com,localhost","url":"localhost:3128"}`)},
+		},
+		{
+			name: "Should return evaluated expression with number variable",
+			patch: clusterv1.JSONPatch{
+				ValueFrom: &clusterv1.JSONPatchValue{
+					Expression: `ratio * 2.0`,
+				},
+			},
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name: "ratio",
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "number"},
+					},
+				},
+			},
+			variables: map[string]apiextensionsv1.JSON{
+				"ratio": {Raw: []byte(`2`)},
+			},
+			want: &apiextensionsv1.JSON{Raw: []byte(`4`)},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			got, err := calculateValue(tt.patch, tt.variables)
+			got, err := calculateValue(t.Context(), tt.patch, newPatchExpressionCache(tt.definitions), tt.variables)
 			if tt.wantErr {
 				g.Expect(err).To(HaveOccurred())
 				return
@@ -1914,6 +2227,38 @@ func TestCalculateValue(t *testing.T) {
 	}
 }
 
+func TestPatchExpressionCache(t *testing.T) {
+	g := NewWithT(t)
+
+	expressions := newPatchExpressionCache([]clusterv1.ClusterClassVariable{
+		{
+			Name: "replicas",
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "integer"},
+			},
+		},
+	})
+
+	// The same expression is compiled only once.
+	first, err := expressions.compile("replicas > 1", celgo.BoolType)
+	g.Expect(err).ToNot(HaveOccurred())
+	second, err := expressions.compile("replicas > 1", celgo.BoolType)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(second).To(BeIdenticalTo(first))
+
+	// The same expression with a different output type is compiled separately.
+	third, err := expressions.compile("replicas > 1", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(third).ToNot(BeIdenticalTo(first))
+
+	// Compile errors are cached as well.
+	_, err = expressions.compile("replicas + 1", celgo.BoolType)
+	g.Expect(err).To(HaveOccurred())
+	_, err = expressions.compile("replicas + 1", celgo.BoolType)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(expressions.entries).To(HaveLen(3))
+}
+
 func TestRenderValueTemplate(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -2378,3 +2723,17 @@ func toJSONCompact(value string) []byte {
 	}
 	return compactValue.Bytes()
 }
+
+var httpProxyVariableDefinition = clusterv1.ClusterClassVariable{
+	Name: "httpProxy",
+	Schema: clusterv1.VariableSchema{
+		OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]clusterv1.JSONSchemaProps{
+				"enabled": {Type: "boolean"},
+				"url":     {Type: "string"},
+				"noProxy": {Type: "string"},
+			},
+		},
+	},
+}
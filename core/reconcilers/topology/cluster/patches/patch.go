@@ -85,6 +85,18 @@ func patchTemplate(ctx context.Context, dest, src *unstructured.Unstructured, op
 	}, opts...)
 }
 
+// patchAuxiliaryResource overwrites spec in auxiliaryResource with spec of modifiedAuxiliaryResource,
+// while preserving the configured fields.
+// NOTE: Templates of auxiliary resources are defined inline in the ClusterClass and they have the
+// same structure of the auxiliary resources, thus spec is copied as is.
+func patchAuxiliaryResource(ctx context.Context, auxiliaryResource, modifiedAuxiliaryResource *unstructured.Unstructured, opts ...PatchOption) error {
+	return patchUnstructured(ctx, auxiliaryResource, modifiedAuxiliaryResource, []patchUnstructuredFields{
+		{Src: []string{"spec"}, Dest: []string{"spec"}},
+		{Src: []string{"metadata", "labels"}, Dest: []string{"metadata", "labels"}},
+		{Src: []string{"metadata", "annotations"}, Dest: []string{"metadata", "annotations"}},
+	}, opts...)
+}
+
 type patchUnstructuredFields struct {
 	Src  []string
 	Dest []string
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/patches/variables"
 	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
@@ -47,8 +48,9 @@ type requestItemBuilder struct {
 
 // requestTopologyName is used to specify the topology name to match in a GeneratePatchesRequest.
 type requestTopologyName struct {
-	mdTopologyName string
-	mpTopologyName string
+	mdTopologyName        string
+	mpTopologyName        string
+	auxiliaryResourceName string
 }
 
 // newRequestItemBuilder returns a new requestItemBuilder.
@@ -122,7 +124,7 @@ func getTemplateAsUnstructured(req *runtimehooksv1.GeneratePatchesRequest, holde
 	requestItem := getRequestItem(req, holderKind, holderFieldPath, topologyNames)
 
 	if requestItem == nil {
-		return nil, pkgerrors.Errorf("failed to get request item with holder kind %q, holder field path %q, MD topology name %q, and MP topology name %q", holderKind, holderFieldPath, topologyNames.mdTopologyName, topologyNames.mpTopologyName)
+		return nil, pkgerrors.Errorf("failed to get request item with holder kind %q, holder field path %q, MD topology name %q, MP topology name %q, and auxiliary resource name %q", holderKind, holderFieldPath, topologyNames.mdTopologyName, topologyNames.mpTopologyName, topologyNames.auxiliaryResourceName)
 	}
 
 	// Unmarshal the template.
@@ -167,12 +169,34 @@ func getRequestItem(req *runtimehooksv1.GeneratePatchesRequest, holderKind, hold
 				continue
 			}
 		}
+		// Auxiliary resources are matched using the auxiliary resource name label, because there are no
+		// builtin variables specific to auxiliary resources.
+		if getAuxiliaryResourceName(template) != topologyNames.auxiliaryResourceName {
+			continue
+		}
 
 		return &template
 	}
 	return nil
 }
 
+// getAuxiliaryResourceName returns the name of the auxiliary resource in the ClusterClass for a request item,
+// or an empty string if the request item is not an auxiliary resource.
+func getAuxiliaryResourceName(requestItem runtimehooksv1.GeneratePatchesRequestItem) string {
+	// Auxiliary resources are held by the Cluster without a field path.
+	if requestItem.HolderReference.Kind != "Cluster" || requestItem.HolderReference.FieldPath != "" {
+		return ""
+	}
+	obj, ok := requestItem.Object.Object.(*unstructured.Unstructured)
+	if !ok {
+		var err error
+		if obj, err = bytesToUnstructured(requestItem.Object.Raw); err != nil {
+			return ""
+		}
+	}
+	return obj.GetLabels()[clusterv1.ClusterTopologyAuxiliaryResourceNameLabel]
+}
+
 // bytesToUnstructured provides a utility method that converts a (JSON) byte array into an Unstructured object.
 func bytesToUnstructured(b []byte) (*unstructured.Unstructured, error) {
 	// Unmarshal the JSON.
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variables
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	topologyvariables "sigs.k8s.io/cluster-api/internal/topology/variables"
+)
+
+const (
+	// maskedValue is used to replace the values of sensitive variables.
+	maskedValue = "*****"
+
+	// minMaskedValueLength is the minimum length of the values masked by a Masker; shorter values, e.g. the
+	// numbers or booleans nested in the value of a sensitive variable, would otherwise mask most of a log message.
+	minMaskedValueLength = 4
+)
+
+// Masker masks the values of sensitive variables, e.g. in errors and log messages.
+// NOTE: A nil Masker does not mask anything.
+type Masker struct {
+	values []string
+}
+
+// Mask replaces all the values of sensitive variables in s.
+func (m *Masker) Mask(s string) string {
+	if m == nil {
+		return s
+	}
+	for _, value := range m.values {
+		s = strings.ReplaceAll(s, value, maskedValue)
+	}
+	return s
+}
+
+// MaskError returns an error with the values of sensitive variables masked.
+func (m *Masker) MaskError(err error) error {
+	if m == nil || err == nil {
+		return err
+	}
+	if masked := m.Mask(err.Error()); masked != err.Error() {
+		return pkgerrors.New(masked)
+	}
+	return err
+}
+
+// add adds a value to the masker; the value is added both as raw JSON and,
+// if it contains strings, as the plain strings it contains.
+// NOTE: Values shorter than minMaskedValueLength are not masked.
+func (m *Masker) add(raw []byte) {
+	values := map[string]bool{string(raw): true}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err == nil {
+		addStrings(value, values)
+	}
+	for value := range values {
+		if len(value) >= minMaskedValueLength {
+			m.values = append(m.values, value)
+		}
+	}
+	// Replace longer values first, so values containing other values are fully masked.
+	sort.Slice(m.values, func(i, j int) bool {
+		if len(m.values[i]) != len(m.values[j]) {
+			return len(m.values[i]) > len(m.values[j])
+		}
+		return m.values[i] < m.values[j]
+	})
+}
+
+func addStrings(value interface{}, values map[string]bool) {
+	switch v := value.(type) {
+	case string:
+		values[v] = true
+	case []interface{}:
+		for _, item := range v {
+			addStrings(item, values)
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			addStrings(item, values)
+		}
+	}
+}
+
+// ResolveValuesFrom returns a copy of the topology where the values of the variables set using valueFrom
+// are resolved, together with a Masker for the values of sensitive variables.
+// NOTE: Values are resolved only when computing patches, and they must never be written back to the Cluster.
+func ResolveValuesFrom(ctx context.Context, c client.Reader, namespace string, topology clusterv1.Topology, definitions []clusterv1.ClusterClassStatusVariable) (clusterv1.Topology, *Masker, error) {
+	r := &valueFromResolver{
+		client:      c,
+		namespace:   namespace,
+		definitions: map[string]clusterv1.ClusterClassStatusVariableDefinition{},
+		secrets:     map[string]*corev1.Secret{},
+		masker:      &Masker{},
+	}
+	for _, definition := range definitions {
+		// Note: Variables with conflicting definitions are rejected when validating the Cluster, so we can just pick the first one.
+		if len(definition.Definitions) > 0 {
+			r.definitions[definition.Name] = definition.Definitions[0]
+		}
+	}
+
+	resolved := *topology.DeepCopy()
+	var errs []error
+	errs = append(errs, r.resolve(ctx, resolved.Variables, field.NewPath("spec", "topology", "variables"))...)
+	errs = append(errs, r.resolve(ctx, resolved.ControlPlane.Variables.Overrides, field.NewPath("spec", "topology", "controlPlane", "variables", "overrides"))...)
+	for i := range resolved.Workers.MachineDeployments {
+		md := &resolved.Workers.MachineDeployments[i]
+		errs = append(errs, r.resolve(ctx, md.Variables.Overrides, field.NewPath("spec", "topology", "workers", "machineDeployments").Key(md.Name).Child("variables", "overrides"))...)
+	}
+	for i := range resolved.Workers.MachinePools {
+		mp := &resolved.Workers.MachinePools[i]
+		errs = append(errs, r.resolve(ctx, mp.Variables.Overrides, field.NewPath("spec", "topology", "workers", "machinePools").Key(mp.Name).Child("variables", "overrides"))...)
+	}
+	if len(errs) > 0 {
+		return clusterv1.Topology{}, nil, kerrors.NewAggregate(errs)
+	}
+	return resolved, r.masker, nil
+}
+
+type valueFromResolver struct {
+	client      client.Reader
+	namespace   string
+	definitions map[string]clusterv1.ClusterClassStatusVariableDefinition
+	secrets     map[string]*corev1.Secret
+	masker      *Masker
+}
+
+// resolve resolves in place the values of the variables set using valueFrom.
+func (r *valueFromResolver) resolve(ctx context.Context, variables []clusterv1.ClusterVariable, fldPath *field.Path) []error {
+	var errs []error
+	for i := range variables {
+		variable := &variables[i]
+		definition, ok := r.definitions[variable.Name]
+
+		if !variable.ValueFrom.IsDefined() {
+			// Mask values of sensitive variables set before the variable was marked as sensitive.
+			if ok && ptr.Deref(definition.Schema.Sensitive, false) && variable.Value.Raw != nil {
+				r.masker.add(variable.Value.Raw)
+			}
+			continue
+		}
+
+		if !ok {
+			errs = append(errs, pkgerrors.Errorf("failed to resolve variable %q: variable is not defined", variable.Name))
+			continue
+		}
+
+		value, err := r.getSecretKeyValue(ctx, variable, definition)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		r.masker.add(value)
+
+		variable.Value = apiextensionsv1.JSON{Raw: value}
+		variable.ValueFrom = clusterv1.ClusterVariableValueSource{}
+
+		// Validate the resolved value against the schema of the variable.
+		// NOTE: The value is omitted from validation errors to avoid leaking it.
+		validationErrs := topologyvariables.ValidateClusterVariable(ctx, variable, nil, &clusterv1.ClusterClassVariable{
+			Name:     variable.Name,
+			Required: definition.Required,
+			Schema:   definition.Schema,
+		}, fldPath.Key(variable.Name))
+		for _, validationErr := range validationErrs {
+			validationErr.BadValue = field.OmitValueType{}
+		}
+		if len(validationErrs) > 0 {
+			errs = append(errs, r.masker.MaskError(validationErrs.ToAggregate()))
+		}
+	}
+	return errs
+}
+
+// getSecretKeyValue returns the value of a variable from the key of a Secret.
+func (r *valueFromResolver) getSecretKeyValue(ctx context.Context, variable *clusterv1.ClusterVariable, definition clusterv1.ClusterClassStatusVariableDefinition) ([]byte, error) {
+	ref := variable.ValueFrom.SecretKeyRef
+	secret, ok := r.secrets[ref.Name]
+	if !ok {
+		secret = &corev1.Secret{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: ref.Name}, secret); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to resolve variable %q: failed to get Secret %s", variable.Name, klog.KRef(r.namespace, ref.Name))
+		}
+		r.secrets[ref.Name] = secret
+	}
+
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, pkgerrors.Errorf("failed to resolve variable %q: key %q not found in Secret %s", variable.Name, ref.Key, klog.KRef(r.namespace, ref.Name))
+	}
+
+	// If the variable is of type string, use the data as is.
+	if definition.Schema.OpenAPIV3Schema.Type == "string" {
+		value, err := json.Marshal(string(data))
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to resolve variable %q: failed to marshal value", variable.Name)
+		}
+		return value, nil
+	}
+
+	// Otherwise the data must be the JSON representation of the value.
+	// NOTE: The error returned by json.Valid is not surfaced to avoid leaking the value.
+	if !json.Valid(data) {
+		return nil, pkgerrors.Errorf("failed to resolve variable %q: key %q in Secret %s does not contain a valid JSON value", variable.Name, ref.Key, klog.KRef(r.namespace, ref.Name))
+	}
+	return data, nil
+}
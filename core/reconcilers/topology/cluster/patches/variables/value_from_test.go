@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variables
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestResolveValuesFrom(t *testing.T) {
+	definitions := []clusterv1.ClusterClassStatusVariable{
+		{
+			Name: "token",
+			Definitions: []clusterv1.ClusterClassStatusVariableDefinition{{
+				From: clusterv1.VariableDefinitionFromInline,
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "string", MinLength: ptr.To[int64](8)},
+					Sensitive:       ptr.To(true),
+				},
+			}},
+		},
+		{
+			Name: "registry",
+			Definitions: []clusterv1.ClusterClassStatusVariableDefinition{{
+				From: clusterv1.VariableDefinitionFromInline,
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]clusterv1.JSONSchemaProps{
+							"username": {Type: "string"},
+							"password": {Type: "string"},
+						},
+					},
+					Sensitive: ptr.To(true),
+				},
+			}},
+		},
+		{
+			Name: "replicas",
+			Definitions: []clusterv1.ClusterClassStatusVariableDefinition{{
+				From: clusterv1.VariableDefinitionFromInline,
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "integer"},
+				},
+			}},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: metav1.NamespaceDefault},
+		Data: map[string][]byte{
+			"token":    []byte("s3cr3t-t0k3n"),
+			"short":    []byte("s3cr3t"),
+			"registry": []byte(`{"username":"admin","password":"p4ssw0rd"}`),
+			"invalid":  []byte("{p4ssw0rd"),
+		},
+	}
+	secretKeyRef := func(key string) clusterv1.ClusterVariableValueSource {
+		return clusterv1.ClusterVariableValueSource{SecretKeyRef: clusterv1.ClusterVariableSecretKeyReference{Name: "credentials", Key: key}}
+	}
+
+	tests := []struct {
+		name          string
+		topology      clusterv1.Topology
+		want          clusterv1.Topology
+		wantErr       string
+		wantMasked    []string
+		wantNotMasked []string
+	}{
+		{
+			name: "resolve values from Secret",
+			topology: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{
+					{Name: "token", ValueFrom: secretKeyRef("token")},
+					{Name: "replicas", Value: apiextensionsv1.JSON{Raw: []byte(`3`)}},
+				},
+				Workers: clusterv1.WorkersTopology{
+					MachineDeployments: []clusterv1.MachineDeploymentTopology{{
+						Name: "md1",
+						Variables: clusterv1.MachineDeploymentVariables{
+							Overrides: []clusterv1.ClusterVariable{{Name: "registry", ValueFrom: secretKeyRef("registry")}},
+						},
+					}},
+				},
+			},
+			want: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{
+					{Name: "token", Value: apiextensionsv1.JSON{Raw: []byte(`"s3cr3t-t0k3n"`)}},
+					{Name: "replicas", Value: apiextensionsv1.JSON{Raw: []byte(`3`)}},
+				},
+				Workers: clusterv1.WorkersTopology{
+					MachineDeployments: []clusterv1.MachineDeploymentTopology{{
+						Name: "md1",
+						Variables: clusterv1.MachineDeploymentVariables{
+							Overrides: []clusterv1.ClusterVariable{{Name: "registry", Value: apiextensionsv1.JSON{Raw: []byte(`{"username":"admin","password":"p4ssw0rd"}`)}}},
+						},
+					}},
+				},
+			},
+			wantMasked:    []string{"s3cr3t-t0k3n", `{"username":"admin","password":"p4ssw0rd"}`, "p4ssw0rd", "admin"},
+			wantNotMasked: []string{"3"},
+		},
+		{
+			name: "mask inline values of sensitive variables",
+			topology: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{
+					{Name: "token", Value: apiextensionsv1.JSON{Raw: []byte(`"inline-t0k3n"`)}},
+				},
+			},
+			want: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{
+					{Name: "token", Value: apiextensionsv1.JSON{Raw: []byte(`"inline-t0k3n"`)}},
+				},
+			},
+			wantMasked: []string{"inline-t0k3n"},
+		},
+		{
+			name: "fail if the Secret does not exist",
+			topology: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{{
+					Name:      "token",
+					ValueFrom: clusterv1.ClusterVariableValueSource{SecretKeyRef: clusterv1.ClusterVariableSecretKeyReference{Name: "does-not-exist", Key: "token"}},
+				}},
+			},
+			wantErr: `failed to resolve variable "token": failed to get Secret default/does-not-exist`,
+		},
+		{
+			name: "fail if the key does not exist",
+			topology: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{{Name: "token", ValueFrom: secretKeyRef("does-not-exist")}},
+			},
+			wantErr: `failed to resolve variable "token": key "does-not-exist" not found in Secret default/credentials`,
+		},
+		{
+			name: "fail without leaking the value if the value is not valid JSON",
+			topology: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{{Name: "registry", ValueFrom: secretKeyRef("invalid")}},
+			},
+			wantErr: `failed to resolve variable "registry": key "invalid" in Secret default/credentials does not contain a valid JSON value`,
+		},
+		{
+			name: "fail without leaking the value if the value is not valid according to the schema",
+			topology: clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{{Name: "token", ValueFrom: secretKeyRef("short")}},
+			},
+			wantErr: `spec.topology.variables[token].value: Invalid value: should be at least 8 chars long`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithScheme(fakeScheme()).WithObjects(secret).Build()
+			got, masker, err := ResolveValuesFrom(t.Context(), c, metav1.NamespaceDefault, tt.topology, definitions)
+			if tt.wantErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.wantErr))
+				g.Expect(err.Error()).ToNot(ContainSubstring("s3cr3t"))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(tt.want))
+
+			for _, value := range tt.wantMasked {
+				g.Expect(masker.Mask("value: " + value)).To(Equal("value: " + maskedValue))
+			}
+			for _, value := range tt.wantNotMasked {
+				g.Expect(masker.Mask("value: " + value)).To(Equal("value: " + value))
+			}
+		})
+	}
+}
+
+func TestMaskerMaskError(t *testing.T) {
+	g := NewWithT(t)
+
+	masker := &Masker{}
+	masker.add([]byte(`"s3cr3t"`))
+
+	g.Expect(masker.MaskError(nil)).ToNot(HaveOccurred())
+	err := errors.New("failed to render template: s3cr3t")
+	g.Expect(masker.MaskError(err)).To(MatchError("failed to render template: *****"))
+	err = errors.New("failed to render template")
+	g.Expect(masker.MaskError(err)).To(BeIdenticalTo(err))
+
+	var nilMasker *Masker
+	g.Expect(nilMasker.Mask("s3cr3t")).To(Equal("s3cr3t"))
+}
+
+func TestMaskerMinLength(t *testing.T) {
+	g := NewWithT(t)
+
+	masker := &Masker{}
+	masker.add([]byte(`{"user":"a","enabled":true,"token":"t0k3n"}`))
+
+	g.Expect(masker.Mask("user: a, enabled: true, token: t0k3n")).To(Equal("user: a, enabled: true, token: *****"))
+}
+
+func fakeScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	return scheme
+}
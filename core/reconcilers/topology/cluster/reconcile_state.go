@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -40,6 +41,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/patches/variables"
 	"sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/structuredmerge"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/feature"
@@ -78,6 +80,14 @@ func (r *Reconciler) reconcileState(ctx context.Context, s *scope.Scope) error {
 		}
 	}
 
+	// Reconcile desired state of the auxiliary resources.
+	// NOTE: Auxiliary resources are reconciled before other objects, because they might be required to provision
+	// the Cluster, e.g. IPAM pools, and before the Cluster object, because the kinds of the auxiliary resources tracked
+	// in the Cluster should be updated only after auxiliary resources of kinds not anymore in use have been deleted.
+	if err := r.reconcileAuxiliaryResources(ctx, s); err != nil {
+		return err
+	}
+
 	// Reconcile desired state of the InfrastructureCluster object.
 	createdInfraCluster, errInfraCluster := r.reconcileInfrastructureCluster(ctx, s)
 	if errInfraCluster != nil {
@@ -304,6 +314,7 @@ func (r *Reconciler) reconcileInfrastructureCluster(ctx context.Context, s *scop
 
 	return r.reconcileReferencedObject(ctx, reconcileReferencedObjectInput{
 		cluster:     s.Current.Cluster,
+		masker:      s.Masker,
 		current:     s.Current.InfrastructureCluster,
 		desired:     s.Desired.InfrastructureCluster,
 		ignorePaths: ignorePaths,
@@ -362,6 +373,7 @@ func (r *Reconciler) reconcileControlPlane(ctx context.Context, s *scope.Scope)
 		// Create or update the MachineInfrastructureTemplate of the control plane.
 		createdInfrastructureTemplate, err := r.reconcileReferencedTemplate(ctx, reconcileReferencedTemplateInput{
 			cluster:              s.Current.Cluster,
+			masker:               s.Masker,
 			ref:                  cpInfraRef,
 			current:              s.Current.ControlPlane.InfrastructureMachineTemplate,
 			desired:              s.Desired.ControlPlane.InfrastructureMachineTemplate,
@@ -407,6 +419,7 @@ func (r *Reconciler) reconcileControlPlane(ctx context.Context, s *scope.Scope)
 	}
 	created, err := r.reconcileReferencedObject(ctx, reconcileReferencedObjectInput{
 		cluster:       s.Current.Cluster,
+		masker:        s.Masker,
 		current:       s.Current.ControlPlane.Object,
 		desired:       s.Desired.ControlPlane.Object,
 		versionGetter: contract.ControlPlane().Version().Get,
@@ -492,6 +505,148 @@ func (r *Reconciler) reconcileMachineHealthCheck(ctx context.Context, current, d
 	return nil
 }
 
+// auxiliaryResourcesError is returned when the reconciliation of the auxiliary resources fails,
+// so the failure can be surfaced with a specific reason in the TopologyReconciled condition.
+type auxiliaryResourcesError struct {
+	err error
+}
+
+func (e *auxiliaryResourcesError) Error() string {
+	return e.err.Error()
+}
+
+func (e *auxiliaryResourcesError) Unwrap() error {
+	return e.err
+}
+
+// reconcileAuxiliaryResources reconciles the desired state of the auxiliary resources; auxiliary resources
+// are created or updated if defined in the ClusterClass, deleted otherwise.
+func (r *Reconciler) reconcileAuxiliaryResources(ctx context.Context, s *scope.Scope) error {
+	var errs []error
+
+	// Create or update the auxiliary resources defined in the ClusterClass.
+	for _, name := range sets.List(sets.KeySet(s.Desired.AuxiliaryResources)) {
+		if err := r.reconcileAuxiliaryResource(ctx, s.Current.Cluster, s.Current.AuxiliaryResources[name], s.Desired.AuxiliaryResources[name], s.Masker); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Delete the auxiliary resources not anymore defined in the ClusterClass.
+	for _, name := range sets.List(sets.KeySet(s.Current.AuxiliaryResources)) {
+		if _, ok := s.Desired.AuxiliaryResources[name]; ok {
+			continue
+		}
+		if err := r.deleteAuxiliaryResource(ctx, s.Current.Cluster, s.Current.AuxiliaryResources[name]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return &auxiliaryResourcesError{err: kerrors.NewAggregate(errs)}
+	}
+	return nil
+}
+
+// reconcileAuxiliaryResource creates, updates or leaves untouched an auxiliary resource depending on the difference between the
+// current state and the desired state.
+func (r *Reconciler) reconcileAuxiliaryResource(ctx context.Context, cluster *clusterv1.Cluster, current, desired *unstructured.Unstructured, masker *variables.Masker) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	// If the kind of the auxiliary resource changed in the ClusterClass, delete the current auxiliary resource
+	// and create a new one.
+	if current != nil && current.GroupVersionKind().GroupKind() != desired.GroupVersionKind().GroupKind() {
+		if err := r.deleteAuxiliaryResource(ctx, cluster, current); err != nil {
+			return err
+		}
+		current = nil
+	}
+
+	// If there is no current auxiliary resource, create it.
+	// NOTE: Auxiliary resources are created with a create call instead of a server side apply patch, so an existing
+	// object with the same name, e.g. an object created by users or by other controllers, is never adopted.
+	if current == nil {
+		log.Info(fmt.Sprintf("Creating %s", desired.GetKind()), desired.GetKind(), klog.KObj(desired))
+		obj := desired.DeepCopy()
+		if err := r.Client.Create(ctx, obj, client.FieldOwner(structuredmerge.TopologyManagerName)); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return pkgerrors.Wrapf(err, "failed to create %s %s: an object with the same name which is not an auxiliary resource of this Cluster already exists", desired.GetKind(), klog.KObj(desired))
+			}
+			return pkgerrors.Wrapf(err, "failed to create %s %s", desired.GetKind(), klog.KObj(desired))
+		}
+		r.recorder.Eventf(cluster, corev1.EventTypeNormal, createEventReason, "Created %s %q", desired.GetKind(), klog.KObj(desired))
+		return r.convertAuxiliaryResourceManagedFields(ctx, obj)
+	}
+
+	log = log.WithValues(current.GetKind(), klog.KObj(current))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	// Complete the conversion of the managedFields of the create call, if it failed when the auxiliary resource was created.
+	if err := r.convertAuxiliaryResourceManagedFields(ctx, current); err != nil {
+		return err
+	}
+
+	// Check differences between current and desired auxiliary resource, and patch if required.
+	// NOTE: we want to be authoritative on the fields defined in the ClusterClass because the users are
+	// expected to change auxiliary resources from the ClusterClass only.
+	patchHelper, err := structuredmerge.NewServerSidePatchHelper(ctx, current, desired, r.Client, r.ssaCache)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to create patch helper for %s %s", current.GetKind(), klog.KObj(current))
+	}
+	if !patchHelper.HasChanges() {
+		log.V(3).Info(fmt.Sprintf("No changes for %s", desired.GetKind()))
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("Patching %s", desired.GetKind()), "diff", masker.Mask(patchHelper.Diff()), "patch", masker.Mask(patchHelper.PatchData()))
+	if _, err := patchHelper.Patch(ctx); err != nil {
+		return pkgerrors.Wrapf(err, "failed to patch %s %s", current.GetKind(), klog.KObj(current))
+	}
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, updateEventReason, "Updated %s %q", desired.GetKind(), klog.KObj(desired))
+	return nil
+}
+
+// convertAuxiliaryResourceManagedFields turns the managedFields entry of the create call of an auxiliary resource
+// into a server side apply entry, so the topology controller owns the fields of the auxiliary resource as if it had been
+// created with a server side apply patch; this ensures that fields removed from the ClusterClass are removed from the
+// auxiliary resource by the following server side apply patches.
+func (r *Reconciler) convertAuxiliaryResourceManagedFields(ctx context.Context, obj *unstructured.Unstructured) error {
+	isTopologyManager := func(operation metav1.ManagedFieldsOperationType) func(entry metav1.ManagedFieldsEntry) bool {
+		return func(entry metav1.ManagedFieldsEntry) bool {
+			return entry.Manager == structuredmerge.TopologyManagerName && entry.Operation == operation && entry.Subresource == ""
+		}
+	}
+	managedFields := obj.GetManagedFields()
+	i := slices.IndexFunc(managedFields, isTopologyManager(metav1.ManagedFieldsOperationUpdate))
+	if i == -1 || slices.ContainsFunc(managedFields, isTopologyManager(metav1.ManagedFieldsOperationApply)) {
+		return nil
+	}
+
+	base := obj.DeepCopy()
+	managedFields = slices.Clone(managedFields)
+	managedFields[i].Operation = metav1.ManagedFieldsOperationApply
+	obj.SetManagedFields(managedFields)
+	// Use optimistic locking to avoid accidentally rolling back managedFields.
+	if err := r.Client.Patch(ctx, obj, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return pkgerrors.Wrapf(err, "failed to update managedFields of %s %s", obj.GetKind(), klog.KObj(obj))
+	}
+	return nil
+}
+
+// deleteAuxiliaryResource deletes an auxiliary resource.
+func (r *Reconciler) deleteAuxiliaryResource(ctx context.Context, cluster *clusterv1.Cluster, auxiliaryResource *unstructured.Unstructured) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info(fmt.Sprintf("Deleting %s", auxiliaryResource.GetKind()), auxiliaryResource.GetKind(), klog.KObj(auxiliaryResource))
+	if err := r.Client.Delete(ctx, auxiliaryResource); err != nil {
+		// If the object to be deleted is not found don't throw an error.
+		if !apierrors.IsNotFound(err) {
+			return pkgerrors.Wrapf(err, "failed to delete %s %s", auxiliaryResource.GetKind(), klog.KObj(auxiliaryResource))
+		}
+	}
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, deleteEventReason, "Deleted %s %q", auxiliaryResource.GetKind(), klog.KObj(auxiliaryResource))
+	return nil
+}
+
 // reconcileCluster reconciles the desired state of the Cluster object.
 // NOTE: this assumes reconcileInfrastructureCluster and reconcileControlPlane being already completed;
 // most specifically, after a Cluster is created it is assumed that the reference to the InfrastructureCluster /
@@ -628,6 +783,7 @@ func (r *Reconciler) createMachineDeployment(ctx context.Context, s *scope.Scope
 	infrastructureMachineCleanupFunc := func() {}
 	createdInfra, err := r.reconcileReferencedTemplate(infraCtx, reconcileReferencedTemplateInput{
 		cluster: cluster,
+		masker:  s.Masker,
 		desired: md.InfrastructureMachineTemplate,
 	})
 	if err != nil {
@@ -649,6 +805,7 @@ func (r *Reconciler) createMachineDeployment(ctx context.Context, s *scope.Scope
 	bootstrapCleanupFunc := func() {}
 	createdBootstrap, err := r.reconcileReferencedTemplate(bootstrapCtx, reconcileReferencedTemplateInput{
 		cluster: cluster,
+		masker:  s.Masker,
 		desired: md.BootstrapTemplate,
 	})
 	if err != nil {
@@ -726,6 +883,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 	infrastructureMachineCleanupFunc := func() {}
 	createdInfra, err := r.reconcileReferencedTemplate(infraCtx, reconcileReferencedTemplateInput{
 		cluster:              cluster,
+		masker:               s.Masker,
 		ref:                  &desiredMD.Object.Spec.Template.Spec.InfrastructureRef,
 		current:              currentMD.InfrastructureMachineTemplate,
 		desired:              desiredMD.InfrastructureMachineTemplate,
@@ -751,6 +909,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 	bootstrapCleanupFunc := func() {}
 	createdBootstrap, err := r.reconcileReferencedTemplate(bootstrapCtx, reconcileReferencedTemplateInput{
 		cluster:              cluster,
+		masker:               s.Masker,
 		ref:                  &desiredMD.Object.Spec.Template.Spec.Bootstrap.ConfigRef,
 		current:              currentMD.BootstrapTemplate,
 		desired:              desiredMD.BootstrapTemplate,
@@ -791,7 +950,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 	if diff == "" && patchData == "" {
 		log.Info("Patching MachineDeployment")
 	} else {
-		log.Info("Patching MachineDeployment", "diff", diff, "patch", patchData)
+		log.Info("Patching MachineDeployment", "diff", s.Masker.Mask(diff), "patch", s.Masker.Mask(patchData))
 	}
 	modifiedResourceVersion, err := patchHelper.Patch(ctx)
 	if err != nil {
@@ -941,6 +1100,7 @@ func (r *Reconciler) createMachinePool(ctx context.Context, s *scope.Scope, mp *
 	infrastructureMachineMachinePoolCleanupFunc := func() {}
 	createdInfrastructureMachinePool, err := r.reconcileReferencedObject(infraCtx, reconcileReferencedObjectInput{
 		cluster: cluster,
+		masker:  s.Masker,
 		desired: mp.InfrastructureMachinePoolObject,
 	})
 	if err != nil {
@@ -962,6 +1122,7 @@ func (r *Reconciler) createMachinePool(ctx context.Context, s *scope.Scope, mp *
 	bootstrapCleanupFunc := func() {}
 	createdBootstrap, err := r.reconcileReferencedObject(bootstrapCtx, reconcileReferencedObjectInput{
 		cluster: cluster,
+		masker:  s.Masker,
 		desired: mp.BootstrapObject,
 	})
 	if err != nil {
@@ -999,6 +1160,12 @@ func (r *Reconciler) createMachinePool(ctx context.Context, s *scope.Scope, mp *
 
 	r.controller.DeferNextReconcileUntilCacheUpToDate(cluster, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "MachinePool"), modifiedResourceVersion)
 
+	// If the MachinePool has defined a MachineHealthCheck reconcile it.
+	if mp.MachineHealthCheck != nil {
+		if err := r.reconcileMachineHealthCheck(ctx, nil, mp.MachineHealthCheck); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1007,6 +1174,15 @@ func (r *Reconciler) updateMachinePool(ctx context.Context, s *scope.Scope, mpTo
 	log := ctrl.LoggerFrom(ctx).WithValues("MachinePool", klog.KObj(desiredMP.Object),
 		"machinePoolTopology", mpTopologyName)
 
+	// Patch MachineHealthCheck for the MachinePool.
+	// MHC changes are not Kubernetes version dependent, therefore proceed with MHC reconciliation
+	// even if the MachinePool is pending an upgrade.
+	if desiredMP.MachineHealthCheck != nil || currentMP.MachineHealthCheck != nil {
+		if err := r.reconcileMachineHealthCheck(ctx, currentMP.MachineHealthCheck, desiredMP.MachineHealthCheck); err != nil {
+			return err
+		}
+	}
+
 	// Return early if the MachinePool is pending an upgrade.
 	// Do not reconcile the MachinePool yet to avoid updating the MachinePool while it is still pending a
 	// version upgrade. This will prevent the MachinePool from performing a double rollout.
@@ -1018,6 +1194,7 @@ func (r *Reconciler) updateMachinePool(ctx context.Context, s *scope.Scope, mpTo
 	infraCtx := ctrl.LoggerInto(ctx, log.WithValues(desiredMP.InfrastructureMachinePoolObject.GetKind(), klog.KObj(desiredMP.InfrastructureMachinePoolObject)))
 	if _, err := r.reconcileReferencedObject(infraCtx, reconcileReferencedObjectInput{
 		cluster: cluster,
+		masker:  s.Masker,
 		current: currentMP.InfrastructureMachinePoolObject,
 		desired: desiredMP.InfrastructureMachinePoolObject,
 	}); err != nil {
@@ -1027,6 +1204,7 @@ func (r *Reconciler) updateMachinePool(ctx context.Context, s *scope.Scope, mpTo
 	bootstrapCtx := ctrl.LoggerInto(ctx, log.WithValues(desiredMP.BootstrapObject.GetKind(), klog.KObj(desiredMP.BootstrapObject)))
 	if _, err := r.reconcileReferencedObject(bootstrapCtx, reconcileReferencedObjectInput{
 		cluster: cluster,
+		masker:  s.Masker,
 		current: currentMP.BootstrapObject,
 		desired: desiredMP.BootstrapObject,
 	}); err != nil {
@@ -1048,7 +1226,7 @@ func (r *Reconciler) updateMachinePool(ctx context.Context, s *scope.Scope, mpTo
 	if diff == "" && patchData == "" {
 		log.Info("Patching MachinePool")
 	} else {
-		log.Info("Patching MachinePool", "diff", diff, "patch", patchData)
+		log.Info("Patching MachinePool", "diff", s.Masker.Mask(diff), "patch", s.Masker.Mask(patchData))
 	}
 	modifiedResourceVersion, err := patchHelper.Patch(ctx)
 	if err != nil {
@@ -1079,6 +1257,12 @@ func (r *Reconciler) deleteMachinePool(ctx context.Context, cluster *clusterv1.C
 		"MachinePool", klog.KObj(mp.Object),
 		"machinePoolTopology", mp.Object.Labels[clusterv1.ClusterTopologyMachinePoolNameLabel])
 
+	// delete MachineHealthCheck for the MachinePool.
+	if mp.MachineHealthCheck != nil {
+		if err := r.reconcileMachineHealthCheck(ctx, mp.MachineHealthCheck, nil); err != nil {
+			return err
+		}
+	}
 	log.Info("Deleting MachinePool")
 	if err := r.Client.Delete(ctx, mp.Object); err != nil && !apierrors.IsNotFound(err) {
 		return pkgerrors.Wrapf(err, "failed to delete MachinePool %s", klog.KObj(mp.Object))
@@ -1139,6 +1323,7 @@ type unstructuredVersionGetter func(obj *unstructured.Unstructured) (*string, er
 
 type reconcileReferencedObjectInput struct {
 	cluster       *clusterv1.Cluster
+	masker        *variables.Masker
 	current       *unstructured.Unstructured
 	desired       *unstructured.Unstructured
 	versionGetter unstructuredVersionGetter
@@ -1189,7 +1374,7 @@ func (r *Reconciler) reconcileReferencedObject(ctx context.Context, in reconcile
 	if diff == "" && patchData == "" {
 		log.Info(fmt.Sprintf("Patching %s", in.desired.GetKind()))
 	} else {
-		log.Info(fmt.Sprintf("Patching %s", in.desired.GetKind()), "diff", diff, "patch", patchData)
+		log.Info(fmt.Sprintf("Patching %s", in.desired.GetKind()), "diff", in.masker.Mask(diff), "patch", in.masker.Mask(patchData))
 	}
 	if _, err := patchHelper.Patch(ctx); err != nil {
 		return false, pkgerrors.Wrapf(err, "failed to patch %s %s", in.current.GetKind(), klog.KObj(in.current))
@@ -1220,6 +1405,7 @@ func logUnstructuredVersionChange(current, desired *unstructured.Unstructured, v
 
 type reconcileReferencedTemplateInput struct {
 	cluster              *clusterv1.Cluster
+	masker               *variables.Masker
 	ref                  *clusterv1.ContractVersionedObjectReference
 	current              *unstructured.Unstructured
 	desired              *unstructured.Unstructured
@@ -1285,7 +1471,7 @@ func (r *Reconciler) reconcileReferencedTemplate(ctx context.Context, in reconci
 		if diff == "" && patchData == "" {
 			log.Info(fmt.Sprintf("Patching %s", in.desired.GetKind()))
 		} else {
-			log.Info(fmt.Sprintf("Patching %s", in.desired.GetKind()), "diff", diff, "patch", patchData)
+			log.Info(fmt.Sprintf("Patching %s", in.desired.GetKind()), "diff", in.masker.Mask(diff), "patch", in.masker.Mask(patchData))
 		}
 		if _, err := patchHelper.Patch(ctx); err != nil {
 			return false, pkgerrors.Wrapf(err, "failed to patch %s %s", in.desired.GetKind(), klog.KObj(in.desired))
@@ -1306,7 +1492,7 @@ func (r *Reconciler) reconcileReferencedTemplate(ctx context.Context, in reconci
 	if diff == "" && patchData == "" {
 		log.Info(fmt.Sprintf("Rotating %s, new name %s", in.current.GetKind(), newName))
 	} else {
-		log.Info(fmt.Sprintf("Rotating %s, new name %s", in.current.GetKind(), newName), "diff", diff, "patch", patchData)
+		log.Info(fmt.Sprintf("Rotating %s, new name %s", in.current.GetKind(), newName), "diff", in.masker.Mask(diff), "patch", in.masker.Mask(patchData))
 	}
 	log.Info(fmt.Sprintf("Creating %s", in.current.GetKind()))
 	helper, err := structuredmerge.NewServerSidePatchHelper(ctx, nil, in.desired, r.Client, r.ssaCache)
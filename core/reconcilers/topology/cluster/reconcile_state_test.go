@@ -3793,6 +3793,214 @@ func TestReconcileMachineDeploymentMachineHealthCheck(t *testing.T) {
 	}
 }
 
+func TestReconcileAuxiliaryResources(t *testing.T) {
+	newDrainRule := func(name, behavior string) *unstructured.Unstructured {
+		drainRule := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": clusterv1.GroupVersion.String(),
+				"kind":       "MachineDrainRule",
+				"spec": map[string]interface{}{
+					"drain": map[string]interface{}{
+						"behavior": behavior,
+					},
+				},
+			},
+		}
+		drainRule.SetName(fmt.Sprintf("cluster-1-%s", name))
+		drainRule.SetLabels(map[string]string{
+			clusterv1.ClusterNameLabel:                          "cluster-1",
+			clusterv1.ClusterTopologyOwnedLabel:                 "",
+			clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: name,
+		})
+		return drainRule
+	}
+
+	tests := []struct {
+		name    string
+		current map[string]*unstructured.Unstructured
+		desired map[string]*unstructured.Unstructured
+		want    map[string]string
+	}{
+		{
+			name:    "Create auxiliary resources",
+			current: map[string]*unstructured.Unstructured{},
+			desired: map[string]*unstructured.Unstructured{
+				"drain-rule": newDrainRule("drain-rule", "Skip"),
+			},
+			want: map[string]string{
+				"cluster-1-drain-rule": "Skip",
+			},
+		},
+		{
+			name: "Update auxiliary resources",
+			current: map[string]*unstructured.Unstructured{
+				"drain-rule": newDrainRule("drain-rule", "Skip"),
+			},
+			desired: map[string]*unstructured.Unstructured{
+				"drain-rule": newDrainRule("drain-rule", "Drain"),
+			},
+			want: map[string]string{
+				"cluster-1-drain-rule": "Drain",
+			},
+		},
+		{
+			name: "Delete auxiliary resources not anymore defined in the ClusterClass",
+			current: map[string]*unstructured.Unstructured{
+				"drain-rule":       newDrainRule("drain-rule", "Skip"),
+				"other-drain-rule": newDrainRule("other-drain-rule", "Skip"),
+			},
+			desired: map[string]*unstructured.Unstructured{
+				"drain-rule": newDrainRule("drain-rule", "Skip"),
+			},
+			want: map[string]string{
+				"cluster-1-drain-rule": "Skip",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			// Create namespace and modify input to have correct namespace set
+			namespace, err := env.CreateNamespace(ctx, "reconcile-auxiliary-resources")
+			g.Expect(err).ToNot(HaveOccurred())
+
+			for _, obj := range tt.current {
+				obj.SetNamespace(namespace.GetName())
+				g.Expect(env.PatchAndWait(ctx, obj, structuredmerge.TopologyManagerName)).To(Succeed())
+			}
+			for _, obj := range tt.desired {
+				obj.SetNamespace(namespace.GetName())
+			}
+
+			s := scope.New(builder.Cluster(namespace.GetName(), "cluster-1").Build())
+			s.Current.AuxiliaryResources = tt.current
+			s.Desired = &scope.ClusterState{AuxiliaryResources: tt.desired}
+
+			r := Reconciler{
+				Client:    env.GetClient(),
+				APIReader: env.GetAPIReader(),
+				recorder:  env.GetEventRecorderFor("test"),
+				ssaCache:  ssa.NewCache("topology/cluster"),
+			}
+			g.Expect(r.reconcileAuxiliaryResources(ctx, s)).To(Succeed())
+
+			gotDrainRules := &clusterv1.MachineDrainRuleList{}
+			g.Expect(env.GetAPIReader().List(ctx, gotDrainRules, client.InNamespace(namespace.GetName()))).To(Succeed())
+			got := map[string]string{}
+			for _, drainRule := range gotDrainRules.Items {
+				if drainRule.DeletionTimestamp.IsZero() {
+					got[drainRule.Name] = string(drainRule.Spec.Drain.Behavior)
+				}
+			}
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+
+	t.Run("Does not adopt an existing object with the same name", func(t *testing.T) {
+		g := NewWithT(t)
+
+		namespace, err := env.CreateNamespace(ctx, "reconcile-auxiliary-resources")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		// Create an object with the same name of the auxiliary resource, e.g. created by a user.
+		existing := &clusterv1.MachineDrainRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-1-drain-rule",
+				Namespace: namespace.GetName(),
+			},
+			Spec: clusterv1.MachineDrainRuleSpec{
+				Drain: clusterv1.MachineDrainRuleDrainConfig{
+					Behavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				},
+			},
+		}
+		g.Expect(env.CreateAndWait(ctx, existing)).To(Succeed())
+
+		drainRule := newDrainRule("drain-rule", "Skip")
+		drainRule.SetNamespace(namespace.GetName())
+
+		s := scope.New(builder.Cluster(namespace.GetName(), "cluster-1").Build())
+		s.Desired = &scope.ClusterState{AuxiliaryResources: map[string]*unstructured.Unstructured{
+			"drain-rule": drainRule,
+		}}
+
+		r := Reconciler{
+			Client:    env.GetClient(),
+			APIReader: env.GetAPIReader(),
+			recorder:  env.GetEventRecorderFor("test"),
+			ssaCache:  ssa.NewCache("topology/cluster"),
+		}
+		err = r.reconcileAuxiliaryResources(ctx, s)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(apierrors.IsAlreadyExists(err)).To(BeTrue())
+
+		// The existing object is not modified.
+		got := &clusterv1.MachineDrainRule{}
+		g.Expect(env.GetAPIReader().Get(ctx, client.ObjectKeyFromObject(existing), got)).To(Succeed())
+		g.Expect(got.Labels).ToNot(HaveKey(clusterv1.ClusterTopologyOwnedLabel))
+		g.Expect(got.Spec.Drain.Behavior).To(Equal(clusterv1.MachineDrainRuleDrainBehaviorDrain))
+	})
+
+	t.Run("Creates auxiliary resources owned by the topology controller via server side apply", func(t *testing.T) {
+		g := NewWithT(t)
+
+		namespace, err := env.CreateNamespace(ctx, "reconcile-auxiliary-resources")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		drainRule := newDrainRule("drain-rule", "Skip")
+		drainRule.SetNamespace(namespace.GetName())
+
+		s := scope.New(builder.Cluster(namespace.GetName(), "cluster-1").Build())
+		s.Desired = &scope.ClusterState{AuxiliaryResources: map[string]*unstructured.Unstructured{
+			"drain-rule": drainRule,
+		}}
+
+		r := Reconciler{
+			Client:    env.GetClient(),
+			APIReader: env.GetAPIReader(),
+			recorder:  env.GetEventRecorderFor("test"),
+			ssaCache:  ssa.NewCache("topology/cluster"),
+		}
+		g.Expect(r.reconcileAuxiliaryResources(ctx, s)).To(Succeed())
+
+		got := &clusterv1.MachineDrainRule{}
+		g.Expect(env.GetAPIReader().Get(ctx, client.ObjectKeyFromObject(drainRule), got)).To(Succeed())
+		g.Expect(got.ManagedFields).To(ContainElement(And(
+			HaveField("Manager", structuredmerge.TopologyManagerName),
+			HaveField("Operation", metav1.ManagedFieldsOperationApply),
+		)))
+		g.Expect(got.ManagedFields).ToNot(ContainElement(And(
+			HaveField("Manager", structuredmerge.TopologyManagerName),
+			HaveField("Operation", metav1.ManagedFieldsOperationUpdate),
+		)))
+	})
+
+	t.Run("Return an auxiliaryResourcesError in case of errors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		drainRule := newDrainRule("drain-rule", "Skip")
+		// Force the creation to fail.
+		drainRule.SetNamespace("do-not-exist")
+
+		s := scope.New(builder.Cluster("do-not-exist", "cluster-1").Build())
+		s.Desired = &scope.ClusterState{AuxiliaryResources: map[string]*unstructured.Unstructured{
+			"drain-rule": drainRule,
+		}}
+
+		r := Reconciler{
+			Client:    env.GetClient(),
+			APIReader: env.GetAPIReader(),
+			recorder:  env.GetEventRecorderFor("test"),
+			ssaCache:  ssa.NewCache("topology/cluster"),
+		}
+		err := r.reconcileAuxiliaryResources(ctx, s)
+		g.Expect(err).To(HaveOccurred())
+		var auxiliaryResourcesErr *auxiliaryResourcesError
+		g.Expect(pkgerrors.As(err, &auxiliaryResourcesErr)).To(BeTrue())
+	})
+}
+
 func TestReconcileState(t *testing.T) {
 	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterTopology, true)
 
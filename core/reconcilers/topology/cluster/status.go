@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	pkgerrors "github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -112,10 +113,18 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 	// If an error occurred during reconciliation set the TopologyReconciled condition to false.
 	// Add the error message from the reconcile function to the message of the condition.
 	if reconcileErr != nil {
+		v1beta1Reason := clusterv1.TopologyReconcileFailedV1Beta1Reason
+		reason := clusterv1.ClusterTopologyReconciledFailedReason
+		// Use a specific reason if the error occurred while reconciling auxiliary resources.
+		var auxiliaryResourcesErr *auxiliaryResourcesError
+		if pkgerrors.As(reconcileErr, &auxiliaryResourcesErr) {
+			v1beta1Reason = clusterv1.TopologyReconciledAuxiliaryResourcesFailedV1Beta1Reason
+			reason = clusterv1.ClusterTopologyReconciledAuxiliaryResourcesFailedReason
+		}
 		v1beta1conditions.Set(cluster,
 			v1beta1conditions.FalseCondition(
 				clusterv1.TopologyReconciledV1Beta1Condition,
-				clusterv1.TopologyReconcileFailedV1Beta1Reason,
+				v1beta1Reason,
 				clusterv1.ConditionSeverityError,
 				// TODO: Add a protection for messages continuously changing leading to Cluster object changes/reconcile.
 				"%s", reconcileErr.Error(),
@@ -124,7 +133,7 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 		conditions.Set(cluster, metav1.Condition{
 			Type:   clusterv1.ClusterTopologyReconciledCondition,
 			Status: metav1.ConditionFalse,
-			Reason: clusterv1.ClusterTopologyReconciledFailedReason,
+			Reason: reason,
 			// TODO: Add a protection for messages continuously changing leading to Cluster object changes/reconcile.
 			Message: reconcileErr.Error(),
 		})
@@ -177,6 +186,30 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 		return nil
 	}
 
+	// If the Cluster is pinned to a previous generation of the ClusterClass, surface that the changes to the
+	// ClusterClass are not yet applied.
+	if s.Blueprint != nil && s.Blueprint.ClusterClass != nil {
+		if pinned, err := isPinnedToPreviousClusterClassGeneration(cluster, s.Blueprint.ClusterClass); err == nil && pinned {
+			message := fmt.Sprintf("Cluster is pinned to generation %s of ClusterClass %s, changes to the ClusterClass are applied when the Cluster is unpinned, e.g. by a ClusterClassRollout",
+				cluster.Annotations[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation], s.Blueprint.ClusterClass.Name)
+			v1beta1conditions.Set(cluster,
+				v1beta1conditions.FalseCondition(
+					clusterv1.TopologyReconciledV1Beta1Condition,
+					clusterv1.TopologyReconciledClusterClassRolloutPendingV1Beta1Reason,
+					clusterv1.ConditionSeverityInfo,
+					"%s", message,
+				),
+			)
+			conditions.Set(cluster, metav1.Condition{
+				Type:    clusterv1.ClusterTopologyReconciledCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.ClusterTopologyReconciledClusterClassRolloutPendingReason,
+				Message: message,
+			})
+			return nil
+		}
+	}
+
 	// If the BeforeClusterCreate hook is blocking, reports it
 	if !s.Current.Cluster.Spec.InfrastructureRef.IsDefined() && !s.Current.Cluster.Spec.ControlPlaneRef.IsDefined() {
 		if s.HookResponseTracker.AggregateRetryAfter() != 0 {
@@ -242,6 +275,15 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 			fmt.Fprintf(msgBuilder, "\n  * %s", s.HookResponseTracker.AggregateMessage("upgrade"))
 		}
 
+		// If maintenance windows are holding the next upgrade step, surface it.
+		if s.UpgradeTracker.MaintenanceWindow.IsHoldingUpgrade {
+			if !s.UpgradeTracker.MaintenanceWindow.NextOpen.IsZero() {
+				fmt.Fprintf(msgBuilder, "\n  * Next upgrade step waiting for the maintenance window opening at %s", s.UpgradeTracker.MaintenanceWindow.NextOpen.UTC().Format(time.RFC3339))
+			} else {
+				fmt.Fprintf(msgBuilder, "\n  * Next upgrade step waiting for a maintenance window, but none of the maintenance windows is going to open again")
+			}
+		}
+
 		// If control plane is upgrading surface it, otherwise surface the pending upgrade plan.
 		if s.UpgradeTracker.ControlPlane.IsStartingUpgrade || s.UpgradeTracker.ControlPlane.IsUpgrading {
 			fmt.Fprintf(msgBuilder, "\n  * %s upgrading to version %s%s", s.Current.ControlPlane.Object.GetKind(), *cpVersion, pendingVersions(s.UpgradeTracker.ControlPlane.UpgradePlan, *cpVersion))
@@ -306,6 +348,16 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 			fmt.Fprintf(msgBuilder, "\n  * %s creation deferred while control plane upgrade is in progress", nameList("MachinePool", "MachinePools", s.UpgradeTracker.MachinePools.PendingCreateTopologyNames()))
 		}
 
+		// If maintenance windows are holding the next upgrade step and nothing else is upgrading, surface it.
+		// Note: Deferred upgrades take the precedence on this signal.
+		if s.UpgradeTracker.MaintenanceWindow.IsHoldingUpgrade &&
+			(!s.UpgradeTracker.ControlPlane.IsStartingUpgrade && !s.UpgradeTracker.ControlPlane.IsUpgrading) &&
+			!s.UpgradeTracker.MachineDeployments.IsAnyUpgrading() && !s.UpgradeTracker.MachinePools.IsAnyUpgrading() &&
+			reason == clusterv1.ClusterTopologyReconciledClusterUpgradingReason {
+			reason = clusterv1.ClusterTopologyReconciledMaintenanceWindowClosedReason
+			v1Beta1Reason = clusterv1.TopologyReconciledMaintenanceWindowClosedV1Beta1Reason
+		}
+
 		v1beta1conditions.Set(cluster,
 			v1beta1conditions.FalseCondition(
 				clusterv1.TopologyReconciledV1Beta1Condition,
@@ -19,6 +19,7 @@ package cluster
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
@@ -77,6 +78,22 @@ func TestReconcileTopologyReconciledCondition(t *testing.T) {
 			wantConditionMessage:        "reconcile error",
 			wantErr:                     false,
 		},
+		{
+			name:         "should set the condition to false with a specific reason if there is an error reconciling auxiliary resources",
+			reconcileErr: pkgerrors.Wrap(&auxiliaryResourcesError{err: pkgerrors.New("failed to create MachineDrainRule")}, "reconcile error"),
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster: &clusterv1.Cluster{},
+				},
+			},
+			wantV1Beta1ConditionStatus:  corev1.ConditionFalse,
+			wantV1Beta1ConditionReason:  clusterv1.TopologyReconciledAuxiliaryResourcesFailedV1Beta1Reason,
+			wantV1Beta1ConditionMessage: "reconcile error: failed to create MachineDrainRule",
+			wantConditionStatus:         metav1.ConditionFalse,
+			wantConditionReason:         clusterv1.ClusterTopologyReconciledAuxiliaryResourcesFailedReason,
+			wantConditionMessage:        "reconcile error: failed to create MachineDrainRule",
+			wantErr:                     false,
+		},
 
 		// Paused
 
@@ -232,6 +249,41 @@ func TestReconcileTopologyReconciledCondition(t *testing.T) {
 			wantErr: false,
 		},
 
+		// Cluster pinned to a previous generation of the ClusterClass
+
+		{
+			name: "should set the condition to false if the Cluster is pinned to a previous generation of the ClusterClass",
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation: "9",
+							},
+						},
+					},
+				},
+				Blueprint: &scope.ClusterBlueprint{
+					ClusterClass: &clusterv1.ClusterClass{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:       "class1",
+							Generation: 10,
+						},
+						Status: clusterv1.ClusterClassStatus{
+							ObservedGeneration: 10,
+						},
+					},
+				},
+			},
+			wantV1Beta1ConditionStatus:  corev1.ConditionFalse,
+			wantV1Beta1ConditionReason:  clusterv1.TopologyReconciledClusterClassRolloutPendingV1Beta1Reason,
+			wantV1Beta1ConditionMessage: "Cluster is pinned to generation 9 of ClusterClass class1, changes to the ClusterClass are applied when the Cluster is unpinned, e.g. by a ClusterClassRollout",
+			wantConditionStatus:         metav1.ConditionFalse,
+			wantConditionReason:         clusterv1.ClusterTopologyReconciledClusterClassRolloutPendingReason,
+			wantConditionMessage:        "Cluster is pinned to generation 9 of ClusterClass class1, changes to the ClusterClass are applied when the Cluster is unpinned, e.g. by a ClusterClassRollout",
+			wantErr:                     false,
+		},
+
 		// BeforeClusterCreate hook is blocking
 
 		{
@@ -1090,6 +1142,48 @@ func TestReconcileTopologyReconciledCondition(t *testing.T) {
 				"  * MachineDeployment md2 upgrade to version v1.22.0 deferred using defer-upgrade or hold-upgrade-sequence annotations",
 		},
 
+		// Maintenance windows
+		{
+			name:         "should report when maintenance windows are holding the upgrade",
+			reconcileErr: nil,
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster: &clusterv1.Cluster{
+						Spec: clusterv1.ClusterSpec{
+							ControlPlaneRef:   clusterv1.ContractVersionedObjectReference{Name: "controlplane1"},
+							InfrastructureRef: clusterv1.ContractVersionedObjectReference{Name: "infra1"},
+							Topology: clusterv1.Topology{
+								Version: "v1.22.0",
+							},
+						},
+					},
+					ControlPlane: &scope.ControlPlaneState{
+						Object: builder.ControlPlane("ns1", "controlplane1").WithVersion("v1.21.2").Build(),
+					},
+				},
+				UpgradeTracker: func() *scope.UpgradeTracker {
+					ut := scope.NewUpgradeTracker()
+					ut.ControlPlane.IsPendingUpgrade = true
+					ut.ControlPlane.UpgradePlan = []string{"v1.22.0"}
+					ut.MaintenanceWindow.IsClosed = true
+					ut.MaintenanceWindow.NextOpen = time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC)
+					ut.MaintenanceWindow.IsHoldingUpgrade = true
+					return ut
+				}(),
+				HookResponseTracker: scope.NewHookResponseTracker(),
+			},
+			wantV1Beta1ConditionStatus: corev1.ConditionFalse,
+			wantV1Beta1ConditionReason: clusterv1.TopologyReconciledMaintenanceWindowClosedV1Beta1Reason,
+			wantV1Beta1ConditionMessage: "Cluster is upgrading to v1.22.0\n" +
+				"  * Next upgrade step waiting for the maintenance window opening at 2026-10-17T22:00:00Z\n" +
+				"  * GenericControlPlane pending upgrade to version v1.22.0",
+			wantConditionStatus: metav1.ConditionFalse,
+			wantConditionReason: clusterv1.ClusterTopologyReconciledMaintenanceWindowClosedReason,
+			wantConditionMessage: "Cluster is upgrading to v1.22.0\n" +
+				"  * Next upgrade step waiting for the maintenance window opening at 2026-10-17T22:00:00Z\n" +
+				"  * GenericControlPlane pending upgrade to version v1.22.0",
+		},
+
 		// Create deferred
 		{
 			name:         "should report MachineDeployment creation deferred while CP is upgrading",
@@ -18,12 +18,14 @@ package cluster
 
 import (
 	"context"
+	"strconv"
 
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog/v2"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/external"
 )
 
@@ -39,3 +41,17 @@ func (r *Reconciler) getReference(ctx context.Context, ref *corev1.ObjectReferen
 	}
 	return obj, nil
 }
+
+// isPinnedToPreviousClusterClassGeneration returns true if the Cluster is pinned to a generation of the ClusterClass
+// older than the current one, and thus the changes to the ClusterClass must not be applied to the Cluster topology yet.
+func isPinnedToPreviousClusterClassGeneration(cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass) (bool, error) {
+	value, ok := cluster.GetAnnotations()[clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation]
+	if !ok {
+		return false, nil
+	}
+	generation, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false, pkgerrors.Wrapf(err, "failed to parse %s annotation", clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation)
+	}
+	return generation < clusterClass.GetGeneration(), nil
+}
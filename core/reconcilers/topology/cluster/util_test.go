@@ -113,3 +113,59 @@ func TestGetReference(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPinnedToPreviousClusterClassGeneration(t *testing.T) {
+	clusterClass := &clusterv1.ClusterClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "class1",
+			Generation: 5,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+		wantErr     bool
+	}{
+		{
+			name: "Cluster not pinned",
+			want: false,
+		},
+		{
+			name:        "Cluster pinned to the current generation",
+			annotations: map[string]string{clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation: "5"},
+			want:        false,
+		},
+		{
+			name:        "Cluster pinned to a previous generation",
+			annotations: map[string]string{clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation: "4"},
+			want:        true,
+		},
+		{
+			name:        "Cluster pinned to an invalid generation",
+			annotations: map[string]string{clusterv1.ClusterTopologyPinnedClusterClassGenerationAnnotation: "foo"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cluster1",
+					Annotations: tt.annotations,
+				},
+			}
+
+			got, err := isPinnedToPreviousClusterClassGeneration(cluster, clusterClass)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -44,6 +44,7 @@ import (
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/hooks"
 	"sigs.k8s.io/cluster-api/internal/topology/check"
+	"sigs.k8s.io/cluster-api/internal/topology/maintenancewindows"
 	"sigs.k8s.io/cluster-api/internal/topology/variables"
 	"sigs.k8s.io/cluster-api/internal/util/taints"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -291,6 +292,8 @@ func (webhook *Cluster) validateTopology(ctx context.Context, oldCluster, newClu
 
 	allErrs = append(allErrs, validateTopologyTaints(newCluster.Spec.Topology, fldPath)...)
 
+	allErrs = append(allErrs, validateMaintenanceWindows(newCluster.Spec.Topology.Upgrade.MaintenanceWindows, fldPath.Child("upgrade", "maintenanceWindows"))...)
+
 	// upgrade concurrency should be a numeric value.
 	if concurrency, ok := newCluster.Annotations[clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation]; ok {
 		concurrencyAnnotationField := field.NewPath("metadata", "annotations", clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation)
@@ -672,6 +675,25 @@ func validateTopologyTaints(topology clusterv1.Topology, fldPath *field.Path) fi
 	return allErrs
 }
 
+// validateMaintenanceWindows validates that schedule and timeZone of maintenance windows can be parsed.
+func validateMaintenanceWindows(windows []clusterv1.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, window := range windows {
+		if window.TimeZone != "" {
+			if _, err := time.LoadLocation(window.TimeZone); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("timeZone"), window.TimeZone, "timeZone must be a valid IANA time zone name"))
+				continue
+			}
+		}
+		if _, err := maintenancewindows.Parse(window); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("schedule"), window.Schedule, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func validateMachineHealthChecks(cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -734,9 +756,48 @@ func validateMachineHealthChecks(cluster *clusterv1.Cluster, clusterClass *clust
 		}
 	}
 
+	for i := range cluster.Spec.Topology.Workers.MachinePools {
+		mp := cluster.Spec.Topology.Workers.MachinePools[i]
+		fldPath := field.NewPath("spec", "topology", "workers", "machinePools").Key(mp.Name).Child("healthCheck")
+
+		// Validate the MachinePool MachineHealthCheck if defined.
+		if mp.HealthCheck.IsDefined() {
+			allErrs = append(allErrs, validateMachineHealthCheckNodeStartupTimeoutSeconds(fldPath, mp.HealthCheck.Checks.NodeStartupTimeoutSeconds)...)
+			allErrs = append(allErrs, validateMachineHealthCheckUnhealthyLessThanOrEqualTo(fldPath, mp.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo)...)
+		}
+
+		// If MachineHealthCheck is explicitly enabled then make sure that a MachineHealthCheck definition is
+		// available either in the Cluster topology or in the ClusterClass.
+		// (One of these definitions will be used in the controller to create the MachineHealthCheck)
+		mpClass := machinePoolClassOfName(clusterClass, mp.Class)
+		if mpClass != nil { // Note: we skip handling the nil case here as it is already handled in previous validations.
+			// Check if the machineHealthCheck is explicitly enabled in the machinePoolTopology.
+			if mp.HealthCheck.Enabled != nil && *mp.HealthCheck.Enabled {
+				// Ensure the MHC is defined in at least one of the MachinePoolTopology of the Cluster or the MachinePoolClass of the ClusterClass.
+				if !mp.HealthCheck.IsDefined() && !mpClass.HealthCheck.IsDefined() {
+					allErrs = append(allErrs, field.Forbidden(
+						fldPath.Child("enable"),
+						fmt.Sprintf("cannot be set to %t as healthCheck definition is not available in the Cluster topology or the ClusterClass", *mp.HealthCheck.Enabled),
+					))
+				}
+			}
+		}
+	}
+
 	return allErrs
 }
 
+// machinePoolClassOfName find a MachinePoolClass of the given name in the provided ClusterClass.
+// Returns nil if it can not find one.
+func machinePoolClassOfName(clusterClass *clusterv1.ClusterClass, name string) *clusterv1.MachinePoolClass {
+	for _, mpClass := range clusterClass.Spec.Workers.MachinePools {
+		if mpClass.Class == name {
+			return &mpClass
+		}
+	}
+	return nil
+}
+
 // machineDeploymentClassOfName find a MachineDeploymentClass of the given name in the provided ClusterClass.
 // Returns nil if it can not find one.
 // TODO: Check if there is already a helper function that can do this.
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -2692,6 +2693,98 @@ func TestClusterTopologyValidationWithClient(t *testing.T) {
 			classReconciled: true,
 			wantErr:         false,
 		},
+		{
+			name: "Reject a cluster that has MHC enabled for machine pool but is missing MHC definition in cluster topology and ClusterClass",
+			cluster: builder.Cluster(metav1.NamespaceDefault, "cluster1").
+				WithTopology(
+					builder.ClusterTopology().
+						WithClass("clusterclass").
+						WithVersion("v1.22.2").
+						WithControlPlaneReplicas(3).
+						WithMachinePool(
+							builder.MachinePoolTopology("mp1").
+								WithClass("worker-class").
+								WithMachineHealthCheck(clusterv1.MachinePoolTopologyHealthCheck{
+									Enabled: ptr.To(true),
+								}).
+								Build(),
+						).
+						Build()).
+				Build(),
+			class: builder.ClusterClass(metav1.NamespaceDefault, "clusterclass").
+				WithWorkerMachinePoolClasses(
+					*builder.MachinePoolClass("worker-class").Build(),
+				).
+				Build(),
+			classReconciled: true,
+			wantErr:         true,
+		},
+		{
+			name: "Reject a cluster that has MHC override defined for machine pool with a too short nodeStartupTimeout",
+			cluster: builder.Cluster(metav1.NamespaceDefault, "cluster1").
+				WithTopology(
+					builder.ClusterTopology().
+						WithClass("clusterclass").
+						WithVersion("v1.22.2").
+						WithControlPlaneReplicas(3).
+						WithMachinePool(
+							builder.MachinePoolTopology("mp1").
+								WithClass("worker-class").
+								WithMachineHealthCheck(clusterv1.MachinePoolTopologyHealthCheck{
+									Checks: clusterv1.MachinePoolTopologyHealthCheckChecks{
+										NodeStartupTimeoutSeconds: ptr.To(int32(10)),
+									},
+								}).
+								Build(),
+						).
+						Build()).
+				Build(),
+			class: builder.ClusterClass(metav1.NamespaceDefault, "clusterclass").
+				WithWorkerMachinePoolClasses(
+					*builder.MachinePoolClass("worker-class").Build(),
+				).
+				Build(),
+			classReconciled: true,
+			wantErr:         true,
+		},
+		{
+			name: "Accept a cluster that has MHC enabled for machine pool with machine pool MHC defined in ClusterClass",
+			cluster: builder.Cluster(metav1.NamespaceDefault, "cluster1").
+				WithTopology(
+					builder.ClusterTopology().
+						WithClass("clusterclass").
+						WithVersion("v1.22.2").
+						WithControlPlaneReplicas(3).
+						WithMachinePool(
+							builder.MachinePoolTopology("mp1").
+								WithClass("worker-class").
+								WithMachineHealthCheck(clusterv1.MachinePoolTopologyHealthCheck{
+									Enabled: ptr.To(true),
+								}).
+								Build(),
+						).
+						Build()).
+				Build(),
+			class: builder.ClusterClass(metav1.NamespaceDefault, "clusterclass").
+				WithWorkerMachinePoolClasses(
+					*builder.MachinePoolClass("worker-class").
+						WithMachineHealthCheckClass(clusterv1.MachinePoolClassHealthCheck{
+							Checks: clusterv1.MachinePoolClassHealthCheckChecks{
+								UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+									{
+										Type:           corev1.NodeReady,
+										Status:         corev1.ConditionUnknown,
+										TimeoutSeconds: ptr.To(int32(5 * 60)),
+									},
+								},
+							},
+						}).
+						Build(),
+				).
+				Build(),
+			classReconciled: true,
+			wantErr:         false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3952,6 +4045,65 @@ func Test_validateTopologyMachinePoolVersions(t *testing.T) {
 	}
 }
 
+func Test_validateMaintenanceWindows(t *testing.T) {
+	tests := []struct {
+		name      string
+		windows   []clusterv1.MaintenanceWindow
+		expectErr bool
+	}{
+		{
+			name: "should pass with no maintenance windows",
+		},
+		{
+			name: "should pass with valid maintenance windows",
+			windows: []clusterv1.MaintenanceWindow{
+				{Schedule: "0 22 * * 6", DurationSeconds: ptr.To[int32](3600)},
+				{Schedule: "@daily", DurationSeconds: ptr.To[int32](3600), TimeZone: "Europe/Rome"},
+			},
+		},
+		{
+			name: "should fail with an invalid schedule",
+			windows: []clusterv1.MaintenanceWindow{
+				{Schedule: "0 25 * * *", DurationSeconds: ptr.To[int32](3600)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail with an @every schedule",
+			windows: []clusterv1.MaintenanceWindow{
+				{Schedule: "@every 2h", DurationSeconds: ptr.To[int32](3600)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail with a time zone in the schedule",
+			windows: []clusterv1.MaintenanceWindow{
+				{Schedule: "TZ=Europe/Rome 0 22 * * 6", DurationSeconds: ptr.To[int32](3600)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail with an invalid time zone",
+			windows: []clusterv1.MaintenanceWindow{
+				{Schedule: "0 22 * * 6", DurationSeconds: ptr.To[int32](3600), TimeZone: "Europe/Atlantis"},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs := validateMaintenanceWindows(tt.windows, field.NewPath("spec", "topology", "upgrade", "maintenanceWindows"))
+			if tt.expectErr {
+				g.Expect(errs).ToNot(BeEmpty())
+				return
+			}
+			g.Expect(errs).To(BeEmpty())
+		})
+	}
+}
+
 func TestValidateAutoscalerAnnotationsForCluster(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -18,12 +18,16 @@ package admission
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/blang/semver/v4"
 	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -57,6 +61,9 @@ func (webhook *ClusterClass) SetupWebhookWithManager(mgr ctrl.Manager) error {
 // ClusterClass implements a validation and defaulting webhook for ClusterClass.
 type ClusterClass struct {
 	Client client.Reader
+	// RESTMapper is used to check that auxiliary resources are namespaced.
+	// NOTE: If not set, the scope of auxiliary resources is not validated.
+	RESTMapper meta.RESTMapper
 }
 
 var _ admission.Validator[*clusterv1.ClusterClass] = &ClusterClass{}
@@ -110,6 +117,12 @@ func (webhook *ClusterClass) validate(ctx context.Context, oldClusterClass, newC
 
 	allErrs = append(allErrs, validateClusterClassRollout(newClusterClass)...)
 
+	// Ensure auxiliary resources are valid.
+	allErrs = append(allErrs, validateAuxiliaryResources(newClusterClass, webhook.RESTMapper)...)
+
+	// Ensure maintenance windows are valid.
+	allErrs = append(allErrs, validateMaintenanceWindows(newClusterClass.Spec.Upgrade.MaintenanceWindows, field.NewPath("spec", "upgrade", "maintenanceWindows"))...)
+
 	// Ensure MachineHealthChecks are valid.
 	allErrs = append(allErrs, validateMachineHealthCheckClasses(newClusterClass)...)
 
@@ -234,6 +247,37 @@ func validateUpdatesToMachineHealthCheckClasses(clusters []clusterv1.Cluster, ol
 		}
 	}
 
+	// For each MachinePoolClass check if the MachineHealthCheck definition is dropped.
+	for _, newMpClass := range newClusterClass.Spec.Workers.MachinePools {
+		oldMpClass := machinePoolClassOfName(oldClusterClass, newMpClass.Class)
+		if oldMpClass == nil {
+			// This is a new MachinePoolClass. Nothing to do here.
+			continue
+		}
+		// If the MachineHealthCheck is dropped then check that no cluster is using it.
+		if oldMpClass.HealthCheck.IsDefined() && !newMpClass.HealthCheck.IsDefined() {
+			clustersUsingMHC := []string{}
+			for _, cluster := range clusters {
+				for _, mpTopology := range cluster.Spec.Topology.Workers.MachinePools {
+					if mpTopology.Class == newMpClass.Class {
+						if mpTopology.HealthCheck.Enabled != nil &&
+							*mpTopology.HealthCheck.Enabled &&
+							!mpTopology.HealthCheck.IsDefined() {
+							clustersUsingMHC = append(clustersUsingMHC, cluster.Name)
+							break
+						}
+					}
+				}
+			}
+			if len(clustersUsingMHC) != 0 {
+				allErrs = append(allErrs, field.Forbidden(
+					field.NewPath("spec", "workers", "machinePools").Key(newMpClass.Class).Child("healthCheck"),
+					fmt.Sprintf("healthCheck cannot be deleted because it is used by Cluster(s) %q", strings.Join(clustersUsingMHC, ",")),
+				))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -419,6 +463,17 @@ func validateMachineHealthCheckClasses(clusterClass *clusterv1.ClusterClass) fie
 		allErrs = append(allErrs, validateMachineHealthCheckUnhealthyLessThanOrEqualTo(fldPath, md.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo)...)
 		allErrs = append(allErrs, validateRemediationMaxInFlight(fldPath.Child("remediation"), md.HealthCheck.Remediation.MaxInFlight)...)
 	}
+
+	// Validate MachinePool MachineHealthChecks.
+	for _, mp := range clusterClass.Spec.Workers.MachinePools {
+		if !mp.HealthCheck.IsDefined() {
+			continue
+		}
+		fldPath := field.NewPath("spec", "workers", "machinePools").Key(mp.Class).Child("healthCheck")
+
+		allErrs = append(allErrs, validateMachineHealthCheckNodeStartupTimeoutSeconds(fldPath, mp.HealthCheck.Checks.NodeStartupTimeoutSeconds)...)
+		allErrs = append(allErrs, validateMachineHealthCheckUnhealthyLessThanOrEqualTo(fldPath, mp.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo)...)
+	}
 	return allErrs
 }
 
@@ -511,6 +566,73 @@ func validateClusterClassMetadata(clusterClass *clusterv1.ClusterClass) field.Er
 	for _, m := range clusterClass.Spec.Workers.MachinePools {
 		allErrs = append(allErrs, m.Metadata.Validate(field.NewPath("spec", "workers", "machinePools").Key(m.Class).Child("template", "metadata"))...)
 	}
+	for _, a := range clusterClass.Spec.AuxiliaryResources {
+		allErrs = append(allErrs, a.Template.Metadata.Validate(field.NewPath("spec", "auxiliaryResources").Key(a.Name).Child("template", "metadata"))...)
+	}
+	return allErrs
+}
+
+// forbiddenAuxiliaryResourceKinds are the kinds which can't be used as auxiliary resources, because they
+// are part of the topology of a Cluster or they are managed by Cluster API controllers.
+// NOTE: Secrets and ConfigMaps are forbidden because Cluster API controllers create Secrets and ConfigMaps
+// with names derived from the name of the Cluster, e.g. the kubeconfig and the certificate authorities Secrets.
+var forbiddenAuxiliaryResourceKinds = sets.New[schema.GroupKind](
+	clusterv1.GroupVersion.WithKind("Cluster").GroupKind(),
+	clusterv1.GroupVersion.WithKind("Machine").GroupKind(),
+	clusterv1.GroupVersion.WithKind("MachineDeployment").GroupKind(),
+	clusterv1.GroupVersion.WithKind("MachineSet").GroupKind(),
+	clusterv1.GroupVersion.WithKind("MachineHealthCheck").GroupKind(),
+	corev1.SchemeGroupVersion.WithKind("Secret").GroupKind(),
+	corev1.SchemeGroupVersion.WithKind("ConfigMap").GroupKind(),
+)
+
+// validateAuxiliaryResources validates the auxiliary resources defined in a ClusterClass.
+// NOTE: Auxiliary resources are created in the namespace of the Cluster, so if a restMapper is provided
+// auxiliary resources of cluster-scoped kinds are rejected. Kinds which are not known yet, e.g. because
+// the corresponding CRD is not installed yet, are accepted.
+func validateAuxiliaryResources(clusterClass *clusterv1.ClusterClass, restMapper meta.RESTMapper) field.ErrorList {
+	var allErrs field.ErrorList
+	names := sets.Set[string]{}
+	for i, auxiliaryResource := range clusterClass.Spec.AuxiliaryResources {
+		fldPath := field.NewPath("spec", "auxiliaryResources").Index(i)
+
+		if names.Has(auxiliaryResource.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), auxiliaryResource.Name))
+		}
+		names.Insert(auxiliaryResource.Name)
+
+		gv, err := schema.ParseGroupVersion(auxiliaryResource.Template.APIVersion)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("template", "apiVersion"), auxiliaryResource.Template.APIVersion,
+				fmt.Sprintf("must be a valid apiVersion: %v", err)))
+		} else {
+			gk := gv.WithKind(auxiliaryResource.Template.Kind).GroupKind()
+			if forbiddenAuxiliaryResourceKinds.Has(gk) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("template", "kind"),
+					fmt.Sprintf("%s can't be used as auxiliary resource", gk)))
+			} else if restMapper != nil {
+				mapping, err := restMapper.RESTMapping(gk, gv.Version)
+				switch {
+				case meta.IsNoMatchError(err):
+					// The kind is not known yet, the scope is validated once the ClusterClass is updated.
+				case err != nil:
+					allErrs = append(allErrs, field.InternalError(fldPath.Child("template", "kind"),
+						pkgerrors.Wrapf(err, "failed to get the scope of %s", gk)))
+				case mapping.Scope.Name() == meta.RESTScopeNameRoot:
+					allErrs = append(allErrs, field.Forbidden(fldPath.Child("template", "kind"),
+						fmt.Sprintf("%s is cluster-scoped, auxiliary resources must be namespaced", gk)))
+				}
+			}
+		}
+
+		if auxiliaryResource.Template.Spec != nil && len(auxiliaryResource.Template.Spec.Raw) > 0 {
+			spec := map[string]interface{}{}
+			if err := json.Unmarshal(auxiliaryResource.Template.Spec.Raw, &spec); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("template", "spec"), string(auxiliaryResource.Template.Spec.Raw),
+					"must be an object"))
+			}
+		}
+	}
 	return allErrs
 }
 
@@ -22,7 +22,9 @@ import (
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/version"
@@ -847,6 +849,31 @@ func TestClusterClassValidation(t *testing.T) {
 			expectErr: false,
 		},
 
+		{
+			name: "create fail if MachinePool MachineHealthCheck NodeStartUpTimeout is too short",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithWorkerMachinePoolClasses(
+					*builder.MachinePoolClass("aa").
+						WithInfrastructureTemplate(
+							builder.InfrastructureMachinePoolTemplate(metav1.NamespaceDefault, "infra1").Build()).
+						WithBootstrapTemplate(
+							builder.BootstrapTemplate(metav1.NamespaceDefault, "bootstrap1").Build()).
+						WithMachineHealthCheckClass(clusterv1.MachinePoolClassHealthCheck{
+							Checks: clusterv1.MachinePoolClassHealthCheckChecks{
+								// nodeStartupTimeout is too short here.
+								NodeStartupTimeoutSeconds: ptr.To(int32(10)),
+							},
+						}).
+						Build()).
+				Build(),
+			expectErr: true,
+		},
+
 		// update tests
 		{
 			name: "update pass in case of no changes",
@@ -1710,6 +1737,171 @@ func TestClusterClassValidation(t *testing.T) {
 			expectErr: false,
 		},
 
+		// Auxiliary resources tests
+		{
+			name: "pass with valid auxiliary resources",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cpInfra1").
+						Build()).
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+							Spec:       &apiextensionsv1.JSON{Raw: []byte(`{"drain":{"behavior":"Skip"}}`)},
+						},
+					},
+					clusterv1.AuxiliaryResourceClass{
+						Name: "other-drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+							Spec:       &apiextensionsv1.JSON{Raw: []byte(`{"drain":{"behavior":"Skip"}}`)},
+						},
+					},
+				).
+				Build(),
+			expectErr: false,
+		},
+		{
+			name: "fail with duplicate auxiliary resource names",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cpInfra1").
+						Build()).
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+							Spec:       &apiextensionsv1.JSON{Raw: []byte(`{"drain":{"behavior":"Skip"}}`)},
+						},
+					},
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+							Spec:       &apiextensionsv1.JSON{Raw: []byte(`{"drain":{"behavior":"Skip"}}`)},
+						},
+					},
+				).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "fail with invalid auxiliary resource apiVersion",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cpInfra1").
+						Build()).
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: "cluster.x-k8s.io/v1beta2/foo",
+							Kind:       "MachineDrainRule",
+							Spec:       &apiextensionsv1.JSON{Raw: []byte(`{"drain":{"behavior":"Skip"}}`)},
+						},
+					},
+				).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "fail if auxiliary resource spec is not an object",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cpInfra1").
+						Build()).
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+							Spec:       &apiextensionsv1.JSON{Raw: []byte(`["foo"]`)},
+						},
+					},
+				).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "fail with invalid auxiliary resource metadata",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cpInfra1").
+						Build()).
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+							Metadata: clusterv1.ObjectMeta{
+								Labels: map[string]string{"-foo": "bar"},
+							},
+							Spec: &apiextensionsv1.JSON{Raw: []byte(`{"drain":{"behavior":"Skip"}}`)},
+						},
+					},
+				).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "fail with a forbidden auxiliary resource kind",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cpInfra1").
+						Build()).
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "machine-deployment",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDeployment",
+							Spec:       &apiextensionsv1.JSON{Raw: []byte(`{"clusterName":"foo"}`)},
+						},
+					},
+				).
+				Build(),
+			expectErr: true,
+		},
+
 		// Versions tests
 		{
 			name: "fails with invalid versions",
@@ -1848,6 +2040,89 @@ func TestClusterClassValidation(t *testing.T) {
 	}
 }
 
+func TestValidateAuxiliaryResourcesScope(t *testing.T) {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(clusterv1.GroupVersion.WithKind("MachineDrainRule"), meta.RESTScopeNamespace)
+	restMapper.Add(rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), meta.RESTScopeRoot)
+
+	tests := []struct {
+		name       string
+		apiVersion string
+		kind       string
+		restMapper meta.RESTMapper
+		wantErr    bool
+	}{
+		{
+			name:       "pass with a namespaced kind",
+			apiVersion: clusterv1.GroupVersion.String(),
+			kind:       "MachineDrainRule",
+			restMapper: restMapper,
+		},
+		{
+			name:       "pass with a kind which is not known yet",
+			apiVersion: "infrastructure.cluster.x-k8s.io/v1beta2",
+			kind:       "GenericInfrastructureThing",
+			restMapper: restMapper,
+		},
+		{
+			name:       "pass with a cluster-scoped kind if there is no RESTMapper",
+			apiVersion: rbacv1.SchemeGroupVersion.String(),
+			kind:       "ClusterRole",
+		},
+		{
+			name:       "fail with a cluster-scoped kind",
+			apiVersion: rbacv1.SchemeGroupVersion.String(),
+			kind:       "ClusterRole",
+			restMapper: restMapper,
+			wantErr:    true,
+		},
+		{
+			name:       "fail with a forbidden kind",
+			apiVersion: clusterv1.GroupVersion.String(),
+			kind:       "Cluster",
+			restMapper: restMapper,
+			wantErr:    true,
+		},
+		{
+			name:       "fail with a Secret",
+			apiVersion: corev1.SchemeGroupVersion.String(),
+			kind:       "Secret",
+			restMapper: restMapper,
+			wantErr:    true,
+		},
+		{
+			name:       "fail with a ConfigMap",
+			apiVersion: corev1.SchemeGroupVersion.String(),
+			kind:       "ConfigMap",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterClass := builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "resource",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: tt.apiVersion,
+							Kind:       tt.kind,
+						},
+					},
+				).
+				Build()
+
+			errs := validateAuxiliaryResources(clusterClass, tt.restMapper)
+			if tt.wantErr {
+				g.Expect(errs).ToNot(BeEmpty())
+				return
+			}
+			g.Expect(errs).To(BeEmpty())
+		})
+	}
+}
+
 func TestClusterClassValidationWithClusterAwareChecks(t *testing.T) {
 	// NOTE: ClusterTopology feature flag is disabled by default, thus preventing to create or update ClusterClasses.
 	// Enabling the feature flag temporarily for this test.
@@ -2424,6 +2699,64 @@ func TestClusterClassValidationWithClusterAwareChecks(t *testing.T) {
 				Build(),
 			expectErr: true,
 		},
+		{
+			name: "error if a MachinePool MachineHealthCheck that is in use by a cluster is removed",
+			clusters: []client.Object{
+				builder.Cluster(metav1.NamespaceDefault, "cluster1").
+					WithTopology(builder.ClusterTopology().
+						WithClass("clusterclass1").
+						WithMachinePool(builder.MachinePoolTopology("mp1").
+							WithClass("mpclass1").
+							WithMachineHealthCheck(clusterv1.MachinePoolTopologyHealthCheck{
+								Enabled: ptr.To(true),
+							}).
+							Build()).
+						Build()).
+					Build(),
+			},
+			oldClusterClass: builder.ClusterClass(metav1.NamespaceDefault, "clusterclass1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "inf").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithWorkerMachinePoolClasses(
+					*builder.MachinePoolClass("mpclass1").
+						WithInfrastructureTemplate(
+							builder.InfrastructureMachinePoolTemplate(metav1.NamespaceDefault, "infra1").Build()).
+						WithBootstrapTemplate(
+							builder.BootstrapTemplate(metav1.NamespaceDefault, "bootstrap1").Build()).
+						WithMachineHealthCheckClass(clusterv1.MachinePoolClassHealthCheck{
+							Checks: clusterv1.MachinePoolClassHealthCheckChecks{
+								UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+									{
+										Type:           corev1.NodeReady,
+										Status:         corev1.ConditionUnknown,
+										TimeoutSeconds: ptr.To(int32(5 * 60)),
+									},
+								},
+							},
+						}).
+						Build(),
+				).
+				Build(),
+			newClusterClass: builder.ClusterClass(metav1.NamespaceDefault, "clusterclass1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "inf").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithWorkerMachinePoolClasses(
+					*builder.MachinePoolClass("mpclass1").
+						WithInfrastructureTemplate(
+							builder.InfrastructureMachinePoolTemplate(metav1.NamespaceDefault, "infra1").Build()).
+						WithBootstrapTemplate(
+							builder.BootstrapTemplate(metav1.NamespaceDefault, "bootstrap1").Build()).
+						Build(),
+				).
+				Build(),
+			expectErr: true,
+		},
 		{
 			name: "pass if a MachineDeployment MachineHealthCheck is removed but no cluster enforces it",
 			clusters: []client.Object{
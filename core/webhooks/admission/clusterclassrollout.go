@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+)
+
+// SetupWebhookWithManager sets up the webhook with the Manager.
+func (webhook *ClusterClassRollout) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &clusterv1.ClusterClassRollout{}).
+		WithValidator(webhook).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-cluster-x-k8s-io-v1beta2-clusterclassrollout,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=cluster.x-k8s.io,resources=clusterclassrollouts,versions=v1beta2,name=validation.clusterclassrollout.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
+
+// ClusterClassRollout implements a validation webhook for ClusterClassRollout.
+type ClusterClassRollout struct{}
+
+var _ admission.Validator[*clusterv1.ClusterClassRollout] = &ClusterClassRollout{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterClassRollout) ValidateCreate(_ context.Context, rollout *clusterv1.ClusterClassRollout) (admission.Warnings, error) {
+	return nil, webhook.validate(rollout)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterClassRollout) ValidateUpdate(_ context.Context, _, newRollout *clusterv1.ClusterClassRollout) (admission.Warnings, error) {
+	return nil, webhook.validate(newRollout)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterClassRollout) ValidateDelete(_ context.Context, _ *clusterv1.ClusterClassRollout) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (webhook *ClusterClassRollout) validate(rollout *clusterv1.ClusterClassRollout) error {
+	// NOTE: ClusterClass and managed topologies are behind ClusterTopology feature gate flag; the web hook
+	// must prevent creating new objects when the feature flag is disabled.
+	if !feature.Gates.Enabled(feature.ClusterTopology) {
+		return field.Forbidden(
+			field.NewPath("spec"),
+			"can be set only if the ClusterTopology feature flag is enabled",
+		)
+	}
+
+	var allErrs field.ErrorList
+
+	if _, err := metav1.LabelSelectorAsSelector(&rollout.Spec.ClusterSelector); err != nil {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "clusterSelector"), rollout.Spec.ClusterSelector, err.Error()),
+		)
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(&rollout.Spec.Strategy.Canary.ClusterSelector); err != nil {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "strategy", "canary", "clusterSelector"), rollout.Spec.Strategy.Canary.ClusterSelector, err.Error()),
+		)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind(clusterv1.ClusterClassRolloutKind).GroupKind(), rollout.Name, allErrs)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+)
+
+func TestClusterClassRolloutValidation(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterTopology, true)
+
+	tests := []struct {
+		name      string
+		spec      clusterv1.ClusterClassRolloutSpec
+		expectErr bool
+	}{
+		{
+			name: "should not return error for a valid ClusterClassRollout",
+			spec: clusterv1.ClusterClassRolloutSpec{
+				From:            clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+				To:              clusterv1.ClusterClassRolloutClassRef{Name: "class-v2"},
+				ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				Strategy: clusterv1.ClusterClassRolloutStrategy{
+					Canary: clusterv1.ClusterClassRolloutCanary{
+						ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should not return error if from and to are the same ClusterClass",
+			spec: clusterv1.ClusterClassRolloutSpec{
+				From: clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+				To:   clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should not return error if from and to are ClusterClasses with the same name in different namespaces",
+			spec: clusterv1.ClusterClassRolloutSpec{
+				From: clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+				To:   clusterv1.ClusterClassRolloutClassRef{Name: "class-v1", Namespace: "other"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error for invalid clusterSelector",
+			spec: clusterv1.ClusterClassRolloutSpec{
+				From:            clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+				To:              clusterv1.ClusterClassRolloutClassRef{Name: "class-v2"},
+				ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"-123-foo": "bar"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error for invalid canary clusterSelector",
+			spec: clusterv1.ClusterClassRolloutSpec{
+				From: clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+				To:   clusterv1.ClusterClassRolloutClassRef{Name: "class-v2"},
+				Strategy: clusterv1.ClusterClassRolloutStrategy{
+					Canary: clusterv1.ClusterClassRolloutCanary{
+						ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"-123-foo": "bar"}},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			rollout := &clusterv1.ClusterClassRollout{
+				ObjectMeta: metav1.ObjectMeta{Name: "rollout", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			webhook := ClusterClassRollout{}
+			_, err := webhook.ValidateCreate(ctx, rollout)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			_, err = webhook.ValidateUpdate(ctx, rollout, rollout)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestClusterClassRolloutValidationFeatureGateDisabled(t *testing.T) {
+	// NOTE: ClusterTopology feature flag is disabled by default, thus preventing to create a ClusterClassRollout.
+	g := NewWithT(t)
+
+	rollout := &clusterv1.ClusterClassRollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "rollout", Namespace: "default"},
+		Spec: clusterv1.ClusterClassRolloutSpec{
+			From: clusterv1.ClusterClassRolloutClassRef{Name: "class-v1"},
+			To:   clusterv1.ClusterClassRolloutClassRef{Name: "class-v2"},
+		},
+	}
+	webhook := ClusterClassRollout{}
+	_, err := webhook.ValidateCreate(ctx, rollout)
+	g.Expect(err).To(HaveOccurred())
+}
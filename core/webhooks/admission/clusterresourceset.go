@@ -22,6 +22,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -106,9 +107,37 @@ func (webhook *ClusterResourceSet) validate(oldCRS, newCRS *addonsv1.ClusterReso
 		)
 	}
 
+	allErrs = append(allErrs, validateWaves(newCRS)...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
 
 	return apierrors.NewInvalid(addonsv1.GroupVersion.WithKind("ClusterResourceSet").GroupKind(), newCRS.Name, allErrs)
 }
+
+// validateWaves validates that waves only reference resources of the ClusterResourceSet, and that each
+// resource is part of only one wave.
+func validateWaves(crs *addonsv1.ClusterResourceSet) field.ErrorList {
+	var allErrs field.ErrorList
+
+	resources := sets.Set[addonsv1.ResourceRef]{}
+	resources.Insert(crs.Spec.Resources...)
+
+	resourcesInWaves := sets.Set[addonsv1.ResourceRef]{}
+	for i, wave := range crs.Spec.Waves {
+		for j, resource := range wave.Resources {
+			path := field.NewPath("spec", "waves").Index(i).Child("resources").Index(j)
+			if !resources.Has(resource) {
+				allErrs = append(allErrs, field.Invalid(path, resource, "resource must be included in spec.resources"))
+				continue
+			}
+			if resourcesInWaves.Has(resource) {
+				allErrs = append(allErrs, field.Invalid(path, resource, "resource can be part of only one wave"))
+				continue
+			}
+			resourcesInWaves.Insert(resource)
+		}
+	}
+	return allErrs
+}
@@ -210,3 +210,61 @@ func TestClusterResourceSetSelectorNotEmptyValidation(t *testing.T) {
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("selector must not be empty"))
 }
+
+func TestClusterResourceSetWavesValidation(t *testing.T) {
+	crds := addonsv1.ResourceRef{Name: "crds", Kind: "ConfigMap"}
+	operator := addonsv1.ResourceRef{Name: "operator", Kind: "Secret"}
+
+	tests := []struct {
+		name      string
+		waves     []addonsv1.ClusterResourceSetWave
+		expectErr bool
+	}{
+		{
+			name: "should not return error for waves referencing resources",
+			waves: []addonsv1.ClusterResourceSetWave{
+				{Name: "crds", Resources: []addonsv1.ResourceRef{crds}},
+				{Name: "operator", Resources: []addonsv1.ResourceRef{operator}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error for waves referencing resources not in spec.resources",
+			waves: []addonsv1.ClusterResourceSetWave{
+				{Name: "crds", Resources: []addonsv1.ResourceRef{{Name: "crds", Kind: "Secret"}}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error for resources in more than one wave",
+			waves: []addonsv1.ClusterResourceSetWave{
+				{Name: "crds", Resources: []addonsv1.ResourceRef{crds}},
+				{Name: "operator", Resources: []addonsv1.ResourceRef{crds, operator}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					ClusterSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Resources: []addonsv1.ResourceRef{crds, operator},
+					Waves:     tt.waves,
+				},
+			}
+			webhook := ClusterResourceSet{}
+			warnings, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
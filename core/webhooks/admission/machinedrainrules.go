@@ -76,6 +76,24 @@ func (webhook *MachineDrainRule) validate(newMDR *clusterv1.MachineDrainRule) er
 		}
 	}
 
+	if newMDR.Spec.Drain.Behavior != clusterv1.MachineDrainRuleDrainBehaviorDrain && newMDR.Spec.Drain.TimeoutSeconds != nil {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "drain", "timeoutSeconds"),
+				*newMDR.Spec.Drain.TimeoutSeconds,
+				fmt.Sprintf("timeoutSeconds can only be set if drain behavior is %q", clusterv1.MachineDrainRuleDrainBehaviorDrain),
+			),
+		)
+	}
+
+	if newMDR.Spec.Drain.TimeoutSeconds == nil && newMDR.Spec.Drain.AfterTimeout != "" {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "drain", "afterTimeout"),
+				newMDR.Spec.Drain.AfterTimeout,
+				"afterTimeout can only be set if timeoutSeconds is set",
+			),
+		)
+	}
+
 	allErrs = append(allErrs, ValidateMachineDrainRulesSelectors(newMDR)...)
 
 	if len(allErrs) == 0 {
@@ -24,14 +24,17 @@ import (
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
+	celgo "github.com/google/cel-go/cel"
 	pkgerrors "github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/cel/environment"
 	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/feature"
+	topologyvariables "sigs.k8s.io/cluster-api/internal/topology/variables"
 )
 
 // validatePatches returns errors if the Patches in the ClusterClass violate any validation rules.
@@ -94,6 +97,7 @@ func validatePatchDefinitions(patch clusterv1.ClusterClassPatch, clusterClass *c
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, validateEnabledIf(patch.EnabledIf, path.Child("enabledIf"))...)
+	allErrs = append(allErrs, validateEnabledIfExpression(patch, clusterClass.Spec.Variables, path)...)
 
 	if patch.Definitions == nil && patch.External == nil {
 		allErrs = append(allErrs,
@@ -140,7 +144,7 @@ func validatePatchDefinitions(patch clusterv1.ClusterClassPatch, clusterClass *c
 	return allErrs
 }
 
-// validateSelectors validates if enabledIf is a valid template if it is set.
+// validateEnabledIf validates if enabledIf is a valid template if it is set.
 func validateEnabledIf(enabledIf string, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -160,6 +164,36 @@ func validateEnabledIf(enabledIf string, path *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// validateEnabledIfExpression validates if enabledIfExpression is a valid CEL expression evaluating to a boolean if it is set.
+func validateEnabledIfExpression(patch clusterv1.ClusterClassPatch, variables []clusterv1.ClusterClassVariable, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if patch.EnabledIfExpression == "" {
+		return allErrs
+	}
+
+	if patch.EnabledIf != "" {
+		allErrs = append(allErrs,
+			field.Invalid(
+				path,
+				prettyPrint(patch),
+				"only one of enabledIf or enabledIfExpression can be defined",
+			))
+	}
+
+	// Error if the expression can not be compiled or does not evaluate to a boolean.
+	if _, err := topologyvariables.CompilePatchExpression(patch.EnabledIfExpression, variables, celgo.BoolType, environment.NewExpressions); err != nil {
+		allErrs = append(allErrs,
+			field.Invalid(
+				path.Child("enabledIfExpression"),
+				patch.EnabledIfExpression,
+				fmt.Sprintf("expression is invalid: %v", err),
+			))
+	}
+
+	return allErrs
+}
+
 // validateSelectors tests to see if the selector matches any template in the ClusterClass.
 // It returns nil as soon as it finds any matching template and an error if there is no match.
 func validateSelectors(selector clusterv1.PatchSelector, class *clusterv1.ClusterClass, path *field.Path) field.ErrorList {
@@ -168,7 +202,8 @@ func validateSelectors(selector clusterv1.PatchSelector, class *clusterv1.Cluste
 	// Return an error if none of the possible selectors are enabled.
 	if !ptr.Deref(selector.MatchResources.InfrastructureCluster, false) && !ptr.Deref(selector.MatchResources.ControlPlane, false) &&
 		(selector.MatchResources.MachineDeploymentClass == nil || len(selector.MatchResources.MachineDeploymentClass.Names) == 0) &&
-		(selector.MatchResources.MachinePoolClass == nil || len(selector.MatchResources.MachinePoolClass.Names) == 0) {
+		(selector.MatchResources.MachinePoolClass == nil || len(selector.MatchResources.MachinePoolClass.Names) == 0) &&
+		(selector.MatchResources.AuxiliaryResource == nil || len(selector.MatchResources.AuxiliaryResource.Names) == 0) {
 		return append(allErrs,
 			field.Invalid(
 				path,
@@ -277,6 +312,39 @@ func validateSelectors(selector clusterv1.PatchSelector, class *clusterv1.Cluste
 		}
 	}
 
+	if selector.MatchResources.AuxiliaryResource != nil && len(selector.MatchResources.AuxiliaryResource.Names) > 0 {
+		for i, name := range selector.MatchResources.AuxiliaryResource.Names {
+			match := false
+			err := validateSelectorName(name, path, "auxiliaryResource", i)
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			for _, auxiliaryResource := range class.Spec.AuxiliaryResources {
+				var matches bool
+				if auxiliaryResource.Name == name || name == "*" {
+					matches = true
+				} else if strings.HasPrefix(name, "*") && strings.HasSuffix(auxiliaryResource.Name, strings.TrimPrefix(name, "*")) {
+					matches = true
+				} else if strings.HasSuffix(name, "*") && strings.HasPrefix(auxiliaryResource.Name, strings.TrimSuffix(name, "*")) {
+					matches = true
+				}
+
+				if matches && selector.Kind == auxiliaryResource.Template.Kind && selector.APIVersion == auxiliaryResource.Template.APIVersion {
+					match = true
+					break
+				}
+			}
+			if !match {
+				allErrs = append(allErrs, field.Invalid(
+					path.Child("matchResources", "auxiliaryResource", "names").Index(i),
+					name,
+					"selector is enabled but does not match the template of an auxiliary resource",
+				))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -347,13 +415,13 @@ func validateJSONPatches(jsonPatches []clusterv1.JSONPatch, variables []clusterv
 
 		// Validate the value and valueFrom fields for the patch.
 		allErrs = append(allErrs,
-			validateJSONPatchValues(jsonPatch, variableSet, path.Index(i))...,
+			validateJSONPatchValues(jsonPatch, variableSet, variables, path.Index(i))...,
 		)
 	}
 	return allErrs
 }
 
-func validateJSONPatchValues(jsonPatch clusterv1.JSONPatch, variableSet map[string]*clusterv1.ClusterClassVariable, path *field.Path) field.ErrorList {
+func validateJSONPatchValues(jsonPatch clusterv1.JSONPatch, variableSet map[string]*clusterv1.ClusterClassVariable, variables []clusterv1.ClusterClassVariable, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
 	// move to the next variable if the jsonPatch does not have "replace" or "add" op. Additional validation is not needed.
@@ -392,20 +460,20 @@ func validateJSONPatchValues(jsonPatch clusterv1.JSONPatch, variableSet map[stri
 				))
 		}
 	}
-	if jsonPatch.ValueFrom != nil && jsonPatch.ValueFrom.Template == "" && jsonPatch.ValueFrom.Variable == "" {
+	if jsonPatch.ValueFrom != nil && jsonPatch.ValueFrom.Template == "" && jsonPatch.ValueFrom.Variable == "" && jsonPatch.ValueFrom.Expression == "" {
 		allErrs = append(allErrs,
 			field.Invalid(
 				path.Child("valueFrom"),
 				prettyPrint(jsonPatch.ValueFrom),
-				"valueFrom must set either template or variable",
+				"valueFrom must set one of template, variable or expression",
 			))
 	}
-	if jsonPatch.ValueFrom != nil && jsonPatch.ValueFrom.Template != "" && jsonPatch.ValueFrom.Variable != "" {
+	if jsonPatch.ValueFrom != nil && sets.New(jsonPatch.ValueFrom.Template, jsonPatch.ValueFrom.Variable, jsonPatch.ValueFrom.Expression).Delete("").Len() > 1 {
 		allErrs = append(allErrs,
 			field.Invalid(
 				path.Child("valueFrom"),
 				prettyPrint(jsonPatch.ValueFrom),
-				"valueFrom can not set both template and variable",
+				"valueFrom can only set one of template, variable or expression",
 			))
 	}
 
@@ -422,6 +490,18 @@ func validateJSONPatchValues(jsonPatch clusterv1.JSONPatch, variableSet map[stri
 		}
 	}
 
+	if jsonPatch.ValueFrom != nil && jsonPatch.ValueFrom.Expression != "" {
+		// Error if the expression can not be compiled.
+		if _, err := topologyvariables.CompilePatchExpression(jsonPatch.ValueFrom.Expression, variables, nil, environment.NewExpressions); err != nil {
+			allErrs = append(allErrs,
+				field.Invalid(
+					path.Child("valueFrom", "expression"),
+					jsonPatch.ValueFrom.Expression,
+					fmt.Sprintf("expression is invalid: %v", err),
+				))
+		}
+	}
+
 	// If set validate that the variable is valid.
 	if jsonPatch.ValueFrom != nil && jsonPatch.ValueFrom.Variable != "" {
 		// If the variable is one of the list of builtin variables it's valid.
@@ -1433,6 +1433,446 @@ func TestValidatePatches(t *testing.T) {
 			runtimeSDK: true,
 			wantErr:    true,
 		},
+
+		// Patch CEL expression validation
+		{
+			name: "pass if enabledIfExpression and valueFrom.expression are valid",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name:                "patch1",
+							EnabledIfExpression: `httpProxy.enabled && builtin.controlPlane.replicas > 1`,
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Expression: `"http://" + httpProxy.url`,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "httpProxy",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]clusterv1.JSONSchemaProps{
+										"enabled": {Type: "boolean"},
+										"url":     {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error if enabledIfExpression and enabledIf are both set",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name:                "patch1",
+							EnabledIf:           `{{ .httpProxy.enabled }}`,
+							EnabledIfExpression: `httpProxy.enabled`,
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "httpProxy.url",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "httpProxy",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]clusterv1.JSONSchemaProps{
+										"enabled": {Type: "boolean"},
+										"url":     {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error if enabledIfExpression does not evaluate to a boolean",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name:                "patch1",
+							EnabledIfExpression: `httpProxy.url`,
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "httpProxy.url",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "httpProxy",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]clusterv1.JSONSchemaProps{
+										"enabled": {Type: "boolean"},
+										"url":     {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error if enabledIfExpression references a field which is not defined in the variable schema",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name:                "patch1",
+							EnabledIfExpression: `httpProxy.disabled`,
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "httpProxy.url",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "httpProxy",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]clusterv1.JSONSchemaProps{
+										"enabled": {Type: "boolean"},
+										"url":     {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error if valueFrom.expression can not be compiled",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Expression: `httpProxy.url +`,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "httpProxy",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]clusterv1.JSONSchemaProps{
+										"enabled": {Type: "boolean"},
+										"url":     {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error if valueFrom.expression does not type-check against the variable schema",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Expression: `httpProxy.url + 1`,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "httpProxy",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]clusterv1.JSONSchemaProps{
+										"enabled": {Type: "boolean"},
+										"url":     {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error if valueFrom.expression references a variable which is not defined",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Expression: `undefinedVariable`,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "httpProxy",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]clusterv1.JSONSchemaProps{
+										"enabled": {Type: "boolean"},
+										"url":     {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error if valueFrom sets both expression and template",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Expression: `httpProxy.url`,
+												Template:   `{{ .httpProxy.url }}`,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "httpProxy",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]clusterv1.JSONSchemaProps{
+										"enabled": {Type: "boolean"},
+										"url":     {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for i := range tests {
 		tt := tests[i]
@@ -2355,6 +2795,126 @@ func Test_validateSelectors(t *testing.T) {
 				Build(),
 			wantErr: true,
 		},
+		{
+			name: "pass if selector targets an existing auxiliary resource",
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"drain-rule"},
+					},
+				},
+			},
+			clusterClass: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+						},
+					},
+				).
+				Build(),
+			wantErr: false,
+		},
+		{
+			name: "pass if selector targets auxiliary resources using a wildcard",
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"drain-*"},
+					},
+				},
+			},
+			clusterClass: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+						},
+					},
+				).
+				Build(),
+			wantErr: false,
+		},
+		{
+			name: "fail if selector targets a non-existing auxiliary resource",
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"other-rule"},
+					},
+				},
+			},
+			clusterClass: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+						},
+					},
+				).
+				Build(),
+			wantErr: true,
+		},
+		{
+			name: "fail if selector targets an auxiliary resource with a different kind",
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineHealthCheck",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"drain-rule"},
+					},
+				},
+			},
+			clusterClass: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+						},
+					},
+				).
+				Build(),
+			wantErr: true,
+		},
+		{
+			name: "fail if selector targets an auxiliary resource with an invalid name",
+			selector: clusterv1.PatchSelector{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDrainRule",
+				MatchResources: clusterv1.PatchSelectorMatch{
+					AuxiliaryResource: &clusterv1.PatchSelectorMatchAuxiliaryResource{
+						Names: []string{"drain-*-rule"},
+					},
+				},
+			},
+			clusterClass: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithAuxiliaryResources(
+					clusterv1.AuxiliaryResourceClass{
+						Name: "drain-rule",
+						Template: clusterv1.AuxiliaryResourceClassTemplate{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDrainRule",
+						},
+					},
+				).
+				Build(),
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -119,6 +119,58 @@ func Test_validate(t *testing.T) {
 				"MachineDrainRule.cluster.x-k8s.io \"mdr\" is invalid: " +
 				"spec.drain.order: Invalid value: 5: order must not be set if drain behavior is \"Skip\" or \"WaitCompleted\"",
 		},
+		{
+			name: "Return no error if timeoutSeconds and afterTimeout are set with drain behavior Drain",
+			machineDrainRule: &clusterv1.MachineDrainRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mdr",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: clusterv1.MachineDrainRuleSpec{
+					Drain: clusterv1.MachineDrainRuleDrainConfig{
+						Behavior:       clusterv1.MachineDrainRuleDrainBehaviorDrain,
+						TimeoutSeconds: ptr.To[int32](300),
+						AfterTimeout:   clusterv1.MachineDrainRuleDrainAfterTimeoutForceDelete,
+					},
+				},
+			},
+		},
+		{
+			name: "Return error if timeoutSeconds is set with drain behavior Skip",
+			machineDrainRule: &clusterv1.MachineDrainRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mdr",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: clusterv1.MachineDrainRuleSpec{
+					Drain: clusterv1.MachineDrainRuleDrainConfig{
+						Behavior:       clusterv1.MachineDrainRuleDrainBehaviorSkip,
+						TimeoutSeconds: ptr.To[int32](300),
+					},
+				},
+			},
+			wantErr: "admission webhook \"validation.machinedrainrule.cluster.x-k8s.io\" denied the request: " +
+				"MachineDrainRule.cluster.x-k8s.io \"mdr\" is invalid: " +
+				"spec.drain.timeoutSeconds: Invalid value: 300: timeoutSeconds can only be set if drain behavior is \"Drain\"",
+		},
+		{
+			name: "Return error if afterTimeout is set without timeoutSeconds",
+			machineDrainRule: &clusterv1.MachineDrainRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mdr",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: clusterv1.MachineDrainRuleSpec{
+					Drain: clusterv1.MachineDrainRuleDrainConfig{
+						Behavior:     clusterv1.MachineDrainRuleDrainBehaviorDrain,
+						AfterTimeout: clusterv1.MachineDrainRuleDrainAfterTimeoutSkip,
+					},
+				},
+			},
+			wantErr: "admission webhook \"validation.machinedrainrule.cluster.x-k8s.io\" denied the request: " +
+				"MachineDrainRule.cluster.x-k8s.io \"mdr\" is invalid: " +
+				"spec.drain.afterTimeout: Invalid value: \"Skip\": afterTimeout can only be set if timeoutSeconds is set",
+		},
 		{
 			name: "Return error for MachineDrainRules with invalid selector",
 			machineDrainRule: &clusterv1.MachineDrainRule{
@@ -71,9 +71,59 @@ func ConvertClusterV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Cluster,
 	if !reflect.DeepEqual(initialization, clusterv1.ClusterInitializationStatus{}) {
 		dst.Status.Initialization = initialization
 	}
+
+	// Recover MachinePoolTopology healthCheck, which does not exist in v1beta1.
+	if ok {
+		for i, mp := range dst.Spec.Topology.Workers.MachinePools {
+			for _, restoredMP := range restored.Spec.Topology.Workers.MachinePools {
+				if restoredMP.Name == mp.Name {
+					dst.Spec.Topology.Workers.MachinePools[i].HealthCheck = restoredMP.HealthCheck
+					break
+				}
+			}
+		}
+	}
+
+	// Recover ClusterVariable valueFrom, which does not exist in v1beta1.
+	if ok {
+		restoreClusterVariablesValueFrom(dst.Spec.Topology.Variables, restored.Spec.Topology.Variables)
+		restoreClusterVariablesValueFrom(dst.Spec.Topology.ControlPlane.Variables.Overrides, restored.Spec.Topology.ControlPlane.Variables.Overrides)
+		for i, md := range dst.Spec.Topology.Workers.MachineDeployments {
+			for _, restoredMD := range restored.Spec.Topology.Workers.MachineDeployments {
+				if restoredMD.Name == md.Name {
+					restoreClusterVariablesValueFrom(dst.Spec.Topology.Workers.MachineDeployments[i].Variables.Overrides, restoredMD.Variables.Overrides)
+					break
+				}
+			}
+		}
+		for i, mp := range dst.Spec.Topology.Workers.MachinePools {
+			for _, restoredMP := range restored.Spec.Topology.Workers.MachinePools {
+				if restoredMP.Name == mp.Name {
+					restoreClusterVariablesValueFrom(dst.Spec.Topology.Workers.MachinePools[i].Variables.Overrides, restoredMP.Variables.Overrides)
+					break
+				}
+			}
+		}
+	}
+
+	// Recover Topology upgrade, which does not exist in v1beta1.
+	if ok && dst.Spec.Topology.IsDefined() {
+		dst.Spec.Topology.Upgrade = restored.Spec.Topology.Upgrade
+	}
 	return nil
 }
 
+func restoreClusterVariablesValueFrom(variables, restored []clusterv1.ClusterVariable) {
+	for i, variable := range variables {
+		for _, restoredVariable := range restored {
+			if restoredVariable.Name == variable.Name {
+				variables[i].ValueFrom = restoredVariable.ValueFrom
+				break
+			}
+		}
+	}
+}
+
 // ConvertClusterHubToV1Beta1 converts a hub Cluster to a v1beta1 Cluster.
 func ConvertClusterHubToV1Beta1(ctx context.Context, src *clusterv1.Cluster, dst *clusterv1beta1.Cluster) error {
 	if err := clusterv1beta1.Convert_v1beta2_Cluster_To_v1beta1_Cluster(src, dst, nil); err != nil {
@@ -90,6 +90,8 @@ func ConvertClusterClassV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Clus
 		for _, v := range restored.Spec.Variables {
 			if v.Name == variable.Name {
 				restoredVariableOpenAPIV3Schema = &v.Schema.OpenAPIV3Schema
+				// Recover sensitive, which does not exist in v1beta1.
+				variable.Schema.Sensitive = v.Schema.Sensitive
 				break
 			}
 		}
@@ -136,6 +138,8 @@ func ConvertClusterClassV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Clus
 				for _, d := range restoredVariable.Definitions {
 					if d.From == definition.From {
 						restoredVariableOpenAPIV3Schema = &d.Schema.OpenAPIV3Schema
+						// Recover sensitive, which does not exist in v1beta1.
+						definition.Schema.Sensitive = d.Schema.Sensitive
 					}
 				}
 			}
@@ -147,6 +151,69 @@ func ConvertClusterClassV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Clus
 		dst.Status.Variables[i] = variable
 	}
 
+	// Recover MachinePoolClass healthCheck, which does not exist in v1beta1.
+	if ok {
+		for i, mp := range dst.Spec.Workers.MachinePools {
+			for _, restoredMP := range restored.Spec.Workers.MachinePools {
+				if restoredMP.Class == mp.Class {
+					dst.Spec.Workers.MachinePools[i].HealthCheck = restoredMP.HealthCheck
+					break
+				}
+			}
+		}
+	}
+
+	// Recover CEL expressions in patches, which do not exist in v1beta1.
+	if ok {
+		for i, patch := range dst.Spec.Patches {
+			for _, restoredPatch := range restored.Spec.Patches {
+				if restoredPatch.Name != patch.Name {
+					continue
+				}
+				dst.Spec.Patches[i].EnabledIfExpression = restoredPatch.EnabledIfExpression
+				if len(restoredPatch.Definitions) != len(patch.Definitions) {
+					break
+				}
+				for j, definition := range patch.Definitions {
+					if len(restoredPatch.Definitions[j].JSONPatches) != len(definition.JSONPatches) {
+						continue
+					}
+					for k, jsonPatch := range definition.JSONPatches {
+						restoredValueFrom := restoredPatch.Definitions[j].JSONPatches[k].ValueFrom
+						if jsonPatch.ValueFrom != nil && restoredValueFrom != nil {
+							jsonPatch.ValueFrom.Expression = restoredValueFrom.Expression
+						}
+					}
+				}
+				break
+			}
+		}
+	}
+
+	// Recover upgrade maintenanceWindows, which do not exist in v1beta1.
+	if ok {
+		dst.Spec.Upgrade.MaintenanceWindows = restored.Spec.Upgrade.MaintenanceWindows
+	}
+
+	// Recover auxiliaryResources and the corresponding patch selectors, which do not exist in v1beta1.
+	if ok {
+		dst.Spec.AuxiliaryResources = restored.Spec.AuxiliaryResources
+		for i, patch := range dst.Spec.Patches {
+			for _, restoredPatch := range restored.Spec.Patches {
+				if restoredPatch.Name != patch.Name {
+					continue
+				}
+				if len(restoredPatch.Definitions) != len(patch.Definitions) {
+					break
+				}
+				for j := range patch.Definitions {
+					dst.Spec.Patches[i].Definitions[j].Selector.MatchResources.AuxiliaryResource = restoredPatch.Definitions[j].Selector.MatchResources.AuxiliaryResource
+				}
+				break
+			}
+		}
+	}
+
 	return nil
 }
 
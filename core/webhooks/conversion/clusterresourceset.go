@@ -23,6 +23,7 @@ import (
 
 	addonsv1beta1 "sigs.k8s.io/cluster-api/api/addons/v1beta1"
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
 )
 
 // ClusterResourceSet is a HubSpokeConverter for the ClusterResourceSet API type.
@@ -32,10 +33,31 @@ var ClusterResourceSet = conversion.NewHubSpokeConverter(&addonsv1.ClusterResour
 
 // ConvertClusterResourceSetV1Beta1ToHub converts a v1beta1 ClusterResourceSet to a hub ClusterResourceSet.
 func ConvertClusterResourceSetV1Beta1ToHub(_ context.Context, src *addonsv1beta1.ClusterResourceSet, dst *addonsv1.ClusterResourceSet) error {
-	return addonsv1beta1.Convert_v1beta1_ClusterResourceSet_To_v1beta2_ClusterResourceSet(src, dst, nil)
+	if err := addonsv1beta1.Convert_v1beta1_ClusterResourceSet_To_v1beta2_ClusterResourceSet(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &addonsv1.ClusterResourceSet{}
+	ok, err := conversionutil.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	// Recover other values.
+	if ok {
+		dst.Spec.Rendering = restored.Spec.Rendering
+		dst.Spec.Waves = restored.Spec.Waves
+	}
+
+	return nil
 }
 
 // ConvertClusterResourceSetHubToV1Beta1 converts a hub ClusterResourceSet to a v1beta1 ClusterResourceSet.
 func ConvertClusterResourceSetHubToV1Beta1(_ context.Context, src *addonsv1.ClusterResourceSet, dst *addonsv1beta1.ClusterResourceSet) error {
-	return addonsv1beta1.Convert_v1beta2_ClusterResourceSet_To_v1beta1_ClusterResourceSet(src, dst, nil)
+	if err := addonsv1beta1.Convert_v1beta2_ClusterResourceSet_To_v1beta1_ClusterResourceSet(src, dst, nil); err != nil {
+		return err
+	}
+
+	return conversionutil.MarshalDataUnsafeNoCopy(src, dst)
 }
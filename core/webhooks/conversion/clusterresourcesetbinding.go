@@ -23,6 +23,7 @@ import (
 
 	addonsv1beta1 "sigs.k8s.io/cluster-api/api/addons/v1beta1"
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
 )
 
 // ClusterResourceSetBinding is a HubSpokeConverter for the ClusterResourceSetBinding API type.
@@ -32,10 +33,43 @@ var ClusterResourceSetBinding = conversion.NewHubSpokeConverter(&addonsv1.Cluste
 
 // ConvertClusterResourceSetBindingV1Beta1ToHub converts a v1beta1 ClusterResourceSetBinding to a hub ClusterResourceSetBinding.
 func ConvertClusterResourceSetBindingV1Beta1ToHub(_ context.Context, src *addonsv1beta1.ClusterResourceSetBinding, dst *addonsv1.ClusterResourceSetBinding) error {
-	return addonsv1beta1.Convert_v1beta1_ClusterResourceSetBinding_To_v1beta2_ClusterResourceSetBinding(src, dst, nil)
+	if err := addonsv1beta1.Convert_v1beta1_ClusterResourceSetBinding_To_v1beta2_ClusterResourceSetBinding(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &addonsv1.ClusterResourceSetBinding{}
+	ok, err := conversionutil.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	// Recover the inventory of objects and the status of waves, which do not exist in v1beta1.
+	// NOTE: Objects are restored only if the binding and the resource are still at the same position.
+	if ok {
+		for i := range dst.Spec.Bindings {
+			if i >= len(restored.Spec.Bindings) || restored.Spec.Bindings[i].ClusterResourceSetName != dst.Spec.Bindings[i].ClusterResourceSetName {
+				continue
+			}
+			dst.Spec.Bindings[i].Waves = restored.Spec.Bindings[i].Waves
+			for j := range dst.Spec.Bindings[i].Resources {
+				restoredResources := restored.Spec.Bindings[i].Resources
+				if j >= len(restoredResources) || restoredResources[j].ResourceRef != dst.Spec.Bindings[i].Resources[j].ResourceRef {
+					continue
+				}
+				dst.Spec.Bindings[i].Resources[j].Objects = restoredResources[j].Objects
+			}
+		}
+	}
+
+	return nil
 }
 
 // ConvertClusterResourceSetBindingHubToV1Beta1 converts a hub ClusterResourceSetBinding to a v1beta1 ClusterResourceSetBinding.
 func ConvertClusterResourceSetBindingHubToV1Beta1(_ context.Context, src *addonsv1.ClusterResourceSetBinding, dst *addonsv1beta1.ClusterResourceSetBinding) error {
-	return addonsv1beta1.Convert_v1beta2_ClusterResourceSetBinding_To_v1beta1_ClusterResourceSetBinding(src, dst, nil)
+	if err := addonsv1beta1.Convert_v1beta2_ClusterResourceSetBinding_To_v1beta1_ClusterResourceSetBinding(src, dst, nil); err != nil {
+		return err
+	}
+
+	return conversionutil.MarshalDataUnsafeNoCopy(src, dst)
 }
@@ -361,6 +361,7 @@ func ClusterClassFuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
 		hubClusterClassStatus,
 		hubJSONPatch,
 		hubJSONSchemaProps,
+		hubAuxiliaryResourceClassTemplate,
 		hubUnhealthyNodeCondition,
 		hubUnhealthyMachineCondition,
 		spokeClusterClass,
@@ -411,6 +412,13 @@ func hubJSONPatch(in *clusterv1.JSONPatch, c randfill.Continue) {
 	in.Value = &apiextensionsv1.JSON{Raw: []byte("5")}
 }
 
+func hubAuxiliaryResourceClassTemplate(in *clusterv1.AuxiliaryResourceClassTemplate, c randfill.Continue) {
+	c.FillNoCustom(in)
+
+	// Not every random byte array is valid JSON, e.g. a string without `""`,so we're setting a valid value.
+	in.Spec = &apiextensionsv1.JSON{Raw: []byte(`{"foo":"bar"}`)}
+}
+
 func hubJSONSchemaProps(in *clusterv1.JSONSchemaProps, c randfill.Continue) {
 	// NOTE: We have to fuzz the individual fields manually,
 	// because we cannot call `FillNoCustom` as it would lead
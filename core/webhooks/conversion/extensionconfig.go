@@ -23,6 +23,7 @@ import (
 
 	runtimev1alpha1 "sigs.k8s.io/cluster-api/api/runtime/v1alpha1"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
 )
 
 // ExtensionConfig is a HubSpokeConverter for the ExtensionConfig API type.
@@ -32,7 +33,23 @@ var ExtensionConfig = conversion.NewHubSpokeConverter(&runtimev1.ExtensionConfig
 
 // ConvertExtensionConfigV1Alpha1ToHub converts a v1beta1 ExtensionConfig to a hub ExtensionConfig.
 func ConvertExtensionConfigV1Alpha1ToHub(_ context.Context, src *runtimev1alpha1.ExtensionConfig, dst *runtimev1.ExtensionConfig) error {
-	return runtimev1alpha1.Convert_v1alpha1_ExtensionConfig_To_v1beta2_ExtensionConfig(src, dst, nil)
+	if err := runtimev1alpha1.Convert_v1alpha1_ExtensionConfig_To_v1beta2_ExtensionConfig(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &runtimev1.ExtensionConfig{}
+	ok, err := conversionutil.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	// Recover other values.
+	if ok {
+		dst.Spec.CallPolicy = restored.Spec.CallPolicy
+	}
+
+	return nil
 }
 
 // ConvertExtensionConfigHubToV1Alpha1 converts a hub ExtensionConfig to a v1beta1 ExtensionConfig.
@@ -48,7 +65,8 @@ func ConvertExtensionConfigHubToV1Alpha1(_ context.Context, src *runtimev1.Exten
 		}
 		dst.Status.Handlers[i] = h
 	}
-	return nil
+
+	return conversionutil.MarshalDataUnsafeNoCopy(src, dst)
 }
 
 func dropEmptyStringsExtensionConfig(dst *runtimev1alpha1.ExtensionConfig) {
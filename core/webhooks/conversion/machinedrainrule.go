@@ -23,6 +23,7 @@ import (
 
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
 )
 
 // MachineDrainRule is a HubSpokeConverter for the MachineDrainRule API type.
@@ -32,10 +33,31 @@ var MachineDrainRule = conversion.NewHubSpokeConverter(&clusterv1.MachineDrainRu
 
 // ConvertMachineDrainRuleV1Beta1ToHub converts a v1beta1 MachineDrainRule to a hub MachineDrainRule.
 func ConvertMachineDrainRuleV1Beta1ToHub(_ context.Context, src *clusterv1beta1.MachineDrainRule, dst *clusterv1.MachineDrainRule) error {
-	return clusterv1beta1.Convert_v1beta1_MachineDrainRule_To_v1beta2_MachineDrainRule(src, dst, nil)
+	if err := clusterv1beta1.Convert_v1beta1_MachineDrainRule_To_v1beta2_MachineDrainRule(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &clusterv1.MachineDrainRule{}
+	ok, err := conversionutil.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	// Recover other values.
+	if ok {
+		dst.Spec.Drain.TimeoutSeconds = restored.Spec.Drain.TimeoutSeconds
+		dst.Spec.Drain.AfterTimeout = restored.Spec.Drain.AfterTimeout
+	}
+
+	return nil
 }
 
 // ConvertMachineDrainRuleHubToV1Beta1 converts a hub MachineDrainRule to a v1beta1 MachineDrainRule.
 func ConvertMachineDrainRuleHubToV1Beta1(_ context.Context, src *clusterv1.MachineDrainRule, dst *clusterv1beta1.MachineDrainRule) error {
-	return clusterv1beta1.Convert_v1beta2_MachineDrainRule_To_v1beta1_MachineDrainRule(src, dst, nil)
+	if err := clusterv1beta1.Convert_v1beta2_MachineDrainRule_To_v1beta1_MachineDrainRule(src, dst, nil); err != nil {
+		return err
+	}
+
+	return conversionutil.MarshalDataUnsafeNoCopy(src, dst)
 }
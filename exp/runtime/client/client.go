@@ -19,6 +19,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -66,6 +67,24 @@ type CallExtensionOptions struct {
 	CacheKeyFunc func(extensionName, extensionConfigResourceVersion string, request runtimehooksv1.RequestObject) string
 }
 
+// ExtensionHandlerCallState is the state of the calls to an ExtensionHandler as observed by a client.
+type ExtensionHandlerCallState struct {
+	// Name is the name of the ExtensionHandler.
+	Name string
+
+	// CircuitBreakerOpen is true if calls to the ExtensionHandler are rejected by the circuit breaker.
+	CircuitBreakerOpen bool
+
+	// CircuitBreakerOpenUntil is the time after which the circuit breaker allows a call to the ExtensionHandler again.
+	CircuitBreakerOpenUntil time.Time
+
+	// ConsecutiveFailures is the number of consecutive failed calls to the ExtensionHandler.
+	ConsecutiveFailures int32
+
+	// InFlightCalls is the number of in-flight calls to the ExtensionHandler.
+	InFlightCalls int32
+}
+
 // Client is the runtime client to interact with extensions.
 type Client interface {
 	// WarmUp can be used to initialize a "cold" RuntimeClient with all
@@ -94,4 +113,7 @@ type Client interface {
 
 	// CallExtension calls the ExtensionHandler with the given name.
 	CallExtension(ctx context.Context, hook runtimecatalog.Hook, forObject client.Object, name string, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject, opts ...CallExtensionOption) error
+
+	// GetExtensionHandlerCallStates returns the state of the calls to the ExtensionHandlers of the ExtensionConfig.
+	GetExtensionHandlerCallStates(extensionConfig *runtimev1.ExtensionConfig) []ExtensionHandlerCallState
 }
@@ -46,7 +46,9 @@ import (
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/hooks"
+	"sigs.k8s.io/cluster-api/internal/topology/auxiliaryresources"
 	"sigs.k8s.io/cluster-api/internal/topology/clustershim"
+	"sigs.k8s.io/cluster-api/internal/topology/maintenancewindows"
 	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
 	"sigs.k8s.io/cluster-api/internal/topology/ownerrefs"
 	"sigs.k8s.io/cluster-api/internal/topology/selectors"
@@ -134,6 +136,15 @@ func (g *generator) Generate(ctx context.Context, s *scope.Scope) (*scope.Cluste
 	}
 	s.UpgradeTracker.ComputeUpgradePlanSucceeded = true
 
+	// Check if new upgrade steps can be started according to the maintenance windows defined
+	// in the Cluster topology or in the ClusterClass.
+	isOpen, nextOpen, err := maintenancewindows.IsOpen(maintenancewindows.For(s.Blueprint.Topology, s.Blueprint.ClusterClass), time.Now())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to check maintenance windows")
+	}
+	s.UpgradeTracker.MaintenanceWindow.IsClosed = !isOpen
+	s.UpgradeTracker.MaintenanceWindow.NextOpen = nextOpen
+
 	// Mark all the MachineDeployments that are currently upgrading.
 	// This captured information is used for:
 	// - Building the TopologyReconciled condition.
@@ -220,15 +231,28 @@ func (g *generator) Generate(ctx context.Context, s *scope.Scope) (*scope.Cluste
 		}
 	}
 
+	// Compute the desired state of the auxiliary resources defined in the ClusterClass.
+	desiredState.AuxiliaryResources = computeAuxiliaryResources(ctx, s)
+
+	// Track the kinds of the auxiliary resources in the Cluster object, so it is possible to find and delete
+	// auxiliary resources of kinds that are not anymore defined in the ClusterClass.
+	if kinds := auxiliaryresources.KindsAnnotationValue(desiredState.AuxiliaryResources); kinds != "" {
+		desiredState.Cluster.Annotations[clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation] = kinds
+	} else {
+		delete(desiredState.Cluster.Annotations, clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation)
+	}
+
 	// Apply patches the desired state according to the patches from the ClusterClass, variables from the Cluster
 	// and builtin variables.
 	// NOTE: We have to make sure all spec fields that were explicitly set in desired objects during the computation above
 	// are preserved during patching. When desired objects are computed their spec is copied from a template, in some cases
 	// further modifications to the spec are made afterwards. In those cases we have to make sure those fields are not overwritten
 	// in apply patches. Some examples are .spec.machineTemplate and .spec.version in control planes.
-	if err := g.patchEngine.Apply(ctx, s.Blueprint, desiredState); err != nil {
+	masker, err := g.patchEngine.Apply(ctx, s.Blueprint, desiredState)
+	if err != nil {
 		return nil, pkgerrors.Wrap(err, "failed to apply patches")
 	}
+	s.Masker = masker
 
 	return desiredState, nil
 }
@@ -659,6 +683,13 @@ func (g *generator) computeControlPlaneVersion(ctx context.Context, s *scope.Sco
 		return *currentVersion, nil
 	}
 
+	// If maintenance windows are closed, do not start a new upgrade step.
+	// We will pick up the next version in the upgrade plan when a maintenance window opens.
+	if s.UpgradeTracker.MaintenanceWindow.IsClosed {
+		s.UpgradeTracker.MaintenanceWindow.IsHoldingUpgrade = true
+		return *currentVersion, nil
+	}
+
 	// If not already done, call the BeforeClusterUpgrade hook before picking up the desired version.
 	if feature.Gates.Enabled(feature.RuntimeSDK) {
 		// Note: calling the BeforeClusterUpgrade is the first step of an upgrade plan;
@@ -1150,6 +1181,14 @@ func (g *generator) computeMachineDeploymentVersion(ctx context.Context, s *scop
 		return currentVersion, nil
 	}
 
+	// Return early if maintenance windows are closed. Do not start a new upgrade step yet.
+	// We will pick up the next version in the upgrade plan when a maintenance window opens.
+	if s.UpgradeTracker.MaintenanceWindow.IsClosed {
+		s.UpgradeTracker.MachineDeployments.MarkPendingUpgrade(currentMDState.Object.Name)
+		s.UpgradeTracker.MaintenanceWindow.IsHoldingUpgrade = true
+		return currentVersion, nil
+	}
+
 	s.UpgradeTracker.MachineDeployments.MarkUpgrading(currentMDState.Object.Name)
 
 	nextVersion := s.UpgradeTracker.MachineDeployments.UpgradePlan[0]
@@ -1403,6 +1442,17 @@ func (g *generator) computeMachinePool(ctx context.Context, s *scope.Scope, mach
 
 	desiredMachinePool.Object = desiredMachinePoolObj
 
+	// If the ClusterClass defines a MachineHealthCheck for the MachinePool add it to the desired state.
+	if s.Blueprint.IsMachinePoolMachineHealthCheckEnabled(&machinePoolTopology) {
+		// Note: The MHC is going to use a selector that provides a minimal set of labels which are common to all Machines belonging to the MachinePool.
+		checks, remediation := s.Blueprint.MachinePoolMachineHealthCheckClass(&machinePoolTopology)
+		desiredMachinePool.MachineHealthCheck = computeMachineHealthCheck(
+			ctx,
+			desiredMachinePoolObj,
+			selectors.ForMachinePoolMHC(desiredMachinePoolObj),
+			s.Current.Cluster,
+			checks, remediation)
+	}
 	return desiredMachinePool, nil
 }
 
@@ -1480,6 +1530,14 @@ func (g *generator) computeMachinePoolVersion(ctx context.Context, s *scope.Scop
 		return currentVersion, nil
 	}
 
+	// Return early if maintenance windows are closed. Do not start a new upgrade step yet.
+	// We will pick up the next version in the upgrade plan when a maintenance window opens.
+	if s.UpgradeTracker.MaintenanceWindow.IsClosed {
+		s.UpgradeTracker.MachinePools.MarkPendingUpgrade(currentMPState.Object.Name)
+		s.UpgradeTracker.MaintenanceWindow.IsHoldingUpgrade = true
+		return currentVersion, nil
+	}
+
 	s.UpgradeTracker.MachinePools.MarkUpgrading(currentMPState.Object.Name)
 
 	nextVersion := s.UpgradeTracker.MachinePools.UpgradePlan[0]
@@ -1648,6 +1706,39 @@ func templateToTemplate(in templateToInput) (*unstructured.Unstructured, error)
 	return template, nil
 }
 
+// computeAuxiliaryResources computes the desired state of the auxiliary resources defined in the ClusterClass.
+func computeAuxiliaryResources(_ context.Context, s *scope.Scope) map[string]*unstructured.Unstructured {
+	cluster := s.Current.Cluster
+
+	auxiliaryResources := map[string]*unstructured.Unstructured{}
+	for name, template := range s.Blueprint.AuxiliaryResources {
+		auxiliaryResource := template.DeepCopy()
+		auxiliaryResource.SetName(topologynames.AuxiliaryResourceName(cluster.Name, name))
+		auxiliaryResource.SetNamespace(cluster.Namespace)
+
+		// Enforce the topology labels.
+		// NOTE: The auxiliary resource name label is used to match auxiliary resources in the current state
+		// with the auxiliary resources defined in the ClusterClass.
+		labels := auxiliaryResource.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[clusterv1.ClusterNameLabel] = cluster.Name
+		labels[clusterv1.ClusterTopologyOwnedLabel] = ""
+		labels[clusterv1.ClusterTopologyAuxiliaryResourceNameLabel] = name
+		auxiliaryResource.SetLabels(labels)
+
+		// Note: we are adding an ownerRef to Cluster so auxiliary resources will be automatically garbage collected
+		// when the Cluster is deleted.
+		auxiliaryResource.SetOwnerReferences([]metav1.OwnerReference{
+			*ownerrefs.OwnerReferenceTo(cluster, clusterv1.GroupVersion.WithKind("Cluster")),
+		})
+
+		auxiliaryResources[name] = auxiliaryResource
+	}
+	return auxiliaryResources
+}
+
 func computeMachineHealthCheck(ctx context.Context, healthCheckTarget client.Object, selector *metav1.LabelSelector, cluster *clusterv1.Cluster, mhcChecks clusterv1.MachineHealthCheckChecks, mhcRemediation clusterv1.MachineHealthCheckRemediation) *clusterv1.MachineHealthCheck {
 	// Create a MachineHealthCheck with the spec given in the ClusterClass.
 	mhc := &clusterv1.MachineHealthCheck{
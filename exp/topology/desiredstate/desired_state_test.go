@@ -1224,10 +1224,12 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 		machinePoolsUpgradePlan            []string
 		upgradingMachineDeployments        []string
 		upgradingMachinePools              []string
+		maintenanceWindowClosed            bool
 		expectedVersion                    string
 		expectedIsPendingUpgrade           bool
 		expectedIsStartingUpgrade          bool
 		expectedIsWaitingForWorkersUpgrade bool
+		expectedIsHoldingUpgrade           bool
 		wantErr                            bool
 	}{
 		{
@@ -1320,6 +1322,32 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 			expectedIsPendingUpgrade:    false,
 			expectedIsStartingUpgrade:   true,
 		},
+		{
+			name:                              "should return controlplane.spec.version if control plane is not upgrading and not scaling and none of the MachineDeployments and MachinePools are upgrading, but maintenance windows are closed",
+			beforeClusterUpgradeResponse:      nonBlockingBeforeClusterUpgradeResponse,
+			beforeControlPlaneUpgradeResponse: nonBlockingBeforeControlPlaneUpgradeResponse,
+			beforeWorkersUpgradeResponse:      nonBlockingBeforeWorkersUpgradeResponse,
+			afterWorkersUpgradeResponse:       nonBlockingAfterWorkersUpgradeResponse,
+			topologyVersion:                   "v1.2.3",
+			controlPlaneObj: builder.ControlPlane("test1", "cp1").
+				WithSpecFields(map[string]interface{}{
+					"spec.version":  "v1.2.2",
+					"spec.replicas": int64(2),
+				}).
+				WithStatusFields(map[string]interface{}{
+					"status.version":  "v1.2.2",
+					"status.replicas": int64(2),
+				}).
+				Build(),
+			controlPlaneUpgradePlan:     []string{"v1.2.3"},
+			upgradingMachineDeployments: []string{},
+			upgradingMachinePools:       []string{},
+			maintenanceWindowClosed:     true,
+			expectedVersion:             "v1.2.2",
+			expectedIsPendingUpgrade:    true,
+			expectedIsStartingUpgrade:   false,
+			expectedIsHoldingUpgrade:    true,
+		},
 		{
 			name:                              "should return cluster.spec.topology.version if the control plane is not upgrading or scaling and none of the MachineDeployments and MachinePools are upgrading - BeforeClusterUpgrade, BeforeControlPlaneUpgrade hooks returns non blocking response",
 			beforeClusterUpgradeResponse:      nonBlockingBeforeClusterUpgradeResponse,
@@ -1693,6 +1721,7 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 			if len(tt.upgradingMachinePools) > 0 {
 				s.UpgradeTracker.MachinePools.MarkUpgrading(tt.upgradingMachinePools...)
 			}
+			s.UpgradeTracker.MaintenanceWindow.IsClosed = tt.maintenanceWindowClosed
 
 			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
 				WithCatalog(catalog).
@@ -1729,6 +1758,7 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 			g.Expect(s.UpgradeTracker.ControlPlane.IsPendingUpgrade).To(Equal(tt.expectedIsPendingUpgrade))
 			g.Expect(s.UpgradeTracker.ControlPlane.IsStartingUpgrade).To(Equal(tt.expectedIsStartingUpgrade))
 			g.Expect(s.UpgradeTracker.ControlPlane.IsWaitingForWorkersUpgrade).To(Equal(tt.expectedIsWaitingForWorkersUpgrade))
+			g.Expect(s.UpgradeTracker.MaintenanceWindow.IsHoldingUpgrade).To(Equal(tt.expectedIsHoldingUpgrade))
 		})
 	}
 }
@@ -2821,6 +2851,53 @@ func TestComputeMachinePool(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Should correctly generate a MachineHealthCheck for the MachinePool", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := scope.New(cluster)
+		scope.Blueprint = blueprint
+		mpTopology := clusterv1.MachinePoolTopology{
+			Class: "linux-worker",
+			Name:  "big-pool-of-machines",
+			HealthCheck: clusterv1.MachinePoolTopologyHealthCheck{
+				Checks: clusterv1.MachinePoolTopologyHealthCheckChecks{
+					NodeStartupTimeoutSeconds: ptr.To(int32(10 * 60)),
+				},
+			},
+		}
+
+		e := generator{}
+
+		actual, err := e.computeMachinePool(ctx, scope, mpTopology)
+		g.Expect(err).ToNot(HaveOccurred())
+		// Check that the ClusterName and selector are set properly for the MachineHealthCheck.
+		g.Expect(actual.MachineHealthCheck.Name).To(Equal(actual.Object.Name))
+		g.Expect(actual.MachineHealthCheck.Spec.ClusterName).To(Equal(cluster.Name))
+		g.Expect(actual.MachineHealthCheck.Spec.Selector).To(BeComparableTo(metav1.LabelSelector{MatchLabels: map[string]string{
+			clusterv1.ClusterTopologyOwnedLabel:           "",
+			clusterv1.ClusterTopologyMachinePoolNameLabel: "big-pool-of-machines",
+		}}))
+
+		// Check that the NodeStartupTime is set as expected.
+		g.Expect(actual.MachineHealthCheck.Spec.Checks.NodeStartupTimeoutSeconds).To(Equal(ptr.To(int32(10 * 60))))
+	})
+
+	t.Run("Should not generate a MachineHealthCheck for the MachinePool if not defined", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := scope.New(cluster)
+		scope.Blueprint = blueprint
+
+		mpTopology := clusterv1.MachinePoolTopology{
+			Class: "linux-worker",
+			Name:  "big-pool-of-machines",
+		}
+
+		e := generator{}
+
+		actual, err := e.computeMachinePool(ctx, scope, mpTopology)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(actual.MachineHealthCheck).To(BeNil())
+	})
 }
 
 func TestComputeMachineDeploymentVersion(t *testing.T) {
@@ -2843,6 +2920,7 @@ func TestComputeMachineDeploymentVersion(t *testing.T) {
 		controlPlaneProvisioning             bool
 		afterControlPlaneUpgradeHookBlocking bool
 		beforeWorkersUpgradeHookBlocking     bool
+		maintenanceWindowClosed              bool
 		topologyVersion                      string
 		upgradePlan                          []string
 		expectedVersion                      string
@@ -2999,6 +3077,16 @@ func TestComputeMachineDeploymentVersion(t *testing.T) {
 			expectedVersion:               "v1.2.2",
 			expectPendingUpgrade:          true,
 		},
+		{
+			name:                          "should return machine deployment's spec.template.spec.version if control plane is stable, none of the machine deployments are upgrading, but maintenance windows are closed",
+			currentMachineDeploymentState: currentMachineDeploymentState,
+			upgradingMachineDeployments:   []string{},
+			maintenanceWindowClosed:       true,
+			topologyVersion:               "v1.2.3",
+			upgradePlan:                   []string{"v1.2.3"},
+			expectedVersion:               "v1.2.2",
+			expectPendingUpgrade:          true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -3042,6 +3130,7 @@ func TestComputeMachineDeploymentVersion(t *testing.T) {
 			s.UpgradeTracker.ControlPlane.IsPendingUpgrade = tt.controlPlanePendingUpgrade
 			s.UpgradeTracker.ControlPlane.IsWaitingForWorkersUpgrade = tt.controlPlaneWaitingForWorkersUpgrade
 			s.UpgradeTracker.MachineDeployments.MarkUpgrading(tt.upgradingMachineDeployments...)
+			s.UpgradeTracker.MaintenanceWindow.IsClosed = tt.maintenanceWindowClosed
 
 			e := generator{}
 
@@ -3088,6 +3177,7 @@ func TestComputeMachinePoolVersion(t *testing.T) {
 		controlPlaneProvisioning             bool
 		afterControlPlaneUpgradeHookBlocking bool
 		beforeWorkersUpgradeHookBlocking     bool
+		maintenanceWindowClosed              bool
 		topologyVersion                      string
 		upgradePlan                          []string
 		expectedVersion                      string
@@ -3244,6 +3334,16 @@ func TestComputeMachinePoolVersion(t *testing.T) {
 			expectedVersion:         "v1.2.2",
 			expectPendingUpgrade:    true,
 		},
+		{
+			name:                    "should return MachinePool's spec.template.spec.version if control plane is stable, none of the MachinePools are upgrading, but maintenance windows are closed",
+			currentMachinePoolState: currentMachinePoolState,
+			upgradingMachinePools:   []string{},
+			maintenanceWindowClosed: true,
+			topologyVersion:         "v1.2.3",
+			upgradePlan:             []string{"v1.2.3"},
+			expectedVersion:         "v1.2.2",
+			expectPendingUpgrade:    true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -3287,6 +3387,7 @@ func TestComputeMachinePoolVersion(t *testing.T) {
 			if tt.upgradePlan != nil {
 				s.UpgradeTracker.MachinePools.UpgradePlan = tt.upgradePlan
 			}
+			s.UpgradeTracker.MaintenanceWindow.IsClosed = tt.maintenanceWindowClosed
 
 			e := generator{}
 
@@ -3837,6 +3938,66 @@ func Test_computeMachineHealthCheck(t *testing.T) {
 	})
 }
 
+func Test_computeAuxiliaryResources(t *testing.T) {
+	cluster := builder.Cluster("ns1", "cluster1").Build()
+	drainRuleTemplate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": clusterv1.GroupVersion.String(),
+			"kind":       "MachineDrainRule",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"foo": "bar",
+				},
+			},
+			"spec": map[string]interface{}{
+				"drain": map[string]interface{}{
+					"behavior": "Skip",
+				},
+			},
+		},
+	}
+
+	t.Run("set all fields correctly", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s := scope.New(cluster)
+		s.Blueprint = &scope.ClusterBlueprint{
+			AuxiliaryResources: map[string]*unstructured.Unstructured{
+				"drain-rule": drainRuleTemplate,
+			},
+		}
+
+		got := computeAuxiliaryResources(ctx, s)
+		g.Expect(got).To(HaveLen(1))
+		g.Expect(got).To(HaveKey("drain-rule"))
+
+		want := drainRuleTemplate.DeepCopy()
+		want.SetName("cluster1-drain-rule")
+		want.SetNamespace("ns1")
+		want.SetLabels(map[string]string{
+			"foo":                               "bar",
+			clusterv1.ClusterNameLabel:          "cluster1",
+			clusterv1.ClusterTopologyOwnedLabel: "",
+			clusterv1.ClusterTopologyAuxiliaryResourceNameLabel: "drain-rule",
+		})
+		want.SetOwnerReferences([]metav1.OwnerReference{
+			*ownerrefs.OwnerReferenceTo(cluster, clusterv1.GroupVersion.WithKind("Cluster")),
+		})
+		g.Expect(got["drain-rule"]).To(BeComparableTo(want), cmp.Diff(got["drain-rule"], want))
+
+		// The template in the blueprint must not be modified.
+		g.Expect(drainRuleTemplate.GetName()).To(BeEmpty())
+	})
+	t.Run("no auxiliary resources", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s := scope.New(cluster)
+		s.Blueprint = &scope.ClusterBlueprint{}
+
+		g.Expect(computeAuxiliaryResources(ctx, s)).To(BeEmpty())
+	})
+}
+
 func TestCalculateRefDesiredAPIVersion(t *testing.T) {
 	tests := []struct {
 		name                    string
@@ -4068,4 +4229,57 @@ func TestGenerate(t *testing.T) {
 		// Verify MP is marked as upgrading
 		g.Expect(s.UpgradeTracker.MachinePools.UpgradingNames()).To(ConsistOf(mp.Name))
 	})
+
+	t.Run("Generate desired state and verify auxiliary resources are tracked in the Cluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(crd).Build()
+		fakeRuntimeClient := fakeruntimeclient.NewRuntimeClientBuilder().Build()
+		clusterCache := clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: cluster.Name, Namespace: cluster.Namespace})
+
+		desiredStateGenerator, err := NewGenerator(
+			fakeClient,
+			clusterCache,
+			fakeRuntimeClient,
+			cache.New[cache.HookEntry](ctx, cache.HookCacheDefaultTTL),
+			cache.New[GenerateUpgradePlanCacheEntry](ctx, 10*time.Minute),
+		)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clusterWithKindsAnnotation := cluster.DeepCopy()
+		clusterWithKindsAnnotation.Annotations = map[string]string{
+			clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation: "ipam.cluster.x-k8s.io/v1beta2/InClusterIPPool",
+		}
+		s := scope.New(clusterWithKindsAnnotation)
+		s.Blueprint = &scope.ClusterBlueprint{
+			ClusterClass:                  blueprint.ClusterClass,
+			InfrastructureClusterTemplate: blueprint.InfrastructureClusterTemplate,
+			ControlPlane:                  blueprint.ControlPlane,
+			AuxiliaryResources: map[string]*unstructured.Unstructured{
+				"drain-rule": {
+					Object: map[string]interface{}{
+						"apiVersion": clusterv1.GroupVersion.String(),
+						"kind":       "MachineDrainRule",
+					},
+				},
+			},
+		}
+
+		// Get the desired state.
+		desiredState, err := desiredStateGenerator.Generate(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(desiredState.AuxiliaryResources).To(HaveKey("drain-rule"))
+		g.Expect(desiredState.AuxiliaryResources["drain-rule"].GetName()).To(Equal("cluster1-drain-rule"))
+		// Verify the kinds annotation only contains the kinds of the desired auxiliary resources.
+		g.Expect(desiredState.Cluster.Annotations).To(HaveKeyWithValue(clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation, "cluster.x-k8s.io/v1beta2/MachineDrainRule"))
+
+		// Remove the auxiliary resources from the blueprint and verify the kinds annotation is removed.
+		s = scope.New(desiredState.Cluster)
+		s.Blueprint = blueprint
+
+		desiredState, err = desiredStateGenerator.Generate(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(desiredState.AuxiliaryResources).To(BeEmpty())
+		g.Expect(desiredState.Cluster.Annotations).ToNot(HaveKey(clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation))
+	})
 }
@@ -42,6 +42,10 @@ type ClusterBlueprint struct {
 
 	// MachinePools holds the MachinePoolBlueprints derived from ClusterClass.
 	MachinePools map[string]*MachinePoolBlueprint
+
+	// AuxiliaryResources holds the templates for the auxiliary resources derived from ClusterClass,
+	// keyed by the name of the auxiliary resource in the ClusterClass.
+	AuxiliaryResources map[string]*unstructured.Unstructured
 }
 
 // ControlPlaneBlueprint holds the templates required for computing the desired state of a managed control plane.
@@ -89,6 +93,10 @@ type MachinePoolBlueprint struct {
 
 	// InfrastructureMachinePoolTemplate holds the infrastructure machine pool template for a MachinePool referenced from ClusterClass.
 	InfrastructureMachinePoolTemplate *unstructured.Unstructured
+
+	// HealthCheck holds the MachineHealthCheckClass for this MachinePool.
+	// +optional
+	HealthCheck clusterv1.MachinePoolClassHealthCheck
 }
 
 // HasControlPlaneInfrastructureMachine checks whether the clusterClass mandates the controlPlane has infrastructureMachines.
@@ -192,6 +200,50 @@ func (b *ClusterBlueprint) MachineDeploymentMachineHealthCheckClass(md *clusterv
 		}
 }
 
+// IsMachinePoolMachineHealthCheckEnabled returns true if a MachineHealthCheck should be created for the MachinePool.
+// Returns false otherwise.
+func (b *ClusterBlueprint) IsMachinePoolMachineHealthCheckEnabled(mp *clusterv1.MachinePoolTopology) bool {
+	// If no MachineHealthCheck is defined in the ClusterClass or in the Cluster Topology then return false.
+	if !b.MachinePools[mp.Class].HealthCheck.IsDefined() && !mp.HealthCheck.IsDefined() {
+		return false
+	}
+	// If `enable` is not set then consider it as true. A MachineHealthCheck will be created from either ClusterClass or Cluster Topology.
+	if mp.HealthCheck.Enabled == nil {
+		return true
+	}
+	// If `enable` is explicitly set, use the value.
+	return *mp.HealthCheck.Enabled
+}
+
+// MachinePoolMachineHealthCheckClass return the MachineHealthCheckClass that should be used to create the MachineHealthCheck object.
+func (b *ClusterBlueprint) MachinePoolMachineHealthCheckClass(mp *clusterv1.MachinePoolTopology) (clusterv1.MachineHealthCheckChecks, clusterv1.MachineHealthCheckRemediation) {
+	if mp.HealthCheck.IsDefined() {
+		return clusterv1.MachineHealthCheckChecks{
+				NodeStartupTimeoutSeconds:  mp.HealthCheck.Checks.NodeStartupTimeoutSeconds,
+				UnhealthyNodeConditions:    mp.HealthCheck.Checks.UnhealthyNodeConditions,
+				UnhealthyMachineConditions: mp.HealthCheck.Checks.UnhealthyMachineConditions,
+			}, clusterv1.MachineHealthCheckRemediation{
+				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+					UnhealthyLessThanOrEqualTo: mp.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+					UnhealthyInRange:           mp.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+				},
+				TemplateRef: mp.HealthCheck.Remediation.TemplateRef,
+			}
+	}
+
+	return clusterv1.MachineHealthCheckChecks{
+			NodeStartupTimeoutSeconds:  b.MachinePools[mp.Class].HealthCheck.Checks.NodeStartupTimeoutSeconds,
+			UnhealthyNodeConditions:    b.MachinePools[mp.Class].HealthCheck.Checks.UnhealthyNodeConditions,
+			UnhealthyMachineConditions: b.MachinePools[mp.Class].HealthCheck.Checks.UnhealthyMachineConditions,
+		}, clusterv1.MachineHealthCheckRemediation{
+			TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+				UnhealthyLessThanOrEqualTo: b.MachinePools[mp.Class].HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+				UnhealthyInRange:           b.MachinePools[mp.Class].HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+			},
+			TemplateRef: b.MachinePools[mp.Class].HealthCheck.Remediation.TemplateRef,
+		}
+}
+
 // HasMachineDeployments checks whether the topology has MachineDeployments.
 func (b *ClusterBlueprint) HasMachineDeployments() bool {
 	return len(b.Topology.Workers.MachineDeployments) > 0
@@ -751,3 +751,181 @@ func TestMachineDeploymentMachineHealthCheckClass(t *testing.T) {
 		})
 	}
 }
+
+func TestIsMachinePoolMachineHealthCheckEnabled(t *testing.T) {
+	mhcClass := clusterv1.MachinePoolClassHealthCheck{
+		Checks: clusterv1.MachinePoolClassHealthCheckChecks{
+			UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+				{
+					Type:           corev1.NodeReady,
+					Status:         corev1.ConditionUnknown,
+					TimeoutSeconds: ptr.To(int32(5 * 60)),
+				},
+			},
+		},
+	}
+	mhcTopology := clusterv1.MachinePoolTopologyHealthCheckChecks{
+		UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+			{
+				Type:           corev1.NodeReady,
+				Status:         corev1.ConditionUnknown,
+				TimeoutSeconds: ptr.To(int32(5 * 60)),
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		blueprint  *ClusterBlueprint
+		mpTopology *clusterv1.MachinePoolTopology
+		want       bool
+	}{
+		{
+			name: "should return false if MachineHealthCheck is not defined in ClusterClass or cluster topology",
+			blueprint: &ClusterBlueprint{
+				MachinePools: map[string]*MachinePoolBlueprint{
+					"worker-class": {},
+				},
+			},
+			mpTopology: &clusterv1.MachinePoolTopology{
+				Class: "worker-class",
+				Name:  "mp-1",
+			},
+			want: false,
+		},
+		{
+			name: "should return true if MachineHealthCheck is defined in ClusterClass, not defined in cluster topology and enable is not set",
+			blueprint: &ClusterBlueprint{
+				MachinePools: map[string]*MachinePoolBlueprint{
+					"worker-class": {
+						HealthCheck: mhcClass,
+					},
+				},
+			},
+			mpTopology: &clusterv1.MachinePoolTopology{
+				Class: "worker-class",
+				Name:  "mp-1",
+			},
+			want: true,
+		},
+		{
+			name: "should return false if MachineHealthCheck is defined in ClusterClass, not defined in cluster topology and enable is false",
+			blueprint: &ClusterBlueprint{
+				MachinePools: map[string]*MachinePoolBlueprint{
+					"worker-class": {
+						HealthCheck: mhcClass,
+					},
+				},
+			},
+			mpTopology: &clusterv1.MachinePoolTopology{
+				Class: "worker-class",
+				Name:  "mp-1",
+				HealthCheck: clusterv1.MachinePoolTopologyHealthCheck{
+					Enabled: ptr.To(false),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "should return true if MachineHealthCheck is defined in cluster topology, not defined in ClusterClass and enable is not set",
+			blueprint: &ClusterBlueprint{
+				MachinePools: map[string]*MachinePoolBlueprint{
+					"worker-class": {},
+				},
+			},
+			mpTopology: &clusterv1.MachinePoolTopology{
+				Class: "worker-class",
+				Name:  "mp-1",
+				HealthCheck: clusterv1.MachinePoolTopologyHealthCheck{
+					Checks: mhcTopology,
+				},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.blueprint.IsMachinePoolMachineHealthCheckEnabled(tt.mpTopology)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestMachinePoolMachineHealthCheckClass(t *testing.T) {
+	tests := []struct {
+		name            string
+		blueprint       *ClusterBlueprint
+		mpTopology      *clusterv1.MachinePoolTopology
+		wantChecks      clusterv1.MachineHealthCheckChecks
+		wantRemediation clusterv1.MachineHealthCheckRemediation
+	}{
+		{
+			name: "should return the MachineHealthCheck from cluster topology if defined - should take precedence over MachineHealthCheck in ClusterClass",
+			blueprint: &ClusterBlueprint{
+				MachinePools: map[string]*MachinePoolBlueprint{
+					"worker-class": {
+						HealthCheck: clusterv1.MachinePoolClassHealthCheck{
+							Checks: clusterv1.MachinePoolClassHealthCheckChecks{
+								NodeStartupTimeoutSeconds: ptr.To(int32(10 * 60)),
+							},
+						},
+					},
+				},
+			},
+			mpTopology: &clusterv1.MachinePoolTopology{
+				Class: "worker-class",
+				Name:  "mp-1",
+				HealthCheck: clusterv1.MachinePoolTopologyHealthCheck{
+					Checks: clusterv1.MachinePoolTopologyHealthCheckChecks{
+						NodeStartupTimeoutSeconds: ptr.To(int32(20 * 60)),
+					},
+					Remediation: clusterv1.MachinePoolTopologyHealthCheckRemediation{
+						TriggerIf: clusterv1.MachinePoolTopologyHealthCheckRemediationTriggerIf{
+							UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("50%")),
+						},
+					},
+				},
+			},
+			wantChecks: clusterv1.MachineHealthCheckChecks{
+				NodeStartupTimeoutSeconds: ptr.To(int32(20 * 60)),
+			},
+			wantRemediation: clusterv1.MachineHealthCheckRemediation{
+				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+					UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("50%")),
+				},
+			},
+		},
+		{
+			name: "should return the MachineHealthCheck from ClusterClass if no MachineHealthCheck is defined in cluster topology",
+			blueprint: &ClusterBlueprint{
+				MachinePools: map[string]*MachinePoolBlueprint{
+					"worker-class": {
+						HealthCheck: clusterv1.MachinePoolClassHealthCheck{
+							Checks: clusterv1.MachinePoolClassHealthCheckChecks{
+								NodeStartupTimeoutSeconds: ptr.To(int32(10 * 60)),
+							},
+						},
+					},
+				},
+			},
+			mpTopology: &clusterv1.MachinePoolTopology{
+				Class: "worker-class",
+				Name:  "mp-1",
+			},
+			wantChecks: clusterv1.MachineHealthCheckChecks{
+				NodeStartupTimeoutSeconds: ptr.To(int32(10 * 60)),
+			},
+			wantRemediation: clusterv1.MachineHealthCheckRemediation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			gotChecks, gotRemediation := tt.blueprint.MachinePoolMachineHealthCheckClass(tt.mpTopology)
+			g.Expect(gotChecks).To(BeComparableTo(tt.wantChecks))
+			g.Expect(gotRemediation).To(BeComparableTo(tt.wantRemediation))
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"strconv"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/patches/variables"
 )
 
 // Scope holds all the information to process a request in the topology/ClusterReconciler controller.
@@ -39,6 +40,10 @@ type Scope struct {
 	// HookResponseTracker holds the hook responses that will be used to
 	// calculate a combined reconcile result.
 	HookResponseTracker *HookResponseTracker
+
+	// Masker masks the values of sensitive variables, e.g. in the diffs and patches logged when reconciling
+	// the managed topology. It is set when computing the desired state.
+	Masker *variables.Masker
 }
 
 // New returns a new Scope with only the cluster; while processing a request in the topology/ClusterReconciler controller
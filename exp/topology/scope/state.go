@@ -45,6 +45,10 @@ type ClusterState struct {
 
 	// MachinePools holds the MachinePools in the Cluster.
 	MachinePools MachinePoolsStateMap
+
+	// AuxiliaryResources holds the auxiliary resources in the Cluster,
+	// keyed by the name of the auxiliary resource in the ClusterClass.
+	AuxiliaryResources map[string]*unstructured.Unstructured
 }
 
 // ControlPlaneState holds all the objects representing the state of a managed control plane.
@@ -131,6 +135,10 @@ type MachinePoolState struct {
 
 	// InfrastructureMachinePoolObject holds the infrastructure machine template referenced by the MachinePool object.
 	InfrastructureMachinePoolObject *unstructured.Unstructured
+
+	// MachineHealthCheck holds a MachineHealthCheck linked to the MachinePool object.
+	// +optional
+	MachineHealthCheck *clusterv1.MachineHealthCheck
 }
 
 // IsUpgrading determines if the MachinePool is upgrading.
@@ -16,7 +16,11 @@ limitations under the License.
 
 package scope
 
-import "k8s.io/apimachinery/pkg/util/sets"
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
 
 // UpgradeTracker is a helper to capture the upgrade status and make upgrade decisions.
 type UpgradeTracker struct {
@@ -25,6 +29,9 @@ type UpgradeTracker struct {
 	MachinePools       WorkerUpgradeTracker
 	MinWorkersVersion  string
 
+	// MaintenanceWindow holds the status of the maintenance windows that apply to the Cluster.
+	MaintenanceWindow MaintenanceWindowTracker
+
 	// ComputeUpgradePlanSucceeded reports when an upgrade plan has been successfully computed
 	// Note: when there are no upgrade in progress, ComputeUpgradePlan succeeds and it returns an empty upgrade plan.
 	ComputeUpgradePlanSucceeded bool
@@ -77,6 +84,22 @@ type ControlPlaneUpgradeTracker struct {
 	IsStartingUpgrade bool
 }
 
+// MaintenanceWindowTracker holds the status of the maintenance windows that apply to the Cluster.
+type MaintenanceWindowTracker struct {
+	// IsClosed is true if maintenance windows are defined for the Cluster and none of them is currently open.
+	// If IsClosed is true, the Control Plane, MachineDeployments and MachinePools are not going to start
+	// new upgrade steps in the current reconcile loop; upgrade steps already in progress are not affected.
+	IsClosed bool
+
+	// NextOpen is the time when the next maintenance window opens.
+	// Note: NextOpen is set only if IsClosed is true, and it is zero if none of the maintenance windows is going to open again.
+	NextOpen time.Time
+
+	// IsHoldingUpgrade is true if the Control Plane, a MachineDeployment or a MachinePool did not start an
+	// upgrade step in the current reconcile loop because maintenance windows are closed.
+	IsHoldingUpgrade bool
+}
+
 // WorkerUpgradeTracker holds the current upgrade status of MachineDeployments or MachinePools.
 type WorkerUpgradeTracker struct {
 	// pendingCreateTopologyNames is the set of MachineDeployment/MachinePool topology names that are newly added to the
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	runtimemetrics "sigs.k8s.io/cluster-api/internal/runtime/metrics"
+	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
+)
+
+const (
+	// defaultCircuitBreakerOpenDuration is the duration a circuit breaker stays open if
+	// openDurationSeconds is not set.
+	defaultCircuitBreakerOpenDuration = 30 * time.Second
+
+	// maxResponseCacheTTL is the maximum TTL of cached responses, it matches the maximum
+	// value allowed for ttlSeconds in the ExtensionConfig API.
+	maxResponseCacheTTL = 1 * time.Hour
+)
+
+// cacheableHooks are the idempotent hooks for which responses can be cached.
+var cacheableHooks = map[string]bool{
+	runtimecatalog.HookName(runtimehooksv1.GeneratePatches):   true,
+	runtimecatalog.HookName(runtimehooksv1.ValidateTopology):  true,
+	runtimecatalog.HookName(runtimehooksv1.DiscoverVariables): true,
+}
+
+// handlerCallStates keeps track of the handlerCallState of all ExtensionHandlers.
+type handlerCallStates struct {
+	lock  sync.Mutex
+	items map[string]*handlerCallState
+}
+
+func newHandlerCallStates() *handlerCallStates {
+	return &handlerCallStates{
+		items: map[string]*handlerCallState{},
+	}
+}
+
+// get returns the handlerCallState for an ExtensionHandler, creating it if it does not exist yet.
+func (s *handlerCallStates) get(name string) *handlerCallState {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, ok := s.items[name]
+	if !ok {
+		state = &handlerCallState{name: name}
+		s.items[name] = state
+	}
+	return state
+}
+
+// peek returns the handlerCallState for an ExtensionHandler, if it exists.
+func (s *handlerCallStates) peek(name string) (*handlerCallState, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, ok := s.items[name]
+	return state, ok
+}
+
+// handlerCallState tracks the circuit breaker and the in-flight calls of an ExtensionHandler.
+type handlerCallState struct {
+	lock sync.Mutex
+	name string
+
+	// consecutiveFailures is the number of consecutive failed calls.
+	consecutiveFailures int32
+	// openUntil is the time until the circuit breaker rejects calls; it is zero if the circuit breaker is closed.
+	openUntil time.Time
+	// trialCallInFlight is true if the circuit breaker is half-open and a trial call is in flight.
+	trialCallInFlight bool
+
+	// inFlight is used as a semaphore to limit in-flight calls; it is nil if in-flight calls are not limited.
+	inFlight chan struct{}
+	// inFlightCalls is the number of in-flight calls.
+	inFlightCalls int32
+}
+
+// startCall checks if the circuit breaker allows a call to the ExtensionHandler.
+// If the circuit breaker is open but openUntil has been reached, a single trial call is allowed (half-open).
+// Note: finishCall must be called for every call allowed by startCall.
+func (s *handlerCallState) startCall(circuitBreaker runtimev1.ExtensionCircuitBreaker, now time.Time) error {
+	if circuitBreaker.FailureThreshold == 0 {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.consecutiveFailures < circuitBreaker.FailureThreshold {
+		return nil
+	}
+	if now.Before(s.openUntil) || s.trialCallInFlight {
+		return pkgerrors.Errorf("circuit breaker is open after %d consecutive failed calls", s.consecutiveFailures)
+	}
+	s.trialCallInFlight = true
+	return nil
+}
+
+// finishCall records the result of a call allowed by startCall.
+// If performed is false the call has not been sent to the ExtensionHandler, so the circuit breaker is not changed.
+// It returns true if the circuit breaker is open after recording the result.
+func (s *handlerCallState) finishCall(circuitBreaker runtimev1.ExtensionCircuitBreaker, performed, failed bool, now time.Time) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.trialCallInFlight = false
+	if !performed {
+		return s.isOpen(circuitBreaker)
+	}
+
+	if !failed {
+		s.consecutiveFailures = 0
+		s.openUntil = time.Time{}
+		return false
+	}
+
+	s.consecutiveFailures++
+	if circuitBreaker.FailureThreshold != 0 && s.consecutiveFailures >= circuitBreaker.FailureThreshold {
+		openDuration := defaultCircuitBreakerOpenDuration
+		if circuitBreaker.OpenDurationSeconds != 0 {
+			openDuration = time.Duration(circuitBreaker.OpenDurationSeconds) * time.Second
+		}
+		s.openUntil = now.Add(openDuration)
+	}
+	return s.isOpen(circuitBreaker)
+}
+
+// isOpen returns true if the circuit breaker is open or half-open.
+// Note: This func must be called while holding the lock.
+func (s *handlerCallState) isOpen(circuitBreaker runtimev1.ExtensionCircuitBreaker) bool {
+	return circuitBreaker.FailureThreshold != 0 && s.consecutiveFailures >= circuitBreaker.FailureThreshold
+}
+
+// acquire waits until the number of in-flight calls is below maxConcurrentCalls or until the timeout expires.
+// If maxConcurrentCalls is 0 in-flight calls are not limited.
+// Note: the returned release func must be called once the call completes.
+func (s *handlerCallState) acquire(ctx context.Context, maxConcurrentCalls int32, timeout time.Duration) (func(), error) {
+	s.lock.Lock()
+	if maxConcurrentCalls == 0 {
+		s.inFlight = nil
+	} else if s.inFlight == nil || cap(s.inFlight) != int(maxConcurrentCalls) {
+		// Note: Calls in flight while maxConcurrentCalls changes are going to release the previous semaphore.
+		s.inFlight = make(chan struct{}, maxConcurrentCalls)
+	}
+	inFlight := s.inFlight
+	s.lock.Unlock()
+
+	if inFlight != nil {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case inFlight <- struct{}{}:
+		case <-timer.C:
+			return nil, pkgerrors.Errorf("timeout waiting for one of the %d in-flight calls to complete", maxConcurrentCalls)
+		case <-ctx.Done():
+			return nil, pkgerrors.Wrapf(ctx.Err(), "context done while waiting for one of the %d in-flight calls to complete", maxConcurrentCalls)
+		}
+	}
+
+	s.lock.Lock()
+	s.inFlightCalls++
+	runtimemetrics.InFlightRequests.Observe(s.name, s.inFlightCalls)
+	s.lock.Unlock()
+
+	return func() {
+		s.lock.Lock()
+		s.inFlightCalls--
+		runtimemetrics.InFlightRequests.Observe(s.name, s.inFlightCalls)
+		s.lock.Unlock()
+
+		if inFlight != nil {
+			<-inFlight
+		}
+	}, nil
+}
+
+// toExtensionHandlerCallState returns the ExtensionHandlerCallState for the handlerCallState.
+func (s *handlerCallState) toExtensionHandlerCallState(circuitBreaker runtimev1.ExtensionCircuitBreaker) runtimeclient.ExtensionHandlerCallState {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state := runtimeclient.ExtensionHandlerCallState{
+		Name:                s.name,
+		CircuitBreakerOpen:  s.isOpen(circuitBreaker),
+		ConsecutiveFailures: s.consecutiveFailures,
+		InFlightCalls:       s.inFlightCalls,
+	}
+	if state.CircuitBreakerOpen {
+		state.CircuitBreakerOpenUntil = s.openUntil
+	}
+	return state
+}
+
+// responseCacheEntry is an entry of the response cache.
+type responseCacheEntry struct {
+	cacheKey  string
+	response  runtimehooksv1.ResponseObject
+	expiresAt time.Time
+}
+
+// Key returns the cache key of a responseCacheEntry.
+func (r responseCacheEntry) Key() string {
+	return r.cacheKey
+}
+
+// responseCacheKey returns the key to be used to cache the response of a call to an ExtensionHandler.
+// The key is based on the name of the ExtensionHandler, the resourceVersion of the ExtensionConfig and a hash of the request,
+// so that responses are not re-used across different requests or after the ExtensionConfig changed.
+// It returns false if responses for the given hook and ExtensionHandler should not be cached.
+func responseCacheKey(registration *runtimeregistry.ExtensionRegistration, hookGVH runtimecatalog.GroupVersionHook, request runtimehooksv1.RequestObject) (string, bool, error) {
+	if registration.CallPolicy.ResponseCache.TTLSeconds == 0 || !cacheableHooks[hookGVH.Hook] {
+		return "", false, nil
+	}
+
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return "", false, pkgerrors.Wrap(err, "failed to compute response cache key: failed to marshal request")
+	}
+	return fmt.Sprintf("%s.%s.%s.%x", registration.Name, registration.ExtensionConfigResourceVersion, hookGVH.Version, sha256.Sum256(requestBytes)), true, nil
+}
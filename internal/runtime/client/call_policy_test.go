@@ -0,0 +1,327 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission/plugin/webhook/testcerts"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	fakev1alpha1 "sigs.k8s.io/cluster-api/internal/runtime/test/v1alpha1"
+)
+
+func TestHandlerCallState_CircuitBreaker(t *testing.T) {
+	g := NewWithT(t)
+
+	circuitBreaker := runtimev1.ExtensionCircuitBreaker{
+		FailureThreshold:    2,
+		OpenDurationSeconds: 10,
+	}
+	state := &handlerCallState{name: "handler"}
+	now := time.Now()
+
+	// The circuit breaker stays closed until failureThreshold consecutive calls failed.
+	g.Expect(state.startCall(circuitBreaker, now)).To(Succeed())
+	g.Expect(state.finishCall(circuitBreaker, true, true, now)).To(BeFalse())
+	g.Expect(state.startCall(circuitBreaker, now)).To(Succeed())
+	g.Expect(state.finishCall(circuitBreaker, true, true, now)).To(BeTrue())
+
+	// While open, calls are rejected.
+	g.Expect(state.startCall(circuitBreaker, now.Add(5*time.Second))).ToNot(Succeed())
+	callState := state.toExtensionHandlerCallState(circuitBreaker)
+	g.Expect(callState.CircuitBreakerOpen).To(BeTrue())
+	g.Expect(callState.ConsecutiveFailures).To(Equal(int32(2)))
+	g.Expect(callState.CircuitBreakerOpenUntil).To(Equal(now.Add(10 * time.Second)))
+
+	// After openDurationSeconds a single trial call is allowed.
+	g.Expect(state.startCall(circuitBreaker, now.Add(11*time.Second))).To(Succeed())
+	g.Expect(state.startCall(circuitBreaker, now.Add(11*time.Second))).ToNot(Succeed())
+
+	// If the trial call fails, the circuit breaker opens again.
+	g.Expect(state.finishCall(circuitBreaker, true, true, now.Add(11*time.Second))).To(BeTrue())
+	g.Expect(state.startCall(circuitBreaker, now.Add(12*time.Second))).ToNot(Succeed())
+
+	// A trial call which is not performed does not change the circuit breaker, but allows another trial call.
+	g.Expect(state.startCall(circuitBreaker, now.Add(22*time.Second))).To(Succeed())
+	g.Expect(state.finishCall(circuitBreaker, false, false, now.Add(22*time.Second))).To(BeTrue())
+	g.Expect(state.startCall(circuitBreaker, now.Add(22*time.Second))).To(Succeed())
+
+	// If the trial call succeeds, the circuit breaker closes.
+	g.Expect(state.finishCall(circuitBreaker, true, false, now.Add(22*time.Second))).To(BeFalse())
+	g.Expect(state.startCall(circuitBreaker, now.Add(22*time.Second))).To(Succeed())
+	callState = state.toExtensionHandlerCallState(circuitBreaker)
+	g.Expect(callState.CircuitBreakerOpen).To(BeFalse())
+	g.Expect(callState.ConsecutiveFailures).To(Equal(int32(0)))
+}
+
+func TestHandlerCallState_CircuitBreakerDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	state := &handlerCallState{name: "handler"}
+	now := time.Now()
+
+	for range 10 {
+		g.Expect(state.startCall(runtimev1.ExtensionCircuitBreaker{}, now)).To(Succeed())
+		g.Expect(state.finishCall(runtimev1.ExtensionCircuitBreaker{}, true, true, now)).To(BeFalse())
+	}
+}
+
+func TestHandlerCallState_Acquire(t *testing.T) {
+	g := NewWithT(t)
+
+	state := &handlerCallState{name: "handler"}
+
+	release1, err := state.acquire(t.Context(), 2, time.Second)
+	g.Expect(err).ToNot(HaveOccurred())
+	release2, err := state.acquire(t.Context(), 2, time.Second)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(state.toExtensionHandlerCallState(runtimev1.ExtensionCircuitBreaker{}).InFlightCalls).To(Equal(int32(2)))
+
+	// A third call has to wait for one of the in-flight calls to complete.
+	_, err = state.acquire(t.Context(), 2, 100*time.Millisecond)
+	g.Expect(err).To(HaveOccurred())
+
+	release1()
+	release3, err := state.acquire(t.Context(), 2, 100*time.Millisecond)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	release2()
+	release3()
+	g.Expect(state.toExtensionHandlerCallState(runtimev1.ExtensionCircuitBreaker{}).InFlightCalls).To(Equal(int32(0)))
+
+	// Without maxConcurrentCalls, in-flight calls are not limited.
+	for range 10 {
+		_, err := state.acquire(t.Context(), 0, 100*time.Millisecond)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	g.Expect(state.toExtensionHandlerCallState(runtimev1.ExtensionCircuitBreaker{}).InFlightCalls).To(Equal(int32(10)))
+}
+
+func TestClient_CallExtensionWithCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name          string
+		failurePolicy runtimev1.FailurePolicy
+		wantErr       bool
+	}{
+		{
+			name:          "should return an error when the circuit breaker is open and FailurePolicyFail",
+			failurePolicy: runtimev1.FailurePolicyFail,
+			wantErr:       true,
+		},
+		{
+			name:          "should not return an error when the circuit breaker is open and FailurePolicyIgnore",
+			failurePolicy: runtimev1.FailurePolicyIgnore,
+			wantErr:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var serverCallCount int
+			srv := createSecureTestServer(testServerConfig{
+				start: true,
+				responses: map[string]testServerResponse{
+					"/*": {
+						response:           &fakev1alpha1.FakeResponse{},
+						responseStatusCode: http.StatusInternalServerError,
+					},
+				},
+			}, func() {
+				serverCallCount++
+			})
+			srv.StartTLS()
+			defer srv.Close()
+
+			extensionConfig := runtimev1.ExtensionConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "extension",
+					ResourceVersion: "15",
+				},
+				Spec: runtimev1.ExtensionConfigSpec{
+					ClientConfig: runtimev1.ClientConfig{
+						URL:      fmt.Sprintf("https://%s/", srv.Listener.Addr().String()),
+						CABundle: testcerts.CACert,
+					},
+					NamespaceSelector: &metav1.LabelSelector{},
+					CallPolicy: runtimev1.ExtensionCallPolicy{
+						CircuitBreaker: runtimev1.ExtensionCircuitBreaker{
+							FailureThreshold:    2,
+							OpenDurationSeconds: 60,
+						},
+					},
+				},
+				Status: runtimev1.ExtensionConfigStatus{
+					Handlers: []runtimev1.ExtensionHandler{
+						{
+							Name: "valid-extension",
+							RequestHook: runtimev1.GroupVersionHook{
+								APIVersion: fakev1alpha1.GroupVersion.String(),
+								Hook:       "FakeHook",
+							},
+							TimeoutSeconds: 1,
+							FailurePolicy:  tt.failurePolicy,
+						},
+					},
+				},
+			}
+
+			cat := runtimecatalog.New()
+			_ = fakev1alpha1.AddToCatalog(cat)
+			c, _, err := New(t.Context(), Options{
+				Catalog:  cat,
+				Registry: registry([]runtimev1.ExtensionConfig{extensionConfig}),
+				Client:   fake.NewClientBuilder().WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}).Build(),
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			obj := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster",
+					Namespace: "foo",
+				},
+			}
+
+			// The first failureThreshold calls reach the server and open the circuit breaker.
+			for range 2 {
+				err = c.CallExtension(t.Context(), fakev1alpha1.FakeHook, obj, "valid-extension", &fakev1alpha1.FakeRequest{}, &fakev1alpha1.FakeResponse{})
+				g.Expect(err != nil).To(Equal(tt.wantErr))
+			}
+			g.Expect(serverCallCount).To(Equal(2))
+
+			states := c.GetExtensionHandlerCallStates(&extensionConfig)
+			g.Expect(states).To(HaveLen(1))
+			g.Expect(states[0].Name).To(Equal("valid-extension"))
+			g.Expect(states[0].CircuitBreakerOpen).To(BeTrue())
+			g.Expect(states[0].ConsecutiveFailures).To(Equal(int32(2)))
+
+			// Further calls are rejected without calling the server.
+			err = c.CallExtension(t.Context(), fakev1alpha1.FakeHook, obj, "valid-extension", &fakev1alpha1.FakeRequest{}, &fakev1alpha1.FakeResponse{})
+			if tt.wantErr {
+				g.Expect(err).To(MatchError(ContainSubstring("circuit breaker is open")))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(serverCallCount).To(Equal(2))
+		})
+	}
+}
+
+func TestClient_CallExtensionWithResponseCache(t *testing.T) {
+	g := NewWithT(t)
+
+	var serverCallCount int
+	srv := createSecureTestServer(testServerConfig{
+		start: true,
+		responses: map[string]testServerResponse{
+			"/*": {
+				response: &runtimehooksv1.GeneratePatchesResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{
+						Status: runtimehooksv1.ResponseStatusSuccess,
+					},
+					Items: []runtimehooksv1.GeneratePatchesResponseItem{
+						{UID: "1", PatchType: runtimehooksv1.JSONPatchType, Patch: []byte(`[]`)},
+					},
+				},
+				responseStatusCode: http.StatusOK,
+			},
+		},
+	}, func() {
+		serverCallCount++
+	})
+	srv.StartTLS()
+	defer srv.Close()
+
+	extensionConfig := runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "extension",
+			ResourceVersion: "15",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				URL:      fmt.Sprintf("https://%s/", srv.Listener.Addr().String()),
+				CABundle: testcerts.CACert,
+			},
+			NamespaceSelector: &metav1.LabelSelector{},
+			CallPolicy: runtimev1.ExtensionCallPolicy{
+				ResponseCache: runtimev1.ExtensionResponseCache{
+					TTLSeconds: 60,
+				},
+			},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "generate-patches",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: runtimehooksv1.GroupVersion.String(),
+						Hook:       "GeneratePatches",
+					},
+					TimeoutSeconds: 1,
+					FailurePolicy:  runtimev1.FailurePolicyFail,
+				},
+			},
+		},
+	}
+
+	cat := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(cat)
+	c, _, err := New(t.Context(), Options{
+		Catalog:  cat,
+		Registry: registry([]runtimev1.ExtensionConfig{extensionConfig}),
+		Client:   fake.NewClientBuilder().WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}).Build(),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	obj := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "foo",
+		},
+	}
+	request := &runtimehooksv1.GeneratePatchesRequest{
+		Items: []runtimehooksv1.GeneratePatchesRequestItem{{UID: "1"}},
+	}
+
+	response := &runtimehooksv1.GeneratePatchesResponse{}
+	g.Expect(c.CallExtension(t.Context(), runtimehooksv1.GeneratePatches, obj, "generate-patches", request, response)).To(Succeed())
+	g.Expect(serverCallCount).To(Equal(1))
+	g.Expect(response.Items).To(HaveLen(1))
+
+	// The same request is served from the cache.
+	cachedResponse := &runtimehooksv1.GeneratePatchesResponse{}
+	g.Expect(c.CallExtension(t.Context(), runtimehooksv1.GeneratePatches, obj, "generate-patches", request, cachedResponse)).To(Succeed())
+	g.Expect(serverCallCount).To(Equal(1))
+	g.Expect(cachedResponse).To(BeComparableTo(response))
+
+	// A different request calls the server.
+	request.Items = append(request.Items, runtimehooksv1.GeneratePatchesRequestItem{UID: "2"})
+	g.Expect(c.CallExtension(t.Context(), runtimehooksv1.GeneratePatches, obj, "generate-patches", request, &runtimehooksv1.GeneratePatchesResponse{})).To(Succeed())
+	g.Expect(serverCallCount).To(Equal(2))
+}
@@ -41,6 +41,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/transport"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -77,6 +78,11 @@ type Options struct {
 	Catalog  *runtimecatalog.Catalog
 	Registry runtimeregistry.ExtensionRegistry
 	Client   ctrlclient.Client
+
+	// ServiceProxyConfig, if set, is the config of the API server used to call the extensions referencing a Service
+	// through the service proxy of the API server, e.g. when the client runs outside of the management cluster.
+	// NOTE: The API server does not verify the serving certificate of the extensions when proxying requests.
+	ServiceProxyConfig *rest.Config
 }
 
 // New returns a new Client.
@@ -94,14 +100,33 @@ func New(ctx context.Context, options Options) (runtimeclient.Client, *certwatch
 			httpClientCache.DeleteAll()
 		})
 	}
-	return &client{
+	c := &client{
 		certFile:         options.CertFile,
 		keyFile:          options.KeyFile,
 		catalog:          options.Catalog,
 		registry:         options.Registry,
 		client:           options.Client,
 		httpClientsCache: httpClientCache,
-	}, certWatcher, nil
+		responseCache:    cache.New[responseCacheEntry](ctx, maxResponseCacheTTL),
+		callStates:       newHandlerCallStates(),
+	}
+
+	if options.ServiceProxyConfig != nil {
+		var err error
+		c.serviceProxyURL, _, err = rest.DefaultServerUrlFor(options.ServiceProxyConfig)
+		if err != nil {
+			return nil, nil, pkgerrors.Wrapf(err, "failed to create RuntimeSDK client: failed to get API server URL")
+		}
+		c.serviceProxyHTTPClient, err = rest.HTTPClientFor(options.ServiceProxyConfig)
+		if err != nil {
+			return nil, nil, pkgerrors.Wrapf(err, "failed to create RuntimeSDK client: failed to create http client for the API server")
+		}
+		// Do not follow redirects, like when calling extensions directly (see createHTTPClient).
+		c.serviceProxyHTTPClient.CheckRedirect = func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	return c, certWatcher, nil
 }
 
 var _ runtimeclient.Client = &client{}
@@ -113,6 +138,13 @@ type client struct {
 	registry         runtimeregistry.ExtensionRegistry
 	client           ctrlclient.Client
 	httpClientsCache cache.Cache[httpClientEntry]
+	responseCache    cache.Cache[responseCacheEntry]
+	callStates       *handlerCallStates
+
+	// serviceProxyURL and serviceProxyHTTPClient are used to call the extensions referencing a Service
+	// through the service proxy of the API server, if set.
+	serviceProxyURL        *url.URL
+	serviceProxyHTTPClient *http.Client
 }
 
 type httpClientEntry struct {
@@ -174,6 +206,7 @@ func (c *client) Discover(ctx context.Context, extensionConfig *runtimev1.Extens
 		hookGVH:         hookGVH,
 		timeout:         defaultDiscoveryTimeout,
 		httpClient:      httpClient,
+		serviceProxyURL: c.serviceProxyURL,
 	}
 	if err := httpCall(ctx, request, response, opts); err != nil {
 		return nil, pkgerrors.Wrapf(err, "failed to discover extension %q", extensionConfig.Name)
@@ -419,6 +452,27 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		}
 	}
 
+	// Return a cached response if the call policy of the ExtensionConfig enables response caching and the response is cached.
+	responseCacheKey, cacheResponse, err := responseCacheKey(registration, hookGVH, request)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to call extension handler %q", name)
+	}
+	if cacheResponse {
+		cacheEntry, ok := c.responseCache.Has(responseCacheKey)
+		hit := ok && time.Now().Before(cacheEntry.expiresAt)
+		runtimemetrics.ResponseCacheRequestsTotal.Observe(registration.Name, hit)
+		if hit {
+			outVal := reflect.ValueOf(response)
+			cacheVal := reflect.ValueOf(cacheEntry.response.DeepCopyObject())
+			if !cacheVal.Type().AssignableTo(outVal.Type()) {
+				return fmt.Errorf("failed to call extension handler %q: cached response of type %s instead of type %s", name, cacheVal.Type(), outVal.Type())
+			}
+			reflect.Indirect(outVal).Set(reflect.Indirect(cacheVal))
+			log.V(4).Info("Using cached response of extension handler")
+			return nil
+		}
+	}
+
 	httpClient, err := c.getHTTPClient(registration.ClientConfig)
 	if err != nil {
 		return pkgerrors.Wrapf(err, "failed to call extension handler %q: failed to get http client", name)
@@ -432,8 +486,9 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		name:            strings.TrimSuffix(registration.Name, "."+registration.ExtensionConfigName),
 		timeout:         timeoutDuration,
 		httpClient:      httpClient,
+		serviceProxyURL: c.serviceProxyURL,
 	}
-	err = httpCall(ctx, request, response, httpOpts)
+	err = c.callWithCallPolicy(ctx, registration, request, response, httpOpts)
 	if err != nil {
 		// If the error is errCallingExtensionHandler then apply failure policy to calculate
 		// the effective result of the operation.
@@ -468,12 +523,25 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		})
 	}
 
+	if cacheResponse {
+		// Add response to the response cache.
+		c.responseCache.Add(responseCacheEntry{
+			cacheKey:  responseCacheKey,
+			response:  response.DeepCopyObject().(runtimehooksv1.ResponseObject),
+			expiresAt: time.Now().Add(time.Duration(registration.CallPolicy.ResponseCache.TTLSeconds) * time.Second),
+		})
+	}
+
 	// Received a successful response from the extension handler. The `response` object
 	// has been populated with the result. Return no error.
 	return nil
 }
 
 func (c *client) getHTTPClient(config runtimev1.ClientConfig) (*http.Client, error) {
+	if c.serviceProxyHTTPClient != nil && config.Service.IsDefined() {
+		return c.serviceProxyHTTPClient, nil
+	}
+
 	// Note: we are passing an empty gvh and "" as name because the only relevant part of the url
 	// for this function is the Hostname, which derives from config (ghv and name are appended to the path).
 	extensionURL, err := urlForExtension(config, runtimecatalog.GroupVersionHook{}, "")
@@ -551,6 +619,54 @@ type httpCallOptions struct {
 	name            string
 	timeout         time.Duration
 	httpClient      *http.Client
+	// serviceProxyURL, if set, is the URL of the API server used to call extensions referencing a Service.
+	serviceProxyURL *url.URL
+}
+
+// callWithCallPolicy calls the ExtensionHandler while enforcing the circuit breaker and the limit of
+// in-flight calls defined in the call policy of the ExtensionConfig.
+// Calls rejected by the circuit breaker or by the limit of in-flight calls return an errCallingExtensionHandler,
+// so they are handled according to the failurePolicy of the ExtensionHandler.
+func (c *client) callWithCallPolicy(ctx context.Context, registration *runtimeregistry.ExtensionRegistration, request, response runtime.Object, opts *httpCallOptions) error {
+	callPolicy := registration.CallPolicy
+	state := c.callStates.get(registration.Name)
+
+	if err := state.startCall(callPolicy.CircuitBreaker, time.Now()); err != nil {
+		runtimemetrics.RejectedRequestsTotal.Observe(registration.Name, runtimemetrics.RejectedCircuitBreakerOpenReason)
+		return errCallingExtensionHandler(pkgerrors.Wrap(err, "call rejected"))
+	}
+
+	release, err := state.acquire(ctx, callPolicy.MaxConcurrentCalls, opts.timeout)
+	if err != nil {
+		state.finishCall(callPolicy.CircuitBreaker, false, false, time.Now())
+		runtimemetrics.RejectedRequestsTotal.Observe(registration.Name, runtimemetrics.RejectedTooManyInFlightRequestsReason)
+		return errCallingExtensionHandler(pkgerrors.Wrap(err, "call rejected"))
+	}
+	err = httpCall(ctx, request, response, opts)
+	release()
+
+	// Note: Only errors calling the ExtensionHandler are considered failures by the circuit breaker.
+	// Failure responses are not, because they prove the ExtensionHandler is available.
+	_, failed := err.(errCallingExtensionHandler)
+	open := state.finishCall(callPolicy.CircuitBreaker, true, failed, time.Now())
+	if callPolicy.CircuitBreaker.FailureThreshold != 0 {
+		runtimemetrics.CircuitBreakerOpen.Observe(registration.Name, open)
+	}
+	return err
+}
+
+// GetExtensionHandlerCallStates returns the state of the calls to the ExtensionHandlers of the ExtensionConfig.
+func (c *client) GetExtensionHandlerCallStates(extensionConfig *runtimev1.ExtensionConfig) []runtimeclient.ExtensionHandlerCallState {
+	states := make([]runtimeclient.ExtensionHandlerCallState, 0, len(extensionConfig.Status.Handlers))
+	for _, handler := range extensionConfig.Status.Handlers {
+		state, ok := c.callStates.peek(handler.Name)
+		if !ok {
+			states = append(states, runtimeclient.ExtensionHandlerCallState{Name: handler.Name})
+			continue
+		}
+		states = append(states, state.toExtensionHandlerCallState(extensionConfig.Spec.CallPolicy.CircuitBreaker))
+	}
+	return states
 }
 
 func httpCall(ctx context.Context, request, response runtime.Object, opts *httpCallOptions) error {
@@ -566,6 +682,9 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 	if err != nil {
 		return pkgerrors.Wrap(err, "http call failed")
 	}
+	if opts.serviceProxyURL != nil && opts.config.Service.IsDefined() {
+		extensionURL = serviceProxyURLForExtension(opts.serviceProxyURL, opts.config.Service, opts.registrationGVH, opts.name)
+	}
 
 	// Observe request duration metric.
 	start := time.Now()
@@ -681,6 +800,19 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 	return nil
 }
 
+// serviceProxyURLForExtension returns the URL of an ExtensionHandler of an extension referencing a Service
+// through the service proxy of the API server with the given URL.
+func serviceProxyURLForExtension(serverURL *url.URL, svc runtimev1.ServiceReference, gvh runtimecatalog.GroupVersionHook, name string) *url.URL {
+	port := int32(443)
+	if svc.Port != nil {
+		port = *svc.Port
+	}
+	u := *serverURL
+	u.Path = path.Join("/", u.Path, "api/v1/namespaces", svc.Namespace, "services", fmt.Sprintf("https:%s:%d", svc.Name, port), "proxy",
+		svc.Path, runtimecatalog.GVHToPath(gvh, name))
+	return &u
+}
+
 func urlForExtension(config runtimev1.ClientConfig, gvh runtimecatalog.GroupVersionHook, name string) (*url.URL, error) {
 	var u *url.URL
 	if config.Service.IsDefined() {
@@ -40,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/admission/plugin/webhook/testcerts"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -1055,6 +1056,83 @@ func TestClient_CallExtensionWithClientAuthentication(t *testing.T) {
 	srv.Close()
 }
 
+func TestClient_CallExtensionThroughServiceProxy(t *testing.T) {
+	g := NewWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+	}
+	extensionConfig := runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "extension",
+			ResourceVersion: "15",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				Service: runtimev1.ServiceReference{
+					Namespace: "test1",
+					Name:      "extension-service",
+					Path:      "/prefix",
+					Port:      ptr.To[int32](8443),
+				},
+				CABundle: testcerts.CACert,
+			},
+			NamespaceSelector: &metav1.LabelSelector{},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "valid-extension.extension",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: fakev1alpha1.GroupVersion.String(),
+						Hook:       "FakeHook",
+					},
+					TimeoutSeconds: 1,
+					FailurePolicy:  runtimev1.FailurePolicyFail,
+				},
+			},
+		},
+	}
+
+	// The test server acts as the API server, proxying requests to the extension.
+	var requestPaths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPaths = append(requestPaths, r.URL.Path)
+		respBody, err := json.Marshal(fakeSuccessResponse(""))
+		if err != nil {
+			panic(err)
+		}
+		_, _ = w.Write(respBody)
+	}))
+	defer srv.Close()
+
+	cat := runtimecatalog.New()
+	_ = fakev1alpha1.AddToCatalog(cat)
+	c, _, err := New(t.Context(), Options{
+		Catalog:            cat,
+		Registry:           registry([]runtimev1.ExtensionConfig{extensionConfig}),
+		Client:             fake.NewClientBuilder().WithObjects(ns).Build(),
+		ServiceProxyConfig: &rest.Config{Host: srv.URL},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	obj := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "foo",
+		},
+	}
+	err = c.CallExtension(t.Context(), fakev1alpha1.FakeHook, obj, "valid-extension.extension", &fakev1alpha1.FakeRequest{}, &fakev1alpha1.FakeResponse{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	gvh := runtimecatalog.GroupVersionHook{Group: fakev1alpha1.GroupVersion.Group, Version: fakev1alpha1.GroupVersion.Version, Hook: "FakeHook"}
+	g.Expect(requestPaths).To(Equal([]string{
+		"/api/v1/namespaces/test1/services/https:extension-service:8443/proxy/prefix" + runtimecatalog.GVHToPath(gvh, "valid-extension"),
+	}))
+}
+
 func TestClient_GetHttpClient(t *testing.T) {
 	g := NewWithT(t)
 
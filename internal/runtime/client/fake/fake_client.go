@@ -39,6 +39,7 @@ type RuntimeClientBuilder struct {
 	callAllValidations func(object runtimehooksv1.RequestObject) error
 	callResponses      map[string]runtimehooksv1.ResponseObject
 	callValidations    func(name string, object runtimehooksv1.RequestObject) error
+	callStates         []runtimeclient.ExtensionHandlerCallState
 }
 
 // NewRuntimeClientBuilder returns a new builder for the fake runtime client.
@@ -82,6 +83,12 @@ func (f *RuntimeClientBuilder) WithCallExtensionValidations(callValidations func
 	return f
 }
 
+// WithExtensionHandlerCallStates can be used to dictate the response for GetExtensionHandlerCallStates.
+func (f *RuntimeClientBuilder) WithExtensionHandlerCallStates(callStates []runtimeclient.ExtensionHandlerCallState) *RuntimeClientBuilder {
+	f.callStates = callStates
+	return f
+}
+
 // MarkReady can be used to mark the fake runtime client as either ready or not ready.
 func (f *RuntimeClientBuilder) MarkReady(ready bool) *RuntimeClientBuilder {
 	f.ready = ready
@@ -97,6 +104,7 @@ func (f *RuntimeClientBuilder) Build() *RuntimeClient {
 		callAllValidations: f.callAllValidations,
 		callResponses:      f.callResponses,
 		callValidations:    f.callValidations,
+		callStates:         f.callStates,
 		catalog:            f.catalog,
 		callAllTracker:     map[string]int{},
 		callTracker:        map[string]int{},
@@ -114,6 +122,7 @@ type RuntimeClient struct {
 	callAllValidations func(object runtimehooksv1.RequestObject) error
 	callResponses      map[string]runtimehooksv1.ResponseObject
 	callValidations    func(name string, object runtimehooksv1.RequestObject) error
+	callStates         []runtimeclient.ExtensionHandlerCallState
 
 	callTracker    map[string]int
 	callAllTracker map[string]int
@@ -203,6 +212,11 @@ func (fc *RuntimeClient) Discover(context.Context, *runtimev1.ExtensionConfig) (
 	panic("unimplemented")
 }
 
+// GetExtensionHandlerCallStates implements Client.
+func (fc *RuntimeClient) GetExtensionHandlerCallStates(_ *runtimev1.ExtensionConfig) []runtimeclient.ExtensionHandlerCallState {
+	return fc.callStates
+}
+
 // IsReady implements Client.
 func (fc *RuntimeClient) IsReady() bool {
 	return fc.isReady
@@ -35,6 +35,10 @@ func init() {
 	// Register the metrics at the controller-runtime metrics registry.
 	ctrlmetrics.Registry.MustRegister(RequestsTotal.metric)
 	ctrlmetrics.Registry.MustRegister(RequestDuration.metric)
+	ctrlmetrics.Registry.MustRegister(CircuitBreakerOpen.metric)
+	ctrlmetrics.Registry.MustRegister(InFlightRequests.metric)
+	ctrlmetrics.Registry.MustRegister(RejectedRequestsTotal.metric)
+	ctrlmetrics.Registry.MustRegister(ResponseCacheRequestsTotal.metric)
 }
 
 // Metrics subsystem and all of the keys used by the Runtime SDK.
@@ -65,6 +69,48 @@ var (
 			NativeHistogramMinResetDuration: 1 * time.Hour,
 		}, []string{"host", "group", "version", "hook"}),
 	}
+	// CircuitBreakerOpen reports if the circuit breaker of an extension handler is open.
+	CircuitBreakerOpen = circuitBreakerOpenObserver{
+		prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Subsystem: runtimeSDKSubsystem,
+			Name:      "circuit_breaker_open",
+			Help:      "Whether the circuit breaker of an extension handler is open (1) or closed (0), partitioned by extension handler.",
+		}, []string{"handler"}),
+	}
+	// InFlightRequests reports the number of in-flight requests.
+	InFlightRequests = inFlightRequestsObserver{
+		prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Subsystem: runtimeSDKSubsystem,
+			Name:      "in_flight_requests",
+			Help:      "Number of in-flight requests, partitioned by extension handler.",
+		}, []string{"handler"}),
+	}
+	// RejectedRequestsTotal reports requests that have been rejected by the client without calling the extension handler.
+	RejectedRequestsTotal = rejectedRequestsTotalObserver{
+		prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: runtimeSDKSubsystem,
+			Name:      "rejected_requests_total",
+			Help:      "Number of requests rejected without calling the extension handler, partitioned by extension handler and reason.",
+		}, []string{"handler", "reason"}),
+	}
+	// ResponseCacheRequestsTotal reports lookups in the response cache.
+	ResponseCacheRequestsTotal = responseCacheRequestsTotalObserver{
+		prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: runtimeSDKSubsystem,
+			Name:      "response_cache_requests_total",
+			Help:      "Number of response cache lookups, partitioned by extension handler and result (hit or miss).",
+		}, []string{"handler", "result"}),
+	}
+)
+
+// Reasons used for the RejectedRequestsTotal metric.
+const (
+	// RejectedCircuitBreakerOpenReason is used when a request is rejected because the circuit breaker is open.
+	RejectedCircuitBreakerOpenReason = "CircuitBreakerOpen"
+
+	// RejectedTooManyInFlightRequestsReason is used when a request is rejected because the maximum number
+	// of in-flight requests has been reached and no request completed in time.
+	RejectedTooManyInFlightRequestsReason = "TooManyInFlightRequests"
 )
 
 type requestsTotalObserver struct {
@@ -99,3 +145,47 @@ type requestDurationObserver struct {
 func (m *requestDurationObserver) Observe(gvh runtimecatalog.GroupVersionHook, u url.URL, latency time.Duration) {
 	m.metric.WithLabelValues(u.Host, gvh.Group, gvh.Version, gvh.Hook).Observe(latency.Seconds())
 }
+
+type circuitBreakerOpenObserver struct {
+	metric *prometheus.GaugeVec
+}
+
+// Observe sets the circuit breaker metric for the given extension handler.
+func (m *circuitBreakerOpenObserver) Observe(handler string, open bool) {
+	value := 0.0
+	if open {
+		value = 1.0
+	}
+	m.metric.WithLabelValues(handler).Set(value)
+}
+
+type inFlightRequestsObserver struct {
+	metric *prometheus.GaugeVec
+}
+
+// Observe sets the in-flight requests metric for the given extension handler.
+func (m *inFlightRequestsObserver) Observe(handler string, inFlight int32) {
+	m.metric.WithLabelValues(handler).Set(float64(inFlight))
+}
+
+type rejectedRequestsTotalObserver struct {
+	metric *prometheus.CounterVec
+}
+
+// Observe increments the rejected requests metric for the given extension handler and reason.
+func (m *rejectedRequestsTotalObserver) Observe(handler, reason string) {
+	m.metric.WithLabelValues(handler, reason).Inc()
+}
+
+type responseCacheRequestsTotalObserver struct {
+	metric *prometheus.CounterVec
+}
+
+// Observe increments the response cache metric for the given extension handler.
+func (m *responseCacheRequestsTotalObserver) Observe(handler string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.metric.WithLabelValues(handler, result).Inc()
+}
@@ -84,6 +84,9 @@ type ExtensionRegistration struct {
 
 	// Settings captures additional information sent in call to the RuntimeExtensions.
 	Settings map[string]string
+
+	// CallPolicy defines how calls to the RuntimeExtension are limited, guarded by a circuit breaker and cached.
+	CallPolicy runtimev1.ExtensionCallPolicy
 }
 
 // extensionRegistry is an implementation of ExtensionRegistry.
@@ -263,6 +266,7 @@ func (r *extensionRegistry) add(extensionConfig *runtimev1.ExtensionConfig) erro
 			TimeoutSeconds:    e.TimeoutSeconds,
 			FailurePolicy:     e.FailurePolicy,
 			Settings:          extensionConfig.Spec.Settings,
+			CallPolicy:        extensionConfig.Spec.CallPolicy,
 		})
 	}
 
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package auxiliaryresources contains utils to track the auxiliary resources of a Cluster topology.
+package auxiliaryresources
+
+import (
+	"fmt"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// KindsAnnotationValue returns the value of the auxiliary resource kinds annotation for the given auxiliary resources,
+// i.e. a sorted, comma-separated list of <apiVersion>/<kind>.
+func KindsAnnotationValue(auxiliaryResources map[string]*unstructured.Unstructured) string {
+	kinds := sets.Set[string]{}
+	for _, obj := range auxiliaryResources {
+		kinds.Insert(fmt.Sprintf("%s/%s", obj.GetAPIVersion(), obj.GetKind()))
+	}
+	return strings.Join(sets.List(kinds), ",")
+}
+
+// KindsFromAnnotations returns the kinds tracked in the auxiliary resource kinds annotation, if any.
+func KindsFromAnnotations(annotations map[string]string) ([]schema.GroupVersionKind, error) {
+	value := annotations[clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation]
+	if value == "" {
+		return nil, nil
+	}
+
+	gvks := []schema.GroupVersionKind{}
+	for _, kind := range strings.Split(value, ",") {
+		i := strings.LastIndex(kind, "/")
+		if i <= 0 || i == len(kind)-1 {
+			return nil, pkgerrors.Errorf("invalid value %q in annotation %s: expected <apiVersion>/<kind>", kind, clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation)
+		}
+		gv, err := schema.ParseGroupVersion(kind[:i])
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "invalid value %q in annotation %s", kind, clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation)
+		}
+		gvks = append(gvks, gv.WithKind(kind[i+1:]))
+	}
+	return gvks, nil
+}
+
+// Kinds returns the kinds of the auxiliary resources that might exist for a Cluster, i.e. the kinds of the
+// auxiliary resources defined in the ClusterClass and the kinds tracked in the annotations of the Cluster.
+// NOTE: If the same group and kind is found in both, the version from the ClusterClass is used.
+func Kinds(auxiliaryResources map[string]*unstructured.Unstructured, annotations map[string]string) ([]schema.GroupVersionKind, error) {
+	trackedGVKs, err := KindsFromAnnotations(annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	gvks := []schema.GroupVersionKind{}
+	groupKinds := sets.Set[schema.GroupKind]{}
+	for _, name := range sets.List(sets.KeySet(auxiliaryResources)) {
+		gvk := auxiliaryResources[name].GroupVersionKind()
+		if groupKinds.Has(gvk.GroupKind()) {
+			continue
+		}
+		groupKinds.Insert(gvk.GroupKind())
+		gvks = append(gvks, gvk)
+	}
+	for _, gvk := range trackedGVKs {
+		if groupKinds.Has(gvk.GroupKind()) {
+			continue
+		}
+		groupKinds.Insert(gvk.GroupKind())
+		gvks = append(gvks, gvk)
+	}
+	return gvks, nil
+}
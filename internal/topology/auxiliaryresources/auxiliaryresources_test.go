@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auxiliaryresources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestKindsAnnotationValue(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(KindsAnnotationValue(nil)).To(BeEmpty())
+	g.Expect(KindsAnnotationValue(map[string]*unstructured.Unstructured{
+		"drain":  newObject("cluster.x-k8s.io/v1beta2", "MachineDrainRule"),
+		"drain2": newObject("cluster.x-k8s.io/v1beta2", "MachineDrainRule"),
+		"config": newObject("v1", "ConfigMap"),
+	})).To(Equal("cluster.x-k8s.io/v1beta2/MachineDrainRule,v1/ConfigMap"))
+}
+
+func TestKindsFromAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []schema.GroupVersionKind
+		wantErr     bool
+	}{
+		{
+			name:        "no annotation",
+			annotations: nil,
+			want:        nil,
+		},
+		{
+			name: "kinds with and without group",
+			annotations: map[string]string{
+				clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation: "cluster.x-k8s.io/v1beta2/MachineDrainRule,v1/ConfigMap",
+			},
+			want: []schema.GroupVersionKind{
+				{Group: "cluster.x-k8s.io", Version: "v1beta2", Kind: "MachineDrainRule"},
+				{Group: "", Version: "v1", Kind: "ConfigMap"},
+			},
+		},
+		{
+			name: "missing kind",
+			annotations: map[string]string{
+				clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation: "cluster.x-k8s.io/v1beta2/",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid apiVersion",
+			annotations: map[string]string{
+				clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation: "a/b/c/MachineDrainRule",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := KindsFromAnnotations(tt.annotations)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestKinds(t *testing.T) {
+	g := NewWithT(t)
+
+	got, err := Kinds(map[string]*unstructured.Unstructured{
+		"drain": newObject("cluster.x-k8s.io/v1beta2", "MachineDrainRule"),
+	}, map[string]string{
+		clusterv1.ClusterTopologyAuxiliaryResourceKindsAnnotation: "cluster.x-k8s.io/v1beta1/MachineDrainRule,v1/ConfigMap",
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(Equal([]schema.GroupVersionKind{
+		{Group: "cluster.x-k8s.io", Version: "v1beta2", Kind: "MachineDrainRule"},
+		{Group: "", Version: "v1", Kind: "ConfigMap"},
+	}))
+}
+
+func newObject(apiVersion, kind string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	return obj
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package maintenancewindows contains utils to evaluate the maintenance windows of a Cluster topology.
+package maintenancewindows
+
+import (
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// Window is a parsed MaintenanceWindow.
+type Window struct {
+	schedule *cron.SpecSchedule
+	duration time.Duration
+}
+
+// Parse parses a MaintenanceWindow.
+func Parse(window clusterv1.MaintenanceWindow) (*Window, error) {
+	loc := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, pkgerrors.Wrapf(err, "invalid time zone %q", window.TimeZone)
+		}
+	}
+
+	// Note: time zones must be set using the timeZone field.
+	if strings.HasPrefix(window.Schedule, "TZ=") || strings.HasPrefix(window.Schedule, "CRON_TZ=") {
+		return nil, pkgerrors.Errorf("invalid schedule %q: time zones must be set using timeZone", window.Schedule)
+	}
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "invalid schedule %q", window.Schedule)
+	}
+	// Note: "@every" schedules are relative to the time they are evaluated, so they cannot be used
+	// to define recurring windows.
+	specSchedule, ok := schedule.(*cron.SpecSchedule)
+	if !ok {
+		return nil, pkgerrors.Errorf("invalid schedule %q: @every is not supported", window.Schedule)
+	}
+	specSchedule.Location = loc
+
+	return &Window{
+		schedule: specSchedule,
+		duration: time.Duration(ptr.Deref(window.DurationSeconds, 0)) * time.Second,
+	}, nil
+}
+
+// IsOpen returns true if the window is open at the given time.
+// If the window is closed, it also returns the time when the window opens next; a zero time is returned
+// if the schedule never activates again.
+func (w *Window) IsOpen(now time.Time) (bool, time.Time) {
+	// Get the first opening which is still relevant for now, i.e. the first opening after now - duration.
+	// If this opening is not after now, now is inside the window.
+	opening := w.schedule.Next(now.Add(-w.duration))
+	if opening.IsZero() {
+		return false, time.Time{}
+	}
+	if !opening.After(now) {
+		return true, time.Time{}
+	}
+	return false, opening
+}
+
+// IsOpen returns true if any of the maintenance windows is open at the given time, or if no maintenance windows are defined.
+// If all the maintenance windows are closed, it also returns the time when the first one opens next.
+func IsOpen(windows []clusterv1.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	if len(windows) == 0 {
+		return true, time.Time{}, nil
+	}
+
+	var nextOpen time.Time
+	for _, window := range windows {
+		w, err := Parse(window)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		open, next := w.IsOpen(now)
+		if open {
+			return true, time.Time{}, nil
+		}
+		if !next.IsZero() && (nextOpen.IsZero() || next.Before(nextOpen)) {
+			nextOpen = next
+		}
+	}
+	return false, nextOpen, nil
+}
+
+// For returns the maintenance windows to be used for a Cluster topology.
+// Maintenance windows defined in the Cluster topology take precedence over the ones defined in the ClusterClass.
+func For(topology clusterv1.Topology, clusterClass *clusterv1.ClusterClass) []clusterv1.MaintenanceWindow {
+	if len(topology.Upgrade.MaintenanceWindows) > 0 {
+		return topology.Upgrade.MaintenanceWindows
+	}
+	if clusterClass != nil {
+		return clusterClass.Spec.Upgrade.MaintenanceWindows
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindows
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  clusterv1.MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name:   "valid schedule",
+			window: clusterv1.MaintenanceWindow{Schedule: "0 22 * * 6", DurationSeconds: ptr.To[int32](3600)},
+		},
+		{
+			name:   "valid descriptor",
+			window: clusterv1.MaintenanceWindow{Schedule: "@daily", DurationSeconds: ptr.To[int32](3600)},
+		},
+		{
+			name:   "valid schedule with time zone",
+			window: clusterv1.MaintenanceWindow{Schedule: "0 22 * * 6", DurationSeconds: ptr.To[int32](3600), TimeZone: "Europe/Rome"},
+		},
+		{
+			name:    "invalid schedule",
+			window:  clusterv1.MaintenanceWindow{Schedule: "0 22 * *", DurationSeconds: ptr.To[int32](3600)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid @every schedule",
+			window:  clusterv1.MaintenanceWindow{Schedule: "@every 1h", DurationSeconds: ptr.To[int32](3600)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone in schedule",
+			window:  clusterv1.MaintenanceWindow{Schedule: "CRON_TZ=Europe/Rome 0 22 * * 6", DurationSeconds: ptr.To[int32](3600)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone",
+			window:  clusterv1.MaintenanceWindow{Schedule: "0 22 * * 6", DurationSeconds: ptr.To[int32](3600), TimeZone: "Mars/Olympus_Mons"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := Parse(tt.window)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestIsOpen(t *testing.T) {
+	// Saturday 22:00 - 23:00 UTC.
+	saturdayNight := clusterv1.MaintenanceWindow{Schedule: "0 22 * * 6", DurationSeconds: ptr.To[int32](3600)}
+	// Every day 02:00 - 04:00 in New York.
+	newYorkNight := clusterv1.MaintenanceWindow{Schedule: "0 2 * * *", DurationSeconds: ptr.To[int32](7200), TimeZone: "America/New_York"}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		windows      []clusterv1.MaintenanceWindow
+		now          time.Time
+		wantOpen     bool
+		wantNextOpen time.Time
+	}{
+		{
+			name:     "no maintenance windows",
+			now:      time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			wantOpen: true,
+		},
+		{
+			name:         "before the window opens",
+			windows:      []clusterv1.MaintenanceWindow{saturdayNight},
+			now:          time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC), // Wednesday
+			wantOpen:     false,
+			wantNextOpen: time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "when the window opens",
+			windows:  []clusterv1.MaintenanceWindow{saturdayNight},
+			now:      time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC),
+			wantOpen: true,
+		},
+		{
+			name:     "inside the window",
+			windows:  []clusterv1.MaintenanceWindow{saturdayNight},
+			now:      time.Date(2026, 10, 17, 22, 59, 59, 0, time.UTC),
+			wantOpen: true,
+		},
+		{
+			name:         "when the window closes",
+			windows:      []clusterv1.MaintenanceWindow{saturdayNight},
+			now:          time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC),
+			wantOpen:     false,
+			wantNextOpen: time.Date(2026, 10, 24, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "inside a window with time zone",
+			windows:  []clusterv1.MaintenanceWindow{newYorkNight},
+			now:      time.Date(2026, 10, 14, 3, 0, 0, 0, newYork),
+			wantOpen: true,
+		},
+		{
+			name:         "outside a window with time zone",
+			windows:      []clusterv1.MaintenanceWindow{newYorkNight},
+			now:          time.Date(2026, 10, 14, 3, 0, 0, 0, time.UTC),
+			wantOpen:     false,
+			wantNextOpen: time.Date(2026, 10, 14, 2, 0, 0, 0, newYork),
+		},
+		{
+			name:         "multiple windows, all closed",
+			windows:      []clusterv1.MaintenanceWindow{saturdayNight, newYorkNight},
+			now:          time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			wantOpen:     false,
+			wantNextOpen: time.Date(2026, 10, 15, 2, 0, 0, 0, newYork),
+		},
+		{
+			name:     "multiple windows, one open",
+			windows:  []clusterv1.MaintenanceWindow{saturdayNight, newYorkNight},
+			now:      time.Date(2026, 10, 17, 22, 30, 0, 0, time.UTC),
+			wantOpen: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			open, nextOpen, err := IsOpen(tt.windows, tt.now)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(open).To(Equal(tt.wantOpen))
+			g.Expect(nextOpen.Equal(tt.wantNextOpen)).To(BeTrue(), "expected next open %s, got %s", tt.wantNextOpen, nextOpen)
+		})
+	}
+}
+
+func TestFor(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterWindows := []clusterv1.MaintenanceWindow{{Schedule: "@daily", DurationSeconds: ptr.To[int32](3600)}}
+	clusterClassWindows := []clusterv1.MaintenanceWindow{{Schedule: "@weekly", DurationSeconds: ptr.To[int32](3600)}}
+	clusterClass := &clusterv1.ClusterClass{
+		Spec: clusterv1.ClusterClassSpec{
+			Upgrade: clusterv1.ClusterClassUpgrade{MaintenanceWindows: clusterClassWindows},
+		},
+	}
+
+	g.Expect(For(clusterv1.Topology{}, nil)).To(BeEmpty())
+	g.Expect(For(clusterv1.Topology{}, clusterClass)).To(Equal(clusterClassWindows))
+	g.Expect(For(clusterv1.Topology{Upgrade: clusterv1.TopologyUpgrade{MaintenanceWindows: clusterWindows}}, clusterClass)).To(Equal(clusterWindows))
+}
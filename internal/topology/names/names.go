@@ -177,3 +177,8 @@ func InfrastructureMachinePoolNamePrefix(clusterName, machinePoolTopologyName st
 func ControlPlaneInfrastructureMachineTemplateNamePrefix(clusterName string) string {
 	return fmt.Sprintf("%s-", clusterName)
 }
+
+// AuxiliaryResourceName calculates the name for an auxiliary resource.
+func AuxiliaryResourceName(clusterName, auxiliaryResourceName string) string {
+	return fmt.Sprintf("%s-%s", clusterName, auxiliaryResourceName)
+}
@@ -34,6 +34,17 @@ func ForMachineDeploymentMHC(md *clusterv1.MachineDeployment) *metav1.LabelSelec
 	}
 }
 
+// ForMachinePoolMHC generates a selector for MachinePool MHCs.
+func ForMachinePoolMHC(mp *clusterv1.MachinePool) *metav1.LabelSelector {
+	// The selector returned here is the minimal common selector for all Machines belonging to a MachinePool.
+	// It does not include any labels set in ClusterClass, Cluster Topology or elsewhere.
+	return &metav1.LabelSelector{MatchLabels: map[string]string{
+		clusterv1.ClusterTopologyOwnedLabel:           "",
+		clusterv1.ClusterTopologyMachinePoolNameLabel: mp.Labels[clusterv1.ClusterTopologyMachinePoolNameLabel],
+	},
+	}
+}
+
 // ForControlPlaneMHC generates a selector for control plane MHCs.
 func ForControlPlaneMHC() *metav1.LabelSelector {
 	// The selector returned here is the minimal common selector for all Machines belonging to the ControlPlane.
@@ -25,6 +25,7 @@ import (
 	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
@@ -114,6 +115,16 @@ func defaultValue(currentValue *clusterv1.ClusterVariable, definition *clusterv1
 		if def.Schema.OpenAPIV3Schema.Default == nil {
 			return nil, nil
 		}
+
+		// Return if the variable does not exist yet and it is sensitive, so the value is never persisted in the Cluster.
+		if ptr.Deref(def.Schema.Sensitive, false) {
+			return nil, nil
+		}
+	}
+
+	// Return the variable as is if the value is set using valueFrom, because the value is resolved only when computing patches.
+	if currentValue != nil && currentValue.ValueFrom.IsDefined() {
+		return currentValue.DeepCopy(), nil
 	}
 
 	// Convert schema to Kubernetes APIExtensions schema.
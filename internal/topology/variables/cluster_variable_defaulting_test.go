@@ -537,6 +537,66 @@ func Test_DefaultClusterVariables(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Don't default variables set using valueFrom",
+			definitions: []clusterv1.ClusterClassStatusVariable{
+				{
+					Name: "token",
+					Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+						{
+							From: clusterv1.VariableDefinitionFromInline,
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type:    "string",
+									Default: &apiextensionsv1.JSON{Raw: []byte(`"default-t0k3n"`)},
+								},
+								Sensitive: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{
+					Name: "token",
+					ValueFrom: clusterv1.ClusterVariableValueSource{
+						SecretKeyRef: clusterv1.ClusterVariableSecretKeyReference{Name: "credentials", Key: "token"},
+					},
+				},
+			},
+			createVariables: true,
+			want: []clusterv1.ClusterVariable{
+				{
+					Name: "token",
+					ValueFrom: clusterv1.ClusterVariableValueSource{
+						SecretKeyRef: clusterv1.ClusterVariableSecretKeyReference{Name: "credentials", Key: "token"},
+					},
+				},
+			},
+		},
+		{
+			name: "Don't create sensitive variables from defaults",
+			definitions: []clusterv1.ClusterClassStatusVariable{
+				{
+					Name: "token",
+					Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+						{
+							From: clusterv1.VariableDefinitionFromInline,
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type:    "string",
+									Default: &apiextensionsv1.JSON{Raw: []byte(`"default-t0k3n"`)},
+								},
+								Sensitive: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			values:          []clusterv1.ClusterVariable{},
+			createVariables: true,
+			want:            []clusterv1.ClusterVariable{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
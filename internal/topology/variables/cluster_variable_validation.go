@@ -17,6 +17,7 @@ limitations under the License.
 package variables
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -97,6 +98,23 @@ func validateClusterVariables(ctx context.Context, values, oldValues []clusterv1
 		// to cluster variable validation.
 		oldValue := oldValuesMap[value.Name]
 
+		// Values set using valueFrom are resolved and validated only when computing patches.
+		if value.ValueFrom.IsDefined() {
+			if value.Value.Raw != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("value"), "value and valueFrom are mutually exclusive"))
+			}
+			continue
+		}
+
+		// Values of sensitive variables must be set using valueFrom.
+		// Note: The value is not surfaced in the error to avoid leaking it; also, existing values are
+		// tolerated so that Clusters created before the variable was marked as sensitive can still be updated.
+		if ptr.Deref(def.Schema.Sensitive, false) && (oldValue == nil || !bytes.Equal(oldValue.Value.Raw, value.Value.Raw)) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("value"),
+				fmt.Sprintf("variable %q is sensitive and its value must be set using valueFrom", value.Name)))
+			continue
+		}
+
 		// Values must be valid according to the schema in their definition.
 		allErrs = append(allErrs, ValidateClusterVariable(
 			ctx,
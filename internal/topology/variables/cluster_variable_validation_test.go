@@ -384,6 +384,108 @@ func Test_ValidateClusterVariables(t *testing.T) {
 			},
 			validateRequired: true,
 		},
+		// valueFrom and sensitive variables
+		{
+			name: "Pass for a sensitive variable set using valueFrom (the value is validated when computing patches)",
+			definitions: []clusterv1.ClusterClassStatusVariable{
+				{
+					Name: "token",
+					Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+						{
+							Required: ptr.To(true),
+							From:     clusterv1.VariableDefinitionFromInline,
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type:      "string",
+									MinLength: ptr.To[int64](8),
+								},
+								Sensitive: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{
+					Name: "token",
+					ValueFrom: clusterv1.ClusterVariableValueSource{
+						SecretKeyRef: clusterv1.ClusterVariableSecretKeyReference{Name: "credentials", Key: "token"},
+					},
+				},
+			},
+			validateRequired: true,
+		},
+		{
+			name: "Error if both value and valueFrom are set",
+			wantErrs: []validationMatch{
+				forbidden("Forbidden: value and valueFrom are mutually exclusive",
+					"spec.topology.variables[token].value"),
+			},
+			definitions: []clusterv1.ClusterClassStatusVariable{
+				{
+					Name: "token",
+					Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+						{
+							Required: ptr.To(true),
+							From:     clusterv1.VariableDefinitionFromInline,
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type:      "string",
+									MinLength: ptr.To[int64](8),
+								},
+								Sensitive: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{
+					Name: "token",
+					Value: apiextensionsv1.JSON{
+						Raw: []byte(`"s3cr3t-t0k3n"`),
+					},
+					ValueFrom: clusterv1.ClusterVariableValueSource{
+						SecretKeyRef: clusterv1.ClusterVariableSecretKeyReference{Name: "credentials", Key: "token"},
+					},
+				},
+			},
+			validateRequired: true,
+		},
+		{
+			name: "Error if the value of a sensitive variable is not set using valueFrom",
+			wantErrs: []validationMatch{
+				forbidden("Forbidden: variable \"token\" is sensitive and its value must be set using valueFrom",
+					"spec.topology.variables[token].value"),
+			},
+			definitions: []clusterv1.ClusterClassStatusVariable{
+				{
+					Name: "token",
+					Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+						{
+							Required: ptr.To(true),
+							From:     clusterv1.VariableDefinitionFromInline,
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type:      "string",
+									MinLength: ptr.To[int64](8),
+								},
+								Sensitive: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{
+					Name: "token",
+					Value: apiextensionsv1.JSON{
+						Raw: []byte(`"s3cr3t-t0k3n"`),
+					},
+				},
+			},
+			validateRequired: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
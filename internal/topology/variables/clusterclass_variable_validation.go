@@ -38,6 +38,7 @@ import (
 	celconfig "k8s.io/apiserver/pkg/apis/cel"
 	apiservercel "k8s.io/apiserver/pkg/cel"
 	"k8s.io/apiserver/pkg/cel/environment"
+	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
@@ -114,12 +115,25 @@ func validateClusterClassVariable(ctx context.Context, oldVariable, variable *cl
 	// Validate variable XMetadata.
 	allErrs = append(allErrs, validateClusterClassXVariableMetadata(&variable.Schema.OpenAPIV3Schema, fldPath.Child("schema", "openAPIV3Schema"))...)
 
+	// Validate sensitive.
+	allErrs = append(allErrs, validateClusterClassVariableSensitive(variable, fldPath.Child("schema"))...)
+
 	// Validate schema.
 	allErrs = append(allErrs, validateRootSchema(ctx, oldVariable, variable, fldPath.Child("schema", "openAPIV3Schema"))...)
 
 	return allErrs
 }
 
+// validateClusterClassVariableSensitive validates a sensitive variable.
+// Note: Sensitive variables cannot have a default value, because defaulting would persist the value in the Cluster.
+func validateClusterClassVariableSensitive(variable *clusterv1.ClusterClassVariable, fldPath *field.Path) field.ErrorList {
+	if !ptr.Deref(variable.Schema.Sensitive, false) || variable.Schema.OpenAPIV3Schema.Default == nil {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath.Child("openAPIV3Schema", "default"),
+		fmt.Sprintf("variable %q is sensitive and it cannot have a default value", variable.Name))}
+}
+
 // validateClusterClassVariableName validates a variable name.
 func validateClusterClassVariableName(variableName string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -389,6 +389,37 @@ func Test_ValidateClusterClassVariable(t *testing.T) {
 				},
 			},
 		},
+		// Sensitive variables
+		{
+			name: "Valid sensitive string schema",
+			clusterClassVariable: &clusterv1.ClusterClassVariable{
+				Name: "token",
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type:      "string",
+						MinLength: ptr.To[int64](1),
+					},
+					Sensitive: ptr.To(true),
+				},
+			},
+		},
+		{
+			name: "fail on sensitive variable with a default value",
+			clusterClassVariable: &clusterv1.ClusterClassVariable{
+				Name: "token",
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type:    "string",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"t0k3n"`)},
+					},
+					Sensitive: ptr.To(true),
+				},
+			},
+			wantErrs: []validationMatch{
+				forbidden("variable \"token\" is sensitive and it cannot have a default value",
+					"spec.variables[token].schema.openAPIV3Schema.default"),
+			},
+		},
 		// Variable names
 		{
 			name: "fail on variable name is builtin",
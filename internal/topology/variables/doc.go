@@ -14,5 +14,6 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package variables implements validation and defaulting for ClusterClass variables.
+// Package variables implements validation and defaulting for ClusterClass variables
+// and the CEL expressions referencing them in ClusterClass patches.
 package variables
@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variables
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	pkgerrors "github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel/model"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/version"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	apiservercel "k8s.io/apiserver/pkg/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// PatchExpression is a compiled CEL expression used in a ClusterClass inline patch,
+// i.e. a patch enabledIfExpression or a JSON patch valueFrom.expression.
+type PatchExpression struct {
+	program celgo.Program
+
+	// identifiers maps variable names to the CEL identifiers they are declared with.
+	identifiers map[string]string
+	// schemas contains the structural schemas of the variables, if any.
+	// Variables without a schema (e.g. builtin) are passed to CEL as dynamic values.
+	schemas map[string]*structuralschema.Structural
+}
+
+// CompilePatchExpression parses and type-checks a CEL expression used in a ClusterClass inline patch.
+// The builtin variable and the variables in definitions are in scope by name. Variable names which
+// are not valid CEL identifiers are escaped the same way as field names in CRD validation rules,
+// e.g. the variable "http-proxy" can be referenced as "http__dash__proxy".
+// Variables are typed according to their schema, the builtin variable is a dynamic value.
+// If outputType is set, the expression must evaluate to a value of that type.
+// NOTE: environment.NewExpressions should be used when validating a ClusterClass, while
+// environment.StoredExpressions should be used when evaluating expressions of an existing ClusterClass.
+func CompilePatchExpression(expression string, definitions []clusterv1.ClusterClassVariable, outputType *celgo.Type, envType environment.Type) (*PatchExpression, error) {
+	e := &PatchExpression{
+		identifiers: map[string]string{builtinsName: builtinsName},
+		schemas:     map[string]*structuralschema.Structural{},
+	}
+
+	envOptions := []celgo.EnvOption{
+		celgo.Variable(builtinsName, celgo.DynType),
+	}
+	declTypes := []*apiservercel.DeclType{}
+	for _, definition := range definitions {
+		identifier, ok := apiservercel.Escape(definition.Name)
+		if !ok || definition.Name == builtinsName {
+			continue
+		}
+
+		ss, declType, err := patchExpressionVariableType(identifier, definition)
+		if err != nil {
+			return nil, err
+		}
+		e.identifiers[definition.Name] = identifier
+		if ss != nil {
+			e.schemas[definition.Name] = ss
+		}
+		envOptions = append(envOptions, celgo.Variable(identifier, declType.CelType()))
+		declTypes = append(declTypes, declType)
+	}
+
+	envSet, err := environment.MustBaseEnvSet(envSetVersion).Extend(
+		environment.VersionedOptions{
+			// Variables should always be present, independent of the compatibility version.
+			IntroducedVersion: version.MajorMinor(1, 0),
+			EnvOptions:        envOptions,
+			DeclTypes:         declTypes,
+		},
+	)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create CEL environment")
+	}
+	env, err := envSet.Env(envType)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create CEL environment")
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, pkgerrors.Errorf("failed to compile expression: %v", issues.Err())
+	}
+	if outputType != nil && !ast.OutputType().IsExactType(celgo.DynType) && !ast.OutputType().IsExactType(outputType) {
+		return nil, pkgerrors.Errorf("expression must evaluate to %s, got %s", outputType, ast.OutputType())
+	}
+
+	// Note: k/k CRD validation also uses celconfig.PerCallLimit when evaluating validation rules.
+	// The current PerCallLimit gives roughly 0.1 second for each expression evaluation.
+	e.program, err = env.Program(ast,
+		celgo.CostLimit(celconfig.PerCallLimit),
+		celgo.InterruptCheckFrequency(celconfig.CheckFrequency),
+	)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create CEL program")
+	}
+	return e, nil
+}
+
+// patchExpressionVariableType returns the structural schema and the CEL type of a variable.
+func patchExpressionVariableType(identifier string, definition clusterv1.ClusterClassVariable) (*structuralschema.Structural, *apiservercel.DeclType, error) {
+	// Values of variables preserving unknown fields can't be typed, so they are passed to CEL as dynamic values.
+	if ptr.Deref(definition.Schema.OpenAPIV3Schema.XPreserveUnknownFields, false) {
+		return nil, apiservercel.DynType, nil
+	}
+
+	apiExtensionsSchema, allErrs := convertToAPIExtensionsJSONSchemaProps(&definition.Schema.OpenAPIV3Schema, field.NewPath("schema"))
+	if len(allErrs) > 0 {
+		return nil, nil, pkgerrors.Wrapf(allErrs.ToAggregate(), "failed to convert schema definition for variable %q", definition.Name)
+	}
+	ss, err := structuralschema.NewStructural(apiExtensionsSchema)
+	if err != nil {
+		return nil, nil, pkgerrors.Wrapf(err, "failed to create structural schema for variable %q", definition.Name)
+	}
+
+	declType := model.SchemaDeclType(ss, false)
+	if declType == nil {
+		return nil, apiservercel.DynType, nil
+	}
+	return ss, declType.MaybeAssignTypeName(fmt.Sprintf("variables.%s", identifier)), nil
+}
+
+// EvalBool evaluates the expression and returns its boolean result.
+func (e *PatchExpression) EvalBool(ctx context.Context, variables map[string]apiextensionsv1.JSON) (bool, error) {
+	out, err := e.eval(ctx, variables)
+	if err != nil {
+		return false, err
+	}
+	b, ok := out.(types.Bool)
+	if !ok {
+		return false, pkgerrors.Errorf("expression must evaluate to bool, got %s", out.Type().TypeName())
+	}
+	return bool(b), nil
+}
+
+// EvalJSON evaluates the expression and returns its result as JSON.
+func (e *PatchExpression) EvalJSON(ctx context.Context, variables map[string]apiextensionsv1.JSON) (*apiextensionsv1.JSON, error) {
+	out, err := e.eval(ctx, variables)
+	if err != nil {
+		return nil, err
+	}
+	value, err := celValueToJSONValue(out)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to convert expression result")
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to marshal expression result")
+	}
+	return &apiextensionsv1.JSON{Raw: raw}, nil
+}
+
+func (e *PatchExpression) eval(ctx context.Context, variables map[string]apiextensionsv1.JSON) (ref.Val, error) {
+	activation := map[string]interface{}{}
+	for name, identifier := range e.identifiers {
+		variable, ok := variables[name]
+		if !ok {
+			// Variables which are not set are not added to the activation. The evaluation
+			// fails if the expression references them.
+			continue
+		}
+
+		// Note: utiljson.Unmarshal converts whole numbers to int64, which is what CEL expects for integers.
+		var value interface{}
+		if err := utiljson.Unmarshal(variable.Raw, &value); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to unmarshal variable %q", name)
+		}
+		if ss, ok := e.schemas[name]; ok {
+			activation[identifier] = cel.UnstructuredToVal(value, ss)
+			continue
+		}
+		activation[identifier] = types.DefaultTypeAdapter.NativeToValue(value)
+	}
+
+	out, _, err := e.program.ContextEval(ctx, activation)
+	if err != nil {
+		return nil, pkgerrors.Errorf("failed to evaluate expression: %v", err)
+	}
+	return out, nil
+}
+
+// celValueToJSONValue converts a CEL value to a value which can be marshalled to JSON.
+func celValueToJSONValue(v ref.Val) (interface{}, error) {
+	switch val := v.(type) {
+	case types.Null:
+		return nil, nil
+	case types.Bool:
+		return bool(val), nil
+	case types.Int:
+		return int64(val), nil
+	case types.Uint:
+		return uint64(val), nil
+	case types.Double:
+		return float64(val), nil
+	case types.String:
+		return string(val), nil
+	case traits.Mapper:
+		out := map[string]interface{}{}
+		it := val.Iterator()
+		for it.HasNext() == types.True {
+			key := it.Next()
+			k, ok := key.(types.String)
+			if !ok {
+				return nil, pkgerrors.Errorf("map keys must be strings, got %s", key.Type().TypeName())
+			}
+			elem, err := celValueToJSONValue(val.Get(key))
+			if err != nil {
+				return nil, err
+			}
+			out[string(k)] = elem
+		}
+		return out, nil
+	case traits.Lister:
+		out := []interface{}{}
+		it := val.Iterator()
+		for it.HasNext() == types.True {
+			elem, err := celValueToJSONValue(it.Next())
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, elem)
+		}
+		return out, nil
+	default:
+		return nil, pkgerrors.Errorf("unsupported value type %s", v.Type().TypeName())
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variables
+
+import (
+	"testing"
+
+	celgo "github.com/google/cel-go/cel"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiserver/pkg/cel/environment"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestCompilePatchExpression(t *testing.T) {
+	definitions := []clusterv1.ClusterClassVariable{
+		{
+			Name: "cpu",
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "integer"},
+			},
+		},
+		{
+			Name: "http-proxy",
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]clusterv1.JSONSchemaProps{
+						"enabled": {Type: "boolean"},
+						"url":     {Type: "string"},
+					},
+				},
+			},
+		},
+		{
+			Name: "arbitrary",
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type:                   "object",
+					XPreserveUnknownFields: ptr.To(true),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		outputType *celgo.Type
+		wantErr    bool
+	}{
+		{
+			name:       "pass with integer variable",
+			expression: `cpu * 2`,
+		},
+		{
+			name:       "pass with escaped variable name",
+			expression: `http__dash__proxy.enabled`,
+			outputType: celgo.BoolType,
+		},
+		{
+			name:       "pass with builtin variable",
+			expression: `builtin.cluster.name`,
+			outputType: celgo.BoolType, // builtin is dynamic, so its type is only checked on evaluation.
+		},
+		{
+			name:       "pass with variable preserving unknown fields",
+			expression: `arbitrary.foo.bar`,
+		},
+		{
+			name:       "fail with invalid syntax",
+			expression: `cpu *`,
+			wantErr:    true,
+		},
+		{
+			name:       "fail with undeclared variable",
+			expression: `memory`,
+			wantErr:    true,
+		},
+		{
+			name:       "fail with field not defined in schema",
+			expression: `http__dash__proxy.noProxy`,
+			wantErr:    true,
+		},
+		{
+			name:       "fail with mismatching types",
+			expression: `cpu + "1"`,
+			wantErr:    true,
+		},
+		{
+			name:       "fail with wrong output type",
+			expression: `cpu`,
+			outputType: celgo.BoolType,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := CompilePatchExpression(tt.expression, definitions, tt.outputType, environment.NewExpressions)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestPatchExpressionEval(t *testing.T) {
+	definitions := []clusterv1.ClusterClassVariable{
+		{
+			Name: "cpu",
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "integer"},
+			},
+		},
+		{
+			Name: "tags",
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type:  "array",
+					Items: &clusterv1.JSONSchemaProps{Type: "string"},
+				},
+			},
+		},
+	}
+	variables := map[string]apiextensionsv1.JSON{
+		"builtin": {Raw: []byte(`{"cluster":{"name":"cluster1"},"controlPlane":{"replicas":3}}`)},
+		"cpu":     {Raw: []byte(`4`)},
+		"tags":    {Raw: []byte(`["a","b"]`)},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "integer arithmetic",
+			expression: `cpu * builtin.controlPlane.replicas`,
+			want:       `12`,
+		},
+		{
+			name:       "string",
+			expression: `builtin.cluster.name + "-lb"`,
+			want:       `"cluster1-lb"`,
+		},
+		{
+			name:       "list",
+			expression: `tags.map(t, t.upperAscii())`,
+			want:       `["A","B"]`,
+		},
+		{
+			name:       "map",
+			expression: `{"name": string(builtin.cluster.name), "tags": tags.join(",")}`,
+			want:       `{"name":"cluster1","tags":"a,b"}`,
+		},
+		{
+			name:       "map with mixed value types",
+			expression: `{"name": dyn(builtin.cluster.name), "cpu": dyn(cpu)}`,
+			want:       `{"cpu":4,"name":"cluster1"}`,
+		},
+		{
+			name:       "null",
+			expression: `null`,
+			want:       `null`,
+		},
+		{
+			name:       "fail on runtime error",
+			expression: `builtin.cluster.doesNotExist`,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			e, err := CompilePatchExpression(tt.expression, definitions, nil, environment.StoredExpressions)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got, err := e.EvalJSON(t.Context(), variables)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(got.Raw)).To(Equal(tt.want))
+		})
+	}
+
+	t.Run("EvalBool", func(t *testing.T) {
+		g := NewWithT(t)
+
+		e, err := CompilePatchExpression(`cpu > 2 && "a" in tags`, definitions, celgo.BoolType, environment.StoredExpressions)
+		g.Expect(err).ToNot(HaveOccurred())
+		got, err := e.EvalBool(t.Context(), variables)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(BeTrue())
+
+		// Expressions on dynamic values are only checked to return a bool on evaluation.
+		e, err = CompilePatchExpression(`builtin.cluster.name`, definitions, celgo.BoolType, environment.StoredExpressions)
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = e.EvalBool(t.Context(), variables)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
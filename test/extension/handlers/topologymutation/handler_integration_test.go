@@ -516,3 +516,7 @@ func (i injectRuntimeClient) Unregister(_ *runtimev1.ExtensionConfig) error {
 func (i injectRuntimeClient) CallAllExtensions(_ context.Context, _ runtimecatalog.Hook, _ client.Object, _ runtimehooksv1.RequestObject, _ runtimehooksv1.ResponseObject) error {
 	panic("implement me")
 }
+
+func (i injectRuntimeClient) GetExtensionHandlerCallStates(_ *runtimev1.ExtensionConfig) []runtimeclient.ExtensionHandlerCallState {
+	panic("implement me")
+}
@@ -138,7 +138,9 @@ func WaitForClusterResourceSetToApplyResources(ctx context.Context, input WaitFo
 			var configSource client.Object
 
 			switch resource.Kind {
-			case string(addonsv1.SecretClusterResourceSetResourceKind):
+			case string(addonsv1.SecretClusterResourceSetResourceKind),
+				string(addonsv1.ManifestArchiveClusterResourceSetResourceKind),
+				string(addonsv1.HelmChartClusterResourceSetResourceKind):
 				configSource = &corev1.Secret{}
 			case string(addonsv1.ConfigMapClusterResourceSetResourceKind):
 				configSource = &corev1.ConfigMap{}
@@ -45,6 +45,7 @@ require (
 require (
 	github.com/clipperhouse/displaywidth v0.10.0 // indirect
 	github.com/clipperhouse/uax29/v2 v2.6.0 // indirect
+	github.com/robfig/cron/v3 v3.0.1 // indirect
 )
 
 require (
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
+	certutil "k8s.io/client-go/util/cert"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -241,6 +242,20 @@ func generateKubeconfig(ctx context.Context, c client.Client, clusterName client
 		return nil, pkgerrors.Wrap(err, "failed to generate a kubeconfig")
 	}
 
+	// Trust all the certificates in the CA bundle, e.g. both the old and the new CA while the cluster CA is being rotated;
+	// the first certificate is the one used for signing.
+	caCerts, err := certutil.ParseCertsPEM(clusterCA.Data[secret.TLSCrtDataName])
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to decode CA Cert bundle")
+	}
+	if len(caCerts) > 1 {
+		caBundle := []byte{}
+		for _, c := range caCerts {
+			caBundle = append(caBundle, certs.EncodeCertPEM(c)...)
+		}
+		cfg.Clusters[clusterName.Name].CertificateAuthorityData = caBundle
+	}
+
 	out, err := clientcmd.Write(*cfg)
 	if err != nil {
 		return nil, pkgerrors.Wrap(err, "failed to serialize config to yaml")
@@ -472,3 +472,44 @@ func TestRegenerateClientCerts(t *testing.T) {
 
 	g.Expect(newCert.NotAfter).To(BeTemporally(">", oldCert.NotAfter))
 }
+
+func TestRegenerateSecretWithCABundle(t *testing.T) {
+	g := NewWithT(t)
+	caKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	caCert, err := getTestCACert(caKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	oldCAKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	oldCACert, err := getTestCACert(oldCAKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// The CA used for signing is the first one in the bundle.
+	caBundle := append(certs.EncodeCertPEM(caCert), certs.EncodeCertPEM(oldCACert)...)
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test1-ca",
+			Namespace: "test",
+		},
+		Data: map[string][]byte{
+			secret.TLSKeyDataName: certs.EncodePrivateKeyPEM(caKey),
+			secret.TLSCrtDataName: caBundle,
+		},
+	}
+
+	configSecret := validSecret.DeepCopy()
+	c := fake.NewClientBuilder().WithObjects(configSecret, caSecret).Build()
+
+	g.Expect(RegenerateSecret(ctx, c, configSecret)).To(Succeed())
+
+	newSecret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, util.ObjectKey(configSecret), newSecret)).To(Succeed())
+	newConfig, err := clientcmd.Load(newSecret.Data[secret.KubeconfigDataName])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(newConfig.Clusters["test1"].CertificateAuthorityData).To(Equal(caBundle))
+
+	newCert, err := certs.DecodeCertPEM(newConfig.AuthInfos["test1-admin"].ClientCertificateData)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(newCert.CheckSignatureFrom(caCert)).To(Succeed())
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package secret
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/rand"
@@ -24,6 +25,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/hex"
+	"encoding/pem"
 	"math/big"
 	"path"
 	"strings"
@@ -424,21 +426,132 @@ func (c *Certificate) Generate() error {
 		return nil
 	}
 
+	kp, err := c.generateKeyPair()
+	if err != nil {
+		return err
+	}
+	c.KeyPair = kp
+	c.Generated = true
+
+	return nil
+}
+
+func (c *Certificate) generateKeyPair() (*certs.KeyPair, error) {
 	generator := generateCACert
 	if c.Purpose == ServiceAccount {
 		generator = generateServiceAccountKeys
 	}
+	return generator(c.ValidityPeriodDays, c.KeyEncryptionAlgorithm)
+}
+
+// AddNextKeyPair generates the key pair the certificate is going to be rotated to, and adds its certificate (or public key
+// for the service account keys) to the trust bundle stored in the secret, after the current one; the private key is stored
+// in the NextTLSKeyDataName key, while the current key pair is still used for signing.
+// The certificate must have been looked up before calling this func, and the caller is responsible for updating the secret.
+// It returns false if the next key pair already exists.
+func (c *Certificate) AddNextKeyPair() (bool, error) {
+	if err := c.ensureRotatable(); err != nil {
+		return false, err
+	}
+	if _, ok := c.Secret.Data[NextTLSKeyDataName]; ok {
+		return false, nil
+	}
 
-	kp, err := generator(c.ValidityPeriodDays, c.KeyEncryptionAlgorithm)
+	kp, err := c.generateKeyPair()
 	if err != nil {
-		return err
+		return false, pkgerrors.Wrapf(err, "failed to generate the next key pair for certificate %s", c.Purpose)
+	}
+
+	c.setKeyPair(bytes.Join(append(pemBlocks(c.KeyPair.Cert), kp.Cert), nil), c.KeyPair.Key)
+	c.Secret.Data[NextTLSKeyDataName] = kp.Key
+	return true, nil
+}
+
+// SwitchToNextKeyPair uses the key pair added by AddNextKeyPair for signing, by moving its certificate (or public key)
+// to the top of the trust bundle and by replacing the current private key; the certificates of the previous key pairs
+// are still trusted.
+// The certificate must have been looked up before calling this func, and the caller is responsible for updating the secret.
+// It returns false if there is no next key pair, e.g. because it is already used for signing.
+func (c *Certificate) SwitchToNextKeyPair() (bool, error) {
+	if err := c.ensureRotatable(); err != nil {
+		return false, err
+	}
+	nextKey, ok := c.Secret.Data[NextTLSKeyDataName]
+	if !ok {
+		return false, nil
+	}
+
+	blocks := pemBlocks(c.KeyPair.Cert)
+	if len(blocks) < 2 {
+		return false, pkgerrors.Errorf("failed to switch to the next key pair for certificate %s: the next key pair is not in the trust bundle", c.Purpose)
+	}
+	next := blocks[len(blocks)-1]
+	previous := blocks[:len(blocks)-1]
+
+	c.setKeyPair(bytes.Join(append([][]byte{next}, previous...), nil), nextKey)
+	delete(c.Secret.Data, NextTLSKeyDataName)
+	return true, nil
+}
+
+// RemovePreviousKeyPairs removes from the trust bundle all the certificates (or public keys) except the one of the
+// key pair used for signing.
+// The certificate must have been looked up before calling this func, and the caller is responsible for updating the secret.
+// It returns false if there are no previous key pairs in the trust bundle.
+func (c *Certificate) RemovePreviousKeyPairs() (bool, error) {
+	if err := c.ensureRotatable(); err != nil {
+		return false, err
+	}
+	if _, ok := c.Secret.Data[NextTLSKeyDataName]; ok {
+		return false, pkgerrors.Errorf("failed to remove the previous key pairs for certificate %s: the next key pair is not used for signing yet", c.Purpose)
 	}
-	c.KeyPair = kp
-	c.Generated = true
 
+	blocks := pemBlocks(c.KeyPair.Cert)
+	if len(blocks) < 2 {
+		return false, nil
+	}
+
+	c.setKeyPair(blocks[0], c.KeyPair.Key)
+	return true, nil
+}
+
+func (c *Certificate) ensureRotatable() error {
+	if c.External {
+		return pkgerrors.Errorf("external certificate %s cannot be rotated", c.Purpose)
+	}
+	if c.KeyPair == nil || c.Secret == nil {
+		return pkgerrors.Wrapf(ErrMissingCertificate, "for certificate: %s", c.Purpose)
+	}
+	if len(c.KeyPair.Key) == 0 {
+		return pkgerrors.Wrapf(ErrMissingKey, "for certificate: %s", c.Purpose)
+	}
 	return nil
 }
 
+func (c *Certificate) setKeyPair(cert, key []byte) {
+	c.KeyPair = &certs.KeyPair{
+		Cert: cert,
+		Key:  key,
+	}
+	if c.Secret.Data == nil {
+		c.Secret.Data = map[string][]byte{}
+	}
+	c.Secret.Data[TLSCrtDataName] = cert
+	c.Secret.Data[TLSKeyDataName] = key
+}
+
+// pemBlocks splits PEM encoded data, e.g. a bundle of certificates, into PEM encoded blocks.
+func pemBlocks(data []byte) [][]byte {
+	var blocks [][]byte
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return blocks
+		}
+		blocks = append(blocks, pem.EncodeToMemory(block))
+	}
+}
+
 // AsFiles converts a slice of certificates into bootstrap files.
 func (c Certificates) AsFiles() []bootstrapv1.File {
 	certFiles := make([]bootstrapv1.File, 0)
@@ -17,10 +17,14 @@ limitations under the License.
 package secret_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	"sigs.k8s.io/cluster-api/util/certs"
@@ -95,3 +99,79 @@ func TestNewCertificatesForInitialControlPlane(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateRotation(t *testing.T) {
+	for _, purpose := range []secret.Purpose{secret.ClusterCA, secret.ServiceAccount} {
+		t.Run(string(purpose), func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterCerts := secret.NewCertificatesForInitialControlPlane(&bootstrapv1.ClusterConfiguration{})
+			g.Expect(clusterCerts.Generate()).To(Succeed())
+			c := clusterCerts.GetByPurpose(purpose)
+			c.Secret = c.AsSecret(client.ObjectKey{Namespace: "default", Name: "test"}, metav1.OwnerReference{})
+			oldCert, oldKey := c.KeyPair.Cert, c.KeyPair.Key
+
+			// Removing previous key pairs is a no-op when there is only one key pair.
+			changed, err := c.RemovePreviousKeyPairs()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(changed).To(BeFalse())
+
+			// Add the next key pair to the trust bundle.
+			changed, err = c.AddNextKeyPair()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(changed).To(BeTrue())
+			g.Expect(pemBlockCount(c.KeyPair.Cert)).To(Equal(2))
+			g.Expect(bytes.HasPrefix(c.KeyPair.Cert, oldCert)).To(BeTrue())
+			g.Expect(c.KeyPair.Key).To(Equal(oldKey))
+			g.Expect(c.Secret.Data[secret.TLSCrtDataName]).To(Equal(c.KeyPair.Cert))
+			nextKey := c.Secret.Data[secret.NextTLSKeyDataName]
+			g.Expect(nextKey).ToNot(BeEmpty())
+			nextCert := bytes.TrimPrefix(c.KeyPair.Cert, oldCert)
+
+			changed, err = c.AddNextKeyPair()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(changed).To(BeFalse())
+
+			// Previous key pairs cannot be removed before switching to the next key pair.
+			_, err = c.RemovePreviousKeyPairs()
+			g.Expect(err).To(HaveOccurred())
+
+			// Switch to the next key pair.
+			changed, err = c.SwitchToNextKeyPair()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(changed).To(BeTrue())
+			g.Expect(c.KeyPair.Cert).To(Equal(append(append([]byte{}, nextCert...), oldCert...)))
+			g.Expect(c.KeyPair.Key).To(Equal(nextKey))
+			g.Expect(c.Secret.Data[secret.TLSKeyDataName]).To(Equal(nextKey))
+			g.Expect(c.Secret.Data).ToNot(HaveKey(secret.NextTLSKeyDataName))
+
+			changed, err = c.SwitchToNextKeyPair()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(changed).To(BeFalse())
+
+			// Remove the previous key pair from the trust bundle.
+			changed, err = c.RemovePreviousKeyPairs()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(changed).To(BeTrue())
+			g.Expect(c.KeyPair.Cert).To(Equal(nextCert))
+			g.Expect(c.Secret.Data[secret.TLSCrtDataName]).To(Equal(nextCert))
+			g.Expect(c.KeyPair.Key).To(Equal(nextKey))
+		})
+	}
+}
+
+func TestCertificateRotationFailsWithoutKey(t *testing.T) {
+	g := NewWithT(t)
+
+	c := &secret.Certificate{
+		Purpose: secret.ClusterCA,
+		KeyPair: &certs.KeyPair{Cert: []byte("cert")},
+		Secret:  &corev1.Secret{},
+	}
+	_, err := c.AddNextKeyPair()
+	g.Expect(err).To(MatchError(ContainSubstring(secret.ErrMissingKey.Error())))
+}
+
+func pemBlockCount(data []byte) int {
+	return bytes.Count(data, []byte("-----BEGIN "))
+}
@@ -27,6 +27,10 @@ const (
 
 	// TLSCrtDataName is the key used to store a TLS certificate in the secret's data field.
 	TLSCrtDataName = "tls.crt"
+
+	// NextTLSKeyDataName is the key used to store, in the secret's data field, the private key of the key pair
+	// a certificate authority or the service account keys are being rotated to.
+	NextTLSKeyDataName = "next-tls.key"
 )
 
 // Purpose is the name to append to the secret generated for a cluster.
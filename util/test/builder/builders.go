@@ -283,6 +283,7 @@ type MachinePoolTopologyBuilder struct {
 	replicas       *int32
 	failureDomains []string
 	variables      []clusterv1.ClusterVariable
+	mhc            clusterv1.MachinePoolTopologyHealthCheck
 }
 
 // MachinePoolTopology returns a builder used to create a testable MachinePoolTopology.
@@ -316,6 +317,12 @@ func (m *MachinePoolTopologyBuilder) WithVariables(variables ...clusterv1.Cluste
 	return m
 }
 
+// WithMachineHealthCheck adds MachinePoolTopologyHealthCheck used as the MachineHealthCheck value.
+func (m *MachinePoolTopologyBuilder) WithMachineHealthCheck(mhc clusterv1.MachinePoolTopologyHealthCheck) *MachinePoolTopologyBuilder {
+	m.mhc = mhc
+	return m
+}
+
 // Build returns a testable MachinePoolTopology with any values passed to the builder.
 func (m *MachinePoolTopologyBuilder) Build() clusterv1.MachinePoolTopology {
 	mp := clusterv1.MachinePoolTopology{
@@ -323,6 +330,7 @@ func (m *MachinePoolTopologyBuilder) Build() clusterv1.MachinePoolTopology {
 		Name:           m.name,
 		Replicas:       m.replicas,
 		FailureDomains: m.failureDomains,
+		HealthCheck:    m.mhc,
 	}
 
 	if len(m.variables) > 0 {
@@ -353,6 +361,7 @@ type ClusterClassBuilder struct {
 	infraClusterNaming                        *clusterv1.InfrastructureClassNamingSpec
 	machineDeploymentClasses                  []clusterv1.MachineDeploymentClass
 	machinePoolClasses                        []clusterv1.MachinePoolClass
+	auxiliaryResources                        []clusterv1.AuxiliaryResourceClass
 	variables                                 []clusterv1.ClusterClassVariable
 	statusVariables                           []clusterv1.ClusterClassStatusVariable
 	patches                                   []clusterv1.ClusterClassPatch
@@ -491,6 +500,12 @@ func (c *ClusterClassBuilder) WithWorkerMachinePoolClasses(mpcs ...clusterv1.Mac
 	return c
 }
 
+// WithAuxiliaryResources adds the auxiliary resources to the ClusterClassBuilder.
+func (c *ClusterClassBuilder) WithAuxiliaryResources(auxiliaryResources ...clusterv1.AuxiliaryResourceClass) *ClusterClassBuilder {
+	c.auxiliaryResources = append(c.auxiliaryResources, auxiliaryResources...)
+	return c
+}
+
 // WithVersions sets versions in the ClusterClass.
 func (c *ClusterClassBuilder) WithVersions(versions ...string) *ClusterClassBuilder {
 	c.versions = versions
@@ -557,6 +572,7 @@ func (c *ClusterClassBuilder) Build() *clusterv1.ClusterClass {
 
 	obj.Spec.Workers.MachineDeployments = c.machineDeploymentClasses
 	obj.Spec.Workers.MachinePools = c.machinePoolClasses
+	obj.Spec.AuxiliaryResources = c.auxiliaryResources
 	obj.Spec.KubernetesVersions = c.versions
 	return obj
 }
@@ -734,6 +750,7 @@ type MachinePoolClassBuilder struct {
 	nodeDeletionTimeout               *int32
 	minReadySeconds                   *int32
 	naming                            *clusterv1.MachinePoolClassNamingSpec
+	machineHealthCheckClass           clusterv1.MachinePoolClassHealthCheck
 }
 
 // MachinePoolClass returns a MachinePoolClassBuilder with the given name and namespace.
@@ -803,6 +820,12 @@ func (m *MachinePoolClassBuilder) WithNaming(n *clusterv1.MachinePoolClassNaming
 	return m
 }
 
+// WithMachineHealthCheckClass sets the MachineHealthCheckClass for the MachinePoolClassBuilder.
+func (m *MachinePoolClassBuilder) WithMachineHealthCheckClass(mhc clusterv1.MachinePoolClassHealthCheck) *MachinePoolClassBuilder {
+	m.machineHealthCheckClass = mhc
+	return m
+}
+
 // Build creates a full MachinePoolClass object with the variables passed to the MachinePoolClassBuilder.
 func (m *MachinePoolClassBuilder) Build() *clusterv1.MachinePoolClass {
 	obj := &clusterv1.MachinePoolClass{
@@ -836,6 +859,7 @@ func (m *MachinePoolClassBuilder) Build() *clusterv1.MachinePoolClass {
 	if m.naming != nil {
 		obj.Naming = *m.naming
 	}
+	obj.HealthCheck = m.machineHealthCheckClass
 	return obj
 }
 
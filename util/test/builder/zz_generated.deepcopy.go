@@ -186,6 +186,13 @@ func (in *ClusterClassBuilder) DeepCopyInto(out *ClusterClassBuilder) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.auxiliaryResources != nil {
+		in, out := &in.auxiliaryResources, &out.auxiliaryResources
+		*out = make([]v1beta2.AuxiliaryResourceClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.variables != nil {
 		in, out := &in.variables, &out.variables
 		*out = make([]v1beta2.ClusterClassVariable, len(*in))
@@ -794,6 +801,7 @@ func (in *MachinePoolClassBuilder) DeepCopyInto(out *MachinePoolClassBuilder) {
 		*out = new(v1beta2.MachinePoolClassNamingSpec)
 		**out = **in
 	}
+	in.machineHealthCheckClass.DeepCopyInto(&out.machineHealthCheckClass)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolClassBuilder.
@@ -826,6 +834,7 @@ func (in *MachinePoolTopologyBuilder) DeepCopyInto(out *MachinePoolTopologyBuild
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.mhc.DeepCopyInto(&out.mhc)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolTopologyBuilder.